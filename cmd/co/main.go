@@ -1,7 +1,7 @@
 package main
 
 import (
-	//"context"
+	"context"
 	"flag"
 	"fmt"
 	"net"
@@ -18,11 +18,15 @@ import (
 	"github.com/balaji-balu/margo-hello-world/pkg/logx"
 	"github.com/balaji-balu/margo-hello-world/pkg/co/model"
 	"github.com/balaji-balu/margo-hello-world/ent"
+	_ "github.com/balaji-balu/margo-hello-world/ent/runtime"
 	"github.com/balaji-balu/margo-hello-world/internal/api"
+	"github.com/balaji-balu/margo-hello-world/internal/api/handlers"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
 	"github.com/balaji-balu/margo-hello-world/internal/config"
 	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
 	"github.com/balaji-balu/margo-hello-world/internal/metrics"
 	"github.com/balaji-balu/margo-hello-world/internal/co"
+	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
 
 )
 
@@ -160,6 +164,26 @@ func main() {
 	//fmt.Printf("CONFIG: %+v\n", gitm.GetConfig("deployments"))	
 	c := co.NewCO(gitm, "app-registry", "deployments")
 
+	go handlers.WatchStaleSites(context.Background(), client, cfg.Sites.StaleAfter)
+	dispatcher := webhook.NewDispatcher(client)
+	dispatcher.Retention = streammanager.Retention{
+		MaxAge:   cfg.Events.MaxAge,
+		MaxCount: cfg.Events.MaxCount,
+	}.Or(dispatcher.Retention)
+	go dispatcher.Run(context.Background())
+
+	if cfg.Auth.Enabled {
+		created, password, err := handlers.BootstrapAdmin(context.Background(), client, os.Getenv("CO_ADMIN_PASSWORD"))
+		switch {
+		case err != nil:
+			log.Errorw("bootstrap admin", "err", err)
+		case created && os.Getenv("CO_ADMIN_PASSWORD") == "":
+			log.Warnw("created user admin; log in and change its password", "password", password)
+		case created:
+			log.Infow("created user admin with CO_ADMIN_PASSWORD")
+		}
+	}
+
 	router := api.NewRouter(client, c, cfg)
 	log.Infow("CO API running on :", "", cfg.Server.Port)
 	if err := router.Run(fmt.Sprintf(":%s", cfg.Server.Port)); err != nil {
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/application/validation"
+)
+
+func newAppCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app",
+		Short: "Work with application descriptions",
+	}
+	cmd.AddCommand(newAppLintCmd())
+	return cmd
+}
+
+func newAppLintCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lint <file>...",
+		Short: "Check application descriptions as the CO does when an app is added",
+		Example: `  edgectl app lint margo.yaml
+  edgectl app lint -o json apps/*/margo.yaml`,
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			found := map[string]validation.Errors{}
+			bad := 0
+			for _, file := range args {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				_, err = validation.Parse(data)
+				errs, ok := validation.AsErrors(err)
+				if err != nil && !ok {
+					// not YAML at all
+					errs = validation.Errors{{Path: "$", Message: err.Error()}}
+				}
+				found[file] = errs
+				bad += len(errs)
+			}
+
+			if output == "json" {
+				fmt.Println(pretty(found))
+			} else {
+				for _, file := range args {
+					for _, e := range found[file] {
+						fmt.Printf("%s:%d: %s: %s\n", file, e.Line, e.Path, e.Message)
+					}
+				}
+			}
+			if bad > 0 {
+				return fmt.Errorf("❌ %d problems in %d files", bad, len(args))
+			}
+			if output != "json" {
+				fmt.Println("✅ valid")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
+)
+
+func newCOAuditCmd() *cobra.Command {
+	var (
+		f       co.AuditFilter
+		since   string
+		until   string
+		export  string
+		outFile string
+	)
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List or export the CO's audit log of changes",
+		Example: `  edgectl co audit --actor alice --since 24h
+  edgectl co audit --result failure --export csv -f audit.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if f.Since, err = auditTime(since); err != nil {
+				return err
+			}
+			if f.Until, err = auditTime(until); err != nil {
+				return err
+			}
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+
+			if export != "" {
+				w := os.Stdout
+				if outFile != "" {
+					if w, err = os.Create(outFile); err != nil {
+						return err
+					}
+					defer w.Close()
+				}
+				if err := client.ExportAudit(f, export, w); err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				return nil
+			}
+
+			entries, next, err := client.Audit(f)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(entries))
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "ID\tTIME\tACTOR\tACTION\tTARGET\tRESULT\tSOURCE")
+			for _, e := range entries {
+				result := e.Result
+				if e.Error != "" {
+					result += fmt.Sprintf(" (%d: %s)", e.Status, e.Error)
+				}
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					e.ID, e.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+					e.Actor, e.Action, e.Target, result, e.SourceIP)
+			}
+			if err := tw.Flush(); err != nil {
+				return err
+			}
+			if next > 0 {
+				fmt.Printf("more: --before %d\n", next)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&f.Actor, "actor", "", "Only entries by this user")
+	cmd.Flags().StringVar(&f.Action, "action", "", "Only actions containing this, e.g. deployments")
+	cmd.Flags().StringVar(&f.Target, "target", "", "Only targets containing this, e.g. a deployment id")
+	cmd.Flags().StringVar(&f.Result, "result", "", "Only this result: success or failure")
+	cmd.Flags().StringVar(&since, "since", "", "Only entries since a time (RFC 3339) or duration ago, e.g. 24h")
+	cmd.Flags().StringVar(&until, "until", "", "Only entries before a time (RFC 3339) or duration ago")
+	cmd.Flags().IntVar(&f.Limit, "limit", 0, "How many entries to list (CO default 100)")
+	cmd.Flags().IntVar(&f.Before, "before", 0, "List entries before this id, for the next page")
+	cmd.Flags().StringVar(&export, "export", "", "Export every matching entry as csv or jsonl")
+	cmd.Flags().StringVarP(&outFile, "file", "f", "", "File to export to (default stdout)")
+	return cmd
+}
+
+// auditTime turns a time or a duration ago into RFC 3339.
+func auditTime(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d).UTC().Format(time.RFC3339), nil
+	}
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		return "", fmt.Errorf("%q is neither a time (RFC 3339) nor a duration", s)
+	}
+	return s, nil
+}
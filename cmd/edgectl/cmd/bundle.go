@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/lo"
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/util"
+	"github.com/balaji-balu/margo-hello-world/internal/bundle"
+)
+
+func newBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Carry deployments to air-gapped sites",
+	}
+	cmd.AddCommand(newBundleExportCmd(), newBundleImportCmd(), newBundleInspectCmd())
+	return cmd
+}
+
+func newBundleExportCmd() *cobra.Command {
+	var depFile, appFile, out string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write a deployment and every image and package it needs to a tarball",
+		Example: `  edgectl bundle export --deployment desiredstate.yaml --app margo.yaml \
+    --site site-a -f demo.tar`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dep, err := os.ReadFile(depFile)
+			if err != nil {
+				return err
+			}
+			var app []byte
+			if appFile != "" {
+				if app, err = os.ReadFile(appFile); err != nil {
+					return err
+				}
+			}
+
+			f, err := os.Create(out)
+			if err != nil {
+				return err
+			}
+			c, err := bundle.Export(context.Background(), f, dep, app, bundle.Options{Site: site})
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(out)
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Printf("✅ wrote %s: deployment %s, %d images, %d packages\n",
+				out, c.DeploymentID, len(c.Images), len(c.Packages))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&depFile, "deployment", "", "deployment (desiredstate.yaml) to bundle")
+	cmd.Flags().StringVar(&appFile, "app", "", "application description (margo.yaml) to include")
+	cmd.Flags().StringVarP(&out, "file", "f", "bundle.tar", "bundle to write")
+	cmd.MarkFlagRequired("deployment")
+	return cmd
+}
+
+func newBundleImportCmd() *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Load a bundle into the LO and deploy it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := util.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %v", err)
+			}
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			client := lo.NewClient(cfg.LocalOrchestrator.URL)
+			client.Token = cfg.LocalOrchestrator.Token
+			c, err := client.ImportBundle(f)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Printf("✅ imported deployment %v\n", c["deployment_id"])
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "bundle.tar", "bundle to import")
+	return cmd
+}
+
+func newBundleInspectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <bundle.tar>",
+		Short: "List what a bundle holds",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bundle.Read(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("deployment: %s\n", c.DeploymentID)
+			if c.Site != "" {
+				fmt.Printf("site:       %s\n", c.Site)
+			}
+			fmt.Printf("images:\n  %s\n", strings.Join(c.Images, "\n  "))
+			fmt.Printf("packages:\n  %s\n", strings.Join(c.Packages, "\n  "))
+			return nil
+		},
+	}
+}
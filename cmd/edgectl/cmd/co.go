@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"encoding/json"
+	"strings"
 	"sync"
 	"github.com/spf13/cobra"
 	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
@@ -24,6 +25,12 @@ func newCOCmd() *cobra.Command {
 		newCOAddAppCmd(),
 		newCODeleteAppCmd(),
 		newCODeploymentsCmd(),
+		newCORevisionsCmd(),
+		newCOWhoAmICmd(),
+		newCOTokensCmd(),
+		newCOAuditCmd(),
+		newCOWebhooksCmd(),
+		newCOEventsCmd(),
 	)
 
 	return cmd
@@ -58,7 +65,7 @@ func newCODeleteAppCmd() *cobra.Command {
                 return fmt.Errorf("⚠️  Failed to load config:", err)
             }
 
-            client := co.NewClient(cfg.Coordinator.URL)
+            client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token)
             if err := client.DeleteApp(
 				sel.Category, sel.App, sel.Version, artifact,
 			); err != nil {
@@ -94,8 +101,9 @@ func newCOAddAppCmd() *cobra.Command {
 				return err
 			}			
 
-			// only enforce version when adding
-			if sel.Version == "" {
+			// only enforce version when adding; an OCI package names
+			// itself
+			if sel.Version == "" && !strings.HasPrefix(artifact, "oci://") {
 				return fmt.Errorf("adding an app requires category/app/version")
 			}
 
@@ -104,7 +112,7 @@ func newCOAddAppCmd() *cobra.Command {
                 return fmt.Errorf("⚠️  Failed to load config:", err)
             }
 
-            client := co.NewClient(cfg.Coordinator.URL)
+            client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token)
             if err := client.AddApp(
 				sel.Category, sel.App, sel.Version, artifact,
 			); err != nil {
@@ -119,7 +127,7 @@ func newCOAddAppCmd() *cobra.Command {
 	// Add flags for the command
 	cmd.PersistentFlags().String("name", "", "Application name")
 	//cmd.PersistentFlags().String("vendor", "", "Vendor name")
-	cmd.PersistentFlags().String("artifact", "", "Artifact URL (OCI or Docker)")	
+	cmd.PersistentFlags().String("artifact", "", "Registry repo URL, or oci://<reference> of an app package")	
 
 	return cmd
 }
@@ -143,7 +151,7 @@ func newCOListCmd() *cobra.Command {
 					return //fmt.Errorf("failed to load config: %v", err)
 				}
 				// Trigger 
-				client := co.NewClient(cfg.Coordinator.URL) // or from config
+				client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token) // or from config
 
 				sel, err := util.ParseAppSelector(name)
 
@@ -166,13 +174,8 @@ func newCOListCmd() *cobra.Command {
 				}			
 			},
 		},
-		&cobra.Command{
-			Use:   "sites",
-			Short: "List registered sites",
-			Run: func(cmd *cobra.Command, args []string) {
-				fmt.Println("Listing sites ...")
-			},
-		},
+		newCOListSitesCmd(),
+		newCOListHostsCmd(),
 	)
 		// Add flags for the command
 	cmd.PersistentFlags().String("name", "", "category/app/version")
@@ -196,7 +199,7 @@ func newCODeployCmd() *cobra.Command {
 			sel, err := util.ParseAppSelector(app)
 
 			// Trigger 
-			client := co.NewClient(cfg.Coordinator.URL) // or from config
+			client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token) // or from config
 			resp, err := client.Deploy(site, sel.Category,sel.App, sel.Version, deploytype)
 			if err != nil {
 				return fmt.Errorf("failed to trigger deployment: %w", err)
@@ -284,7 +287,7 @@ func newCOStatusCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to load config: %v", err)
 			}			
-			client := co.NewClient(cfg.Coordinator.URL)
+			client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token)
 			//co := client.New(url)
 			h, err := client.Health()
 			if err != nil {
@@ -348,7 +351,7 @@ func newCODeploymentStatusCmd() *cobra.Command {
                 return fmt.Errorf("failed to load config: %v", err)
             }
 
-            client := co.NewClient(cfg.Coordinator.URL)
+            client := co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token)
             resp, err := client.DeploymentStatus(depID)
             if err != nil {
                 return fmt.Errorf("❌ %v", err)
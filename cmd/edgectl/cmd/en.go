@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"github.com/spf13/cobra"
 
 	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/lo"
@@ -35,6 +36,7 @@ func newENCmd() *cobra.Command {
 				return nil
 			},
 		},
+		newENLogsCmd(),
 		&cobra.Command{
 			Use:   "metrics",
 			Short: "Display node metrics",
@@ -47,3 +49,27 @@ func newENCmd() *cobra.Command {
 }
 
 
+
+func newENLogsCmd() *cobra.Command {
+	var (
+		follow bool
+		tail   int
+	)
+	cmd := &cobra.Command{
+		Use:   "logs <host> <component>",
+		Short: "Print a component's stdout/stderr, relayed through the LO",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := util.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %v", err)
+			}
+
+			client := lo.NewClient(cfg.LocalOrchestrator.URL)
+			return client.Logs(args[0], args[1], tail, follow, os.Stdout)
+		},
+	}
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream new lines as they are written")
+	cmd.Flags().IntVar(&tail, "tail", 100, "Number of recent lines to show (0 for all)")
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
+)
+
+func newCOEventsCmd() *cobra.Command {
+	var (
+		f     co.EventFilter
+		after int
+	)
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Follow the fleet's events: deployment state changes, hosts going down and coming back",
+		Example: `  edgectl co events --type host.*
+  edgectl co events --site plant-1 --after 0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			f.SiteID = site
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+
+			show := func(ev co.Event) {
+				if output == "json" {
+					b, _ := json.Marshal(ev)
+					fmt.Println(string(b))
+					return
+				}
+				about := ev.SiteID
+				if ev.DeploymentID != "" {
+					about = ev.DeploymentID + "@" + ev.SiteID
+				}
+				fmt.Printf("%d\t%s\t%-26s %s\t%s\n",
+					ev.ID, ev.Time.Local().Format("2006-01-02 15:04:05"), ev.Type, about, ev.Data)
+			}
+			last := after
+			for {
+				var dropped *co.Dropped
+				last, dropped, err = client.StreamEvents(ctx, f, last, show)
+				switch {
+				case ctx.Err() != nil:
+					return nil
+				case err != nil:
+					return fmt.Errorf("❌ %v", err)
+				case dropped != nil && dropped.Pruned:
+					fmt.Fprintf(os.Stderr, "⚠️  events after %d were pruned; resuming after %d\n",
+						last, dropped.LastEventID)
+					last = dropped.LastEventID
+				case dropped != nil:
+					// the CO kept them; pick up where the stream fell behind
+					fmt.Fprintf(os.Stderr, "⚠️  fell behind and missed %d events; resuming after %d\n",
+						dropped.Dropped, dropped.LastEventID)
+					last = dropped.LastEventID
+				default:
+					// the CO went away; resume once it is back
+					time.Sleep(2 * time.Second)
+				}
+			}
+		},
+	}
+	cmd.Flags().StringSliceVar(&f.Types, "type", nil, "Only events of this type, or prefix ending in .* (repeatable)")
+	cmd.Flags().StringVar(&f.DeploymentID, "deployment", "", "Only events of this deployment")
+	cmd.Flags().IntVar(&after, "after", -1, "Replay the events logged after this id first; 0 is the whole log")
+	return cmd
+}
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/util"
+)
+
+func newLoginCmd() *cobra.Command {
+	var username, url string
+	var passwordStdin bool
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in to the Coordinator and keep the token in the edgectl config",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := util.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %v", err)
+			}
+			if url != "" {
+				cfg.Coordinator.URL = url
+			}
+			if username == "" {
+				if username, err = prompt("Username: "); err != nil {
+					return err
+				}
+			}
+			var password string
+			if passwordStdin {
+				b, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return err
+				}
+				password = strings.TrimRight(string(b), "\r\n")
+			} else if password, err = promptPassword("Password: "); err != nil {
+				return err
+			}
+
+			host, _ := os.Hostname()
+			t, err := co.NewClient(cfg.Coordinator.URL).Login(username, password, "edgectl@"+host)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			cfg.Coordinator.Token = t.Token
+			if err := util.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %v", err)
+			}
+			expires := "never"
+			if t.ExpiresAt != nil {
+				expires = t.ExpiresAt.Local().Format("2006-01-02 15:04")
+			}
+			fmt.Printf("✅ logged in to %s as %s (token expires %s)\n", cfg.Coordinator.URL, t.User, expires)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&username, "username", "u", "", "Username (prompted for if not set)")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from stdin")
+	cmd.Flags().StringVar(&url, "url", "", "Coordinator URL to log in to and keep in the config")
+	return cmd
+}
+
+func newLogoutCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the Coordinator token from the edgectl config",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := util.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %v", err)
+			}
+			cfg.Coordinator.Token = ""
+			if err := util.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %v", err)
+			}
+			fmt.Println("✅ logged out; revoke the token with 'edgectl co tokens revoke' if it may have leaked")
+			return nil
+		},
+	}
+}
+
+func newCOWhoAmICmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Show who the Coordinator token acts for and its roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			p, err := client.WhoAmI()
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(p))
+				return nil
+			}
+			if p.Organization != "" {
+				fmt.Printf("%s (%s)\n", p.Username, p.Organization)
+			} else {
+				fmt.Println(p.Username)
+			}
+			for _, b := range p.Roles {
+				fmt.Printf("  %s on %s\n", b.Role, b.Scope)
+			}
+			if p.Limit != nil {
+				fmt.Printf("  token limited to %s on %s\n", p.Limit.Role, p.Limit.Scope)
+			}
+			return nil
+		},
+	}
+}
+
+func newCOTokensCmd() *cobra.Command {
+	var user string
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage API tokens, e.g. for CI or an LO",
+	}
+	cmd.PersistentFlags().StringVar(&user, "user", "", "Another user's tokens (admin only)")
+
+	var req co.TokenRequest
+	create := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Issue a token; it is shown once",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			req.Name = args[0]
+			t, err := client.CreateToken(user, req)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(t))
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "✅ token %s (%s) for %s; it will not be shown again:\n", t.Name, t.ID, t.User)
+			fmt.Println(t.Token)
+			return nil
+		},
+	}
+	create.Flags().StringVar(&req.Role, "role", "", "Limit the token to this role (viewer|deployer|admin)")
+	create.Flags().StringVar(&req.Scope, "scope", "", "Limit the token to *, site:<id> or group:<name>")
+	create.Flags().StringVar(&req.ExpiresIn, "expires-in", "", "Expire the token after this long, e.g. 720h")
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			tokens, err := client.Tokens(user)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(tokens))
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "ID\tNAME\tPREFIX\tROLE\tSCOPE\tEXPIRES\tLAST USED")
+			for _, t := range tokens {
+				role, expires, used := t.Role, "never", "never"
+				if role == "" {
+					role = "(user's)"
+				}
+				if t.ExpiresAt != nil {
+					expires = t.ExpiresAt.Local().Format("2006-01-02 15:04")
+				}
+				if t.LastUsedAt != nil {
+					used = ago(*t.LastUsedAt)
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s…\t%s\t%s\t%s\t%s\n", t.ID, t.Name, t.Prefix, role, t.Scope, expires, used)
+			}
+			return tw.Flush()
+		},
+	}
+
+	revoke := &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			if err := client.RevokeToken(args[0]); err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Println("✅ token revoked")
+			return nil
+		},
+	}
+
+	cmd.AddCommand(create, list, revoke)
+	return cmd
+}
+
+func prompt(label string) (string, error) {
+	fmt.Fprint(os.Stderr, label)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func promptPassword(label string) (string, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("stdin is not a terminal; use --password-stdin")
+	}
+	fmt.Fprint(os.Stderr, label)
+	b, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return string(b), err
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/util"
+)
+
+func newCORevisionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "Deployment revision history: list, show, diff and redeploy",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "list <deployment-id>",
+			Short: "List the revisions of a deployment, newest first",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := coClient()
+				if err != nil {
+					return err
+				}
+				revs, err := client.Revisions(args[0])
+				if err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				if output == "json" {
+					fmt.Println(pretty(revs))
+					return nil
+				}
+				tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "REV\tAPP\tVERSION\tSITE\tSTATE\tCOMMIT\tAUTHOR\tCREATED")
+				for _, r := range revs {
+					app := r.AppName
+					if r.RedeployedFrom > 0 {
+						app += fmt.Sprintf(" (from %d)", r.RedeployedFrom)
+					}
+					fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%.8s\t%s\t%s\n",
+						r.Revision, app, r.AppVersion, r.SiteID, r.State,
+						r.CommitSHA, r.Author, r.CreatedAt.Local().Format("2006-01-02 15:04"))
+				}
+				return tw.Flush()
+			},
+		},
+		&cobra.Command{
+			Use:   "show <deployment-id> <revision>",
+			Short: "Show a revision with its spec and outcome",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				rev, err := revisionArg(args[1])
+				if err != nil {
+					return err
+				}
+				client, err := coClient()
+				if err != nil {
+					return err
+				}
+				r, err := client.Revision(args[0], rev)
+				if err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				fmt.Println(pretty(r))
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "diff <deployment-id> <from> <to>",
+			Short: "Show how two revisions differ",
+			Args:  cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				from, err := revisionArg(args[1])
+				if err != nil {
+					return err
+				}
+				to, err := revisionArg(args[2])
+				if err != nil {
+					return err
+				}
+				client, err := coClient()
+				if err != nil {
+					return err
+				}
+				d, err := client.DiffRevisions(args[0], from, to)
+				if err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				if output == "json" {
+					fmt.Println(pretty(d))
+					return nil
+				}
+				fmt.Printf("revision %d → %d\n", d.From, d.To)
+				for name, ch := range d.Changes {
+					fmt.Printf("  %s: %s → %s\n", name, ch[0], ch[1])
+				}
+				fmt.Println()
+				fmt.Print(d.SpecDiff)
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "redeploy <deployment-id> <revision>",
+			Short: "Deploy an older revision again",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				rev, err := revisionArg(args[1])
+				if err != nil {
+					return err
+				}
+				client, err := coClient()
+				if err != nil {
+					return err
+				}
+				r, err := client.Redeploy(args[0], rev)
+				if err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				fmt.Printf("✅ revision %d redeployed as revision %d (commit %.8s)\n", rev, r.Revision, r.CommitSHA)
+				return nil
+			},
+		},
+	)
+	return cmd
+}
+
+func coClient() (*co.Client, error) {
+	cfg, err := util.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %v", err)
+	}
+	return co.NewClient(cfg.Coordinator.URL).WithToken(cfg.Coordinator.Token), nil
+}
+
+func revisionArg(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid revision %q", s)
+	}
+	return n, nil
+}
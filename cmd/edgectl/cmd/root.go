@@ -31,7 +31,11 @@ func NewRootCmd() *cobra.Command {
 		newCOCmd(),
 		newLOCmd(),
 		newENCmd(),
+		newAppCmd(),
+		newBundleCmd(),
 		newConfigCmd(),
+		newLoginCmd(),
+		newLogoutCmd(),
 		newVersionCmd(),
 	)
 
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func newCOListSitesCmd() *cobra.Command {
+	var location, status string
+	cmd := &cobra.Command{
+		Use:   "sites [site-id]",
+		Short: "List registered sites, or show one with its hosts",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			if len(args) == 1 {
+				s, err := client.GetSite(args[0])
+				if err != nil {
+					return fmt.Errorf("❌ %v", err)
+				}
+				fmt.Println(pretty(s))
+				return nil
+			}
+
+			sites, err := client.ListSites(location, status)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(sites))
+				return nil
+			}
+			if len(sites) == 0 {
+				fmt.Println("No sites found.")
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "SITE\tNAME\tLOCATION\tORCHESTRATOR\tSTATUS\tHOSTS\tLAST SYNC")
+			for _, s := range sites {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d/%d\t%s\n",
+					s.SiteID, s.Name, s.Location, s.Orchestrator, s.Status, s.HostsOnline, s.Hosts, ago(s.LastSeen))
+			}
+			return tw.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&location, "location", "", "Only sites whose location contains this")
+	cmd.Flags().StringVar(&status, "status", "", "Only sites with this status (online|degraded|offline|stale|unknown)")
+	return cmd
+}
+
+func newCOListHostsCmd() *cobra.Command {
+	var status string
+	cmd := &cobra.Command{
+		Use:   "hosts",
+		Short: "List hosts, of every site or of --site",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			hosts, err := client.ListHosts(site, status)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(hosts))
+				return nil
+			}
+			if len(hosts) == 0 {
+				fmt.Println("No hosts found.")
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "HOST\tSITE\tHOSTNAME\tADDRESS\tRUNTIME\tSTATUS\tLAST SEEN")
+			for _, h := range hosts {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					h.HostID, h.SiteID, h.Hostname, h.IPAddress, h.Runtime, h.Status, ago(h.LastSeen))
+			}
+			return tw.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&status, "status", "", "Only hosts with this status (online|offline|unknown)")
+	return cmd
+}
+
+// ago renders t relative to now, or "-" when it is not set.
+func ago(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return time.Since(t).Round(time.Second).String() + " ago"
+}
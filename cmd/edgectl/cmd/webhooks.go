@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/balaji-balu/margo-hello-world/cmd/edgectl/internal/co"
+)
+
+func newCOWebhooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhooks",
+		Short: "Manage webhooks notified of deployment and host events",
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			hooks, types, err := client.Webhooks()
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(hooks))
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "ID\tNAME\tURL\tEVENTS\tACTIVE")
+			for _, h := range hooks {
+				events := strings.Join(h.Events, ",")
+				if events == "" {
+					events = "(all)"
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\n", h.ID, h.Name, h.URL, events, h.Active)
+			}
+			tw.Flush()
+			fmt.Printf("\nEvent types: %s\n", strings.Join(types, ", "))
+			return nil
+		},
+	}
+
+	var req co.WebhookRequest
+	var events []string
+	var inactive bool
+	create := &cobra.Command{
+		Use:     "create <name> <url>",
+		Short:   "Subscribe a URL to events; its secret is shown once",
+		Example: `  edgectl co webhooks create pager https://hooks.example.com/margo --event host.dead --event host.recovered`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			req.Name, req.URL = args[0], args[1]
+			if len(events) > 0 {
+				req.Events = &events
+			}
+			if inactive {
+				active := false
+				req.Active = &active
+			}
+			h, err := client.CreateWebhook(req)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(h))
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "✅ webhook %s (%s); its signing secret will not be shown again:\n", h.Name, h.ID)
+			fmt.Println(h.Secret)
+			return nil
+		},
+	}
+	create.Flags().StringSliceVar(&events, "event", nil, "Only deliver this event type (repeatable); all by default")
+	create.Flags().StringVar(&req.Secret, "secret", "", "Sign deliveries with this secret instead of a generated one")
+	create.Flags().BoolVar(&inactive, "inactive", false, "Create the webhook paused")
+
+	var enable, disable bool
+	update := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Change a webhook's URL, events or secret, or pause it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			var upd co.WebhookRequest
+			upd.URL, _ = cmd.Flags().GetString("url")
+			upd.Secret, _ = cmd.Flags().GetString("secret")
+			if cmd.Flags().Changed("event") {
+				upd.Events = &events
+			}
+			if enable || disable {
+				upd.Active = &enable
+			}
+			h, err := client.UpdateWebhook(args[0], upd)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Println(pretty(h))
+			return nil
+		},
+	}
+	update.Flags().String("url", "", "New URL")
+	update.Flags().String("secret", "", "New signing secret")
+	update.Flags().StringSliceVar(&events, "event", nil, "Deliver only these event types; --event= for all")
+	update.Flags().BoolVar(&enable, "enable", false, "Resume deliveries")
+	update.Flags().BoolVar(&disable, "disable", false, "Pause deliveries; they are kept until resumed")
+	update.MarkFlagsMutuallyExclusive("enable", "disable")
+
+	del := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a webhook and its delivery log",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			if err := client.DeleteWebhook(args[0]); err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Println("✅ webhook deleted")
+			return nil
+		},
+	}
+
+	var status string
+	var limit, before int
+	deliveries := &cobra.Command{
+		Use:   "deliveries <id>",
+		Short: "Show a webhook's delivery log, newest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			log, next, err := client.WebhookDeliveries(args[0], status, limit, before)
+			if err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			if output == "json" {
+				fmt.Println(pretty(log))
+				return nil
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "ID\tEVENT\tSTATUS\tATTEMPTS\tRESPONSE\tCREATED\tERROR")
+			for _, d := range log {
+				resp := "-"
+				if d.ResponseStatus > 0 {
+					resp = strconv.Itoa(d.ResponseStatus)
+				}
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n",
+					d.ID, d.EventType, d.Status, d.Attempts, resp, ago(d.CreatedAt), d.Error)
+			}
+			tw.Flush()
+			if next != 0 {
+				fmt.Fprintf(os.Stderr, "more: --before %d\n", next)
+			}
+			return nil
+		},
+	}
+	deliveries.Flags().StringVar(&status, "status", "", "Only deliveries with this status (pending|succeeded|failed)")
+	deliveries.Flags().IntVar(&limit, "limit", 0, "Deliveries per page (50 by default)")
+	deliveries.Flags().IntVar(&before, "before", 0, "Page back from this delivery id")
+
+	redeliver := &cobra.Command{
+		Use:   "redeliver <id> <delivery-id>",
+		Short: "Send a delivery again",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := coClient()
+			if err != nil {
+				return err
+			}
+			delivery, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid delivery id %q", args[1])
+			}
+			if err := client.Redeliver(args[0], delivery); err != nil {
+				return fmt.Errorf("❌ %v", err)
+			}
+			fmt.Println("✅ delivery queued")
+			return nil
+		},
+	}
+
+	cmd.AddCommand(list, create, update, del, deliveries, redeliver)
+	return cmd
+}
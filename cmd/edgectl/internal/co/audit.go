@@ -0,0 +1,86 @@
+package co
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AuditEntry is one mutating request the CO recorded.
+type AuditEntry struct {
+	ID          int       `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Actor       string    `json:"actor"`
+	Action      string    `json:"action"`
+	Target      string    `json:"target"`
+	PayloadHash string    `json:"payload_hash"`
+	Result      string    `json:"result"`
+	Status      int       `json:"status"`
+	Error       string    `json:"error"`
+	SourceIP    string    `json:"source_ip"`
+}
+
+// AuditFilter picks audit entries; empty fields do not filter. Since and
+// Until are RFC 3339 times.
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Result string
+	Since  string
+	Until  string
+	Limit  int
+	// Before pages back from an entry id, as returned by Audit.
+	Before int
+}
+
+func (f AuditFilter) query() url.Values {
+	q := url.Values{}
+	for k, v := range map[string]string{
+		"actor": f.Actor, "action": f.Action, "target": f.Target,
+		"result": f.Result, "since": f.Since, "until": f.Until,
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	if f.Limit > 0 {
+		q.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Before > 0 {
+		q.Set("before", strconv.Itoa(f.Before))
+	}
+	return q
+}
+
+// Audit lists audit entries, newest first. next, if not 0, is the Before
+// of the page after.
+func (c *Client) Audit(f AuditFilter) (entries []AuditEntry, next int, err error) {
+	var out struct {
+		Entries []AuditEntry `json:"entries"`
+		Next    int          `json:"next"`
+	}
+	err = c.doJSON(http.MethodGet, "/api/v1/audit?"+f.query().Encode(), nil, &out)
+	return out.Entries, out.Next, err
+}
+
+// ExportAudit writes every entry f picks, oldest first, to w as format,
+// csv or jsonl.
+func (c *Client) ExportAudit(f AuditFilter, format string, w io.Writer) error {
+	q := f.query()
+	q.Set("format", format)
+	resp, err := c.client.Get(c.BaseURL + "/api/v1/audit/export?" + q.Encode())
+	if err != nil {
+		return fmt.Errorf("failed to contact CO: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("CO returned %d: %s", resp.StatusCode, data)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,110 @@
+package co
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithToken makes c send token as its bearer token, for a CO with auth on.
+// An empty token leaves c as it is.
+func (c *Client) WithToken(token string) *Client {
+	if token != "" {
+		c.client.Transport = bearer{token: token, next: http.DefaultTransport}
+	}
+	return c
+}
+
+type bearer struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (b bearer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+b.token)
+	return b.next.RoundTrip(req)
+}
+
+// Token is an API token. Token itself is only set when it is issued.
+type Token struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	User       string     `json:"user"`
+	Prefix     string     `json:"prefix"`
+	Role       string     `json:"role"`
+	Scope      string     `json:"scope"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Token      string     `json:"token"`
+}
+
+// Binding is a role on a scope: *, site:<id> or group:<name>.
+type Binding struct {
+	Role  string `json:"role"`
+	Scope string `json:"scope"`
+}
+
+// Principal is who a token acts for.
+type Principal struct {
+	Username     string    `json:"username"`
+	Organization string    `json:"organization"`
+	Roles        []Binding `json:"roles"`
+	Limit        *Binding  `json:"limit"`
+}
+
+// Login exchanges a username and password for a token named tokenName.
+func (c *Client) Login(username, password, tokenName string) (*Token, error) {
+	var out Token
+	err := c.doJSON(http.MethodPost, "/api/v1/auth/login", map[string]string{
+		"username":   username,
+		"password":   password,
+		"token_name": tokenName,
+	}, &out)
+	return &out, err
+}
+
+// WhoAmI returns who the client's token acts for.
+func (c *Client) WhoAmI() (*Principal, error) {
+	var out Principal
+	err := c.doJSON(http.MethodGet, "/api/v1/auth/whoami", nil, &out)
+	return &out, err
+}
+
+// TokenRequest asks for a token; empty role and scope mean all of what the
+// user may do, empty ExpiresIn a token that does not expire.
+type TokenRequest struct {
+	Name      string `json:"name"`
+	Role      string `json:"role,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	ExpiresIn string `json:"expires_in,omitempty"`
+}
+
+// Tokens lists the tokens of user, or the caller's own if user is empty.
+func (c *Client) Tokens(user string) ([]Token, error) {
+	var out struct {
+		Tokens []Token `json:"tokens"`
+	}
+	err := c.doJSON(http.MethodGet, tokensPath(user), nil, &out)
+	return out.Tokens, err
+}
+
+// CreateToken issues a token for user, or for the caller if user is empty.
+func (c *Client) CreateToken(user string, req TokenRequest) (*Token, error) {
+	var out Token
+	err := c.doJSON(http.MethodPost, tokensPath(user), req, &out)
+	return &out, err
+}
+
+// RevokeToken deletes a token by id.
+func (c *Client) RevokeToken(id string) error {
+	return c.doJSON(http.MethodDelete, "/api/v1/auth/tokens/"+url.PathEscape(id), nil, nil)
+}
+
+func tokensPath(user string) string {
+	if user == "" {
+		return "/api/v1/auth/tokens"
+	}
+	return "/api/v1/users/" + url.PathEscape(user) + "/tokens"
+}
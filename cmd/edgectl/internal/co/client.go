@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 type Client struct {
@@ -127,6 +128,9 @@ func (c *Client) ListApps(category, appName, version string) ([]AppInfo, error)
 	return wrapper.Apps, nil
 }
 
+// AddApp registers an app from the git registry at artifact, or, when
+// artifact is oci://<reference>, imports the package at reference; then
+// appName and version may be left to the package's margo.yaml.
 func (c *Client) AddApp(category, appName, version, artifact string) error {
 	app := map[string]string{
 		"category": category,
@@ -134,6 +138,10 @@ func (c *Client) AddApp(category, appName, version, artifact string) error {
 		"version": version,
 		"repo_url": artifact,
 	}
+	if ref, ok := strings.CutPrefix(artifact, "oci://"); ok {
+		delete(app, "repo_url")
+		app["oci_ref"] = ref
+	}
 	body, _ := json.Marshal(app)
 
 	url := fmt.Sprintf("%s/api/v1/apps", c.BaseURL)
@@ -205,6 +213,8 @@ func (c *Client) Health() (*HealthResponse, error) {
 
 type ComponentStatus struct {
     Name         string `json:"name"`
+    SiteID       string `json:"siteId,omitempty"`
+    HostID       string `json:"hostId,omitempty"`
     State        string `json:"state"`
     ErrorCode    string `json:"errorCode"`
     ErrorMessage string `json:"errorMessage"`
@@ -217,6 +227,18 @@ type DeploymentStatusResponse struct {
     ErrorCode    string            `json:"errorCode"`
     ErrorMessage string            `json:"errorMessage"`
     Components   []ComponentStatus `json:"components"`
+    Sites        map[string]SiteStatus `json:"sites,omitempty"`
+}
+
+// SiteStatus is a deployment's state on one site, rolled up from its hosts.
+type SiteStatus struct {
+    State string                `json:"state"`
+    Hosts map[string]HostStatus `json:"hosts"`
+}
+
+type HostStatus struct {
+    State      string            `json:"state"`
+    Components map[string]string `json:"components"`
 }
 
 func (c *Client) DeploymentStatus(depID string) (*DeploymentStatusResponse, error) {
@@ -0,0 +1,109 @@
+package co
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is an entry of the CO's event log.
+type Event struct {
+	ID           int             `json:"id"`
+	Type         string          `json:"type"`
+	Time         time.Time       `json:"time"`
+	DeploymentID string          `json:"deployment_id,omitempty"`
+	SiteID       string          `json:"site_id,omitempty"`
+	Data         json.RawMessage `json:"data,omitempty"`
+}
+
+// EventFilter picks events; empty fields do not filter.
+type EventFilter struct {
+	// Types are event types, or prefixes of them ending in ".*".
+	Types        []string
+	DeploymentID string
+	SiteID       string
+}
+
+// Dropped is the CO telling a client that fell behind how many events it
+// missed after the event LastEventID, or, if Pruned, that the events it
+// would resume after are no longer logged.
+type Dropped struct {
+	Dropped     int  `json:"dropped"`
+	Pruned      bool `json:"pruned"`
+	LastEventID int  `json:"last_event_id"`
+}
+
+// StreamEvents streams the events that pass f, after the event after if it
+// is not negative, until ctx ends or the CO ends the stream. It returns the
+// id of the last event streamed, and what was dropped if that is why the
+// stream ended.
+func (c *Client) StreamEvents(ctx context.Context, f EventFilter, after int, onEvent func(Event)) (int, *Dropped, error) {
+	q := url.Values{}
+	if len(f.Types) > 0 {
+		q.Set("type", strings.Join(f.Types, ","))
+	}
+	if f.DeploymentID != "" {
+		q.Set("deployment", f.DeploymentID)
+	}
+	if f.SiteID != "" {
+		q.Set("site", f.SiteID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/api/v1/events?"+q.Encode(), nil)
+	if err != nil {
+		return after, nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if after >= 0 {
+		req.Header.Set("Last-Event-ID", strconv.Itoa(after))
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return after, nil, fmt.Errorf("failed to contact CO: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		return after, nil, fmt.Errorf("CO returned %s: %s", resp.Status, e.Error)
+	}
+
+	last := after
+	var name, data string
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(line[len("event:"):])
+		case strings.HasPrefix(line, "data:"):
+			data = strings.TrimSpace(line[len("data:"):])
+		case line == "" && data != "":
+			if name == "dropped" {
+				var d Dropped
+				if err := json.Unmarshal([]byte(data), &d); err != nil {
+					return last, nil, err
+				}
+				return last, &d, nil
+			}
+			var ev Event
+			if err := json.Unmarshal([]byte(data), &ev); err == nil {
+				last = ev.ID
+				onEvent(ev)
+			}
+			name, data = "", ""
+		}
+	}
+	if ctx.Err() != nil {
+		return last, nil, nil
+	}
+	return last, nil, scanner.Err()
+}
@@ -0,0 +1,112 @@
+package co
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Revision is one version of a deployment as the CO recorded it.
+type Revision struct {
+	DeploymentID   string                 `json:"deployment_id"`
+	Revision       int                    `json:"revision"`
+	AppName        string                 `json:"app_name"`
+	AppVersion     string                 `json:"app_version"`
+	Profile        string                 `json:"profile"`
+	SiteID         string                 `json:"site_id"`
+	CommitSHA      string                 `json:"commit_sha"`
+	Author         string                 `json:"author"`
+	RedeployedFrom int                    `json:"redeployed_from"`
+	State          string                 `json:"state"`
+	Outcomes       map[string]interface{} `json:"outcomes"`
+	CreatedAt      time.Time              `json:"created_at"`
+	Spec           string                 `json:"spec"`
+}
+
+// RevisionDiff is how two revisions of a deployment differ.
+type RevisionDiff struct {
+	From     int                 `json:"from"`
+	To       int                 `json:"to"`
+	Changes  map[string][]string `json:"changes"`
+	SpecDiff string              `json:"spec_diff"`
+}
+
+// Revisions lists the revisions of a deployment, newest first.
+func (c *Client) Revisions(depID string) ([]Revision, error) {
+	var out struct {
+		Revisions []Revision `json:"revisions"`
+	}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/revisions", depID), nil, &out)
+	return out.Revisions, err
+}
+
+// Revision gets one revision of a deployment, with its spec.
+func (c *Client) Revision(depID string, rev int) (*Revision, error) {
+	var out Revision
+	err := c.doJSON(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/revisions/%d", depID, rev), nil, &out)
+	return &out, err
+}
+
+// DiffRevisions compares two revisions of a deployment.
+func (c *Client) DiffRevisions(depID string, from, to int) (*RevisionDiff, error) {
+	var out RevisionDiff
+	err := c.doJSON(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/diff?from=%d&to=%d", depID, from, to), nil, &out)
+	return &out, err
+}
+
+// Redeploy deploys revision rev of a deployment again, as a new revision.
+func (c *Client) Redeploy(depID string, rev int) (*Revision, error) {
+	var out Revision
+	err := c.doJSON(http.MethodPost, fmt.Sprintf("/api/v1/deployments/%s/revisions/%d/redeploy", depID, rev), nil, &out)
+	return &out, err
+}
+
+// doJSON sends in, if not nil, as the local user and decodes the JSON
+// answer into out.
+func (c *Client) doJSON(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.BaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if u := os.Getenv("USER"); u != "" {
+		req.Header.Set("X-Author", u)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to contact CO: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		var e struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(data, &e) == nil && e.Error != "" {
+			return fmt.Errorf("CO returned %d: %s", resp.StatusCode, e.Error)
+		}
+		return fmt.Errorf("CO returned %d: %s", resp.StatusCode, data)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package co
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Site is a site registered with the CO, with its hosts rolled up.
+type Site struct {
+	SiteID       string    `json:"site_id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Location     string    `json:"location"`
+	Group        string    `json:"group"`
+	Orchestrator string    `json:"orchestrator"`
+	Status       string    `json:"status"`
+	Hosts        int       `json:"hosts"`
+	HostsOnline  int       `json:"hosts_online"`
+	LastSeen     time.Time `json:"last_seen"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	HostList     []Host    `json:"host_list,omitempty"`
+}
+
+// Host is a host of a site as its LO reported it.
+type Host struct {
+	HostID    string                 `json:"host_id"`
+	SiteID    string                 `json:"site_id"`
+	Hostname  string                 `json:"hostname"`
+	IPAddress string                 `json:"ip_address"`
+	EdgeURL   string                 `json:"edge_url"`
+	Runtime   string                 `json:"runtime"`
+	Status    string                 `json:"status"`
+	LastSeen  time.Time              `json:"last_seen"`
+	CPUFree   float64                `json:"cpu_free"`
+	Inventory map[string]interface{} `json:"inventory,omitempty"`
+}
+
+// ListSites lists sites; location and status filter them when not empty.
+func (c *Client) ListSites(location, status string) ([]Site, error) {
+	q := url.Values{}
+	if location != "" {
+		q.Set("location", location)
+	}
+	if status != "" {
+		q.Set("status", status)
+	}
+	var out struct {
+		Sites []Site `json:"sites"`
+	}
+	err := c.doJSON(http.MethodGet, "/api/v1/sites?"+q.Encode(), nil, &out)
+	return out.Sites, err
+}
+
+// GetSite gets a site with its hosts.
+func (c *Client) GetSite(siteID string) (*Site, error) {
+	var out Site
+	err := c.doJSON(http.MethodGet, "/api/v1/sites/"+url.PathEscape(siteID), nil, &out)
+	return &out, err
+}
+
+// ListHosts lists hosts; site and status filter them when not empty.
+func (c *Client) ListHosts(siteID, status string) ([]Host, error) {
+	q := url.Values{}
+	if siteID != "" {
+		q.Set("site", siteID)
+	}
+	if status != "" {
+		q.Set("status", status)
+	}
+	var out struct {
+		Hosts []Host `json:"hosts"`
+	}
+	err := c.doJSON(http.MethodGet, "/api/v1/hosts?"+q.Encode(), nil, &out)
+	return out.Hosts, err
+}
@@ -0,0 +1,102 @@
+package co
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Webhook is a URL subscribed to CO events. Secret is only set when it is
+// created.
+type Webhook struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookRequest creates a webhook, or updates the fields set of one.
+type WebhookRequest struct {
+	Name   string    `json:"name,omitempty"`
+	URL    string    `json:"url,omitempty"`
+	Secret string    `json:"secret,omitempty"`
+	Events *[]string `json:"events,omitempty"`
+	Active *bool     `json:"active,omitempty"`
+}
+
+// WebhookDelivery is one event sent, or to be sent, to a webhook.
+type WebhookDelivery struct {
+	ID             int        `json:"id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	ResponseStatus int        `json:"response_status"`
+	Error          string     `json:"error"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// Webhooks lists the webhooks and the event types they can subscribe to.
+func (c *Client) Webhooks() ([]Webhook, []string, error) {
+	var out struct {
+		Webhooks   []Webhook `json:"webhooks"`
+		EventTypes []string  `json:"event_types"`
+	}
+	err := c.doJSON(http.MethodGet, "/api/v1/webhooks", nil, &out)
+	return out.Webhooks, out.EventTypes, err
+}
+
+// CreateWebhook subscribes a URL to events.
+func (c *Client) CreateWebhook(req WebhookRequest) (*Webhook, error) {
+	var out Webhook
+	err := c.doJSON(http.MethodPost, "/api/v1/webhooks", req, &out)
+	return &out, err
+}
+
+// UpdateWebhook changes the fields set in req of the webhook id.
+func (c *Client) UpdateWebhook(id string, req WebhookRequest) (*Webhook, error) {
+	var out Webhook
+	err := c.doJSON(http.MethodPatch, "/api/v1/webhooks/"+url.PathEscape(id), req, &out)
+	return &out, err
+}
+
+// DeleteWebhook removes a webhook and its delivery log.
+func (c *Client) DeleteWebhook(id string) error {
+	return c.doJSON(http.MethodDelete, "/api/v1/webhooks/"+url.PathEscape(id), nil, nil)
+}
+
+// WebhookDeliveries lists a webhook's deliveries, newest first; status
+// filters them when not empty. next, if not 0, is the before of the next
+// page.
+func (c *Client) WebhookDeliveries(id, status string, limit, before int) ([]WebhookDelivery, int, error) {
+	q := url.Values{}
+	if status != "" {
+		q.Set("status", status)
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if before > 0 {
+		q.Set("before", strconv.Itoa(before))
+	}
+	var out struct {
+		Deliveries []WebhookDelivery `json:"deliveries"`
+		Next       int               `json:"next"`
+	}
+	err := c.doJSON(http.MethodGet, "/api/v1/webhooks/"+url.PathEscape(id)+"/deliveries?"+q.Encode(), nil, &out)
+	return out.Deliveries, out.Next, err
+}
+
+// Redeliver queues a webhook's delivery to be sent again.
+func (c *Client) Redeliver(id string, delivery int) error {
+	path := fmt.Sprintf("/api/v1/webhooks/%s/deliveries/%d/redeliver", url.PathEscape(id), delivery)
+	return c.doJSON(http.MethodPost, path, nil, nil)
+}
@@ -3,12 +3,17 @@ package lo
 import (
 	"fmt"
 	"encoding/json"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Client struct {
 	BaseURL string
-	client  *http.Client
+	// Token is the LO's api token, which bundle import needs.
+	Token  string
+	client *http.Client
 }
 
 type HealthResponse struct {
@@ -77,6 +82,59 @@ func (c *Client) Actual() (error) {
 	return nil
 }
 
+// Logs copies a component's output on host to w. With follow it keeps
+// streaming until the LO closes the response or the process is interrupted.
+func (c *Client) Logs(host, component string, tail int, follow bool, w io.Writer) error {
+	q := url.Values{}
+	q.Set("tail", strconv.Itoa(tail))
+	q.Set("follow", strconv.FormatBool(follow))
+	u := fmt.Sprintf("%s/hosts/%s/logs/%s?%s", c.BaseURL,
+		url.PathEscape(host), url.PathEscape(component), q.Encode())
+
+	resp, err := c.client.Get(u)
+	if err != nil {
+		return fmt.Errorf("failed to reach LO service: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		return fmt.Errorf("failed to get logs (status=%d): %s", resp.StatusCode, e.Error)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// ImportBundle uploads the bundle tarball r to the LO, which stores its
+// artifacts and deploys it, and returns the LO's description of it.
+func (c *Client) ImportBundle(r io.Reader) (map[string]interface{}, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/bundles", c.BaseURL), r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-tar")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach LO service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to import bundle (status=%d): %v", resp.StatusCode, body["error"])
+	}
+	return body, nil
+}
+
 func pretty(v interface{}) string {
 	b, _ := json.MarshalIndent(v, "", "  ")
 	return string(b)
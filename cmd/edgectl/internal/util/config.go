@@ -13,10 +13,15 @@ import (
 type Config struct {
 	Coordinator struct {
 		URL string `yaml:"url"`
+		// Token is the bearer token from edgectl login, for a CO with
+		// auth on.
+		Token string `yaml:"token,omitempty"`
 	} `yaml:"coordinator"`
 
 	LocalOrchestrator struct {
 		URL string `yaml:"url"`
+		// Token is the LO's api token, for bundle import.
+		Token string `yaml:"token,omitempty"`
 	} `yaml:"local_orchestrator"`
 
 	EdgeNode struct {
@@ -56,9 +61,15 @@ func Load() (*Config, error) {
 	if val := os.Getenv("EDGECTL_CO_URL"); val != "" {
 		cfg.Coordinator.URL = val
 	}
+	if val := os.Getenv("EDGECTL_CO_TOKEN"); val != "" {
+		cfg.Coordinator.Token = val
+	}
 	if val := os.Getenv("EDGECTL_LO_URL"); val != "" {
 		cfg.LocalOrchestrator.URL = val
 	}
+	if val := os.Getenv("EDGECTL_LO_TOKEN"); val != "" {
+		cfg.LocalOrchestrator.Token = val
+	}
 	if val := os.Getenv("EDGECTL_EN_URL"); val != "" {
 		cfg.EdgeNode.URL = val
 	}
@@ -93,5 +104,6 @@ func Save(cfg *Config) error {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	// it may hold a token
+	return os.WriteFile(path, data, 0600)
 }
@@ -1,7 +1,8 @@
 package main
 
 import (
-    //"fmt"
+    "fmt"
+    "context"
     "io"
     "encoding/json"
     "os"
@@ -11,16 +12,23 @@ import (
     "bytes"
     "errors"
     "runtime"
+    "time"
     "github.com/google/uuid"
     "go.uber.org/zap"
 
+    "github.com/balaji-balu/margo-hello-world/internal/era/boltstore"
+    "github.com/balaji-balu/margo-hello-world/internal/era/gc"
+    "github.com/balaji-balu/margo-hello-world/internal/era/inventory"
+    "github.com/balaji-balu/margo-hello-world/internal/era/logs"
     "github.com/balaji-balu/margo-hello-world/internal/era/runtimemgr"
+    "github.com/balaji-balu/margo-hello-world/internal/era/verify"
     "github.com/balaji-balu/margo-hello-world/pkg/logx"
+    "github.com/balaji-balu/margo-hello-world/pkg/model"
     "github.com/balaji-balu/margo-hello-world/internal/config"
     "github.com/balaji-balu/margo-hello-world/internal/natsbroker"
     "github.com/balaji-balu/margo-hello-world/internal/era/heartbeat"
     //_ "github.com/balaji-balu/margo-hello-world/internal/era/plugins/containerd"
-    _ "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
+    mockcontainerd "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
 )
 // sudo ctr -n era containers ls
 // sudo ctr -n era tasks ls
@@ -60,9 +68,74 @@ type EraConfig struct {
     LO struct {
         URL     string `koanf:"url"`
     }
+
+    Region string `koanf:"region"`
+
+    Inventory struct {
+        // Interval is how often hardware is re-read; changes are sent to
+        // the LO on inventory.<site>.<host>.
+        Interval time.Duration `koanf:"interval"`
+    } `koanf:"inventory"`
+
+    Heartbeat struct {
+        Interval   time.Duration `koanf:"interval"`
+        Jitter     time.Duration `koanf:"jitter"`
+        MaxBackoff time.Duration `koanf:"max_backoff"`
+    } `koanf:"heartbeat"`
+
+    Logs struct {
+        MaxSizeMB int `koanf:"max_size_mb"`
+        MaxFiles  int `koanf:"max_files"`
+        Loki      struct {
+            URL string `koanf:"url"`
+        } `koanf:"loki"`
+    } `koanf:"logs"`
+
+    GC struct {
+        Enabled       bool          `koanf:"enabled"`
+        KeepRevisions int           `koanf:"keep_revisions"`
+        Interval      time.Duration `koanf:"interval"`
+        HighWatermark float64       `koanf:"high_watermark"`
+    } `koanf:"gc"`
+
+    State struct {
+        // Orphans is what to do with runtime components no recorded app
+        // owns at startup: "adopt" (default) or "delete".
+        Orphans string `koanf:"orphans"`
+    } `koanf:"state"`
+
+    Verify struct {
+        Enabled  bool   `koanf:"enabled"`
+        TrustDir string `koanf:"trust_dir"`
+    } `koanf:"verify"`
+
+    Probes struct {
+        Enabled        bool          `koanf:"enabled"`
+        ReadyTimeout   time.Duration `koanf:"ready_timeout"`
+        RestartBackoff time.Duration `koanf:"restart_backoff"`
+        MaxBackoff     time.Duration `koanf:"max_backoff"`
+        MaxRestarts    int           `koanf:"max_restarts"`
+    } `koanf:"probes"`
+
+    Artifacts struct {
+        // Mirror is the site's LO (http://lo:8081); images and packages
+        // are pulled through its cache instead of from the internet.
+        Mirror string `koanf:"mirror"`
+        // Token is the LO's era_token; ERA_MIRROR_TOKEN overrides it.
+        Token string `koanf:"token"`
+    } `koanf:"artifacts"`
+
+    Mock struct {
+        // Scenario is a YAML file of injected failures, delays and
+        // crashes for the mock-containerd runtime.
+        Scenario string `koanf:"scenario"`
+    } `koanf:"mock"`
 }
 
 var log *zap.SugaredLogger
+
+// version is set at build time with -ldflags "-X main.version=...".
+var version = "0.1.0"
 func Init() {
 
 }
@@ -73,7 +146,7 @@ func main() {
 
     logx.Init(logx.Options{
         Env:     os.Getenv("APP_ENV"),     // dev / prod
-        Version: "0.1.0",
+        Version: version,
     })    
     log = logx.New("era")
     log.Infow("ERA starting", "pid", os.Getpid())
@@ -93,14 +166,25 @@ func main() {
 		log.Errorf("❌ Failed to connect to NATS.","err:", err)
         return
 	}
-    siteID, err := register(cfg.LO.URL, ls.HostID)
+    collector := inventory.NewCollector()
+    inv := collector.Collect()
+    siteID, err := register(cfg.LO.URL, ls.HostID, inv)
     if err != nil {
         log.Errorf("❌ Unable to Register with LO","err:", err)
         return
     }
     log.Infow("LO", "siteid", siteID)
+
+    go collector.Watch(context.Background(), cfg.Inventory.Interval, inv, func(inv model.HardwareInventory) {
+        inv.HostID, inv.SiteID = ls.HostID, siteID
+        subj := fmt.Sprintf("inventory.%s.%s", siteID, ls.HostID)
+        if err := nb.Publish(subj, inv); err != nil {
+            log.Warnw("inventory publish failed", "err", err)
+            return
+        }
+        log.Infow("hardware inventory changed", "hash", inv.Hash)
+    })
     
-    heartbeat.StartHeartbeat(nb, log, siteID, ls.HostID)
     // Pass log into your DI / top-level orchestrator
 
     // comp := edgeruntime.ComponentSpec{
@@ -109,6 +193,79 @@ func main() {
     //     Artifact: "ghcr.io/edge-orchestration-platform/edge-ai-sample:74fb8f5c0bcdeecb53685605a1c30889b33601b6",
     // }
     era := runtimemgr.NewRuntimeManager("mock-containerd", nb, log)
+    era.SetParamDir(filepath.Join(ls.BaseDir, "params"))
+    if token := os.Getenv("ERA_MIRROR_TOKEN"); token != "" {
+        cfg.Artifacts.Token = token
+    }
+    if cfg.Artifacts.Mirror != "" {
+        era.SetMirror(cfg.Artifacts.Mirror, cfg.Artifacts.Token)
+        log.Infow("pulling artifacts through", "mirror", cfg.Artifacts.Mirror)
+    }
+    if mock, ok := era.Plugin().(*mockcontainerd.MockContainerd); ok && cfg.Mock.Scenario != "" {
+        scenario, err := mockcontainerd.LoadScenario(cfg.Mock.Scenario)
+        if err != nil {
+            log.Errorw("❌ Unable to load mock scenario", "err", err)
+            return
+        }
+        mock.SetScenario(scenario)
+        log.Infow("mock scenario loaded", "file", cfg.Mock.Scenario, "rules", len(scenario.Rules))
+    }
+
+    heartbeat.StartHeartbeat(nb, log, siteID, ls.HostID, heartbeat.Options{
+        Interval:     cfg.Heartbeat.Interval,
+        Jitter:       cfg.Heartbeat.Jitter,
+        MaxBackoff:   cfg.Heartbeat.MaxBackoff,
+        Runtime:      era.Runtime(),
+        Region:       cfg.Region,
+        Version:      version,
+        Capabilities: era.Capabilities(),
+        DiskPath:     ls.BaseDir,
+    })
+    if cfg.Verify.Enabled {
+        era.EnableVerification(verify.New(verify.Options{
+            TrustDir: cfg.Verify.TrustDir,
+            CacheDir: filepath.Join(ls.BaseDir, "verify"),
+        }))
+    }
+
+    logStore, err := newLogStore(cfg, ls, siteID)
+    if err != nil {
+        log.Errorw("log capture disabled", "err", err)
+    } else {
+        era.EnableLogs(logStore)
+        if err := era.ServeLogs(siteID, ls.HostID); err != nil {
+            log.Errorw("unable to serve log requests", "err", err)
+        }
+    }
+
+    state, err := boltstore.NewStateStore(filepath.Join(ls.BaseDir, "era.db"))
+    if err != nil {
+        log.Errorw("❌ Unable to open ERA state store", "err", err)
+        return
+    }
+    defer state.Close()
+    era.EnableState(state)
+
+    if cfg.GC.Enabled {
+        if err := enableGC(era, cfg, ls, state, siteID); err != nil {
+            log.Errorw("image gc disabled", "err", err)
+        }
+    }
+
+    if cfg.Probes.Enabled {
+        era.EnableProbes(siteID, ls.HostID, runtimemgr.ProbeOptions{
+            ReadyTimeout:   cfg.Probes.ReadyTimeout,
+            RestartBackoff: cfg.Probes.RestartBackoff,
+            MaxBackoff:     cfg.Probes.MaxBackoff,
+            MaxRestarts:    cfg.Probes.MaxRestarts,
+        })
+    }
+
+    // bring the runtime back in line with what we recorded before taking
+    // new ops from the LO
+    if err := era.Recover(siteID, ls.HostID, cfg.State.Orphans); err != nil {
+        log.Errorw("startup reconciliation failed", "err", err)
+    }
     era.LoActionDispatcher(siteID, ls.HostID)
 
     // log.Infow("Deploy status", "", era.Deploy(comp))
@@ -127,11 +284,44 @@ func main() {
     select{}
 }
 
-func register(loURL, hostID string) (string, error) {
-    // Prepare payload
-    payload := map[string]string{
-        "host_id": hostID,
+// newLogStore keeps component output under <BaseDir>/logs and, when
+// logs.loki.url is set, also ships it to Loki.
+func newLogStore(cfg EraConfig, ls *ERAStorage, siteID string) (*logs.Store, error) {
+    opts := logs.Options{
+        MaxBytes: int64(cfg.Logs.MaxSizeMB) << 20,
+        MaxFiles: cfg.Logs.MaxFiles,
     }
+    if cfg.Logs.Loki.URL != "" {
+        labels := map[string]string{"site": siteID, "host": ls.HostID}
+        opts.Sinks = append(opts.Sinks, logs.NewLokiSink(cfg.Logs.Loki.URL, labels, log))
+    }
+    return logs.NewStore(filepath.Join(ls.BaseDir, "logs"), opts)
+}
+
+// enableGC keeps revision history in the ERA state store and watches the
+// filesystem holding BaseDir for disk pressure.
+func enableGC(era *runtimemgr.RuntimeManager, cfg EraConfig, ls *ERAStorage, state *boltstore.StateStore, siteID string) error {
+    tracker, err := gc.NewTrackerWithStore(state.Blob("gc_revisions"))
+    if err != nil {
+        return err
+    }
+    _, err = era.EnableGC(context.Background(), tracker, gc.Options{
+        KeepRevisions: cfg.GC.KeepRevisions,
+        Interval:      cfg.GC.Interval,
+        DiskPath:      ls.BaseDir,
+        HighWatermark: cfg.GC.HighWatermark,
+        PackageDir:    filepath.Join(ls.BaseDir, "packages"),
+    }, siteID, ls.HostID)
+    return err
+}
+
+func register(loURL, hostID string, inv model.HardwareInventory) (string, error) {
+    // Prepare payload
+    inv.HostID = hostID
+    payload := struct {
+        HostID    string                  `json:"host_id"`
+        Inventory model.HardwareInventory `json:"inventory"`
+    }{hostID, inv}
 
     b, err := json.Marshal(payload)
     if err != nil {
@@ -0,0 +1,157 @@
+// fleetsim runs a CO, N LOs and M simulated ERA hosts per LO in one
+// process, plays a plan of deployments, host failures and network
+// partitions against them, and reports how long the fleet took to converge
+// after each step.
+//
+//	fleetsim -sites 3 -hosts 20
+//	fleetsim -plan plan.yaml -json report.json
+//
+// Everything the components log goes to -log; only the report is printed.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	mockcontainerd "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
+	"github.com/balaji-balu/margo-hello-world/internal/fleetsim"
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	"github.com/balaji-balu/margo-hello-world/pkg/logx"
+)
+
+func main() {
+	planFile := flag.String("plan", "", "plan file (YAML); default deploys, fails a host and partitions a site")
+	sites := flag.Int("sites", 0, "number of sites (LOs); overrides the plan")
+	hosts := flag.Int("hosts", 0, "hosts per site; overrides the plan")
+	dir := flag.String("dir", "", "state directory; default a temporary one, removed on exit")
+	db := flag.String("db", "", "CO database: postgres:// URL or SQLite file; default <dir>/co.db")
+	appFile := flag.String("app", "", "application description to deploy; default a three-component sample")
+	scenario := flag.String("scenario", "", "mock runtime scenario applied to every host")
+	hb := flag.Duration("heartbeat", 2*time.Second, "ERA heartbeat interval")
+	logFile := flag.String("log", "fleetsim.log", "file the components log to")
+	jsonFile := flag.String("json", "", "also write the report as JSON to this file")
+	flag.Parse()
+
+	stderr := os.Stderr
+	if err := run(*planFile, *sites, *hosts, *dir, *db, *appFile, *scenario, *hb, *logFile, *jsonFile); err != nil {
+		fmt.Fprintln(stderr, "fleetsim:", err)
+		os.Exit(1)
+	}
+}
+
+func run(planFile string, sites, hosts int, dir, db, appFile, scenario string,
+	hb time.Duration, logFile, jsonFile string) error {
+	plan := fleetsim.DefaultPlan()
+	if planFile != "" {
+		var err error
+		if plan, err = fleetsim.LoadPlan(planFile); err != nil {
+			return err
+		}
+	}
+	if sites > 0 {
+		plan.Sites = sites
+	}
+	if hosts > 0 {
+		plan.HostsPerSite = hosts
+	}
+	if err := plan.Validate(); err != nil {
+		return err
+	}
+
+	opts := fleetsim.Options{
+		Dir:          dir,
+		Sites:        plan.Sites,
+		HostsPerSite: plan.HostsPerSite,
+		DB:           db,
+		Heartbeat:    hb,
+	}
+	if appFile != "" {
+		app, err := application.ParseFromFile(appFile)
+		if err != nil {
+			return fmt.Errorf("app: %w", err)
+		}
+		opts.App = app
+	}
+	if scenario != "" {
+		scn, err := mockcontainerd.LoadScenario(scenario)
+		if err != nil {
+			return fmt.Errorf("scenario: %w", err)
+		}
+		opts.Scenario = scn
+	}
+	if opts.Dir == "" {
+		tmp, err := os.MkdirTemp("", "fleetsim-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		opts.Dir = tmp
+	}
+
+	// the components print freely; keep the terminal for the report
+	out, err := redirectOutput(logFile)
+	if err != nil {
+		return err
+	}
+	if err := logx.Init(logx.Options{Env: "dev", Version: "fleetsim"}); err != nil {
+		return err
+	}
+	opts.Log = logx.New("fleetsim")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(out, "starting %d sites x %d hosts (logs in %s)\n", plan.Sites, plan.HostsPerSite, logFile)
+	began := time.Now()
+	f, err := fleetsim.Start(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rep := &fleetsim.Report{
+		Sites:        plan.Sites,
+		HostsPerSite: plan.HostsPerSite,
+		StartupSecs:  time.Since(began).Seconds(),
+	}
+	rep.Results = f.Run(plan)
+	rep.WriteText(out)
+
+	if jsonFile != "" {
+		b, err := json.MarshalIndent(rep, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(jsonFile, b, 0o644); err != nil {
+			return err
+		}
+	}
+	if !rep.OK() {
+		return fmt.Errorf("fleet did not converge")
+	}
+	return nil
+}
+
+// redirectOutput sends stdout, stderr, the standard logger and gin to file
+// and returns the original stdout.
+func redirectOutput(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	out := os.Stdout
+	os.Stdout, os.Stderr = f, f
+	log.SetOutput(f)
+	gin.SetMode(gin.ReleaseMode)
+	gin.DefaultWriter, gin.DefaultErrorWriter = f, f
+	return out, nil
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/balaji-balu/margo-hello-world/pkg/logx"
 	"github.com/balaji-balu/margo-hello-world/internal/lo"
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
 	"github.com/balaji-balu/margo-hello-world/internal/config"
 	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
 	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
@@ -45,7 +46,49 @@ type LoConfig struct{
 	}
 	CO struct {
 		URL		string `koanf:"url"`
+		// SyncInterval is how often the site, its hosts and their
+		// inventory are synced to the CO; 0 means 1m.
+		SyncInterval time.Duration `koanf:"sync_interval"`
+		// Token is the bearer token for a CO with auth on; LO_CO_TOKEN
+		// overrides it.
+		Token string `koanf:"token"`
 	}
+	API struct {
+		// Token is the bearer token edgectl needs to import bundles;
+		// LO_API_TOKEN overrides it. Empty refuses imports.
+		Token string `koanf:"token"`
+	} `koanf:"api"`
+	// Site describes this LO's site to the CO; all optional.
+	Site struct {
+		// Organization is the CO organization owning the site; its
+		// deployments are under <organization>/<site_id> in the repo.
+		Organization string `koanf:"organization"`
+		Name         string `koanf:"name"`
+		Location     string `koanf:"location"`
+		Region       string `koanf:"region"`
+		APIEndpoint  string `koanf:"api_endpoint"`
+	} `koanf:"site"`
+	Artifacts struct {
+		// Dir holds the images and packages the LO serves its ERAs;
+		// empty means <base dir>/artifacts.
+		Dir string `koanf:"dir"`
+		// Offline stops the LO pulling what it does not have from the
+		// internet; only imported bundles are served.
+		Offline bool `koanf:"offline"`
+		// PrefetchTimeout bounds how long a new deployment waits for
+		// its artifacts before ops are dispatched; 0 means 10m.
+		PrefetchTimeout time.Duration `koanf:"prefetch_timeout"`
+		// Registries and PackageHosts are the only upstreams pulled
+		// through; ERAs get nothing from anywhere else.
+		Registries   []string `koanf:"registries"`
+		PackageHosts []string `koanf:"package_hosts"`
+		// MaxSizeMB bounds the store; the least recently used artifacts
+		// are evicted past it. 0 means unbounded.
+		MaxSizeMB int64 `koanf:"max_size_mb"`
+		// ERAToken is the bearer token ERAs pull with; LO_ERA_TOKEN
+		// overrides it. Empty refuses every pull.
+		ERAToken string `koanf:"era_token"`
+	} `koanf:"artifacts"`
 }
 
 func main() {
@@ -110,6 +153,31 @@ func main() {
 		return
 	}
 
+	artifactDir := cfg.Artifacts.Dir
+	if artifactDir == "" {
+		artifactDir = filepath.Join(loStorage.BaseDir, "artifacts")
+	}
+	store, err := artifacts.NewStore(artifactDir)
+	if err != nil {
+		log.Errorw("artifact store", "err", err)
+		return
+	}
+	if !cfg.Artifacts.Offline {
+		store.PullThrough(artifacts.Upstream{
+			Registries:   cfg.Artifacts.Registries,
+			PackageHosts: cfg.Artifacts.PackageHosts,
+		})
+	}
+	localorch.EnableArtifacts(store, cfg.Artifacts.PrefetchTimeout, cfg.Artifacts.MaxSizeMB<<20)
+	localorch.DescribeSite(lo.SiteConfig{
+		Organization: cfg.Site.Organization,
+		Name:         cfg.Site.Name,
+		Location:     cfg.Site.Location,
+		Region:       cfg.Site.Region,
+		APIEndpoint:  cfg.Site.APIEndpoint,
+		SyncInterval: cfg.CO.SyncInterval,
+	})
+
 	log.Infow("🚀 Starting adaptive mode manager...")
 
 	// ------------------------------------------------------------
@@ -121,8 +189,29 @@ func main() {
 	})
 	r.GET("/hosts", localorch.HandlerGetHosts)
 	r.GET("/actual", localorch.HandlerGetActual)
+	r.GET("/hosts/:host/logs/:component", localorch.HandlerGetLogs)
 
 	r.POST("/register", localorch.RegisterERA)
+
+	// bundle import, and the artifact cache ERAs pull through
+	if token := os.Getenv("LO_API_TOKEN"); token != "" {
+		cfg.API.Token = token
+	}
+	if cfg.API.Token == "" {
+		log.Warnw("no api token set: bundle import is refused")
+	}
+	r.POST("/bundles", lo.RequireToken(cfg.API.Token), localorch.HandlerImportBundle)
+	if token := os.Getenv("LO_ERA_TOKEN"); token != "" {
+		cfg.Artifacts.ERAToken = token
+	}
+	if cfg.Artifacts.ERAToken == "" {
+		log.Warnw("no era token set: artifact pulls are refused")
+	}
+	eraAuth := lo.RequireToken(cfg.Artifacts.ERAToken)
+	for _, p := range []string{"/v2/*path", "/packages/*path"} {
+		r.GET(p, eraAuth, gin.WrapH(store))
+		r.HEAD(p, eraAuth, gin.WrapH(store))
+	}
 	//r.POST("/deployment_status", lo.DeployStatus)
 
 	srv := &http.Server{
@@ -134,6 +223,10 @@ func main() {
 	// 4️⃣ Start orchestrator and HTTP server
 	// ------------------------------------------------------------
 	//go lo.StartModeLoop(ctx)
+	if token := os.Getenv("LO_CO_TOKEN"); token != "" {
+		cfg.CO.Token = token
+	}
+	localorch.SetCOToken(cfg.CO.Token)
 	localorch.Start(cfg.CO.URL) // NetworkMonitor(ctx)
 
 	go func() {
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +19,8 @@ type ApplicationDesc struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
 	// AppID holds the value of the "app_id" field.
 	AppID string `json:"app_id,omitempty"`
 	// Name holds the value of the "name" field.
@@ -42,6 +45,14 @@ type ApplicationDesc struct {
 	Tags []string `json:"tags,omitempty"`
 	// Published holds the value of the "published" field.
 	Published string `json:"published,omitempty"`
+	// IconData holds the value of the "icon_data" field.
+	IconData []byte `json:"-"`
+	// IconMediaType holds the value of the "icon_media_type" field.
+	IconMediaType string `json:"icon_media_type,omitempty"`
+	// License holds the value of the "license" field.
+	License string `json:"license,omitempty"`
+	// ReleaseNotes holds the value of the "release_notes" field.
+	ReleaseNotes string `json:"release_notes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApplicationDescQuery when eager-loading is set.
 	Edges        ApplicationDescEdges `json:"edges"`
@@ -52,9 +63,11 @@ type ApplicationDesc struct {
 type ApplicationDescEdges struct {
 	// DeploymentProfiles holds the value of the deployment_profiles edge.
 	DeploymentProfiles []*DeploymentProfile `json:"deployment_profiles,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // DeploymentProfilesOrErr returns the DeploymentProfiles value or an error if the edge
@@ -66,16 +79,27 @@ func (e ApplicationDescEdges) DeploymentProfilesOrErr() ([]*DeploymentProfile, e
 	return nil, &NotLoadedError{edge: "deployment_profiles"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ApplicationDescEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ApplicationDesc) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case applicationdesc.FieldTags:
+		case applicationdesc.FieldTags, applicationdesc.FieldIconData:
 			values[i] = new([]byte)
-		case applicationdesc.FieldAppID, applicationdesc.FieldName, applicationdesc.FieldVendor, applicationdesc.FieldVersion, applicationdesc.FieldCategory, applicationdesc.FieldDescription, applicationdesc.FieldIcon, applicationdesc.FieldArtifacturl, applicationdesc.FieldSite, applicationdesc.FieldTagLine, applicationdesc.FieldPublished:
+		case applicationdesc.FieldAppID, applicationdesc.FieldName, applicationdesc.FieldVendor, applicationdesc.FieldVersion, applicationdesc.FieldCategory, applicationdesc.FieldDescription, applicationdesc.FieldIcon, applicationdesc.FieldArtifacturl, applicationdesc.FieldSite, applicationdesc.FieldTagLine, applicationdesc.FieldPublished, applicationdesc.FieldIconMediaType, applicationdesc.FieldLicense, applicationdesc.FieldReleaseNotes:
 			values[i] = new(sql.NullString)
-		case applicationdesc.FieldID:
+		case applicationdesc.FieldID, applicationdesc.FieldOrgID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -98,6 +122,12 @@ func (_m *ApplicationDesc) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case applicationdesc.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				_m.OrgID = *value
+			}
 		case applicationdesc.FieldAppID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field app_id", values[i])
@@ -172,6 +202,30 @@ func (_m *ApplicationDesc) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Published = value.String
 			}
+		case applicationdesc.FieldIconData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_data", values[i])
+			} else if value != nil {
+				_m.IconData = *value
+			}
+		case applicationdesc.FieldIconMediaType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_media_type", values[i])
+			} else if value.Valid {
+				_m.IconMediaType = value.String
+			}
+		case applicationdesc.FieldLicense:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field license", values[i])
+			} else if value.Valid {
+				_m.License = value.String
+			}
+		case applicationdesc.FieldReleaseNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field release_notes", values[i])
+			} else if value.Valid {
+				_m.ReleaseNotes = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -190,6 +244,11 @@ func (_m *ApplicationDesc) QueryDeploymentProfiles() *DeploymentProfileQuery {
 	return NewApplicationDescClient(_m.config).QueryDeploymentProfiles(_m)
 }
 
+// QueryOrganization queries the "organization" edge of the ApplicationDesc entity.
+func (_m *ApplicationDesc) QueryOrganization() *OrganizationQuery {
+	return NewApplicationDescClient(_m.config).QueryOrganization(_m)
+}
+
 // Update returns a builder for updating this ApplicationDesc.
 // Note that you need to call ApplicationDesc.Unwrap() before calling this method if this ApplicationDesc
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -213,6 +272,9 @@ func (_m *ApplicationDesc) String() string {
 	var builder strings.Builder
 	builder.WriteString("ApplicationDesc(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgID))
+	builder.WriteString(", ")
 	builder.WriteString("app_id=")
 	builder.WriteString(_m.AppID)
 	builder.WriteString(", ")
@@ -248,6 +310,18 @@ func (_m *ApplicationDesc) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("published=")
 	builder.WriteString(_m.Published)
+	builder.WriteString(", ")
+	builder.WriteString("icon_data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IconData))
+	builder.WriteString(", ")
+	builder.WriteString("icon_media_type=")
+	builder.WriteString(_m.IconMediaType)
+	builder.WriteString(", ")
+	builder.WriteString("license=")
+	builder.WriteString(_m.License)
+	builder.WriteString(", ")
+	builder.WriteString("release_notes=")
+	builder.WriteString(_m.ReleaseNotes)
 	builder.WriteByte(')')
 	return builder.String()
 }
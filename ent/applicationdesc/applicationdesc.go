@@ -3,6 +3,7 @@
 package applicationdesc
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -13,6 +14,8 @@ const (
 	Label = "application_desc"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
 	// FieldAppID holds the string denoting the app_id field in the database.
 	FieldAppID = "app_id"
 	// FieldName holds the string denoting the name field in the database.
@@ -37,8 +40,18 @@ const (
 	FieldTags = "tags"
 	// FieldPublished holds the string denoting the published field in the database.
 	FieldPublished = "published"
+	// FieldIconData holds the string denoting the icon_data field in the database.
+	FieldIconData = "icon_data"
+	// FieldIconMediaType holds the string denoting the icon_media_type field in the database.
+	FieldIconMediaType = "icon_media_type"
+	// FieldLicense holds the string denoting the license field in the database.
+	FieldLicense = "license"
+	// FieldReleaseNotes holds the string denoting the release_notes field in the database.
+	FieldReleaseNotes = "release_notes"
 	// EdgeDeploymentProfiles holds the string denoting the deployment_profiles edge name in mutations.
 	EdgeDeploymentProfiles = "deployment_profiles"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// Table holds the table name of the applicationdesc in the database.
 	Table = "application_desc"
 	// DeploymentProfilesTable is the table that holds the deployment_profiles relation/edge.
@@ -48,11 +61,19 @@ const (
 	DeploymentProfilesInverseTable = "deployment_profile"
 	// DeploymentProfilesColumn is the table column denoting the deployment_profiles relation/edge.
 	DeploymentProfilesColumn = "app_id"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "application_desc"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "org_id"
 )
 
 // Columns holds all SQL columns for applicationdesc fields.
 var Columns = []string{
 	FieldID,
+	FieldOrgID,
 	FieldAppID,
 	FieldName,
 	FieldVendor,
@@ -65,6 +86,10 @@ var Columns = []string{
 	FieldTagLine,
 	FieldTags,
 	FieldPublished,
+	FieldIconData,
+	FieldIconMediaType,
+	FieldLicense,
+	FieldReleaseNotes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -77,7 +102,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/balaji-balu/margo-hello-world/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -90,6 +122,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
 // ByAppID orders the results by the app_id field.
 func ByAppID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAppID, opts...).ToFunc()
@@ -145,6 +182,21 @@ func ByPublished(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublished, opts...).ToFunc()
 }
 
+// ByIconMediaType orders the results by the icon_media_type field.
+func ByIconMediaType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconMediaType, opts...).ToFunc()
+}
+
+// ByLicense orders the results by the license field.
+func ByLicense(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicense, opts...).ToFunc()
+}
+
+// ByReleaseNotes orders the results by the release_notes field.
+func ByReleaseNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseNotes, opts...).ToFunc()
+}
+
 // ByDeploymentProfilesCount orders the results by deployment_profiles count.
 func ByDeploymentProfilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -158,6 +210,13 @@ func ByDeploymentProfiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newDeploymentProfilesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newDeploymentProfilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -165,3 +224,10 @@ func newDeploymentProfilesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DeploymentProfilesTable, DeploymentProfilesColumn),
 	)
 }
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.FieldLTE(FieldID, id))
 }
 
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v uuid.UUID) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldOrgID, v))
+}
+
 // AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
 func AppID(v string) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.FieldEQ(FieldAppID, v))
@@ -109,6 +114,56 @@ func Published(v string) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.FieldEQ(FieldPublished, v))
 }
 
+// IconData applies equality check predicate on the "icon_data" field. It's identical to IconDataEQ.
+func IconData(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldIconData, v))
+}
+
+// IconMediaType applies equality check predicate on the "icon_media_type" field. It's identical to IconMediaTypeEQ.
+func IconMediaType(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldIconMediaType, v))
+}
+
+// License applies equality check predicate on the "license" field. It's identical to LicenseEQ.
+func License(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldLicense, v))
+}
+
+// ReleaseNotes applies equality check predicate on the "release_notes" field. It's identical to ReleaseNotesEQ.
+func ReleaseNotes(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldReleaseNotes, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v uuid.UUID) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v uuid.UUID) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...uuid.UUID) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...uuid.UUID) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDIsNil applies the IsNil predicate on the "org_id" field.
+func OrgIDIsNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIsNull(FieldOrgID))
+}
+
+// OrgIDNotNil applies the NotNil predicate on the "org_id" field.
+func OrgIDNotNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotNull(FieldOrgID))
+}
+
 // AppIDEQ applies the EQ predicate on the "app_id" field.
 func AppIDEQ(v string) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.FieldEQ(FieldAppID, v))
@@ -944,6 +999,281 @@ func PublishedContainsFold(v string) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.FieldContainsFold(FieldPublished, v))
 }
 
+// IconDataEQ applies the EQ predicate on the "icon_data" field.
+func IconDataEQ(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldIconData, v))
+}
+
+// IconDataNEQ applies the NEQ predicate on the "icon_data" field.
+func IconDataNEQ(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNEQ(FieldIconData, v))
+}
+
+// IconDataIn applies the In predicate on the "icon_data" field.
+func IconDataIn(vs ...[]byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIn(FieldIconData, vs...))
+}
+
+// IconDataNotIn applies the NotIn predicate on the "icon_data" field.
+func IconDataNotIn(vs ...[]byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotIn(FieldIconData, vs...))
+}
+
+// IconDataGT applies the GT predicate on the "icon_data" field.
+func IconDataGT(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGT(FieldIconData, v))
+}
+
+// IconDataGTE applies the GTE predicate on the "icon_data" field.
+func IconDataGTE(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGTE(FieldIconData, v))
+}
+
+// IconDataLT applies the LT predicate on the "icon_data" field.
+func IconDataLT(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLT(FieldIconData, v))
+}
+
+// IconDataLTE applies the LTE predicate on the "icon_data" field.
+func IconDataLTE(v []byte) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLTE(FieldIconData, v))
+}
+
+// IconDataIsNil applies the IsNil predicate on the "icon_data" field.
+func IconDataIsNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIsNull(FieldIconData))
+}
+
+// IconDataNotNil applies the NotNil predicate on the "icon_data" field.
+func IconDataNotNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotNull(FieldIconData))
+}
+
+// IconMediaTypeEQ applies the EQ predicate on the "icon_media_type" field.
+func IconMediaTypeEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldIconMediaType, v))
+}
+
+// IconMediaTypeNEQ applies the NEQ predicate on the "icon_media_type" field.
+func IconMediaTypeNEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNEQ(FieldIconMediaType, v))
+}
+
+// IconMediaTypeIn applies the In predicate on the "icon_media_type" field.
+func IconMediaTypeIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIn(FieldIconMediaType, vs...))
+}
+
+// IconMediaTypeNotIn applies the NotIn predicate on the "icon_media_type" field.
+func IconMediaTypeNotIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotIn(FieldIconMediaType, vs...))
+}
+
+// IconMediaTypeGT applies the GT predicate on the "icon_media_type" field.
+func IconMediaTypeGT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGT(FieldIconMediaType, v))
+}
+
+// IconMediaTypeGTE applies the GTE predicate on the "icon_media_type" field.
+func IconMediaTypeGTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGTE(FieldIconMediaType, v))
+}
+
+// IconMediaTypeLT applies the LT predicate on the "icon_media_type" field.
+func IconMediaTypeLT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLT(FieldIconMediaType, v))
+}
+
+// IconMediaTypeLTE applies the LTE predicate on the "icon_media_type" field.
+func IconMediaTypeLTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLTE(FieldIconMediaType, v))
+}
+
+// IconMediaTypeContains applies the Contains predicate on the "icon_media_type" field.
+func IconMediaTypeContains(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContains(FieldIconMediaType, v))
+}
+
+// IconMediaTypeHasPrefix applies the HasPrefix predicate on the "icon_media_type" field.
+func IconMediaTypeHasPrefix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasPrefix(FieldIconMediaType, v))
+}
+
+// IconMediaTypeHasSuffix applies the HasSuffix predicate on the "icon_media_type" field.
+func IconMediaTypeHasSuffix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasSuffix(FieldIconMediaType, v))
+}
+
+// IconMediaTypeIsNil applies the IsNil predicate on the "icon_media_type" field.
+func IconMediaTypeIsNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIsNull(FieldIconMediaType))
+}
+
+// IconMediaTypeNotNil applies the NotNil predicate on the "icon_media_type" field.
+func IconMediaTypeNotNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotNull(FieldIconMediaType))
+}
+
+// IconMediaTypeEqualFold applies the EqualFold predicate on the "icon_media_type" field.
+func IconMediaTypeEqualFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEqualFold(FieldIconMediaType, v))
+}
+
+// IconMediaTypeContainsFold applies the ContainsFold predicate on the "icon_media_type" field.
+func IconMediaTypeContainsFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContainsFold(FieldIconMediaType, v))
+}
+
+// LicenseEQ applies the EQ predicate on the "license" field.
+func LicenseEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldLicense, v))
+}
+
+// LicenseNEQ applies the NEQ predicate on the "license" field.
+func LicenseNEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNEQ(FieldLicense, v))
+}
+
+// LicenseIn applies the In predicate on the "license" field.
+func LicenseIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIn(FieldLicense, vs...))
+}
+
+// LicenseNotIn applies the NotIn predicate on the "license" field.
+func LicenseNotIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotIn(FieldLicense, vs...))
+}
+
+// LicenseGT applies the GT predicate on the "license" field.
+func LicenseGT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGT(FieldLicense, v))
+}
+
+// LicenseGTE applies the GTE predicate on the "license" field.
+func LicenseGTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGTE(FieldLicense, v))
+}
+
+// LicenseLT applies the LT predicate on the "license" field.
+func LicenseLT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLT(FieldLicense, v))
+}
+
+// LicenseLTE applies the LTE predicate on the "license" field.
+func LicenseLTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLTE(FieldLicense, v))
+}
+
+// LicenseContains applies the Contains predicate on the "license" field.
+func LicenseContains(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContains(FieldLicense, v))
+}
+
+// LicenseHasPrefix applies the HasPrefix predicate on the "license" field.
+func LicenseHasPrefix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasPrefix(FieldLicense, v))
+}
+
+// LicenseHasSuffix applies the HasSuffix predicate on the "license" field.
+func LicenseHasSuffix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasSuffix(FieldLicense, v))
+}
+
+// LicenseIsNil applies the IsNil predicate on the "license" field.
+func LicenseIsNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIsNull(FieldLicense))
+}
+
+// LicenseNotNil applies the NotNil predicate on the "license" field.
+func LicenseNotNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotNull(FieldLicense))
+}
+
+// LicenseEqualFold applies the EqualFold predicate on the "license" field.
+func LicenseEqualFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEqualFold(FieldLicense, v))
+}
+
+// LicenseContainsFold applies the ContainsFold predicate on the "license" field.
+func LicenseContainsFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContainsFold(FieldLicense, v))
+}
+
+// ReleaseNotesEQ applies the EQ predicate on the "release_notes" field.
+func ReleaseNotesEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEQ(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesNEQ applies the NEQ predicate on the "release_notes" field.
+func ReleaseNotesNEQ(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNEQ(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesIn applies the In predicate on the "release_notes" field.
+func ReleaseNotesIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIn(FieldReleaseNotes, vs...))
+}
+
+// ReleaseNotesNotIn applies the NotIn predicate on the "release_notes" field.
+func ReleaseNotesNotIn(vs ...string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotIn(FieldReleaseNotes, vs...))
+}
+
+// ReleaseNotesGT applies the GT predicate on the "release_notes" field.
+func ReleaseNotesGT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGT(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesGTE applies the GTE predicate on the "release_notes" field.
+func ReleaseNotesGTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldGTE(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesLT applies the LT predicate on the "release_notes" field.
+func ReleaseNotesLT(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLT(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesLTE applies the LTE predicate on the "release_notes" field.
+func ReleaseNotesLTE(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldLTE(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesContains applies the Contains predicate on the "release_notes" field.
+func ReleaseNotesContains(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContains(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesHasPrefix applies the HasPrefix predicate on the "release_notes" field.
+func ReleaseNotesHasPrefix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasPrefix(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesHasSuffix applies the HasSuffix predicate on the "release_notes" field.
+func ReleaseNotesHasSuffix(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldHasSuffix(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesIsNil applies the IsNil predicate on the "release_notes" field.
+func ReleaseNotesIsNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldIsNull(FieldReleaseNotes))
+}
+
+// ReleaseNotesNotNil applies the NotNil predicate on the "release_notes" field.
+func ReleaseNotesNotNil() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldNotNull(FieldReleaseNotes))
+}
+
+// ReleaseNotesEqualFold applies the EqualFold predicate on the "release_notes" field.
+func ReleaseNotesEqualFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldEqualFold(FieldReleaseNotes, v))
+}
+
+// ReleaseNotesContainsFold applies the ContainsFold predicate on the "release_notes" field.
+func ReleaseNotesContainsFold(v string) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(sql.FieldContainsFold(FieldReleaseNotes, v))
+}
+
 // HasDeploymentProfiles applies the HasEdge predicate on the "deployment_profiles" edge.
 func HasDeploymentProfiles() predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(func(s *sql.Selector) {
@@ -967,6 +1297,29 @@ func HasDeploymentProfilesWith(preds ...predicate.DeploymentProfile) predicate.A
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.ApplicationDesc {
+	return predicate.ApplicationDesc(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ApplicationDesc) predicate.ApplicationDesc {
 	return predicate.ApplicationDesc(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +25,20 @@ type ApplicationDescCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetOrgID sets the "org_id" field.
+func (_c *ApplicationDescCreate) SetOrgID(v uuid.UUID) *ApplicationDescCreate {
+	_c.mutation.SetOrgID(v)
+	return _c
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_c *ApplicationDescCreate) SetNillableOrgID(v *uuid.UUID) *ApplicationDescCreate {
+	if v != nil {
+		_c.SetOrgID(*v)
+	}
+	return _c
+}
+
 // SetAppID sets the "app_id" field.
 func (_c *ApplicationDescCreate) SetAppID(v string) *ApplicationDescCreate {
 	_c.mutation.SetAppID(v)
@@ -184,6 +199,54 @@ func (_c *ApplicationDescCreate) SetNillablePublished(v *string) *ApplicationDes
 	return _c
 }
 
+// SetIconData sets the "icon_data" field.
+func (_c *ApplicationDescCreate) SetIconData(v []byte) *ApplicationDescCreate {
+	_c.mutation.SetIconData(v)
+	return _c
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (_c *ApplicationDescCreate) SetIconMediaType(v string) *ApplicationDescCreate {
+	_c.mutation.SetIconMediaType(v)
+	return _c
+}
+
+// SetNillableIconMediaType sets the "icon_media_type" field if the given value is not nil.
+func (_c *ApplicationDescCreate) SetNillableIconMediaType(v *string) *ApplicationDescCreate {
+	if v != nil {
+		_c.SetIconMediaType(*v)
+	}
+	return _c
+}
+
+// SetLicense sets the "license" field.
+func (_c *ApplicationDescCreate) SetLicense(v string) *ApplicationDescCreate {
+	_c.mutation.SetLicense(v)
+	return _c
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_c *ApplicationDescCreate) SetNillableLicense(v *string) *ApplicationDescCreate {
+	if v != nil {
+		_c.SetLicense(*v)
+	}
+	return _c
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (_c *ApplicationDescCreate) SetReleaseNotes(v string) *ApplicationDescCreate {
+	_c.mutation.SetReleaseNotes(v)
+	return _c
+}
+
+// SetNillableReleaseNotes sets the "release_notes" field if the given value is not nil.
+func (_c *ApplicationDescCreate) SetNillableReleaseNotes(v *string) *ApplicationDescCreate {
+	if v != nil {
+		_c.SetReleaseNotes(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ApplicationDescCreate) SetID(v uuid.UUID) *ApplicationDescCreate {
 	_c.mutation.SetID(v)
@@ -213,6 +276,25 @@ func (_c *ApplicationDescCreate) AddDeploymentProfiles(v ...*DeploymentProfile)
 	return _c.AddDeploymentProfileIDs(ids...)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *ApplicationDescCreate) SetOrganizationID(id uuid.UUID) *ApplicationDescCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_c *ApplicationDescCreate) SetNillableOrganizationID(id *uuid.UUID) *ApplicationDescCreate {
+	if id != nil {
+		_c = _c.SetOrganizationID(*id)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *ApplicationDescCreate) SetOrganization(v *Organization) *ApplicationDescCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the ApplicationDescMutation object of the builder.
 func (_c *ApplicationDescCreate) Mutation() *ApplicationDescMutation {
 	return _c.mutation
@@ -220,7 +302,9 @@ func (_c *ApplicationDescCreate) Mutation() *ApplicationDescMutation {
 
 // Save creates the ApplicationDesc in the database.
 func (_c *ApplicationDescCreate) Save(ctx context.Context) (*ApplicationDesc, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -247,11 +331,15 @@ func (_c *ApplicationDescCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ApplicationDescCreate) defaults() {
+func (_c *ApplicationDescCreate) defaults() error {
 	if _, ok := _c.mutation.ID(); !ok {
+		if applicationdesc.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized applicationdesc.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := applicationdesc.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -340,6 +428,22 @@ func (_c *ApplicationDescCreate) createSpec() (*ApplicationDesc, *sqlgraph.Creat
 		_spec.SetField(applicationdesc.FieldPublished, field.TypeString, value)
 		_node.Published = value
 	}
+	if value, ok := _c.mutation.IconData(); ok {
+		_spec.SetField(applicationdesc.FieldIconData, field.TypeBytes, value)
+		_node.IconData = value
+	}
+	if value, ok := _c.mutation.IconMediaType(); ok {
+		_spec.SetField(applicationdesc.FieldIconMediaType, field.TypeString, value)
+		_node.IconMediaType = value
+	}
+	if value, ok := _c.mutation.License(); ok {
+		_spec.SetField(applicationdesc.FieldLicense, field.TypeString, value)
+		_node.License = value
+	}
+	if value, ok := _c.mutation.ReleaseNotes(); ok {
+		_spec.SetField(applicationdesc.FieldReleaseNotes, field.TypeString, value)
+		_node.ReleaseNotes = value
+	}
 	if nodes := _c.mutation.DeploymentProfilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -356,6 +460,23 @@ func (_c *ApplicationDescCreate) createSpec() (*ApplicationDesc, *sqlgraph.Creat
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applicationdesc.OrganizationTable,
+			Columns: []string{applicationdesc.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -363,7 +484,7 @@ func (_c *ApplicationDescCreate) createSpec() (*ApplicationDesc, *sqlgraph.Creat
 // of the `INSERT` statement. For example:
 //
 //	client.ApplicationDesc.Create().
-//		SetAppID(v).
+//		SetOrgID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -372,7 +493,7 @@ func (_c *ApplicationDescCreate) createSpec() (*ApplicationDesc, *sqlgraph.Creat
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ApplicationDescUpsert) {
-//			SetAppID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *ApplicationDescCreate) OnConflict(opts ...sql.ConflictOption) *ApplicationDescUpsertOne {
@@ -408,6 +529,24 @@ type (
 	}
 )
 
+// SetOrgID sets the "org_id" field.
+func (u *ApplicationDescUpsert) SetOrgID(v uuid.UUID) *ApplicationDescUpsert {
+	u.Set(applicationdesc.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ApplicationDescUpsert) UpdateOrgID() *ApplicationDescUpsert {
+	u.SetExcluded(applicationdesc.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *ApplicationDescUpsert) ClearOrgID() *ApplicationDescUpsert {
+	u.SetNull(applicationdesc.FieldOrgID)
+	return u
+}
+
 // SetAppID sets the "app_id" field.
 func (u *ApplicationDescUpsert) SetAppID(v string) *ApplicationDescUpsert {
 	u.Set(applicationdesc.FieldAppID, v)
@@ -624,6 +763,78 @@ func (u *ApplicationDescUpsert) ClearPublished() *ApplicationDescUpsert {
 	return u
 }
 
+// SetIconData sets the "icon_data" field.
+func (u *ApplicationDescUpsert) SetIconData(v []byte) *ApplicationDescUpsert {
+	u.Set(applicationdesc.FieldIconData, v)
+	return u
+}
+
+// UpdateIconData sets the "icon_data" field to the value that was provided on create.
+func (u *ApplicationDescUpsert) UpdateIconData() *ApplicationDescUpsert {
+	u.SetExcluded(applicationdesc.FieldIconData)
+	return u
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (u *ApplicationDescUpsert) ClearIconData() *ApplicationDescUpsert {
+	u.SetNull(applicationdesc.FieldIconData)
+	return u
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (u *ApplicationDescUpsert) SetIconMediaType(v string) *ApplicationDescUpsert {
+	u.Set(applicationdesc.FieldIconMediaType, v)
+	return u
+}
+
+// UpdateIconMediaType sets the "icon_media_type" field to the value that was provided on create.
+func (u *ApplicationDescUpsert) UpdateIconMediaType() *ApplicationDescUpsert {
+	u.SetExcluded(applicationdesc.FieldIconMediaType)
+	return u
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (u *ApplicationDescUpsert) ClearIconMediaType() *ApplicationDescUpsert {
+	u.SetNull(applicationdesc.FieldIconMediaType)
+	return u
+}
+
+// SetLicense sets the "license" field.
+func (u *ApplicationDescUpsert) SetLicense(v string) *ApplicationDescUpsert {
+	u.Set(applicationdesc.FieldLicense, v)
+	return u
+}
+
+// UpdateLicense sets the "license" field to the value that was provided on create.
+func (u *ApplicationDescUpsert) UpdateLicense() *ApplicationDescUpsert {
+	u.SetExcluded(applicationdesc.FieldLicense)
+	return u
+}
+
+// ClearLicense clears the value of the "license" field.
+func (u *ApplicationDescUpsert) ClearLicense() *ApplicationDescUpsert {
+	u.SetNull(applicationdesc.FieldLicense)
+	return u
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (u *ApplicationDescUpsert) SetReleaseNotes(v string) *ApplicationDescUpsert {
+	u.Set(applicationdesc.FieldReleaseNotes, v)
+	return u
+}
+
+// UpdateReleaseNotes sets the "release_notes" field to the value that was provided on create.
+func (u *ApplicationDescUpsert) UpdateReleaseNotes() *ApplicationDescUpsert {
+	u.SetExcluded(applicationdesc.FieldReleaseNotes)
+	return u
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (u *ApplicationDescUpsert) ClearReleaseNotes() *ApplicationDescUpsert {
+	u.SetNull(applicationdesc.FieldReleaseNotes)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -672,6 +883,27 @@ func (u *ApplicationDescUpsertOne) Update(set func(*ApplicationDescUpsert)) *App
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *ApplicationDescUpsertOne) SetOrgID(v uuid.UUID) *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ApplicationDescUpsertOne) UpdateOrgID() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *ApplicationDescUpsertOne) ClearOrgID() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetAppID sets the "app_id" field.
 func (u *ApplicationDescUpsertOne) SetAppID(v string) *ApplicationDescUpsertOne {
 	return u.Update(func(s *ApplicationDescUpsert) {
@@ -924,6 +1156,90 @@ func (u *ApplicationDescUpsertOne) ClearPublished() *ApplicationDescUpsertOne {
 	})
 }
 
+// SetIconData sets the "icon_data" field.
+func (u *ApplicationDescUpsertOne) SetIconData(v []byte) *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetIconData(v)
+	})
+}
+
+// UpdateIconData sets the "icon_data" field to the value that was provided on create.
+func (u *ApplicationDescUpsertOne) UpdateIconData() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateIconData()
+	})
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (u *ApplicationDescUpsertOne) ClearIconData() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearIconData()
+	})
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (u *ApplicationDescUpsertOne) SetIconMediaType(v string) *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetIconMediaType(v)
+	})
+}
+
+// UpdateIconMediaType sets the "icon_media_type" field to the value that was provided on create.
+func (u *ApplicationDescUpsertOne) UpdateIconMediaType() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateIconMediaType()
+	})
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (u *ApplicationDescUpsertOne) ClearIconMediaType() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearIconMediaType()
+	})
+}
+
+// SetLicense sets the "license" field.
+func (u *ApplicationDescUpsertOne) SetLicense(v string) *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetLicense(v)
+	})
+}
+
+// UpdateLicense sets the "license" field to the value that was provided on create.
+func (u *ApplicationDescUpsertOne) UpdateLicense() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateLicense()
+	})
+}
+
+// ClearLicense clears the value of the "license" field.
+func (u *ApplicationDescUpsertOne) ClearLicense() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearLicense()
+	})
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (u *ApplicationDescUpsertOne) SetReleaseNotes(v string) *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetReleaseNotes(v)
+	})
+}
+
+// UpdateReleaseNotes sets the "release_notes" field to the value that was provided on create.
+func (u *ApplicationDescUpsertOne) UpdateReleaseNotes() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateReleaseNotes()
+	})
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (u *ApplicationDescUpsertOne) ClearReleaseNotes() *ApplicationDescUpsertOne {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearReleaseNotes()
+	})
+}
+
 // Exec executes the query.
 func (u *ApplicationDescUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1060,7 +1376,7 @@ func (_c *ApplicationDescCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ApplicationDescUpsert) {
-//			SetAppID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *ApplicationDescCreateBulk) OnConflict(opts ...sql.ConflictOption) *ApplicationDescUpsertBulk {
@@ -1139,6 +1455,27 @@ func (u *ApplicationDescUpsertBulk) Update(set func(*ApplicationDescUpsert)) *Ap
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *ApplicationDescUpsertBulk) SetOrgID(v uuid.UUID) *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ApplicationDescUpsertBulk) UpdateOrgID() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *ApplicationDescUpsertBulk) ClearOrgID() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetAppID sets the "app_id" field.
 func (u *ApplicationDescUpsertBulk) SetAppID(v string) *ApplicationDescUpsertBulk {
 	return u.Update(func(s *ApplicationDescUpsert) {
@@ -1391,6 +1728,90 @@ func (u *ApplicationDescUpsertBulk) ClearPublished() *ApplicationDescUpsertBulk
 	})
 }
 
+// SetIconData sets the "icon_data" field.
+func (u *ApplicationDescUpsertBulk) SetIconData(v []byte) *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetIconData(v)
+	})
+}
+
+// UpdateIconData sets the "icon_data" field to the value that was provided on create.
+func (u *ApplicationDescUpsertBulk) UpdateIconData() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateIconData()
+	})
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (u *ApplicationDescUpsertBulk) ClearIconData() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearIconData()
+	})
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (u *ApplicationDescUpsertBulk) SetIconMediaType(v string) *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetIconMediaType(v)
+	})
+}
+
+// UpdateIconMediaType sets the "icon_media_type" field to the value that was provided on create.
+func (u *ApplicationDescUpsertBulk) UpdateIconMediaType() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateIconMediaType()
+	})
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (u *ApplicationDescUpsertBulk) ClearIconMediaType() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearIconMediaType()
+	})
+}
+
+// SetLicense sets the "license" field.
+func (u *ApplicationDescUpsertBulk) SetLicense(v string) *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetLicense(v)
+	})
+}
+
+// UpdateLicense sets the "license" field to the value that was provided on create.
+func (u *ApplicationDescUpsertBulk) UpdateLicense() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateLicense()
+	})
+}
+
+// ClearLicense clears the value of the "license" field.
+func (u *ApplicationDescUpsertBulk) ClearLicense() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearLicense()
+	})
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (u *ApplicationDescUpsertBulk) SetReleaseNotes(v string) *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.SetReleaseNotes(v)
+	})
+}
+
+// UpdateReleaseNotes sets the "release_notes" field to the value that was provided on create.
+func (u *ApplicationDescUpsertBulk) UpdateReleaseNotes() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.UpdateReleaseNotes()
+	})
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (u *ApplicationDescUpsertBulk) ClearReleaseNotes() *ApplicationDescUpsertBulk {
+	return u.Update(func(s *ApplicationDescUpsert) {
+		s.ClearReleaseNotes()
+	})
+}
+
 // Exec executes the query.
 func (u *ApplicationDescUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
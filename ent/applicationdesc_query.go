@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,7 @@ type ApplicationDescQuery struct {
 	inters                 []Interceptor
 	predicates             []predicate.ApplicationDesc
 	withDeploymentProfiles *DeploymentProfileQuery
+	withOrganization       *OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (_q *ApplicationDescQuery) QueryDeploymentProfiles() *DeploymentProfileQuer
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *ApplicationDescQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(applicationdesc.Table, applicationdesc.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, applicationdesc.OrganizationTable, applicationdesc.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ApplicationDesc entity from the query.
 // Returns a *NotFoundError when no ApplicationDesc was found.
 func (_q *ApplicationDescQuery) First(ctx context.Context) (*ApplicationDesc, error) {
@@ -277,6 +301,7 @@ func (_q *ApplicationDescQuery) Clone() *ApplicationDescQuery {
 		inters:                 append([]Interceptor{}, _q.inters...),
 		predicates:             append([]predicate.ApplicationDesc{}, _q.predicates...),
 		withDeploymentProfiles: _q.withDeploymentProfiles.Clone(),
+		withOrganization:       _q.withOrganization.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,18 +319,29 @@ func (_q *ApplicationDescQuery) WithDeploymentProfiles(opts ...func(*DeploymentP
 	return _q
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ApplicationDescQuery) WithOrganization(opts ...func(*OrganizationQuery)) *ApplicationDescQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		AppID string `json:"app_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ApplicationDesc.Query().
-//		GroupBy(applicationdesc.FieldAppID).
+//		GroupBy(applicationdesc.FieldOrgID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ApplicationDescQuery) GroupBy(field string, fields ...string) *ApplicationDescGroupBy {
@@ -323,11 +359,11 @@ func (_q *ApplicationDescQuery) GroupBy(field string, fields ...string) *Applica
 // Example:
 //
 //	var v []struct {
-//		AppID string `json:"app_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //	}
 //
 //	client.ApplicationDesc.Query().
-//		Select(applicationdesc.FieldAppID).
+//		Select(applicationdesc.FieldOrgID).
 //		Scan(ctx, &v)
 func (_q *ApplicationDescQuery) Select(fields ...string) *ApplicationDescSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -372,8 +408,9 @@ func (_q *ApplicationDescQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*ApplicationDesc{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withDeploymentProfiles != nil,
+			_q.withOrganization != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +440,12 @@ func (_q *ApplicationDescQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *ApplicationDesc, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +479,35 @@ func (_q *ApplicationDescQuery) loadDeploymentProfiles(ctx context.Context, quer
 	}
 	return nil
 }
+func (_q *ApplicationDescQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*ApplicationDesc, init func(*ApplicationDesc), assign func(*ApplicationDesc, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ApplicationDesc)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *ApplicationDescQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -462,6 +534,9 @@ func (_q *ApplicationDescQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withOrganization != nil {
+			_spec.Node.AddColumnOnce(applicationdesc.FieldOrgID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
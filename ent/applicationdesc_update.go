@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -30,6 +31,26 @@ func (_u *ApplicationDescUpdate) Where(ps ...predicate.ApplicationDesc) *Applica
 	return _u
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *ApplicationDescUpdate) SetOrgID(v uuid.UUID) *ApplicationDescUpdate {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *ApplicationDescUpdate) SetNillableOrgID(v *uuid.UUID) *ApplicationDescUpdate {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *ApplicationDescUpdate) ClearOrgID() *ApplicationDescUpdate {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetAppID sets the "app_id" field.
 func (_u *ApplicationDescUpdate) SetAppID(v string) *ApplicationDescUpdate {
 	_u.mutation.SetAppID(v)
@@ -268,6 +289,78 @@ func (_u *ApplicationDescUpdate) ClearPublished() *ApplicationDescUpdate {
 	return _u
 }
 
+// SetIconData sets the "icon_data" field.
+func (_u *ApplicationDescUpdate) SetIconData(v []byte) *ApplicationDescUpdate {
+	_u.mutation.SetIconData(v)
+	return _u
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (_u *ApplicationDescUpdate) ClearIconData() *ApplicationDescUpdate {
+	_u.mutation.ClearIconData()
+	return _u
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (_u *ApplicationDescUpdate) SetIconMediaType(v string) *ApplicationDescUpdate {
+	_u.mutation.SetIconMediaType(v)
+	return _u
+}
+
+// SetNillableIconMediaType sets the "icon_media_type" field if the given value is not nil.
+func (_u *ApplicationDescUpdate) SetNillableIconMediaType(v *string) *ApplicationDescUpdate {
+	if v != nil {
+		_u.SetIconMediaType(*v)
+	}
+	return _u
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (_u *ApplicationDescUpdate) ClearIconMediaType() *ApplicationDescUpdate {
+	_u.mutation.ClearIconMediaType()
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *ApplicationDescUpdate) SetLicense(v string) *ApplicationDescUpdate {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *ApplicationDescUpdate) SetNillableLicense(v *string) *ApplicationDescUpdate {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// ClearLicense clears the value of the "license" field.
+func (_u *ApplicationDescUpdate) ClearLicense() *ApplicationDescUpdate {
+	_u.mutation.ClearLicense()
+	return _u
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (_u *ApplicationDescUpdate) SetReleaseNotes(v string) *ApplicationDescUpdate {
+	_u.mutation.SetReleaseNotes(v)
+	return _u
+}
+
+// SetNillableReleaseNotes sets the "release_notes" field if the given value is not nil.
+func (_u *ApplicationDescUpdate) SetNillableReleaseNotes(v *string) *ApplicationDescUpdate {
+	if v != nil {
+		_u.SetReleaseNotes(*v)
+	}
+	return _u
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (_u *ApplicationDescUpdate) ClearReleaseNotes() *ApplicationDescUpdate {
+	_u.mutation.ClearReleaseNotes()
+	return _u
+}
+
 // AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
 func (_u *ApplicationDescUpdate) AddDeploymentProfileIDs(ids ...uuid.UUID) *ApplicationDescUpdate {
 	_u.mutation.AddDeploymentProfileIDs(ids...)
@@ -283,6 +376,25 @@ func (_u *ApplicationDescUpdate) AddDeploymentProfiles(v ...*DeploymentProfile)
 	return _u.AddDeploymentProfileIDs(ids...)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *ApplicationDescUpdate) SetOrganizationID(id uuid.UUID) *ApplicationDescUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *ApplicationDescUpdate) SetNillableOrganizationID(id *uuid.UUID) *ApplicationDescUpdate {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ApplicationDescUpdate) SetOrganization(v *Organization) *ApplicationDescUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the ApplicationDescMutation object of the builder.
 func (_u *ApplicationDescUpdate) Mutation() *ApplicationDescMutation {
 	return _u.mutation
@@ -309,6 +421,12 @@ func (_u *ApplicationDescUpdate) RemoveDeploymentProfiles(v ...*DeploymentProfil
 	return _u.RemoveDeploymentProfileIDs(ids...)
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ApplicationDescUpdate) ClearOrganization() *ApplicationDescUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ApplicationDescUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -422,6 +540,30 @@ func (_u *ApplicationDescUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.PublishedCleared() {
 		_spec.ClearField(applicationdesc.FieldPublished, field.TypeString)
 	}
+	if value, ok := _u.mutation.IconData(); ok {
+		_spec.SetField(applicationdesc.FieldIconData, field.TypeBytes, value)
+	}
+	if _u.mutation.IconDataCleared() {
+		_spec.ClearField(applicationdesc.FieldIconData, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.IconMediaType(); ok {
+		_spec.SetField(applicationdesc.FieldIconMediaType, field.TypeString, value)
+	}
+	if _u.mutation.IconMediaTypeCleared() {
+		_spec.ClearField(applicationdesc.FieldIconMediaType, field.TypeString)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(applicationdesc.FieldLicense, field.TypeString, value)
+	}
+	if _u.mutation.LicenseCleared() {
+		_spec.ClearField(applicationdesc.FieldLicense, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseNotes(); ok {
+		_spec.SetField(applicationdesc.FieldReleaseNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReleaseNotesCleared() {
+		_spec.ClearField(applicationdesc.FieldReleaseNotes, field.TypeString)
+	}
 	if _u.mutation.DeploymentProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -467,6 +609,35 @@ func (_u *ApplicationDescUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applicationdesc.OrganizationTable,
+			Columns: []string{applicationdesc.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applicationdesc.OrganizationTable,
+			Columns: []string{applicationdesc.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{applicationdesc.Label}
@@ -487,6 +658,26 @@ type ApplicationDescUpdateOne struct {
 	mutation *ApplicationDescMutation
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *ApplicationDescUpdateOne) SetOrgID(v uuid.UUID) *ApplicationDescUpdateOne {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *ApplicationDescUpdateOne) SetNillableOrgID(v *uuid.UUID) *ApplicationDescUpdateOne {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *ApplicationDescUpdateOne) ClearOrgID() *ApplicationDescUpdateOne {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetAppID sets the "app_id" field.
 func (_u *ApplicationDescUpdateOne) SetAppID(v string) *ApplicationDescUpdateOne {
 	_u.mutation.SetAppID(v)
@@ -725,6 +916,78 @@ func (_u *ApplicationDescUpdateOne) ClearPublished() *ApplicationDescUpdateOne {
 	return _u
 }
 
+// SetIconData sets the "icon_data" field.
+func (_u *ApplicationDescUpdateOne) SetIconData(v []byte) *ApplicationDescUpdateOne {
+	_u.mutation.SetIconData(v)
+	return _u
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (_u *ApplicationDescUpdateOne) ClearIconData() *ApplicationDescUpdateOne {
+	_u.mutation.ClearIconData()
+	return _u
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (_u *ApplicationDescUpdateOne) SetIconMediaType(v string) *ApplicationDescUpdateOne {
+	_u.mutation.SetIconMediaType(v)
+	return _u
+}
+
+// SetNillableIconMediaType sets the "icon_media_type" field if the given value is not nil.
+func (_u *ApplicationDescUpdateOne) SetNillableIconMediaType(v *string) *ApplicationDescUpdateOne {
+	if v != nil {
+		_u.SetIconMediaType(*v)
+	}
+	return _u
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (_u *ApplicationDescUpdateOne) ClearIconMediaType() *ApplicationDescUpdateOne {
+	_u.mutation.ClearIconMediaType()
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *ApplicationDescUpdateOne) SetLicense(v string) *ApplicationDescUpdateOne {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *ApplicationDescUpdateOne) SetNillableLicense(v *string) *ApplicationDescUpdateOne {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// ClearLicense clears the value of the "license" field.
+func (_u *ApplicationDescUpdateOne) ClearLicense() *ApplicationDescUpdateOne {
+	_u.mutation.ClearLicense()
+	return _u
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (_u *ApplicationDescUpdateOne) SetReleaseNotes(v string) *ApplicationDescUpdateOne {
+	_u.mutation.SetReleaseNotes(v)
+	return _u
+}
+
+// SetNillableReleaseNotes sets the "release_notes" field if the given value is not nil.
+func (_u *ApplicationDescUpdateOne) SetNillableReleaseNotes(v *string) *ApplicationDescUpdateOne {
+	if v != nil {
+		_u.SetReleaseNotes(*v)
+	}
+	return _u
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (_u *ApplicationDescUpdateOne) ClearReleaseNotes() *ApplicationDescUpdateOne {
+	_u.mutation.ClearReleaseNotes()
+	return _u
+}
+
 // AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
 func (_u *ApplicationDescUpdateOne) AddDeploymentProfileIDs(ids ...uuid.UUID) *ApplicationDescUpdateOne {
 	_u.mutation.AddDeploymentProfileIDs(ids...)
@@ -740,6 +1003,25 @@ func (_u *ApplicationDescUpdateOne) AddDeploymentProfiles(v ...*DeploymentProfil
 	return _u.AddDeploymentProfileIDs(ids...)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *ApplicationDescUpdateOne) SetOrganizationID(id uuid.UUID) *ApplicationDescUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *ApplicationDescUpdateOne) SetNillableOrganizationID(id *uuid.UUID) *ApplicationDescUpdateOne {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ApplicationDescUpdateOne) SetOrganization(v *Organization) *ApplicationDescUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the ApplicationDescMutation object of the builder.
 func (_u *ApplicationDescUpdateOne) Mutation() *ApplicationDescMutation {
 	return _u.mutation
@@ -766,6 +1048,12 @@ func (_u *ApplicationDescUpdateOne) RemoveDeploymentProfiles(v ...*DeploymentPro
 	return _u.RemoveDeploymentProfileIDs(ids...)
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ApplicationDescUpdateOne) ClearOrganization() *ApplicationDescUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Where appends a list predicates to the ApplicationDescUpdate builder.
 func (_u *ApplicationDescUpdateOne) Where(ps ...predicate.ApplicationDesc) *ApplicationDescUpdateOne {
 	_u.mutation.Where(ps...)
@@ -909,6 +1197,30 @@ func (_u *ApplicationDescUpdateOne) sqlSave(ctx context.Context) (_node *Applica
 	if _u.mutation.PublishedCleared() {
 		_spec.ClearField(applicationdesc.FieldPublished, field.TypeString)
 	}
+	if value, ok := _u.mutation.IconData(); ok {
+		_spec.SetField(applicationdesc.FieldIconData, field.TypeBytes, value)
+	}
+	if _u.mutation.IconDataCleared() {
+		_spec.ClearField(applicationdesc.FieldIconData, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.IconMediaType(); ok {
+		_spec.SetField(applicationdesc.FieldIconMediaType, field.TypeString, value)
+	}
+	if _u.mutation.IconMediaTypeCleared() {
+		_spec.ClearField(applicationdesc.FieldIconMediaType, field.TypeString)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(applicationdesc.FieldLicense, field.TypeString, value)
+	}
+	if _u.mutation.LicenseCleared() {
+		_spec.ClearField(applicationdesc.FieldLicense, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseNotes(); ok {
+		_spec.SetField(applicationdesc.FieldReleaseNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReleaseNotesCleared() {
+		_spec.ClearField(applicationdesc.FieldReleaseNotes, field.TypeString)
+	}
 	if _u.mutation.DeploymentProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -954,6 +1266,35 @@ func (_u *ApplicationDescUpdateOne) sqlSave(ctx context.Context) (_node *Applica
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applicationdesc.OrganizationTable,
+			Columns: []string{applicationdesc.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applicationdesc.OrganizationTable,
+			Columns: []string{applicationdesc.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ApplicationDesc{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
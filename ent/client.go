@@ -16,15 +16,23 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/auditentry"
 	"github.com/balaji-balu/margo-hello-world/ent/component"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/ent/rolebinding"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,24 +40,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
 	// ApplicationDesc is the client for interacting with the ApplicationDesc builders.
 	ApplicationDesc *ApplicationDescClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// Component is the client for interacting with the Component builders.
 	Component *ComponentClient
 	// DeploymentComponentStatus is the client for interacting with the DeploymentComponentStatus builders.
 	DeploymentComponentStatus *DeploymentComponentStatusClient
 	// DeploymentProfile is the client for interacting with the DeploymentProfile builders.
 	DeploymentProfile *DeploymentProfileClient
+	// DeploymentRevision is the client for interacting with the DeploymentRevision builders.
+	DeploymentRevision *DeploymentRevisionClient
 	// DeploymentStatus is the client for interacting with the DeploymentStatus builders.
 	DeploymentStatus *DeploymentStatusClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
 	// Host is the client for interacting with the Host builders.
 	Host *HostClient
 	// Orchestrator is the client for interacting with the Orchestrator builders.
 	Orchestrator *OrchestratorClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// RoleBinding is the client for interacting with the RoleBinding builders.
+	RoleBinding *RoleBindingClient
 	// Site is the client for interacting with the Site builders.
 	Site *SiteClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -61,15 +85,23 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIToken = NewAPITokenClient(c.config)
 	c.ApplicationDesc = NewApplicationDescClient(c.config)
+	c.AuditEntry = NewAuditEntryClient(c.config)
 	c.Component = NewComponentClient(c.config)
 	c.DeploymentComponentStatus = NewDeploymentComponentStatusClient(c.config)
 	c.DeploymentProfile = NewDeploymentProfileClient(c.config)
+	c.DeploymentRevision = NewDeploymentRevisionClient(c.config)
 	c.DeploymentStatus = NewDeploymentStatusClient(c.config)
+	c.Event = NewEventClient(c.config)
 	c.Host = NewHostClient(c.config)
 	c.Orchestrator = NewOrchestratorClient(c.config)
+	c.Organization = NewOrganizationClient(c.config)
+	c.RoleBinding = NewRoleBindingClient(c.config)
 	c.Site = NewSiteClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -162,15 +194,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                       ctx,
 		config:                    cfg,
+		APIToken:                  NewAPITokenClient(cfg),
 		ApplicationDesc:           NewApplicationDescClient(cfg),
+		AuditEntry:                NewAuditEntryClient(cfg),
 		Component:                 NewComponentClient(cfg),
 		DeploymentComponentStatus: NewDeploymentComponentStatusClient(cfg),
 		DeploymentProfile:         NewDeploymentProfileClient(cfg),
+		DeploymentRevision:        NewDeploymentRevisionClient(cfg),
 		DeploymentStatus:          NewDeploymentStatusClient(cfg),
+		Event:                     NewEventClient(cfg),
 		Host:                      NewHostClient(cfg),
 		Orchestrator:              NewOrchestratorClient(cfg),
+		Organization:              NewOrganizationClient(cfg),
+		RoleBinding:               NewRoleBindingClient(cfg),
 		Site:                      NewSiteClient(cfg),
 		User:                      NewUserClient(cfg),
+		Webhook:                   NewWebhookClient(cfg),
+		WebhookDelivery:           NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -190,22 +230,30 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                       ctx,
 		config:                    cfg,
+		APIToken:                  NewAPITokenClient(cfg),
 		ApplicationDesc:           NewApplicationDescClient(cfg),
+		AuditEntry:                NewAuditEntryClient(cfg),
 		Component:                 NewComponentClient(cfg),
 		DeploymentComponentStatus: NewDeploymentComponentStatusClient(cfg),
 		DeploymentProfile:         NewDeploymentProfileClient(cfg),
+		DeploymentRevision:        NewDeploymentRevisionClient(cfg),
 		DeploymentStatus:          NewDeploymentStatusClient(cfg),
+		Event:                     NewEventClient(cfg),
 		Host:                      NewHostClient(cfg),
 		Orchestrator:              NewOrchestratorClient(cfg),
+		Organization:              NewOrganizationClient(cfg),
+		RoleBinding:               NewRoleBindingClient(cfg),
 		Site:                      NewSiteClient(cfg),
 		User:                      NewUserClient(cfg),
+		Webhook:                   NewWebhookClient(cfg),
+		WebhookDelivery:           NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ApplicationDesc.
+//		APIToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -228,9 +276,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApplicationDesc, c.Component, c.DeploymentComponentStatus,
-		c.DeploymentProfile, c.DeploymentStatus, c.Host, c.Orchestrator, c.Site,
-		c.User,
+		c.APIToken, c.ApplicationDesc, c.AuditEntry, c.Component,
+		c.DeploymentComponentStatus, c.DeploymentProfile, c.DeploymentRevision,
+		c.DeploymentStatus, c.Event, c.Host, c.Orchestrator, c.Organization,
+		c.RoleBinding, c.Site, c.User, c.Webhook, c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -240,9 +289,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApplicationDesc, c.Component, c.DeploymentComponentStatus,
-		c.DeploymentProfile, c.DeploymentStatus, c.Host, c.Orchestrator, c.Site,
-		c.User,
+		c.APIToken, c.ApplicationDesc, c.AuditEntry, c.Component,
+		c.DeploymentComponentStatus, c.DeploymentProfile, c.DeploymentRevision,
+		c.DeploymentStatus, c.Event, c.Host, c.Orchestrator, c.Organization,
+		c.RoleBinding, c.Site, c.User, c.Webhook, c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -251,29 +301,194 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APITokenMutation:
+		return c.APIToken.mutate(ctx, m)
 	case *ApplicationDescMutation:
 		return c.ApplicationDesc.mutate(ctx, m)
+	case *AuditEntryMutation:
+		return c.AuditEntry.mutate(ctx, m)
 	case *ComponentMutation:
 		return c.Component.mutate(ctx, m)
 	case *DeploymentComponentStatusMutation:
 		return c.DeploymentComponentStatus.mutate(ctx, m)
 	case *DeploymentProfileMutation:
 		return c.DeploymentProfile.mutate(ctx, m)
+	case *DeploymentRevisionMutation:
+		return c.DeploymentRevision.mutate(ctx, m)
 	case *DeploymentStatusMutation:
 		return c.DeploymentStatus.mutate(ctx, m)
+	case *EventMutation:
+		return c.Event.mutate(ctx, m)
 	case *HostMutation:
 		return c.Host.mutate(ctx, m)
 	case *OrchestratorMutation:
 		return c.Orchestrator.mutate(ctx, m)
+	case *OrganizationMutation:
+		return c.Organization.mutate(ctx, m)
+	case *RoleBindingMutation:
+		return c.RoleBinding.mutate(ctx, m)
 	case *SiteMutation:
 		return c.Site.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APITokenClient is a client for the APIToken schema.
+type APITokenClient struct {
+	config
+}
+
+// NewAPITokenClient returns a client for the APIToken from the given config.
+func NewAPITokenClient(c config) *APITokenClient {
+	return &APITokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *APITokenClient) Use(hooks ...Hook) {
+	c.hooks.APIToken = append(c.hooks.APIToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *APITokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIToken = append(c.inters.APIToken, interceptors...)
+}
+
+// Create returns a builder for creating a APIToken entity.
+func (c *APITokenClient) Create() *APITokenCreate {
+	mutation := newAPITokenMutation(c.config, OpCreate)
+	return &APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIToken entities.
+func (c *APITokenClient) CreateBulk(builders ...*APITokenCreate) *APITokenCreateBulk {
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APITokenClient) MapCreateBulk(slice any, setFunc func(*APITokenCreate, int)) *APITokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APITokenCreateBulk{err: fmt.Errorf("calling to APITokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APITokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIToken.
+func (c *APITokenClient) Update() *APITokenUpdate {
+	mutation := newAPITokenMutation(c.config, OpUpdate)
+	return &APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APITokenClient) UpdateOne(_m *APIToken) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPIToken(_m))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APITokenClient) UpdateOneID(id uuid.UUID) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPITokenID(id))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIToken.
+func (c *APITokenClient) Delete() *APITokenDelete {
+	mutation := newAPITokenMutation(c.config, OpDelete)
+	return &APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APITokenClient) DeleteOne(_m *APIToken) *APITokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APITokenClient) DeleteOneID(id uuid.UUID) *APITokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APITokenDeleteOne{builder}
+}
+
+// Query returns a query builder for APIToken.
+func (c *APITokenClient) Query() *APITokenQuery {
+	return &APITokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIToken entity by its id.
+func (c *APITokenClient) Get(ctx context.Context, id uuid.UUID) (*APIToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APITokenClient) GetX(ctx context.Context, id uuid.UUID) *APIToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a APIToken.
+func (c *APITokenClient) QueryUser(_m *APIToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APITokenClient) Hooks() []Hook {
+	return c.hooks.APIToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *APITokenClient) Interceptors() []Interceptor {
+	return c.inters.APIToken
+}
+
+func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIToken mutation op: %q", m.Op())
+	}
+}
+
 // ApplicationDescClient is a client for the ApplicationDesc schema.
 type ApplicationDescClient struct {
 	config
@@ -398,14 +613,32 @@ func (c *ApplicationDescClient) QueryDeploymentProfiles(_m *ApplicationDesc) *De
 	return query
 }
 
+// QueryOrganization queries the organization edge of a ApplicationDesc.
+func (c *ApplicationDescClient) QueryOrganization(_m *ApplicationDesc) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(applicationdesc.Table, applicationdesc.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, applicationdesc.OrganizationTable, applicationdesc.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ApplicationDescClient) Hooks() []Hook {
-	return c.hooks.ApplicationDesc
+	hooks := c.hooks.ApplicationDesc
+	return append(hooks[:len(hooks):len(hooks)], applicationdesc.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ApplicationDescClient) Interceptors() []Interceptor {
-	return c.inters.ApplicationDesc
+	inters := c.inters.ApplicationDesc
+	return append(inters[:len(inters):len(inters)], applicationdesc.Interceptors[:]...)
 }
 
 func (c *ApplicationDescClient) mutate(ctx context.Context, m *ApplicationDescMutation) (Value, error) {
@@ -423,6 +656,141 @@ func (c *ApplicationDescClient) mutate(ctx context.Context, m *ApplicationDescMu
 	}
 }
 
+// AuditEntryClient is a client for the AuditEntry schema.
+type AuditEntryClient struct {
+	config
+}
+
+// NewAuditEntryClient returns a client for the AuditEntry from the given config.
+func NewAuditEntryClient(c config) *AuditEntryClient {
+	return &AuditEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditentry.Hooks(f(g(h())))`.
+func (c *AuditEntryClient) Use(hooks ...Hook) {
+	c.hooks.AuditEntry = append(c.hooks.AuditEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditentry.Intercept(f(g(h())))`.
+func (c *AuditEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEntry = append(c.inters.AuditEntry, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEntry entity.
+func (c *AuditEntryClient) Create() *AuditEntryCreate {
+	mutation := newAuditEntryMutation(c.config, OpCreate)
+	return &AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEntry entities.
+func (c *AuditEntryClient) CreateBulk(builders ...*AuditEntryCreate) *AuditEntryCreateBulk {
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEntryClient) MapCreateBulk(slice any, setFunc func(*AuditEntryCreate, int)) *AuditEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEntryCreateBulk{err: fmt.Errorf("calling to AuditEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEntry.
+func (c *AuditEntryClient) Update() *AuditEntryUpdate {
+	mutation := newAuditEntryMutation(c.config, OpUpdate)
+	return &AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEntryClient) UpdateOne(_m *AuditEntry) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntry(_m))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEntryClient) UpdateOneID(id int) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntryID(id))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEntry.
+func (c *AuditEntryClient) Delete() *AuditEntryDelete {
+	mutation := newAuditEntryMutation(c.config, OpDelete)
+	return &AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEntryClient) DeleteOne(_m *AuditEntry) *AuditEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEntryClient) DeleteOneID(id int) *AuditEntryDeleteOne {
+	builder := c.Delete().Where(auditentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEntry.
+func (c *AuditEntryClient) Query() *AuditEntryQuery {
+	return &AuditEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEntry entity by its id.
+func (c *AuditEntryClient) Get(ctx context.Context, id int) (*AuditEntry, error) {
+	return c.Query().Where(auditentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEntryClient) GetX(ctx context.Context, id int) *AuditEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEntryClient) Hooks() []Hook {
+	hooks := c.hooks.AuditEntry
+	return append(hooks[:len(hooks):len(hooks)], auditentry.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEntryClient) Interceptors() []Interceptor {
+	inters := c.inters.AuditEntry
+	return append(inters[:len(inters):len(inters)], auditentry.Interceptors[:]...)
+}
+
+func (c *AuditEntryClient) mutate(ctx context.Context, m *AuditEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEntry mutation op: %q", m.Op())
+	}
+}
+
 // ComponentClient is a client for the Component schema.
 type ComponentClient struct {
 	config
@@ -886,107 +1254,107 @@ func (c *DeploymentProfileClient) mutate(ctx context.Context, m *DeploymentProfi
 	}
 }
 
-// DeploymentStatusClient is a client for the DeploymentStatus schema.
-type DeploymentStatusClient struct {
+// DeploymentRevisionClient is a client for the DeploymentRevision schema.
+type DeploymentRevisionClient struct {
 	config
 }
 
-// NewDeploymentStatusClient returns a client for the DeploymentStatus from the given config.
-func NewDeploymentStatusClient(c config) *DeploymentStatusClient {
-	return &DeploymentStatusClient{config: c}
+// NewDeploymentRevisionClient returns a client for the DeploymentRevision from the given config.
+func NewDeploymentRevisionClient(c config) *DeploymentRevisionClient {
+	return &DeploymentRevisionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deploymentstatus.Hooks(f(g(h())))`.
-func (c *DeploymentStatusClient) Use(hooks ...Hook) {
-	c.hooks.DeploymentStatus = append(c.hooks.DeploymentStatus, hooks...)
+// A call to `Use(f, g, h)` equals to `deploymentrevision.Hooks(f(g(h())))`.
+func (c *DeploymentRevisionClient) Use(hooks ...Hook) {
+	c.hooks.DeploymentRevision = append(c.hooks.DeploymentRevision, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deploymentstatus.Intercept(f(g(h())))`.
-func (c *DeploymentStatusClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeploymentStatus = append(c.inters.DeploymentStatus, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deploymentrevision.Intercept(f(g(h())))`.
+func (c *DeploymentRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeploymentRevision = append(c.inters.DeploymentRevision, interceptors...)
 }
 
-// Create returns a builder for creating a DeploymentStatus entity.
-func (c *DeploymentStatusClient) Create() *DeploymentStatusCreate {
-	mutation := newDeploymentStatusMutation(c.config, OpCreate)
-	return &DeploymentStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeploymentRevision entity.
+func (c *DeploymentRevisionClient) Create() *DeploymentRevisionCreate {
+	mutation := newDeploymentRevisionMutation(c.config, OpCreate)
+	return &DeploymentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeploymentStatus entities.
-func (c *DeploymentStatusClient) CreateBulk(builders ...*DeploymentStatusCreate) *DeploymentStatusCreateBulk {
-	return &DeploymentStatusCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeploymentRevision entities.
+func (c *DeploymentRevisionClient) CreateBulk(builders ...*DeploymentRevisionCreate) *DeploymentRevisionCreateBulk {
+	return &DeploymentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeploymentStatusClient) MapCreateBulk(slice any, setFunc func(*DeploymentStatusCreate, int)) *DeploymentStatusCreateBulk {
+func (c *DeploymentRevisionClient) MapCreateBulk(slice any, setFunc func(*DeploymentRevisionCreate, int)) *DeploymentRevisionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeploymentStatusCreateBulk{err: fmt.Errorf("calling to DeploymentStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeploymentRevisionCreateBulk{err: fmt.Errorf("calling to DeploymentRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeploymentStatusCreate, rv.Len())
+	builders := make([]*DeploymentRevisionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeploymentStatusCreateBulk{config: c.config, builders: builders}
+	return &DeploymentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeploymentStatus.
-func (c *DeploymentStatusClient) Update() *DeploymentStatusUpdate {
-	mutation := newDeploymentStatusMutation(c.config, OpUpdate)
-	return &DeploymentStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeploymentRevision.
+func (c *DeploymentRevisionClient) Update() *DeploymentRevisionUpdate {
+	mutation := newDeploymentRevisionMutation(c.config, OpUpdate)
+	return &DeploymentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeploymentStatusClient) UpdateOne(_m *DeploymentStatus) *DeploymentStatusUpdateOne {
-	mutation := newDeploymentStatusMutation(c.config, OpUpdateOne, withDeploymentStatus(_m))
-	return &DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeploymentRevisionClient) UpdateOne(_m *DeploymentRevision) *DeploymentRevisionUpdateOne {
+	mutation := newDeploymentRevisionMutation(c.config, OpUpdateOne, withDeploymentRevision(_m))
+	return &DeploymentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeploymentStatusClient) UpdateOneID(id uuid.UUID) *DeploymentStatusUpdateOne {
-	mutation := newDeploymentStatusMutation(c.config, OpUpdateOne, withDeploymentStatusID(id))
-	return &DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeploymentRevisionClient) UpdateOneID(id uuid.UUID) *DeploymentRevisionUpdateOne {
+	mutation := newDeploymentRevisionMutation(c.config, OpUpdateOne, withDeploymentRevisionID(id))
+	return &DeploymentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeploymentStatus.
-func (c *DeploymentStatusClient) Delete() *DeploymentStatusDelete {
-	mutation := newDeploymentStatusMutation(c.config, OpDelete)
-	return &DeploymentStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeploymentRevision.
+func (c *DeploymentRevisionClient) Delete() *DeploymentRevisionDelete {
+	mutation := newDeploymentRevisionMutation(c.config, OpDelete)
+	return &DeploymentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeploymentStatusClient) DeleteOne(_m *DeploymentStatus) *DeploymentStatusDeleteOne {
+func (c *DeploymentRevisionClient) DeleteOne(_m *DeploymentRevision) *DeploymentRevisionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeploymentStatusClient) DeleteOneID(id uuid.UUID) *DeploymentStatusDeleteOne {
-	builder := c.Delete().Where(deploymentstatus.ID(id))
+func (c *DeploymentRevisionClient) DeleteOneID(id uuid.UUID) *DeploymentRevisionDeleteOne {
+	builder := c.Delete().Where(deploymentrevision.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeploymentStatusDeleteOne{builder}
+	return &DeploymentRevisionDeleteOne{builder}
 }
 
-// Query returns a query builder for DeploymentStatus.
-func (c *DeploymentStatusClient) Query() *DeploymentStatusQuery {
-	return &DeploymentStatusQuery{
+// Query returns a query builder for DeploymentRevision.
+func (c *DeploymentRevisionClient) Query() *DeploymentRevisionQuery {
+	return &DeploymentRevisionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeploymentStatus},
+		ctx:    &QueryContext{Type: TypeDeploymentRevision},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeploymentStatus entity by its id.
-func (c *DeploymentStatusClient) Get(ctx context.Context, id uuid.UUID) (*DeploymentStatus, error) {
-	return c.Query().Where(deploymentstatus.ID(id)).Only(ctx)
+// Get returns a DeploymentRevision entity by its id.
+func (c *DeploymentRevisionClient) Get(ctx context.Context, id uuid.UUID) (*DeploymentRevision, error) {
+	return c.Query().Where(deploymentrevision.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeploymentStatusClient) GetX(ctx context.Context, id uuid.UUID) *DeploymentStatus {
+func (c *DeploymentRevisionClient) GetX(ctx context.Context, id uuid.UUID) *DeploymentRevision {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -994,15 +1362,15 @@ func (c *DeploymentStatusClient) GetX(ctx context.Context, id uuid.UUID) *Deploy
 	return obj
 }
 
-// QueryComponents queries the components edge of a DeploymentStatus.
-func (c *DeploymentStatusClient) QueryComponents(_m *DeploymentStatus) *DeploymentComponentStatusQuery {
-	query := (&DeploymentComponentStatusClient{config: c.config}).Query()
+// QueryDeployment queries the deployment edge of a DeploymentRevision.
+func (c *DeploymentRevisionClient) QueryDeployment(_m *DeploymentRevision) *DeploymentStatusQuery {
+	query := (&DeploymentStatusClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, id),
-			sqlgraph.To(deploymentcomponentstatus.Table, deploymentcomponentstatus.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, deploymentstatus.ComponentsTable, deploymentstatus.ComponentsColumn),
+			sqlgraph.From(deploymentrevision.Table, deploymentrevision.FieldID, id),
+			sqlgraph.To(deploymentstatus.Table, deploymentstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentrevision.DeploymentTable, deploymentrevision.DeploymentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1011,52 +1379,372 @@ func (c *DeploymentStatusClient) QueryComponents(_m *DeploymentStatus) *Deployme
 }
 
 // Hooks returns the client hooks.
-func (c *DeploymentStatusClient) Hooks() []Hook {
-	return c.hooks.DeploymentStatus
+func (c *DeploymentRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.DeploymentRevision
+	return append(hooks[:len(hooks):len(hooks)], deploymentrevision.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeploymentStatusClient) Interceptors() []Interceptor {
-	return c.inters.DeploymentStatus
+func (c *DeploymentRevisionClient) Interceptors() []Interceptor {
+	inters := c.inters.DeploymentRevision
+	return append(inters[:len(inters):len(inters)], deploymentrevision.Interceptors[:]...)
 }
 
-func (c *DeploymentStatusClient) mutate(ctx context.Context, m *DeploymentStatusMutation) (Value, error) {
+func (c *DeploymentRevisionClient) mutate(ctx context.Context, m *DeploymentRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeploymentStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeploymentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeploymentStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeploymentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeploymentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeploymentStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeploymentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeploymentStatus mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeploymentRevision mutation op: %q", m.Op())
 	}
 }
 
-// HostClient is a client for the Host schema.
-type HostClient struct {
+// DeploymentStatusClient is a client for the DeploymentStatus schema.
+type DeploymentStatusClient struct {
 	config
 }
 
-// NewHostClient returns a client for the Host from the given config.
-func NewHostClient(c config) *HostClient {
-	return &HostClient{config: c}
+// NewDeploymentStatusClient returns a client for the DeploymentStatus from the given config.
+func NewDeploymentStatusClient(c config) *DeploymentStatusClient {
+	return &DeploymentStatusClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `host.Hooks(f(g(h())))`.
-func (c *HostClient) Use(hooks ...Hook) {
-	c.hooks.Host = append(c.hooks.Host, hooks...)
+// A call to `Use(f, g, h)` equals to `deploymentstatus.Hooks(f(g(h())))`.
+func (c *DeploymentStatusClient) Use(hooks ...Hook) {
+	c.hooks.DeploymentStatus = append(c.hooks.DeploymentStatus, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `host.Intercept(f(g(h())))`.
-func (c *HostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Host = append(c.inters.Host, interceptors...)
-}
-
+// A call to `Intercept(f, g, h)` equals to `deploymentstatus.Intercept(f(g(h())))`.
+func (c *DeploymentStatusClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeploymentStatus = append(c.inters.DeploymentStatus, interceptors...)
+}
+
+// Create returns a builder for creating a DeploymentStatus entity.
+func (c *DeploymentStatusClient) Create() *DeploymentStatusCreate {
+	mutation := newDeploymentStatusMutation(c.config, OpCreate)
+	return &DeploymentStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeploymentStatus entities.
+func (c *DeploymentStatusClient) CreateBulk(builders ...*DeploymentStatusCreate) *DeploymentStatusCreateBulk {
+	return &DeploymentStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeploymentStatusClient) MapCreateBulk(slice any, setFunc func(*DeploymentStatusCreate, int)) *DeploymentStatusCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeploymentStatusCreateBulk{err: fmt.Errorf("calling to DeploymentStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeploymentStatusCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeploymentStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeploymentStatus.
+func (c *DeploymentStatusClient) Update() *DeploymentStatusUpdate {
+	mutation := newDeploymentStatusMutation(c.config, OpUpdate)
+	return &DeploymentStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeploymentStatusClient) UpdateOne(_m *DeploymentStatus) *DeploymentStatusUpdateOne {
+	mutation := newDeploymentStatusMutation(c.config, OpUpdateOne, withDeploymentStatus(_m))
+	return &DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeploymentStatusClient) UpdateOneID(id uuid.UUID) *DeploymentStatusUpdateOne {
+	mutation := newDeploymentStatusMutation(c.config, OpUpdateOne, withDeploymentStatusID(id))
+	return &DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeploymentStatus.
+func (c *DeploymentStatusClient) Delete() *DeploymentStatusDelete {
+	mutation := newDeploymentStatusMutation(c.config, OpDelete)
+	return &DeploymentStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeploymentStatusClient) DeleteOne(_m *DeploymentStatus) *DeploymentStatusDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeploymentStatusClient) DeleteOneID(id uuid.UUID) *DeploymentStatusDeleteOne {
+	builder := c.Delete().Where(deploymentstatus.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeploymentStatusDeleteOne{builder}
+}
+
+// Query returns a query builder for DeploymentStatus.
+func (c *DeploymentStatusClient) Query() *DeploymentStatusQuery {
+	return &DeploymentStatusQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeploymentStatus},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeploymentStatus entity by its id.
+func (c *DeploymentStatusClient) Get(ctx context.Context, id uuid.UUID) (*DeploymentStatus, error) {
+	return c.Query().Where(deploymentstatus.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeploymentStatusClient) GetX(ctx context.Context, id uuid.UUID) *DeploymentStatus {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryComponents queries the components edge of a DeploymentStatus.
+func (c *DeploymentStatusClient) QueryComponents(_m *DeploymentStatus) *DeploymentComponentStatusQuery {
+	query := (&DeploymentComponentStatusClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, id),
+			sqlgraph.To(deploymentcomponentstatus.Table, deploymentcomponentstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deploymentstatus.ComponentsTable, deploymentstatus.ComponentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a DeploymentStatus.
+func (c *DeploymentStatusClient) QueryRevisions(_m *DeploymentStatus) *DeploymentRevisionQuery {
+	query := (&DeploymentRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, id),
+			sqlgraph.To(deploymentrevision.Table, deploymentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deploymentstatus.RevisionsTable, deploymentstatus.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a DeploymentStatus.
+func (c *DeploymentStatusClient) QueryOrganization(_m *DeploymentStatus) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentstatus.OrganizationTable, deploymentstatus.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeploymentStatusClient) Hooks() []Hook {
+	hooks := c.hooks.DeploymentStatus
+	return append(hooks[:len(hooks):len(hooks)], deploymentstatus.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeploymentStatusClient) Interceptors() []Interceptor {
+	inters := c.inters.DeploymentStatus
+	return append(inters[:len(inters):len(inters)], deploymentstatus.Interceptors[:]...)
+}
+
+func (c *DeploymentStatusClient) mutate(ctx context.Context, m *DeploymentStatusMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeploymentStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeploymentStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeploymentStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeploymentStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeploymentStatus mutation op: %q", m.Op())
+	}
+}
+
+// EventClient is a client for the Event schema.
+type EventClient struct {
+	config
+}
+
+// NewEventClient returns a client for the Event from the given config.
+func NewEventClient(c config) *EventClient {
+	return &EventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
+func (c *EventClient) Use(hooks ...Hook) {
+	c.hooks.Event = append(c.hooks.Event, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
+func (c *EventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Event = append(c.inters.Event, interceptors...)
+}
+
+// Create returns a builder for creating a Event entity.
+func (c *EventClient) Create() *EventCreate {
+	mutation := newEventMutation(c.config, OpCreate)
+	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Event entities.
+func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Event.
+func (c *EventClient) Update() *EventUpdate {
+	mutation := newEventMutation(c.config, OpUpdate)
+	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventClient) UpdateOneID(id int) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Event.
+func (c *EventClient) Delete() *EventDelete {
+	mutation := newEventMutation(c.config, OpDelete)
+	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventClient) DeleteOneID(id int) *EventDeleteOne {
+	builder := c.Delete().Where(event.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventDeleteOne{builder}
+}
+
+// Query returns a query builder for Event.
+func (c *EventClient) Query() *EventQuery {
+	return &EventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Event entity by its id.
+func (c *EventClient) Get(ctx context.Context, id int) (*Event, error) {
+	return c.Query().Where(event.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventClient) GetX(ctx context.Context, id int) *Event {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventClient) Hooks() []Hook {
+	hooks := c.hooks.Event
+	return append(hooks[:len(hooks):len(hooks)], event.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventClient) Interceptors() []Interceptor {
+	inters := c.inters.Event
+	return append(inters[:len(inters):len(inters)], event.Interceptors[:]...)
+}
+
+func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Event mutation op: %q", m.Op())
+	}
+}
+
+// HostClient is a client for the Host schema.
+type HostClient struct {
+	config
+}
+
+// NewHostClient returns a client for the Host from the given config.
+func NewHostClient(c config) *HostClient {
+	return &HostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `host.Hooks(f(g(h())))`.
+func (c *HostClient) Use(hooks ...Hook) {
+	c.hooks.Host = append(c.hooks.Host, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `host.Intercept(f(g(h())))`.
+func (c *HostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Host = append(c.inters.Host, interceptors...)
+}
+
 // Create returns a builder for creating a Host entity.
 func (c *HostClient) Create() *HostCreate {
 	mutation := newHostMutation(c.config, OpCreate)
@@ -1161,12 +1849,14 @@ func (c *HostClient) QuerySite(_m *Host) *SiteQuery {
 
 // Hooks returns the client hooks.
 func (c *HostClient) Hooks() []Hook {
-	return c.hooks.Host
+	hooks := c.hooks.Host
+	return append(hooks[:len(hooks):len(hooks)], host.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *HostClient) Interceptors() []Interceptor {
-	return c.inters.Host
+	inters := c.inters.Host
+	return append(inters[:len(inters):len(inters)], host.Interceptors[:]...)
 }
 
 func (c *HostClient) mutate(ctx context.Context, m *HostMutation) (Value, error) {
@@ -1222,69 +1912,415 @@ func (c *OrchestratorClient) CreateBulk(builders ...*OrchestratorCreate) *Orches
 func (c *OrchestratorClient) MapCreateBulk(slice any, setFunc func(*OrchestratorCreate, int)) *OrchestratorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrchestratorCreateBulk{err: fmt.Errorf("calling to OrchestratorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrchestratorCreateBulk{err: fmt.Errorf("calling to OrchestratorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrchestratorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrchestratorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Orchestrator.
+func (c *OrchestratorClient) Update() *OrchestratorUpdate {
+	mutation := newOrchestratorMutation(c.config, OpUpdate)
+	return &OrchestratorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrchestratorClient) UpdateOne(_m *Orchestrator) *OrchestratorUpdateOne {
+	mutation := newOrchestratorMutation(c.config, OpUpdateOne, withOrchestrator(_m))
+	return &OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrchestratorClient) UpdateOneID(id uuid.UUID) *OrchestratorUpdateOne {
+	mutation := newOrchestratorMutation(c.config, OpUpdateOne, withOrchestratorID(id))
+	return &OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Orchestrator.
+func (c *OrchestratorClient) Delete() *OrchestratorDelete {
+	mutation := newOrchestratorMutation(c.config, OpDelete)
+	return &OrchestratorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrchestratorClient) DeleteOne(_m *Orchestrator) *OrchestratorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrchestratorClient) DeleteOneID(id uuid.UUID) *OrchestratorDeleteOne {
+	builder := c.Delete().Where(orchestrator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrchestratorDeleteOne{builder}
+}
+
+// Query returns a query builder for Orchestrator.
+func (c *OrchestratorClient) Query() *OrchestratorQuery {
+	return &OrchestratorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrchestrator},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Orchestrator entity by its id.
+func (c *OrchestratorClient) Get(ctx context.Context, id uuid.UUID) (*Orchestrator, error) {
+	return c.Query().Where(orchestrator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrchestratorClient) GetX(ctx context.Context, id uuid.UUID) *Orchestrator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySites queries the sites edge of a Orchestrator.
+func (c *OrchestratorClient) QuerySites(_m *Orchestrator) *SiteQuery {
+	query := (&SiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orchestrator.Table, orchestrator.FieldID, id),
+			sqlgraph.To(site.Table, site.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orchestrator.SitesTable, orchestrator.SitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrchestratorClient) Hooks() []Hook {
+	return c.hooks.Orchestrator
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrchestratorClient) Interceptors() []Interceptor {
+	return c.inters.Orchestrator
+}
+
+func (c *OrchestratorClient) mutate(ctx context.Context, m *OrchestratorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrchestratorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrchestratorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrchestratorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Orchestrator mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApps queries the apps edge of a Organization.
+func (c *OrganizationClient) QueryApps(_m *Organization) *ApplicationDescQuery {
+	query := (&ApplicationDescClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(applicationdesc.Table, applicationdesc.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AppsTable, organization.AppsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySites queries the sites edge of a Organization.
+func (c *OrganizationClient) QuerySites(_m *Organization) *SiteQuery {
+	query := (&SiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(site.Table, site.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SitesTable, organization.SitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeployments queries the deployments edge of a Organization.
+func (c *OrganizationClient) QueryDeployments(_m *Organization) *DeploymentStatusQuery {
+	query := (&DeploymentStatusClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(deploymentstatus.Table, deploymentstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.DeploymentsTable, organization.DeploymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsers queries the users edge of a Organization.
+func (c *OrganizationClient) QueryUsers(_m *Organization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// RoleBindingClient is a client for the RoleBinding schema.
+type RoleBindingClient struct {
+	config
+}
+
+// NewRoleBindingClient returns a client for the RoleBinding from the given config.
+func NewRoleBindingClient(c config) *RoleBindingClient {
+	return &RoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolebinding.Hooks(f(g(h())))`.
+func (c *RoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.RoleBinding = append(c.hooks.RoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolebinding.Intercept(f(g(h())))`.
+func (c *RoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleBinding = append(c.inters.RoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a RoleBinding entity.
+func (c *RoleBindingClient) Create() *RoleBindingCreate {
+	mutation := newRoleBindingMutation(c.config, OpCreate)
+	return &RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleBinding entities.
+func (c *RoleBindingClient) CreateBulk(builders ...*RoleBindingCreate) *RoleBindingCreateBulk {
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleBindingClient) MapCreateBulk(slice any, setFunc func(*RoleBindingCreate, int)) *RoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleBindingCreateBulk{err: fmt.Errorf("calling to RoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrchestratorCreate, rv.Len())
+	builders := make([]*RoleBindingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrchestratorCreateBulk{config: c.config, builders: builders}
+	return &RoleBindingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Orchestrator.
-func (c *OrchestratorClient) Update() *OrchestratorUpdate {
-	mutation := newOrchestratorMutation(c.config, OpUpdate)
-	return &OrchestratorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RoleBinding.
+func (c *RoleBindingClient) Update() *RoleBindingUpdate {
+	mutation := newRoleBindingMutation(c.config, OpUpdate)
+	return &RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrchestratorClient) UpdateOne(_m *Orchestrator) *OrchestratorUpdateOne {
-	mutation := newOrchestratorMutation(c.config, OpUpdateOne, withOrchestrator(_m))
-	return &OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleBindingClient) UpdateOne(_m *RoleBinding) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBinding(_m))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrchestratorClient) UpdateOneID(id uuid.UUID) *OrchestratorUpdateOne {
-	mutation := newOrchestratorMutation(c.config, OpUpdateOne, withOrchestratorID(id))
-	return &OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleBindingClient) UpdateOneID(id uuid.UUID) *RoleBindingUpdateOne {
+	mutation := newRoleBindingMutation(c.config, OpUpdateOne, withRoleBindingID(id))
+	return &RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Orchestrator.
-func (c *OrchestratorClient) Delete() *OrchestratorDelete {
-	mutation := newOrchestratorMutation(c.config, OpDelete)
-	return &OrchestratorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RoleBinding.
+func (c *RoleBindingClient) Delete() *RoleBindingDelete {
+	mutation := newRoleBindingMutation(c.config, OpDelete)
+	return &RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrchestratorClient) DeleteOne(_m *Orchestrator) *OrchestratorDeleteOne {
+func (c *RoleBindingClient) DeleteOne(_m *RoleBinding) *RoleBindingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrchestratorClient) DeleteOneID(id uuid.UUID) *OrchestratorDeleteOne {
-	builder := c.Delete().Where(orchestrator.ID(id))
+func (c *RoleBindingClient) DeleteOneID(id uuid.UUID) *RoleBindingDeleteOne {
+	builder := c.Delete().Where(rolebinding.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrchestratorDeleteOne{builder}
+	return &RoleBindingDeleteOne{builder}
 }
 
-// Query returns a query builder for Orchestrator.
-func (c *OrchestratorClient) Query() *OrchestratorQuery {
-	return &OrchestratorQuery{
+// Query returns a query builder for RoleBinding.
+func (c *RoleBindingClient) Query() *RoleBindingQuery {
+	return &RoleBindingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrchestrator},
+		ctx:    &QueryContext{Type: TypeRoleBinding},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Orchestrator entity by its id.
-func (c *OrchestratorClient) Get(ctx context.Context, id uuid.UUID) (*Orchestrator, error) {
-	return c.Query().Where(orchestrator.ID(id)).Only(ctx)
+// Get returns a RoleBinding entity by its id.
+func (c *RoleBindingClient) Get(ctx context.Context, id uuid.UUID) (*RoleBinding, error) {
+	return c.Query().Where(rolebinding.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrchestratorClient) GetX(ctx context.Context, id uuid.UUID) *Orchestrator {
+func (c *RoleBindingClient) GetX(ctx context.Context, id uuid.UUID) *RoleBinding {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1292,15 +2328,15 @@ func (c *OrchestratorClient) GetX(ctx context.Context, id uuid.UUID) *Orchestrat
 	return obj
 }
 
-// QuerySites queries the sites edge of a Orchestrator.
-func (c *OrchestratorClient) QuerySites(_m *Orchestrator) *SiteQuery {
-	query := (&SiteClient{config: c.config}).Query()
+// QueryUser queries the user edge of a RoleBinding.
+func (c *RoleBindingClient) QueryUser(_m *RoleBinding) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orchestrator.Table, orchestrator.FieldID, id),
-			sqlgraph.To(site.Table, site.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, orchestrator.SitesTable, orchestrator.SitesColumn),
+			sqlgraph.From(rolebinding.Table, rolebinding.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolebinding.UserTable, rolebinding.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1309,27 +2345,27 @@ func (c *OrchestratorClient) QuerySites(_m *Orchestrator) *SiteQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrchestratorClient) Hooks() []Hook {
-	return c.hooks.Orchestrator
+func (c *RoleBindingClient) Hooks() []Hook {
+	return c.hooks.RoleBinding
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrchestratorClient) Interceptors() []Interceptor {
-	return c.inters.Orchestrator
+func (c *RoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.RoleBinding
 }
 
-func (c *OrchestratorClient) mutate(ctx context.Context, m *OrchestratorMutation) (Value, error) {
+func (c *RoleBindingClient) mutate(ctx context.Context, m *RoleBindingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrchestratorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrchestratorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrchestratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrchestratorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Orchestrator mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RoleBinding mutation op: %q", m.Op())
 	}
 }
 
@@ -1473,14 +2509,32 @@ func (c *SiteClient) QueryOrchestrator(_m *Site) *OrchestratorQuery {
 	return query
 }
 
+// QueryOrganization queries the organization edge of a Site.
+func (c *SiteClient) QueryOrganization(_m *Site) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(site.Table, site.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, site.OrganizationTable, site.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SiteClient) Hooks() []Hook {
-	return c.hooks.Site
+	hooks := c.hooks.Site
+	return append(hooks[:len(hooks):len(hooks)], site.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *SiteClient) Interceptors() []Interceptor {
-	return c.inters.Site
+	inters := c.inters.Site
+	return append(inters[:len(inters):len(inters)], site.Interceptors[:]...)
 }
 
 func (c *SiteClient) mutate(ctx context.Context, m *SiteMutation) (Value, error) {
@@ -1606,14 +2660,64 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// QueryRoles queries the roles edge of a User.
+func (c *UserClient) QueryRoles(_m *User) *RoleBindingQuery {
+	query := (&RoleBindingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(rolebinding.Table, rolebinding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RolesTable, user.RolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTokens queries the tokens edge of a User.
+func (c *UserClient) QueryTokens(_m *User) *APITokenQuery {
+	query := (&APITokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TokensTable, user.TokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a User.
+func (c *UserClient) QueryOrganization(_m *User) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.OrganizationTable, user.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+	inters := c.inters.User
+	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
 }
 
 func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
@@ -1631,14 +2735,320 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(_m *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(_m))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id uuid.UUID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(_m *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id uuid.UUID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id uuid.UUID) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(_m *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	hooks := c.hooks.Webhook
+	return append(hooks[:len(hooks):len(hooks)], webhook.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	inters := c.inters.Webhook
+	return append(inters[:len(inters):len(inters)], webhook.Interceptors[:]...)
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(_m *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	inters := c.inters.WebhookDelivery
+	return append(inters[:len(inters):len(inters)], webhookdelivery.Interceptors[:]...)
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApplicationDesc, Component, DeploymentComponentStatus, DeploymentProfile,
-		DeploymentStatus, Host, Orchestrator, Site, User []ent.Hook
+		APIToken, ApplicationDesc, AuditEntry, Component, DeploymentComponentStatus,
+		DeploymentProfile, DeploymentRevision, DeploymentStatus, Event, Host,
+		Orchestrator, Organization, RoleBinding, Site, User, Webhook,
+		WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		ApplicationDesc, Component, DeploymentComponentStatus, DeploymentProfile,
-		DeploymentStatus, Host, Orchestrator, Site, User []ent.Interceptor
+		APIToken, ApplicationDesc, AuditEntry, Component, DeploymentComponentStatus,
+		DeploymentProfile, DeploymentRevision, DeploymentStatus, Event, Host,
+		Orchestrator, Organization, RoleBinding, Site, User, Webhook,
+		WebhookDelivery []ent.Interceptor
 	}
 )
@@ -21,12 +21,18 @@ type DeploymentComponentStatus struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// HostID holds the value of the "host_id" field.
+	HostID string `json:"host_id,omitempty"`
 	// State holds the value of the "state" field.
 	State string `json:"state,omitempty"`
 	// ErrorCode holds the value of the "error_code" field.
 	ErrorCode string `json:"error_code,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage string `json:"error_message,omitempty"`
+	// ReportedAt holds the value of the "reported_at" field.
+	ReportedAt int64 `json:"reported_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -63,7 +69,9 @@ func (*DeploymentComponentStatus) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deploymentcomponentstatus.FieldName, deploymentcomponentstatus.FieldState, deploymentcomponentstatus.FieldErrorCode, deploymentcomponentstatus.FieldErrorMessage:
+		case deploymentcomponentstatus.FieldReportedAt:
+			values[i] = new(sql.NullInt64)
+		case deploymentcomponentstatus.FieldName, deploymentcomponentstatus.FieldSiteID, deploymentcomponentstatus.FieldHostID, deploymentcomponentstatus.FieldState, deploymentcomponentstatus.FieldErrorCode, deploymentcomponentstatus.FieldErrorMessage:
 			values[i] = new(sql.NullString)
 		case deploymentcomponentstatus.FieldCreatedAt, deploymentcomponentstatus.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -98,6 +106,18 @@ func (_m *DeploymentComponentStatus) assignValues(columns []string, values []any
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case deploymentcomponentstatus.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case deploymentcomponentstatus.FieldHostID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_id", values[i])
+			} else if value.Valid {
+				_m.HostID = value.String
+			}
 		case deploymentcomponentstatus.FieldState:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field state", values[i])
@@ -116,6 +136,12 @@ func (_m *DeploymentComponentStatus) assignValues(columns []string, values []any
 			} else if value.Valid {
 				_m.ErrorMessage = value.String
 			}
+		case deploymentcomponentstatus.FieldReportedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reported_at", values[i])
+			} else if value.Valid {
+				_m.ReportedAt = value.Int64
+			}
 		case deploymentcomponentstatus.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -179,6 +205,12 @@ func (_m *DeploymentComponentStatus) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("host_id=")
+	builder.WriteString(_m.HostID)
+	builder.WriteString(", ")
 	builder.WriteString("state=")
 	builder.WriteString(_m.State)
 	builder.WriteString(", ")
@@ -188,6 +220,9 @@ func (_m *DeploymentComponentStatus) String() string {
 	builder.WriteString("error_message=")
 	builder.WriteString(_m.ErrorMessage)
 	builder.WriteString(", ")
+	builder.WriteString("reported_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReportedAt))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -61,6 +61,16 @@ func Name(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldName, v))
 }
 
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldSiteID, v))
+}
+
+// HostID applies equality check predicate on the "host_id" field. It's identical to HostIDEQ.
+func HostID(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldHostID, v))
+}
+
 // State applies equality check predicate on the "state" field. It's identical to StateEQ.
 func State(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldState, v))
@@ -76,6 +86,11 @@ func ErrorMessage(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// ReportedAt applies equality check predicate on the "reported_at" field. It's identical to ReportedAtEQ.
+func ReportedAt(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldReportedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldCreatedAt, v))
@@ -151,6 +166,136 @@ func NameContainsFold(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldContainsFold(FieldName, v))
 }
 
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// HostIDEQ applies the EQ predicate on the "host_id" field.
+func HostIDEQ(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldHostID, v))
+}
+
+// HostIDNEQ applies the NEQ predicate on the "host_id" field.
+func HostIDNEQ(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNEQ(FieldHostID, v))
+}
+
+// HostIDIn applies the In predicate on the "host_id" field.
+func HostIDIn(vs ...string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldIn(FieldHostID, vs...))
+}
+
+// HostIDNotIn applies the NotIn predicate on the "host_id" field.
+func HostIDNotIn(vs ...string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNotIn(FieldHostID, vs...))
+}
+
+// HostIDGT applies the GT predicate on the "host_id" field.
+func HostIDGT(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGT(FieldHostID, v))
+}
+
+// HostIDGTE applies the GTE predicate on the "host_id" field.
+func HostIDGTE(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGTE(FieldHostID, v))
+}
+
+// HostIDLT applies the LT predicate on the "host_id" field.
+func HostIDLT(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLT(FieldHostID, v))
+}
+
+// HostIDLTE applies the LTE predicate on the "host_id" field.
+func HostIDLTE(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLTE(FieldHostID, v))
+}
+
+// HostIDContains applies the Contains predicate on the "host_id" field.
+func HostIDContains(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldContains(FieldHostID, v))
+}
+
+// HostIDHasPrefix applies the HasPrefix predicate on the "host_id" field.
+func HostIDHasPrefix(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldHasPrefix(FieldHostID, v))
+}
+
+// HostIDHasSuffix applies the HasSuffix predicate on the "host_id" field.
+func HostIDHasSuffix(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldHasSuffix(FieldHostID, v))
+}
+
+// HostIDEqualFold applies the EqualFold predicate on the "host_id" field.
+func HostIDEqualFold(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEqualFold(FieldHostID, v))
+}
+
+// HostIDContainsFold applies the ContainsFold predicate on the "host_id" field.
+func HostIDContainsFold(v string) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldContainsFold(FieldHostID, v))
+}
+
 // StateEQ applies the EQ predicate on the "state" field.
 func StateEQ(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldState, v))
@@ -366,6 +511,46 @@ func ErrorMessageContainsFold(v string) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// ReportedAtEQ applies the EQ predicate on the "reported_at" field.
+func ReportedAtEQ(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldReportedAt, v))
+}
+
+// ReportedAtNEQ applies the NEQ predicate on the "reported_at" field.
+func ReportedAtNEQ(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNEQ(FieldReportedAt, v))
+}
+
+// ReportedAtIn applies the In predicate on the "reported_at" field.
+func ReportedAtIn(vs ...int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtNotIn applies the NotIn predicate on the "reported_at" field.
+func ReportedAtNotIn(vs ...int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldNotIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtGT applies the GT predicate on the "reported_at" field.
+func ReportedAtGT(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGT(FieldReportedAt, v))
+}
+
+// ReportedAtGTE applies the GTE predicate on the "reported_at" field.
+func ReportedAtGTE(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldGTE(FieldReportedAt, v))
+}
+
+// ReportedAtLT applies the LT predicate on the "reported_at" field.
+func ReportedAtLT(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLT(FieldReportedAt, v))
+}
+
+// ReportedAtLTE applies the LTE predicate on the "reported_at" field.
+func ReportedAtLTE(v int64) predicate.DeploymentComponentStatus {
+	return predicate.DeploymentComponentStatus(sql.FieldLTE(FieldReportedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DeploymentComponentStatus {
 	return predicate.DeploymentComponentStatus(sql.FieldEQ(FieldCreatedAt, v))
@@ -31,6 +31,34 @@ func (_c *DeploymentComponentStatusCreate) SetName(v string) *DeploymentComponen
 	return _c
 }
 
+// SetSiteID sets the "site_id" field.
+func (_c *DeploymentComponentStatusCreate) SetSiteID(v string) *DeploymentComponentStatusCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *DeploymentComponentStatusCreate) SetNillableSiteID(v *string) *DeploymentComponentStatusCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetHostID sets the "host_id" field.
+func (_c *DeploymentComponentStatusCreate) SetHostID(v string) *DeploymentComponentStatusCreate {
+	_c.mutation.SetHostID(v)
+	return _c
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (_c *DeploymentComponentStatusCreate) SetNillableHostID(v *string) *DeploymentComponentStatusCreate {
+	if v != nil {
+		_c.SetHostID(*v)
+	}
+	return _c
+}
+
 // SetState sets the "state" field.
 func (_c *DeploymentComponentStatusCreate) SetState(v string) *DeploymentComponentStatusCreate {
 	_c.mutation.SetState(v)
@@ -73,6 +101,20 @@ func (_c *DeploymentComponentStatusCreate) SetNillableErrorMessage(v *string) *D
 	return _c
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (_c *DeploymentComponentStatusCreate) SetReportedAt(v int64) *DeploymentComponentStatusCreate {
+	_c.mutation.SetReportedAt(v)
+	return _c
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_c *DeploymentComponentStatusCreate) SetNillableReportedAt(v *int64) *DeploymentComponentStatusCreate {
+	if v != nil {
+		_c.SetReportedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DeploymentComponentStatusCreate) SetCreatedAt(v time.Time) *DeploymentComponentStatusCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -161,10 +203,22 @@ func (_c *DeploymentComponentStatusCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *DeploymentComponentStatusCreate) defaults() {
+	if _, ok := _c.mutation.SiteID(); !ok {
+		v := deploymentcomponentstatus.DefaultSiteID
+		_c.mutation.SetSiteID(v)
+	}
+	if _, ok := _c.mutation.HostID(); !ok {
+		v := deploymentcomponentstatus.DefaultHostID
+		_c.mutation.SetHostID(v)
+	}
 	if _, ok := _c.mutation.State(); !ok {
 		v := deploymentcomponentstatus.DefaultState
 		_c.mutation.SetState(v)
 	}
+	if _, ok := _c.mutation.ReportedAt(); !ok {
+		v := deploymentcomponentstatus.DefaultReportedAt
+		_c.mutation.SetReportedAt(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := deploymentcomponentstatus.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -189,9 +243,18 @@ func (_c *DeploymentComponentStatusCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeploymentComponentStatus.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SiteID(); !ok {
+		return &ValidationError{Name: "site_id", err: errors.New(`ent: missing required field "DeploymentComponentStatus.site_id"`)}
+	}
+	if _, ok := _c.mutation.HostID(); !ok {
+		return &ValidationError{Name: "host_id", err: errors.New(`ent: missing required field "DeploymentComponentStatus.host_id"`)}
+	}
 	if _, ok := _c.mutation.State(); !ok {
 		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "DeploymentComponentStatus.state"`)}
 	}
+	if _, ok := _c.mutation.ReportedAt(); !ok {
+		return &ValidationError{Name: "reported_at", err: errors.New(`ent: missing required field "DeploymentComponentStatus.reported_at"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeploymentComponentStatus.created_at"`)}
 	}
@@ -241,6 +304,14 @@ func (_c *DeploymentComponentStatusCreate) createSpec() (*DeploymentComponentSta
 		_spec.SetField(deploymentcomponentstatus.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.HostID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldHostID, field.TypeString, value)
+		_node.HostID = value
+	}
 	if value, ok := _c.mutation.State(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldState, field.TypeString, value)
 		_node.State = value
@@ -253,6 +324,10 @@ func (_c *DeploymentComponentStatusCreate) createSpec() (*DeploymentComponentSta
 		_spec.SetField(deploymentcomponentstatus.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = value
 	}
+	if value, ok := _c.mutation.ReportedAt(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldReportedAt, field.TypeInt64, value)
+		_node.ReportedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -342,6 +417,30 @@ func (u *DeploymentComponentStatusUpsert) UpdateName() *DeploymentComponentStatu
 	return u
 }
 
+// SetSiteID sets the "site_id" field.
+func (u *DeploymentComponentStatusUpsert) SetSiteID(v string) *DeploymentComponentStatusUpsert {
+	u.Set(deploymentcomponentstatus.FieldSiteID, v)
+	return u
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsert) UpdateSiteID() *DeploymentComponentStatusUpsert {
+	u.SetExcluded(deploymentcomponentstatus.FieldSiteID)
+	return u
+}
+
+// SetHostID sets the "host_id" field.
+func (u *DeploymentComponentStatusUpsert) SetHostID(v string) *DeploymentComponentStatusUpsert {
+	u.Set(deploymentcomponentstatus.FieldHostID, v)
+	return u
+}
+
+// UpdateHostID sets the "host_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsert) UpdateHostID() *DeploymentComponentStatusUpsert {
+	u.SetExcluded(deploymentcomponentstatus.FieldHostID)
+	return u
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentComponentStatusUpsert) SetState(v string) *DeploymentComponentStatusUpsert {
 	u.Set(deploymentcomponentstatus.FieldState, v)
@@ -390,6 +489,24 @@ func (u *DeploymentComponentStatusUpsert) ClearErrorMessage() *DeploymentCompone
 	return u
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (u *DeploymentComponentStatusUpsert) SetReportedAt(v int64) *DeploymentComponentStatusUpsert {
+	u.Set(deploymentcomponentstatus.FieldReportedAt, v)
+	return u
+}
+
+// UpdateReportedAt sets the "reported_at" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsert) UpdateReportedAt() *DeploymentComponentStatusUpsert {
+	u.SetExcluded(deploymentcomponentstatus.FieldReportedAt)
+	return u
+}
+
+// AddReportedAt adds v to the "reported_at" field.
+func (u *DeploymentComponentStatusUpsert) AddReportedAt(v int64) *DeploymentComponentStatusUpsert {
+	u.Add(deploymentcomponentstatus.FieldReportedAt, v)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *DeploymentComponentStatusUpsert) SetCreatedAt(v time.Time) *DeploymentComponentStatusUpsert {
 	u.Set(deploymentcomponentstatus.FieldCreatedAt, v)
@@ -476,6 +593,34 @@ func (u *DeploymentComponentStatusUpsertOne) UpdateName() *DeploymentComponentSt
 	})
 }
 
+// SetSiteID sets the "site_id" field.
+func (u *DeploymentComponentStatusUpsertOne) SetSiteID(v string) *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetSiteID(v)
+	})
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertOne) UpdateSiteID() *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateSiteID()
+	})
+}
+
+// SetHostID sets the "host_id" field.
+func (u *DeploymentComponentStatusUpsertOne) SetHostID(v string) *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetHostID(v)
+	})
+}
+
+// UpdateHostID sets the "host_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertOne) UpdateHostID() *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateHostID()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentComponentStatusUpsertOne) SetState(v string) *DeploymentComponentStatusUpsertOne {
 	return u.Update(func(s *DeploymentComponentStatusUpsert) {
@@ -532,6 +677,27 @@ func (u *DeploymentComponentStatusUpsertOne) ClearErrorMessage() *DeploymentComp
 	})
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (u *DeploymentComponentStatusUpsertOne) SetReportedAt(v int64) *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetReportedAt(v)
+	})
+}
+
+// AddReportedAt adds v to the "reported_at" field.
+func (u *DeploymentComponentStatusUpsertOne) AddReportedAt(v int64) *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.AddReportedAt(v)
+	})
+}
+
+// UpdateReportedAt sets the "reported_at" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertOne) UpdateReportedAt() *DeploymentComponentStatusUpsertOne {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateReportedAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *DeploymentComponentStatusUpsertOne) SetCreatedAt(v time.Time) *DeploymentComponentStatusUpsertOne {
 	return u.Update(func(s *DeploymentComponentStatusUpsert) {
@@ -789,6 +955,34 @@ func (u *DeploymentComponentStatusUpsertBulk) UpdateName() *DeploymentComponentS
 	})
 }
 
+// SetSiteID sets the "site_id" field.
+func (u *DeploymentComponentStatusUpsertBulk) SetSiteID(v string) *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetSiteID(v)
+	})
+}
+
+// UpdateSiteID sets the "site_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertBulk) UpdateSiteID() *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateSiteID()
+	})
+}
+
+// SetHostID sets the "host_id" field.
+func (u *DeploymentComponentStatusUpsertBulk) SetHostID(v string) *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetHostID(v)
+	})
+}
+
+// UpdateHostID sets the "host_id" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertBulk) UpdateHostID() *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateHostID()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentComponentStatusUpsertBulk) SetState(v string) *DeploymentComponentStatusUpsertBulk {
 	return u.Update(func(s *DeploymentComponentStatusUpsert) {
@@ -845,6 +1039,27 @@ func (u *DeploymentComponentStatusUpsertBulk) ClearErrorMessage() *DeploymentCom
 	})
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (u *DeploymentComponentStatusUpsertBulk) SetReportedAt(v int64) *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.SetReportedAt(v)
+	})
+}
+
+// AddReportedAt adds v to the "reported_at" field.
+func (u *DeploymentComponentStatusUpsertBulk) AddReportedAt(v int64) *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.AddReportedAt(v)
+	})
+}
+
+// UpdateReportedAt sets the "reported_at" field to the value that was provided on create.
+func (u *DeploymentComponentStatusUpsertBulk) UpdateReportedAt() *DeploymentComponentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentComponentStatusUpsert) {
+		s.UpdateReportedAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *DeploymentComponentStatusUpsertBulk) SetCreatedAt(v time.Time) *DeploymentComponentStatusUpsertBulk {
 	return u.Update(func(s *DeploymentComponentStatusUpsert) {
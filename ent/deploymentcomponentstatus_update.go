@@ -44,6 +44,34 @@ func (_u *DeploymentComponentStatusUpdate) SetNillableName(v *string) *Deploymen
 	return _u
 }
 
+// SetSiteID sets the "site_id" field.
+func (_u *DeploymentComponentStatusUpdate) SetSiteID(v string) *DeploymentComponentStatusUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdate) SetNillableSiteID(v *string) *DeploymentComponentStatusUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// SetHostID sets the "host_id" field.
+func (_u *DeploymentComponentStatusUpdate) SetHostID(v string) *DeploymentComponentStatusUpdate {
+	_u.mutation.SetHostID(v)
+	return _u
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdate) SetNillableHostID(v *string) *DeploymentComponentStatusUpdate {
+	if v != nil {
+		_u.SetHostID(*v)
+	}
+	return _u
+}
+
 // SetState sets the "state" field.
 func (_u *DeploymentComponentStatusUpdate) SetState(v string) *DeploymentComponentStatusUpdate {
 	_u.mutation.SetState(v)
@@ -98,6 +126,27 @@ func (_u *DeploymentComponentStatusUpdate) ClearErrorMessage() *DeploymentCompon
 	return _u
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (_u *DeploymentComponentStatusUpdate) SetReportedAt(v int64) *DeploymentComponentStatusUpdate {
+	_u.mutation.ResetReportedAt()
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdate) SetNillableReportedAt(v *int64) *DeploymentComponentStatusUpdate {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// AddReportedAt adds value to the "reported_at" field.
+func (_u *DeploymentComponentStatusUpdate) AddReportedAt(v int64) *DeploymentComponentStatusUpdate {
+	_u.mutation.AddReportedAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *DeploymentComponentStatusUpdate) SetCreatedAt(v time.Time) *DeploymentComponentStatusUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -204,6 +253,12 @@ func (_u *DeploymentComponentStatusUpdate) sqlSave(ctx context.Context) (_node i
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldSiteID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldHostID, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.State(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldState, field.TypeString, value)
 	}
@@ -219,6 +274,12 @@ func (_u *DeploymentComponentStatusUpdate) sqlSave(ctx context.Context) (_node i
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(deploymentcomponentstatus.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldReportedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReportedAt(); ok {
+		_spec.AddField(deploymentcomponentstatus.FieldReportedAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -288,6 +349,34 @@ func (_u *DeploymentComponentStatusUpdateOne) SetNillableName(v *string) *Deploy
 	return _u
 }
 
+// SetSiteID sets the "site_id" field.
+func (_u *DeploymentComponentStatusUpdateOne) SetSiteID(v string) *DeploymentComponentStatusUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdateOne) SetNillableSiteID(v *string) *DeploymentComponentStatusUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// SetHostID sets the "host_id" field.
+func (_u *DeploymentComponentStatusUpdateOne) SetHostID(v string) *DeploymentComponentStatusUpdateOne {
+	_u.mutation.SetHostID(v)
+	return _u
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdateOne) SetNillableHostID(v *string) *DeploymentComponentStatusUpdateOne {
+	if v != nil {
+		_u.SetHostID(*v)
+	}
+	return _u
+}
+
 // SetState sets the "state" field.
 func (_u *DeploymentComponentStatusUpdateOne) SetState(v string) *DeploymentComponentStatusUpdateOne {
 	_u.mutation.SetState(v)
@@ -342,6 +431,27 @@ func (_u *DeploymentComponentStatusUpdateOne) ClearErrorMessage() *DeploymentCom
 	return _u
 }
 
+// SetReportedAt sets the "reported_at" field.
+func (_u *DeploymentComponentStatusUpdateOne) SetReportedAt(v int64) *DeploymentComponentStatusUpdateOne {
+	_u.mutation.ResetReportedAt()
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *DeploymentComponentStatusUpdateOne) SetNillableReportedAt(v *int64) *DeploymentComponentStatusUpdateOne {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// AddReportedAt adds value to the "reported_at" field.
+func (_u *DeploymentComponentStatusUpdateOne) AddReportedAt(v int64) *DeploymentComponentStatusUpdateOne {
+	_u.mutation.AddReportedAt(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *DeploymentComponentStatusUpdateOne) SetCreatedAt(v time.Time) *DeploymentComponentStatusUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -478,6 +588,12 @@ func (_u *DeploymentComponentStatusUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldSiteID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HostID(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldHostID, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.State(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldState, field.TypeString, value)
 	}
@@ -493,6 +609,12 @@ func (_u *DeploymentComponentStatusUpdateOne) sqlSave(ctx context.Context) (_nod
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(deploymentcomponentstatus.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(deploymentcomponentstatus.FieldReportedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReportedAt(); ok {
+		_spec.AddField(deploymentcomponentstatus.FieldReportedAt, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(deploymentcomponentstatus.FieldCreatedAt, field.TypeTime, value)
 	}
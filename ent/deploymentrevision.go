@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+	"github.com/google/uuid"
+)
+
+// DeploymentRevision is the model entity for the DeploymentRevision schema.
+type DeploymentRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
+	// DeploymentID holds the value of the "deployment_id" field.
+	DeploymentID uuid.UUID `json:"deployment_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// AppName holds the value of the "app_name" field.
+	AppName string `json:"app_name,omitempty"`
+	// AppVersion holds the value of the "app_version" field.
+	AppVersion string `json:"app_version,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile string `json:"profile,omitempty"`
+	// Parameters holds the value of the "parameters" field.
+	Parameters []deployment.Parameter `json:"parameters,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// Spec holds the value of the "spec" field.
+	Spec string `json:"spec,omitempty"`
+	// CommitSha holds the value of the "commit_sha" field.
+	CommitSha string `json:"commit_sha,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// RedeployedFrom holds the value of the "redeployed_from" field.
+	RedeployedFrom int `json:"redeployed_from,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Outcomes holds the value of the "outcomes" field.
+	Outcomes map[string]model.SiteOutcome `json:"outcomes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeploymentRevisionQuery when eager-loading is set.
+	Edges        DeploymentRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeploymentRevisionEdges holds the relations/edges for other nodes in the graph.
+type DeploymentRevisionEdges struct {
+	// Deployment holds the value of the deployment edge.
+	Deployment *DeploymentStatus `json:"deployment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeploymentOrErr returns the Deployment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeploymentRevisionEdges) DeploymentOrErr() (*DeploymentStatus, error) {
+	if e.Deployment != nil {
+		return e.Deployment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: deploymentstatus.Label}
+	}
+	return nil, &NotLoadedError{edge: "deployment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeploymentRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deploymentrevision.FieldParameters, deploymentrevision.FieldOutcomes:
+			values[i] = new([]byte)
+		case deploymentrevision.FieldRevision, deploymentrevision.FieldRedeployedFrom:
+			values[i] = new(sql.NullInt64)
+		case deploymentrevision.FieldAppID, deploymentrevision.FieldAppName, deploymentrevision.FieldAppVersion, deploymentrevision.FieldProfile, deploymentrevision.FieldSiteID, deploymentrevision.FieldSpec, deploymentrevision.FieldCommitSha, deploymentrevision.FieldAuthor, deploymentrevision.FieldState:
+			values[i] = new(sql.NullString)
+		case deploymentrevision.FieldCreatedAt, deploymentrevision.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case deploymentrevision.FieldID, deploymentrevision.FieldOrgID, deploymentrevision.FieldDeploymentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeploymentRevision fields.
+func (_m *DeploymentRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deploymentrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deploymentrevision.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				_m.OrgID = *value
+			}
+		case deploymentrevision.FieldDeploymentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field deployment_id", values[i])
+			} else if value != nil {
+				_m.DeploymentID = *value
+			}
+		case deploymentrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case deploymentrevision.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				_m.AppID = value.String
+			}
+		case deploymentrevision.FieldAppName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_name", values[i])
+			} else if value.Valid {
+				_m.AppName = value.String
+			}
+		case deploymentrevision.FieldAppVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_version", values[i])
+			} else if value.Valid {
+				_m.AppVersion = value.String
+			}
+		case deploymentrevision.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				_m.Profile = value.String
+			}
+		case deploymentrevision.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case deploymentrevision.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case deploymentrevision.FieldSpec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spec", values[i])
+			} else if value.Valid {
+				_m.Spec = value.String
+			}
+		case deploymentrevision.FieldCommitSha:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_sha", values[i])
+			} else if value.Valid {
+				_m.CommitSha = value.String
+			}
+		case deploymentrevision.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = value.String
+			}
+		case deploymentrevision.FieldRedeployedFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redeployed_from", values[i])
+			} else if value.Valid {
+				_m.RedeployedFrom = int(value.Int64)
+			}
+		case deploymentrevision.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case deploymentrevision.FieldOutcomes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field outcomes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Outcomes); err != nil {
+					return fmt.Errorf("unmarshal field outcomes: %w", err)
+				}
+			}
+		case deploymentrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case deploymentrevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeploymentRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeploymentRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDeployment queries the "deployment" edge of the DeploymentRevision entity.
+func (_m *DeploymentRevision) QueryDeployment() *DeploymentStatusQuery {
+	return NewDeploymentRevisionClient(_m.config).QueryDeployment(_m)
+}
+
+// Update returns a builder for updating this DeploymentRevision.
+// Note that you need to call DeploymentRevision.Unwrap() before calling this method if this DeploymentRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeploymentRevision) Update() *DeploymentRevisionUpdateOne {
+	return NewDeploymentRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeploymentRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeploymentRevision) Unwrap() *DeploymentRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeploymentRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeploymentRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeploymentRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("deployment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeploymentID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(_m.AppID)
+	builder.WriteString(", ")
+	builder.WriteString("app_name=")
+	builder.WriteString(_m.AppName)
+	builder.WriteString(", ")
+	builder.WriteString("app_version=")
+	builder.WriteString(_m.AppVersion)
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(_m.Profile)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("spec=")
+	builder.WriteString(_m.Spec)
+	builder.WriteString(", ")
+	builder.WriteString("commit_sha=")
+	builder.WriteString(_m.CommitSha)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(_m.Author)
+	builder.WriteString(", ")
+	builder.WriteString("redeployed_from=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedeployedFrom))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("outcomes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Outcomes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeploymentRevisions is a parsable slice of DeploymentRevision.
+type DeploymentRevisions []*DeploymentRevision
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deploymentrevision
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the deploymentrevision type in the database.
+	Label = "deployment_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldDeploymentID holds the string denoting the deployment_id field in the database.
+	FieldDeploymentID = "deployment_id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldAppName holds the string denoting the app_name field in the database.
+	FieldAppName = "app_name"
+	// FieldAppVersion holds the string denoting the app_version field in the database.
+	FieldAppVersion = "app_version"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldSpec holds the string denoting the spec field in the database.
+	FieldSpec = "spec"
+	// FieldCommitSha holds the string denoting the commit_sha field in the database.
+	FieldCommitSha = "commit_sha"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldRedeployedFrom holds the string denoting the redeployed_from field in the database.
+	FieldRedeployedFrom = "redeployed_from"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldOutcomes holds the string denoting the outcomes field in the database.
+	FieldOutcomes = "outcomes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeDeployment holds the string denoting the deployment edge name in mutations.
+	EdgeDeployment = "deployment"
+	// Table holds the table name of the deploymentrevision in the database.
+	Table = "deployment_revisions"
+	// DeploymentTable is the table that holds the deployment relation/edge.
+	DeploymentTable = "deployment_revisions"
+	// DeploymentInverseTable is the table name for the DeploymentStatus entity.
+	// It exists in this package in order to avoid circular dependency with the "deploymentstatus" package.
+	DeploymentInverseTable = "deployment_status"
+	// DeploymentColumn is the table column denoting the deployment relation/edge.
+	DeploymentColumn = "deployment_id"
+)
+
+// Columns holds all SQL columns for deploymentrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldOrgID,
+	FieldDeploymentID,
+	FieldRevision,
+	FieldAppID,
+	FieldAppName,
+	FieldAppVersion,
+	FieldProfile,
+	FieldParameters,
+	FieldSiteID,
+	FieldSpec,
+	FieldCommitSha,
+	FieldAuthor,
+	FieldRedeployedFrom,
+	FieldState,
+	FieldOutcomes,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/balaji-balu/margo-hello-world/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	RevisionValidator func(int) error
+	// DefaultState holds the default value on creation for the "state" field.
+	DefaultState string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DeploymentRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByDeploymentID orders the results by the deployment_id field.
+func ByDeploymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeploymentID, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByAppID orders the results by the app_id field.
+func ByAppID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppID, opts...).ToFunc()
+}
+
+// ByAppName orders the results by the app_name field.
+func ByAppName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppName, opts...).ToFunc()
+}
+
+// ByAppVersion orders the results by the app_version field.
+func ByAppVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppVersion, opts...).ToFunc()
+}
+
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// BySpec orders the results by the spec field.
+func BySpec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpec, opts...).ToFunc()
+}
+
+// ByCommitSha orders the results by the commit_sha field.
+func ByCommitSha(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitSha, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByRedeployedFrom orders the results by the redeployed_from field.
+func ByRedeployedFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeployedFrom, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeploymentField orders the results by deployment field.
+func ByDeploymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeploymentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDeploymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeploymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeploymentTable, DeploymentColumn),
+	)
+}
@@ -0,0 +1,1075 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deploymentrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldOrgID, v))
+}
+
+// DeploymentID applies equality check predicate on the "deployment_id" field. It's identical to DeploymentIDEQ.
+func DeploymentID(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppName applies equality check predicate on the "app_name" field. It's identical to AppNameEQ.
+func AppName(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppName, v))
+}
+
+// AppVersion applies equality check predicate on the "app_version" field. It's identical to AppVersionEQ.
+func AppVersion(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppVersion, v))
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldProfile, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldSiteID, v))
+}
+
+// Spec applies equality check predicate on the "spec" field. It's identical to SpecEQ.
+func Spec(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldSpec, v))
+}
+
+// CommitSha applies equality check predicate on the "commit_sha" field. It's identical to CommitShaEQ.
+func CommitSha(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAuthor, v))
+}
+
+// RedeployedFrom applies equality check predicate on the "redeployed_from" field. It's identical to RedeployedFromEQ.
+func RedeployedFrom(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldRedeployedFrom, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldState, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldOrgID, v))
+}
+
+// OrgIDIsNil applies the IsNil predicate on the "org_id" field.
+func OrgIDIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldOrgID))
+}
+
+// OrgIDNotNil applies the NotNil predicate on the "org_id" field.
+func OrgIDNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldOrgID))
+}
+
+// DeploymentIDEQ applies the EQ predicate on the "deployment_id" field.
+func DeploymentIDEQ(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDNEQ applies the NEQ predicate on the "deployment_id" field.
+func DeploymentIDNEQ(v uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDIn applies the In predicate on the "deployment_id" field.
+func DeploymentIDIn(vs ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldDeploymentID, vs...))
+}
+
+// DeploymentIDNotIn applies the NotIn predicate on the "deployment_id" field.
+func DeploymentIDNotIn(vs ...uuid.UUID) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldDeploymentID, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldAppID, v))
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldAppID, v))
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldAppID, v))
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldAppID, v))
+}
+
+// AppIDContains applies the Contains predicate on the "app_id" field.
+func AppIDContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldAppID, v))
+}
+
+// AppIDHasPrefix applies the HasPrefix predicate on the "app_id" field.
+func AppIDHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldAppID, v))
+}
+
+// AppIDHasSuffix applies the HasSuffix predicate on the "app_id" field.
+func AppIDHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldAppID, v))
+}
+
+// AppIDIsNil applies the IsNil predicate on the "app_id" field.
+func AppIDIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldAppID))
+}
+
+// AppIDNotNil applies the NotNil predicate on the "app_id" field.
+func AppIDNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldAppID))
+}
+
+// AppIDEqualFold applies the EqualFold predicate on the "app_id" field.
+func AppIDEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldAppID, v))
+}
+
+// AppIDContainsFold applies the ContainsFold predicate on the "app_id" field.
+func AppIDContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldAppID, v))
+}
+
+// AppNameEQ applies the EQ predicate on the "app_name" field.
+func AppNameEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppName, v))
+}
+
+// AppNameNEQ applies the NEQ predicate on the "app_name" field.
+func AppNameNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldAppName, v))
+}
+
+// AppNameIn applies the In predicate on the "app_name" field.
+func AppNameIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldAppName, vs...))
+}
+
+// AppNameNotIn applies the NotIn predicate on the "app_name" field.
+func AppNameNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldAppName, vs...))
+}
+
+// AppNameGT applies the GT predicate on the "app_name" field.
+func AppNameGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldAppName, v))
+}
+
+// AppNameGTE applies the GTE predicate on the "app_name" field.
+func AppNameGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldAppName, v))
+}
+
+// AppNameLT applies the LT predicate on the "app_name" field.
+func AppNameLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldAppName, v))
+}
+
+// AppNameLTE applies the LTE predicate on the "app_name" field.
+func AppNameLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldAppName, v))
+}
+
+// AppNameContains applies the Contains predicate on the "app_name" field.
+func AppNameContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldAppName, v))
+}
+
+// AppNameHasPrefix applies the HasPrefix predicate on the "app_name" field.
+func AppNameHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldAppName, v))
+}
+
+// AppNameHasSuffix applies the HasSuffix predicate on the "app_name" field.
+func AppNameHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldAppName, v))
+}
+
+// AppNameIsNil applies the IsNil predicate on the "app_name" field.
+func AppNameIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldAppName))
+}
+
+// AppNameNotNil applies the NotNil predicate on the "app_name" field.
+func AppNameNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldAppName))
+}
+
+// AppNameEqualFold applies the EqualFold predicate on the "app_name" field.
+func AppNameEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldAppName, v))
+}
+
+// AppNameContainsFold applies the ContainsFold predicate on the "app_name" field.
+func AppNameContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldAppName, v))
+}
+
+// AppVersionEQ applies the EQ predicate on the "app_version" field.
+func AppVersionEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAppVersion, v))
+}
+
+// AppVersionNEQ applies the NEQ predicate on the "app_version" field.
+func AppVersionNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldAppVersion, v))
+}
+
+// AppVersionIn applies the In predicate on the "app_version" field.
+func AppVersionIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldAppVersion, vs...))
+}
+
+// AppVersionNotIn applies the NotIn predicate on the "app_version" field.
+func AppVersionNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldAppVersion, vs...))
+}
+
+// AppVersionGT applies the GT predicate on the "app_version" field.
+func AppVersionGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldAppVersion, v))
+}
+
+// AppVersionGTE applies the GTE predicate on the "app_version" field.
+func AppVersionGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldAppVersion, v))
+}
+
+// AppVersionLT applies the LT predicate on the "app_version" field.
+func AppVersionLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldAppVersion, v))
+}
+
+// AppVersionLTE applies the LTE predicate on the "app_version" field.
+func AppVersionLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldAppVersion, v))
+}
+
+// AppVersionContains applies the Contains predicate on the "app_version" field.
+func AppVersionContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldAppVersion, v))
+}
+
+// AppVersionHasPrefix applies the HasPrefix predicate on the "app_version" field.
+func AppVersionHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldAppVersion, v))
+}
+
+// AppVersionHasSuffix applies the HasSuffix predicate on the "app_version" field.
+func AppVersionHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldAppVersion, v))
+}
+
+// AppVersionIsNil applies the IsNil predicate on the "app_version" field.
+func AppVersionIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldAppVersion))
+}
+
+// AppVersionNotNil applies the NotNil predicate on the "app_version" field.
+func AppVersionNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldAppVersion))
+}
+
+// AppVersionEqualFold applies the EqualFold predicate on the "app_version" field.
+func AppVersionEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldAppVersion, v))
+}
+
+// AppVersionContainsFold applies the ContainsFold predicate on the "app_version" field.
+func AppVersionContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldAppVersion, v))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldProfile))
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldProfile))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// ParametersIsNil applies the IsNil predicate on the "parameters" field.
+func ParametersIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldParameters))
+}
+
+// ParametersNotNil applies the NotNil predicate on the "parameters" field.
+func ParametersNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldParameters))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// SpecEQ applies the EQ predicate on the "spec" field.
+func SpecEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldSpec, v))
+}
+
+// SpecNEQ applies the NEQ predicate on the "spec" field.
+func SpecNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldSpec, v))
+}
+
+// SpecIn applies the In predicate on the "spec" field.
+func SpecIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldSpec, vs...))
+}
+
+// SpecNotIn applies the NotIn predicate on the "spec" field.
+func SpecNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldSpec, vs...))
+}
+
+// SpecGT applies the GT predicate on the "spec" field.
+func SpecGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldSpec, v))
+}
+
+// SpecGTE applies the GTE predicate on the "spec" field.
+func SpecGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldSpec, v))
+}
+
+// SpecLT applies the LT predicate on the "spec" field.
+func SpecLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldSpec, v))
+}
+
+// SpecLTE applies the LTE predicate on the "spec" field.
+func SpecLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldSpec, v))
+}
+
+// SpecContains applies the Contains predicate on the "spec" field.
+func SpecContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldSpec, v))
+}
+
+// SpecHasPrefix applies the HasPrefix predicate on the "spec" field.
+func SpecHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldSpec, v))
+}
+
+// SpecHasSuffix applies the HasSuffix predicate on the "spec" field.
+func SpecHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldSpec, v))
+}
+
+// SpecEqualFold applies the EqualFold predicate on the "spec" field.
+func SpecEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldSpec, v))
+}
+
+// SpecContainsFold applies the ContainsFold predicate on the "spec" field.
+func SpecContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldSpec, v))
+}
+
+// CommitShaEQ applies the EQ predicate on the "commit_sha" field.
+func CommitShaEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// CommitShaNEQ applies the NEQ predicate on the "commit_sha" field.
+func CommitShaNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldCommitSha, v))
+}
+
+// CommitShaIn applies the In predicate on the "commit_sha" field.
+func CommitShaIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldCommitSha, vs...))
+}
+
+// CommitShaNotIn applies the NotIn predicate on the "commit_sha" field.
+func CommitShaNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldCommitSha, vs...))
+}
+
+// CommitShaGT applies the GT predicate on the "commit_sha" field.
+func CommitShaGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldCommitSha, v))
+}
+
+// CommitShaGTE applies the GTE predicate on the "commit_sha" field.
+func CommitShaGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldCommitSha, v))
+}
+
+// CommitShaLT applies the LT predicate on the "commit_sha" field.
+func CommitShaLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldCommitSha, v))
+}
+
+// CommitShaLTE applies the LTE predicate on the "commit_sha" field.
+func CommitShaLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldCommitSha, v))
+}
+
+// CommitShaContains applies the Contains predicate on the "commit_sha" field.
+func CommitShaContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldCommitSha, v))
+}
+
+// CommitShaHasPrefix applies the HasPrefix predicate on the "commit_sha" field.
+func CommitShaHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldCommitSha, v))
+}
+
+// CommitShaHasSuffix applies the HasSuffix predicate on the "commit_sha" field.
+func CommitShaHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldCommitSha, v))
+}
+
+// CommitShaIsNil applies the IsNil predicate on the "commit_sha" field.
+func CommitShaIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldCommitSha))
+}
+
+// CommitShaNotNil applies the NotNil predicate on the "commit_sha" field.
+func CommitShaNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldCommitSha))
+}
+
+// CommitShaEqualFold applies the EqualFold predicate on the "commit_sha" field.
+func CommitShaEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldCommitSha, v))
+}
+
+// CommitShaContainsFold applies the ContainsFold predicate on the "commit_sha" field.
+func CommitShaContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldCommitSha, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// RedeployedFromEQ applies the EQ predicate on the "redeployed_from" field.
+func RedeployedFromEQ(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromNEQ applies the NEQ predicate on the "redeployed_from" field.
+func RedeployedFromNEQ(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromIn applies the In predicate on the "redeployed_from" field.
+func RedeployedFromIn(vs ...int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldRedeployedFrom, vs...))
+}
+
+// RedeployedFromNotIn applies the NotIn predicate on the "redeployed_from" field.
+func RedeployedFromNotIn(vs ...int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldRedeployedFrom, vs...))
+}
+
+// RedeployedFromGT applies the GT predicate on the "redeployed_from" field.
+func RedeployedFromGT(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromGTE applies the GTE predicate on the "redeployed_from" field.
+func RedeployedFromGTE(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromLT applies the LT predicate on the "redeployed_from" field.
+func RedeployedFromLT(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromLTE applies the LTE predicate on the "redeployed_from" field.
+func RedeployedFromLTE(v int) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldRedeployedFrom, v))
+}
+
+// RedeployedFromIsNil applies the IsNil predicate on the "redeployed_from" field.
+func RedeployedFromIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldRedeployedFrom))
+}
+
+// RedeployedFromNotNil applies the NotNil predicate on the "redeployed_from" field.
+func RedeployedFromNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldRedeployedFrom))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldContainsFold(FieldState, v))
+}
+
+// OutcomesIsNil applies the IsNil predicate on the "outcomes" field.
+func OutcomesIsNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIsNull(FieldOutcomes))
+}
+
+// OutcomesNotNil applies the NotNil predicate on the "outcomes" field.
+func OutcomesNotNil() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotNull(FieldOutcomes))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasDeployment applies the HasEdge predicate on the "deployment" edge.
+func HasDeployment() predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeploymentTable, DeploymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeploymentWith applies the HasEdge predicate on the "deployment" edge with a given conditions (other predicates).
+func HasDeploymentWith(preds ...predicate.DeploymentStatus) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(func(s *sql.Selector) {
+		step := newDeploymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeploymentRevision) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeploymentRevision) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeploymentRevision) predicate.DeploymentRevision {
+	return predicate.DeploymentRevision(sql.NotPredicates(p))
+}
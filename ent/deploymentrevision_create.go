@@ -0,0 +1,1120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+	"github.com/google/uuid"
+)
+
+// DeploymentRevisionCreate is the builder for creating a DeploymentRevision entity.
+type DeploymentRevisionCreate struct {
+	config
+	mutation *DeploymentRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (_c *DeploymentRevisionCreate) SetOrgID(v uuid.UUID) *DeploymentRevisionCreate {
+	_c.mutation.SetOrgID(v)
+	return _c
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableOrgID(v *uuid.UUID) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetOrgID(*v)
+	}
+	return _c
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_c *DeploymentRevisionCreate) SetDeploymentID(v uuid.UUID) *DeploymentRevisionCreate {
+	_c.mutation.SetDeploymentID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *DeploymentRevisionCreate) SetRevision(v int) *DeploymentRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *DeploymentRevisionCreate) SetAppID(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableAppID(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetAppID(*v)
+	}
+	return _c
+}
+
+// SetAppName sets the "app_name" field.
+func (_c *DeploymentRevisionCreate) SetAppName(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetAppName(v)
+	return _c
+}
+
+// SetNillableAppName sets the "app_name" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableAppName(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetAppName(*v)
+	}
+	return _c
+}
+
+// SetAppVersion sets the "app_version" field.
+func (_c *DeploymentRevisionCreate) SetAppVersion(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetAppVersion(v)
+	return _c
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableAppVersion(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetAppVersion(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" field.
+func (_c *DeploymentRevisionCreate) SetProfile(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetProfile(v)
+	return _c
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableProfile(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetProfile(*v)
+	}
+	return _c
+}
+
+// SetParameters sets the "parameters" field.
+func (_c *DeploymentRevisionCreate) SetParameters(v []deployment.Parameter) *DeploymentRevisionCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *DeploymentRevisionCreate) SetSiteID(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetSpec sets the "spec" field.
+func (_c *DeploymentRevisionCreate) SetSpec(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetSpec(v)
+	return _c
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_c *DeploymentRevisionCreate) SetCommitSha(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetCommitSha(v)
+	return _c
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableCommitSha(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetCommitSha(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *DeploymentRevisionCreate) SetAuthor(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableAuthor(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetRedeployedFrom sets the "redeployed_from" field.
+func (_c *DeploymentRevisionCreate) SetRedeployedFrom(v int) *DeploymentRevisionCreate {
+	_c.mutation.SetRedeployedFrom(v)
+	return _c
+}
+
+// SetNillableRedeployedFrom sets the "redeployed_from" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableRedeployedFrom(v *int) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetRedeployedFrom(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *DeploymentRevisionCreate) SetState(v string) *DeploymentRevisionCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableState(v *string) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (_c *DeploymentRevisionCreate) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionCreate {
+	_c.mutation.SetOutcomes(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeploymentRevisionCreate) SetCreatedAt(v time.Time) *DeploymentRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableCreatedAt(v *time.Time) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeploymentRevisionCreate) SetUpdatedAt(v time.Time) *DeploymentRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableUpdatedAt(v *time.Time) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeploymentRevisionCreate) SetID(v uuid.UUID) *DeploymentRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeploymentRevisionCreate) SetNillableID(v *uuid.UUID) *DeploymentRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDeployment sets the "deployment" edge to the DeploymentStatus entity.
+func (_c *DeploymentRevisionCreate) SetDeployment(v *DeploymentStatus) *DeploymentRevisionCreate {
+	return _c.SetDeploymentID(v.ID)
+}
+
+// Mutation returns the DeploymentRevisionMutation object of the builder.
+func (_c *DeploymentRevisionCreate) Mutation() *DeploymentRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the DeploymentRevision in the database.
+func (_c *DeploymentRevisionCreate) Save(ctx context.Context) (*DeploymentRevision, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeploymentRevisionCreate) SaveX(ctx context.Context) *DeploymentRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeploymentRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeploymentRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeploymentRevisionCreate) defaults() error {
+	if _, ok := _c.mutation.State(); !ok {
+		v := deploymentrevision.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if deploymentrevision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentrevision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := deploymentrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if deploymentrevision.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentrevision.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := deploymentrevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if deploymentrevision.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized deploymentrevision.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := deploymentrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeploymentRevisionCreate) check() error {
+	if _, ok := _c.mutation.DeploymentID(); !ok {
+		return &ValidationError{Name: "deployment_id", err: errors.New(`ent: missing required field "DeploymentRevision.deployment_id"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "DeploymentRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := deploymentrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "DeploymentRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SiteID(); !ok {
+		return &ValidationError{Name: "site_id", err: errors.New(`ent: missing required field "DeploymentRevision.site_id"`)}
+	}
+	if _, ok := _c.mutation.Spec(); !ok {
+		return &ValidationError{Name: "spec", err: errors.New(`ent: missing required field "DeploymentRevision.spec"`)}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "DeploymentRevision.state"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeploymentRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeploymentRevision.updated_at"`)}
+	}
+	if len(_c.mutation.DeploymentIDs()) == 0 {
+		return &ValidationError{Name: "deployment", err: errors.New(`ent: missing required edge "DeploymentRevision.deployment"`)}
+	}
+	return nil
+}
+
+func (_c *DeploymentRevisionCreate) sqlSave(ctx context.Context) (*DeploymentRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeploymentRevisionCreate) createSpec() (*DeploymentRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeploymentRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deploymentrevision.Table, sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OrgID(); ok {
+		_spec.SetField(deploymentrevision.FieldOrgID, field.TypeUUID, value)
+		_node.OrgID = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(deploymentrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.AppID(); ok {
+		_spec.SetField(deploymentrevision.FieldAppID, field.TypeString, value)
+		_node.AppID = value
+	}
+	if value, ok := _c.mutation.AppName(); ok {
+		_spec.SetField(deploymentrevision.FieldAppName, field.TypeString, value)
+		_node.AppName = value
+	}
+	if value, ok := _c.mutation.AppVersion(); ok {
+		_spec.SetField(deploymentrevision.FieldAppVersion, field.TypeString, value)
+		_node.AppVersion = value
+	}
+	if value, ok := _c.mutation.Profile(); ok {
+		_spec.SetField(deploymentrevision.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(deploymentrevision.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(deploymentrevision.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.Spec(); ok {
+		_spec.SetField(deploymentrevision.FieldSpec, field.TypeString, value)
+		_node.Spec = value
+	}
+	if value, ok := _c.mutation.CommitSha(); ok {
+		_spec.SetField(deploymentrevision.FieldCommitSha, field.TypeString, value)
+		_node.CommitSha = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(deploymentrevision.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.RedeployedFrom(); ok {
+		_spec.SetField(deploymentrevision.FieldRedeployedFrom, field.TypeInt, value)
+		_node.RedeployedFrom = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(deploymentrevision.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Outcomes(); ok {
+		_spec.SetField(deploymentrevision.FieldOutcomes, field.TypeJSON, value)
+		_node.Outcomes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deploymentrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deploymentrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.DeploymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentrevision.DeploymentTable,
+			Columns: []string{deploymentrevision.DeploymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeploymentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeploymentRevision.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeploymentRevisionUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeploymentRevisionCreate) OnConflict(opts ...sql.ConflictOption) *DeploymentRevisionUpsertOne {
+	_c.conflict = opts
+	return &DeploymentRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeploymentRevisionCreate) OnConflictColumns(columns ...string) *DeploymentRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeploymentRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeploymentRevisionUpsertOne is the builder for "upsert"-ing
+	//  one DeploymentRevision node.
+	DeploymentRevisionUpsertOne struct {
+		create *DeploymentRevisionCreate
+	}
+
+	// DeploymentRevisionUpsert is the "OnConflict" setter.
+	DeploymentRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentRevisionUpsert) SetOrgID(v uuid.UUID) *DeploymentRevisionUpsert {
+	u.Set(deploymentrevision.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsert) UpdateOrgID() *DeploymentRevisionUpsert {
+	u.SetExcluded(deploymentrevision.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentRevisionUpsert) ClearOrgID() *DeploymentRevisionUpsert {
+	u.SetNull(deploymentrevision.FieldOrgID)
+	return u
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (u *DeploymentRevisionUpsert) SetCommitSha(v string) *DeploymentRevisionUpsert {
+	u.Set(deploymentrevision.FieldCommitSha, v)
+	return u
+}
+
+// UpdateCommitSha sets the "commit_sha" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsert) UpdateCommitSha() *DeploymentRevisionUpsert {
+	u.SetExcluded(deploymentrevision.FieldCommitSha)
+	return u
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (u *DeploymentRevisionUpsert) ClearCommitSha() *DeploymentRevisionUpsert {
+	u.SetNull(deploymentrevision.FieldCommitSha)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *DeploymentRevisionUpsert) SetState(v string) *DeploymentRevisionUpsert {
+	u.Set(deploymentrevision.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsert) UpdateState() *DeploymentRevisionUpsert {
+	u.SetExcluded(deploymentrevision.FieldState)
+	return u
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (u *DeploymentRevisionUpsert) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionUpsert {
+	u.Set(deploymentrevision.FieldOutcomes, v)
+	return u
+}
+
+// UpdateOutcomes sets the "outcomes" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsert) UpdateOutcomes() *DeploymentRevisionUpsert {
+	u.SetExcluded(deploymentrevision.FieldOutcomes)
+	return u
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (u *DeploymentRevisionUpsert) ClearOutcomes() *DeploymentRevisionUpsert {
+	u.SetNull(deploymentrevision.FieldOutcomes)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeploymentRevisionUpsert) SetUpdatedAt(v time.Time) *DeploymentRevisionUpsert {
+	u.Set(deploymentrevision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsert) UpdateUpdatedAt() *DeploymentRevisionUpsert {
+	u.SetExcluded(deploymentrevision.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deploymentrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeploymentRevisionUpsertOne) UpdateNewValues() *DeploymentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deploymentrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.DeploymentID(); exists {
+			s.SetIgnore(deploymentrevision.FieldDeploymentID)
+		}
+		if _, exists := u.create.mutation.Revision(); exists {
+			s.SetIgnore(deploymentrevision.FieldRevision)
+		}
+		if _, exists := u.create.mutation.AppID(); exists {
+			s.SetIgnore(deploymentrevision.FieldAppID)
+		}
+		if _, exists := u.create.mutation.AppName(); exists {
+			s.SetIgnore(deploymentrevision.FieldAppName)
+		}
+		if _, exists := u.create.mutation.AppVersion(); exists {
+			s.SetIgnore(deploymentrevision.FieldAppVersion)
+		}
+		if _, exists := u.create.mutation.Profile(); exists {
+			s.SetIgnore(deploymentrevision.FieldProfile)
+		}
+		if _, exists := u.create.mutation.Parameters(); exists {
+			s.SetIgnore(deploymentrevision.FieldParameters)
+		}
+		if _, exists := u.create.mutation.SiteID(); exists {
+			s.SetIgnore(deploymentrevision.FieldSiteID)
+		}
+		if _, exists := u.create.mutation.Spec(); exists {
+			s.SetIgnore(deploymentrevision.FieldSpec)
+		}
+		if _, exists := u.create.mutation.Author(); exists {
+			s.SetIgnore(deploymentrevision.FieldAuthor)
+		}
+		if _, exists := u.create.mutation.RedeployedFrom(); exists {
+			s.SetIgnore(deploymentrevision.FieldRedeployedFrom)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deploymentrevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeploymentRevisionUpsertOne) Ignore() *DeploymentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeploymentRevisionUpsertOne) DoNothing() *DeploymentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeploymentRevisionCreate.OnConflict
+// documentation for more info.
+func (u *DeploymentRevisionUpsertOne) Update(set func(*DeploymentRevisionUpsert)) *DeploymentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeploymentRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentRevisionUpsertOne) SetOrgID(v uuid.UUID) *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertOne) UpdateOrgID() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentRevisionUpsertOne) ClearOrgID() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearOrgID()
+	})
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (u *DeploymentRevisionUpsertOne) SetCommitSha(v string) *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetCommitSha(v)
+	})
+}
+
+// UpdateCommitSha sets the "commit_sha" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertOne) UpdateCommitSha() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateCommitSha()
+	})
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (u *DeploymentRevisionUpsertOne) ClearCommitSha() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearCommitSha()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *DeploymentRevisionUpsertOne) SetState(v string) *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertOne) UpdateState() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (u *DeploymentRevisionUpsertOne) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetOutcomes(v)
+	})
+}
+
+// UpdateOutcomes sets the "outcomes" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertOne) UpdateOutcomes() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateOutcomes()
+	})
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (u *DeploymentRevisionUpsertOne) ClearOutcomes() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearOutcomes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeploymentRevisionUpsertOne) SetUpdatedAt(v time.Time) *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertOne) UpdateUpdatedAt() *DeploymentRevisionUpsertOne {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeploymentRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeploymentRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeploymentRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeploymentRevisionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeploymentRevisionUpsertOne.ID is not supported by MySQL driver. Use DeploymentRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeploymentRevisionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeploymentRevisionCreateBulk is the builder for creating many DeploymentRevision entities in bulk.
+type DeploymentRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*DeploymentRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeploymentRevision entities in the database.
+func (_c *DeploymentRevisionCreateBulk) Save(ctx context.Context) ([]*DeploymentRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeploymentRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeploymentRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeploymentRevisionCreateBulk) SaveX(ctx context.Context) []*DeploymentRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeploymentRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeploymentRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeploymentRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeploymentRevisionUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeploymentRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeploymentRevisionUpsertBulk {
+	_c.conflict = opts
+	return &DeploymentRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeploymentRevisionCreateBulk) OnConflictColumns(columns ...string) *DeploymentRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeploymentRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeploymentRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeploymentRevision nodes.
+type DeploymentRevisionUpsertBulk struct {
+	create *DeploymentRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deploymentrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeploymentRevisionUpsertBulk) UpdateNewValues() *DeploymentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deploymentrevision.FieldID)
+			}
+			if _, exists := b.mutation.DeploymentID(); exists {
+				s.SetIgnore(deploymentrevision.FieldDeploymentID)
+			}
+			if _, exists := b.mutation.Revision(); exists {
+				s.SetIgnore(deploymentrevision.FieldRevision)
+			}
+			if _, exists := b.mutation.AppID(); exists {
+				s.SetIgnore(deploymentrevision.FieldAppID)
+			}
+			if _, exists := b.mutation.AppName(); exists {
+				s.SetIgnore(deploymentrevision.FieldAppName)
+			}
+			if _, exists := b.mutation.AppVersion(); exists {
+				s.SetIgnore(deploymentrevision.FieldAppVersion)
+			}
+			if _, exists := b.mutation.Profile(); exists {
+				s.SetIgnore(deploymentrevision.FieldProfile)
+			}
+			if _, exists := b.mutation.Parameters(); exists {
+				s.SetIgnore(deploymentrevision.FieldParameters)
+			}
+			if _, exists := b.mutation.SiteID(); exists {
+				s.SetIgnore(deploymentrevision.FieldSiteID)
+			}
+			if _, exists := b.mutation.Spec(); exists {
+				s.SetIgnore(deploymentrevision.FieldSpec)
+			}
+			if _, exists := b.mutation.Author(); exists {
+				s.SetIgnore(deploymentrevision.FieldAuthor)
+			}
+			if _, exists := b.mutation.RedeployedFrom(); exists {
+				s.SetIgnore(deploymentrevision.FieldRedeployedFrom)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deploymentrevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeploymentRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeploymentRevisionUpsertBulk) Ignore() *DeploymentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeploymentRevisionUpsertBulk) DoNothing() *DeploymentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeploymentRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeploymentRevisionUpsertBulk) Update(set func(*DeploymentRevisionUpsert)) *DeploymentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeploymentRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentRevisionUpsertBulk) SetOrgID(v uuid.UUID) *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertBulk) UpdateOrgID() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentRevisionUpsertBulk) ClearOrgID() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearOrgID()
+	})
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (u *DeploymentRevisionUpsertBulk) SetCommitSha(v string) *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetCommitSha(v)
+	})
+}
+
+// UpdateCommitSha sets the "commit_sha" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertBulk) UpdateCommitSha() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateCommitSha()
+	})
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (u *DeploymentRevisionUpsertBulk) ClearCommitSha() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearCommitSha()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *DeploymentRevisionUpsertBulk) SetState(v string) *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertBulk) UpdateState() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (u *DeploymentRevisionUpsertBulk) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetOutcomes(v)
+	})
+}
+
+// UpdateOutcomes sets the "outcomes" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertBulk) UpdateOutcomes() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateOutcomes()
+	})
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (u *DeploymentRevisionUpsertBulk) ClearOutcomes() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.ClearOutcomes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeploymentRevisionUpsertBulk) SetUpdatedAt(v time.Time) *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeploymentRevisionUpsertBulk) UpdateUpdatedAt() *DeploymentRevisionUpsertBulk {
+	return u.Update(func(s *DeploymentRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeploymentRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeploymentRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeploymentRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeploymentRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+)
+
+// DeploymentRevisionDelete is the builder for deleting a DeploymentRevision entity.
+type DeploymentRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeploymentRevisionMutation
+}
+
+// Where appends a list predicates to the DeploymentRevisionDelete builder.
+func (_d *DeploymentRevisionDelete) Where(ps ...predicate.DeploymentRevision) *DeploymentRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DeploymentRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeploymentRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DeploymentRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deploymentrevision.Table, sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DeploymentRevisionDeleteOne is the builder for deleting a single DeploymentRevision entity.
+type DeploymentRevisionDeleteOne struct {
+	_d *DeploymentRevisionDelete
+}
+
+// Where appends a list predicates to the DeploymentRevisionDelete builder.
+func (_d *DeploymentRevisionDeleteOne) Where(ps ...predicate.DeploymentRevision) *DeploymentRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DeploymentRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deploymentrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeploymentRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+	"github.com/google/uuid"
+)
+
+// DeploymentRevisionUpdate is the builder for updating DeploymentRevision entities.
+type DeploymentRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeploymentRevisionMutation
+}
+
+// Where appends a list predicates to the DeploymentRevisionUpdate builder.
+func (_u *DeploymentRevisionUpdate) Where(ps ...predicate.DeploymentRevision) *DeploymentRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrgID sets the "org_id" field.
+func (_u *DeploymentRevisionUpdate) SetOrgID(v uuid.UUID) *DeploymentRevisionUpdate {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdate) SetNillableOrgID(v *uuid.UUID) *DeploymentRevisionUpdate {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *DeploymentRevisionUpdate) ClearOrgID() *DeploymentRevisionUpdate {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_u *DeploymentRevisionUpdate) SetCommitSha(v string) *DeploymentRevisionUpdate {
+	_u.mutation.SetCommitSha(v)
+	return _u
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdate) SetNillableCommitSha(v *string) *DeploymentRevisionUpdate {
+	if v != nil {
+		_u.SetCommitSha(*v)
+	}
+	return _u
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (_u *DeploymentRevisionUpdate) ClearCommitSha() *DeploymentRevisionUpdate {
+	_u.mutation.ClearCommitSha()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *DeploymentRevisionUpdate) SetState(v string) *DeploymentRevisionUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdate) SetNillableState(v *string) *DeploymentRevisionUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (_u *DeploymentRevisionUpdate) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionUpdate {
+	_u.mutation.SetOutcomes(v)
+	return _u
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (_u *DeploymentRevisionUpdate) ClearOutcomes() *DeploymentRevisionUpdate {
+	_u.mutation.ClearOutcomes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeploymentRevisionUpdate) SetUpdatedAt(v time.Time) *DeploymentRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeploymentRevisionMutation object of the builder.
+func (_u *DeploymentRevisionUpdate) Mutation() *DeploymentRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeploymentRevisionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeploymentRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeploymentRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeploymentRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeploymentRevisionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if deploymentrevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentrevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := deploymentrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeploymentRevisionUpdate) check() error {
+	if _u.mutation.DeploymentCleared() && len(_u.mutation.DeploymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeploymentRevision.deployment"`)
+	}
+	return nil
+}
+
+func (_u *DeploymentRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deploymentrevision.Table, deploymentrevision.Columns, sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrgID(); ok {
+		_spec.SetField(deploymentrevision.FieldOrgID, field.TypeUUID, value)
+	}
+	if _u.mutation.OrgIDCleared() {
+		_spec.ClearField(deploymentrevision.FieldOrgID, field.TypeUUID)
+	}
+	if _u.mutation.AppIDCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppID, field.TypeString)
+	}
+	if _u.mutation.AppNameCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppName, field.TypeString)
+	}
+	if _u.mutation.AppVersionCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppVersion, field.TypeString)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(deploymentrevision.FieldProfile, field.TypeString)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(deploymentrevision.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CommitSha(); ok {
+		_spec.SetField(deploymentrevision.FieldCommitSha, field.TypeString, value)
+	}
+	if _u.mutation.CommitShaCleared() {
+		_spec.ClearField(deploymentrevision.FieldCommitSha, field.TypeString)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(deploymentrevision.FieldAuthor, field.TypeString)
+	}
+	if _u.mutation.RedeployedFromCleared() {
+		_spec.ClearField(deploymentrevision.FieldRedeployedFrom, field.TypeInt)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(deploymentrevision.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Outcomes(); ok {
+		_spec.SetField(deploymentrevision.FieldOutcomes, field.TypeJSON, value)
+	}
+	if _u.mutation.OutcomesCleared() {
+		_spec.ClearField(deploymentrevision.FieldOutcomes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deploymentrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deploymentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeploymentRevisionUpdateOne is the builder for updating a single DeploymentRevision entity.
+type DeploymentRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeploymentRevisionMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (_u *DeploymentRevisionUpdateOne) SetOrgID(v uuid.UUID) *DeploymentRevisionUpdateOne {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdateOne) SetNillableOrgID(v *uuid.UUID) *DeploymentRevisionUpdateOne {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *DeploymentRevisionUpdateOne) ClearOrgID() *DeploymentRevisionUpdateOne {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_u *DeploymentRevisionUpdateOne) SetCommitSha(v string) *DeploymentRevisionUpdateOne {
+	_u.mutation.SetCommitSha(v)
+	return _u
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdateOne) SetNillableCommitSha(v *string) *DeploymentRevisionUpdateOne {
+	if v != nil {
+		_u.SetCommitSha(*v)
+	}
+	return _u
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (_u *DeploymentRevisionUpdateOne) ClearCommitSha() *DeploymentRevisionUpdateOne {
+	_u.mutation.ClearCommitSha()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *DeploymentRevisionUpdateOne) SetState(v string) *DeploymentRevisionUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *DeploymentRevisionUpdateOne) SetNillableState(v *string) *DeploymentRevisionUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (_u *DeploymentRevisionUpdateOne) SetOutcomes(v map[string]model.SiteOutcome) *DeploymentRevisionUpdateOne {
+	_u.mutation.SetOutcomes(v)
+	return _u
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (_u *DeploymentRevisionUpdateOne) ClearOutcomes() *DeploymentRevisionUpdateOne {
+	_u.mutation.ClearOutcomes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeploymentRevisionUpdateOne) SetUpdatedAt(v time.Time) *DeploymentRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeploymentRevisionMutation object of the builder.
+func (_u *DeploymentRevisionUpdateOne) Mutation() *DeploymentRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeploymentRevisionUpdate builder.
+func (_u *DeploymentRevisionUpdateOne) Where(ps ...predicate.DeploymentRevision) *DeploymentRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeploymentRevisionUpdateOne) Select(field string, fields ...string) *DeploymentRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeploymentRevision entity.
+func (_u *DeploymentRevisionUpdateOne) Save(ctx context.Context) (*DeploymentRevision, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeploymentRevisionUpdateOne) SaveX(ctx context.Context) *DeploymentRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeploymentRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeploymentRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeploymentRevisionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if deploymentrevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentrevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := deploymentrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeploymentRevisionUpdateOne) check() error {
+	if _u.mutation.DeploymentCleared() && len(_u.mutation.DeploymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeploymentRevision.deployment"`)
+	}
+	return nil
+}
+
+func (_u *DeploymentRevisionUpdateOne) sqlSave(ctx context.Context) (_node *DeploymentRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deploymentrevision.Table, deploymentrevision.Columns, sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeploymentRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deploymentrevision.FieldID)
+		for _, f := range fields {
+			if !deploymentrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deploymentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrgID(); ok {
+		_spec.SetField(deploymentrevision.FieldOrgID, field.TypeUUID, value)
+	}
+	if _u.mutation.OrgIDCleared() {
+		_spec.ClearField(deploymentrevision.FieldOrgID, field.TypeUUID)
+	}
+	if _u.mutation.AppIDCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppID, field.TypeString)
+	}
+	if _u.mutation.AppNameCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppName, field.TypeString)
+	}
+	if _u.mutation.AppVersionCleared() {
+		_spec.ClearField(deploymentrevision.FieldAppVersion, field.TypeString)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(deploymentrevision.FieldProfile, field.TypeString)
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(deploymentrevision.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CommitSha(); ok {
+		_spec.SetField(deploymentrevision.FieldCommitSha, field.TypeString, value)
+	}
+	if _u.mutation.CommitShaCleared() {
+		_spec.ClearField(deploymentrevision.FieldCommitSha, field.TypeString)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(deploymentrevision.FieldAuthor, field.TypeString)
+	}
+	if _u.mutation.RedeployedFromCleared() {
+		_spec.ClearField(deploymentrevision.FieldRedeployedFrom, field.TypeInt)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(deploymentrevision.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Outcomes(); ok {
+		_spec.SetField(deploymentrevision.FieldOutcomes, field.TypeJSON, value)
+	}
+	if _u.mutation.OutcomesCleared() {
+		_spec.ClearField(deploymentrevision.FieldOutcomes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deploymentrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DeploymentRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deploymentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
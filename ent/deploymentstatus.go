@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +19,8 @@ type DeploymentStatus struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
 	// State holds the value of the "state" field.
 	State string `json:"state,omitempty"`
 	// ErrorCode holds the value of the "error_code" field.
@@ -40,9 +43,13 @@ type DeploymentStatus struct {
 type DeploymentStatusEdges struct {
 	// Components holds the value of the components edge.
 	Components []*DeploymentComponentStatus `json:"components,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*DeploymentRevision `json:"revisions,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ComponentsOrErr returns the Components value or an error if the edge
@@ -54,6 +61,26 @@ func (e DeploymentStatusEdges) ComponentsOrErr() ([]*DeploymentComponentStatus,
 	return nil, &NotLoadedError{edge: "components"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeploymentStatusEdges) RevisionsOrErr() ([]*DeploymentRevision, error) {
+	if e.loadedTypes[1] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeploymentStatusEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DeploymentStatus) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -63,7 +90,7 @@ func (*DeploymentStatus) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case deploymentstatus.FieldCreatedAt, deploymentstatus.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case deploymentstatus.FieldID:
+		case deploymentstatus.FieldID, deploymentstatus.FieldOrgID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -86,6 +113,12 @@ func (_m *DeploymentStatus) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case deploymentstatus.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				_m.OrgID = *value
+			}
 		case deploymentstatus.FieldState:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field state", values[i])
@@ -140,6 +173,16 @@ func (_m *DeploymentStatus) QueryComponents() *DeploymentComponentStatusQuery {
 	return NewDeploymentStatusClient(_m.config).QueryComponents(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the DeploymentStatus entity.
+func (_m *DeploymentStatus) QueryRevisions() *DeploymentRevisionQuery {
+	return NewDeploymentStatusClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryOrganization queries the "organization" edge of the DeploymentStatus entity.
+func (_m *DeploymentStatus) QueryOrganization() *OrganizationQuery {
+	return NewDeploymentStatusClient(_m.config).QueryOrganization(_m)
+}
+
 // Update returns a builder for updating this DeploymentStatus.
 // Note that you need to call DeploymentStatus.Unwrap() before calling this method if this DeploymentStatus
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -163,6 +206,9 @@ func (_m *DeploymentStatus) String() string {
 	var builder strings.Builder
 	builder.WriteString("DeploymentStatus(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgID))
+	builder.WriteString(", ")
 	builder.WriteString("state=")
 	builder.WriteString(_m.State)
 	builder.WriteString(", ")
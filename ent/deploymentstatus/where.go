@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.DeploymentStatus {
 	return predicate.DeploymentStatus(sql.FieldLTE(FieldID, id))
 }
 
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v uuid.UUID) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldEQ(FieldOrgID, v))
+}
+
 // State applies equality check predicate on the "state" field. It's identical to StateEQ.
 func State(v string) predicate.DeploymentStatus {
 	return predicate.DeploymentStatus(sql.FieldEQ(FieldState, v))
@@ -86,6 +91,36 @@ func UpdatedAt(v time.Time) predicate.DeploymentStatus {
 	return predicate.DeploymentStatus(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v uuid.UUID) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v uuid.UUID) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...uuid.UUID) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...uuid.UUID) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDIsNil applies the IsNil predicate on the "org_id" field.
+func OrgIDIsNil() predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldIsNull(FieldOrgID))
+}
+
+// OrgIDNotNil applies the NotNil predicate on the "org_id" field.
+func OrgIDNotNil() predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(sql.FieldNotNull(FieldOrgID))
+}
+
 // StateEQ applies the EQ predicate on the "state" field.
 func StateEQ(v string) predicate.DeploymentStatus {
 	return predicate.DeploymentStatus(sql.FieldEQ(FieldState, v))
@@ -479,6 +514,52 @@ func HasComponentsWith(preds ...predicate.DeploymentComponentStatus) predicate.D
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.DeploymentRevision) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.DeploymentStatus {
+	return predicate.DeploymentStatus(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DeploymentStatus) predicate.DeploymentStatus {
 	return predicate.DeploymentStatus(sql.AndPredicates(predicates...))
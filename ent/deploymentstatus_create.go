@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/google/uuid"
 )
 
@@ -25,6 +27,20 @@ type DeploymentStatusCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetOrgID sets the "org_id" field.
+func (_c *DeploymentStatusCreate) SetOrgID(v uuid.UUID) *DeploymentStatusCreate {
+	_c.mutation.SetOrgID(v)
+	return _c
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_c *DeploymentStatusCreate) SetNillableOrgID(v *uuid.UUID) *DeploymentStatusCreate {
+	if v != nil {
+		_c.SetOrgID(*v)
+	}
+	return _c
+}
+
 // SetState sets the "state" field.
 func (_c *DeploymentStatusCreate) SetState(v string) *DeploymentStatusCreate {
 	_c.mutation.SetState(v)
@@ -138,6 +154,40 @@ func (_c *DeploymentStatusCreate) AddComponents(v ...*DeploymentComponentStatus)
 	return _c.AddComponentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the DeploymentRevision entity by IDs.
+func (_c *DeploymentStatusCreate) AddRevisionIDs(ids ...uuid.UUID) *DeploymentStatusCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the DeploymentRevision entity.
+func (_c *DeploymentStatusCreate) AddRevisions(v ...*DeploymentRevision) *DeploymentStatusCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *DeploymentStatusCreate) SetOrganizationID(id uuid.UUID) *DeploymentStatusCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_c *DeploymentStatusCreate) SetNillableOrganizationID(id *uuid.UUID) *DeploymentStatusCreate {
+	if id != nil {
+		_c = _c.SetOrganizationID(*id)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *DeploymentStatusCreate) SetOrganization(v *Organization) *DeploymentStatusCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the DeploymentStatusMutation object of the builder.
 func (_c *DeploymentStatusCreate) Mutation() *DeploymentStatusMutation {
 	return _c.mutation
@@ -145,7 +195,9 @@ func (_c *DeploymentStatusCreate) Mutation() *DeploymentStatusMutation {
 
 // Save creates the DeploymentStatus in the database.
 func (_c *DeploymentStatusCreate) Save(ctx context.Context) (*DeploymentStatus, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -172,23 +224,33 @@ func (_c *DeploymentStatusCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DeploymentStatusCreate) defaults() {
+func (_c *DeploymentStatusCreate) defaults() error {
 	if _, ok := _c.mutation.State(); !ok {
 		v := deploymentstatus.DefaultState
 		_c.mutation.SetState(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if deploymentstatus.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentstatus.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := deploymentstatus.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if deploymentstatus.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentstatus.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := deploymentstatus.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if deploymentstatus.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized deploymentstatus.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := deploymentstatus.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -278,6 +340,39 @@ func (_c *DeploymentStatusCreate) createSpec() (*DeploymentStatus, *sqlgraph.Cre
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentstatus.OrganizationTable,
+			Columns: []string{deploymentstatus.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -285,7 +380,7 @@ func (_c *DeploymentStatusCreate) createSpec() (*DeploymentStatus, *sqlgraph.Cre
 // of the `INSERT` statement. For example:
 //
 //	client.DeploymentStatus.Create().
-//		SetState(v).
+//		SetOrgID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -294,7 +389,7 @@ func (_c *DeploymentStatusCreate) createSpec() (*DeploymentStatus, *sqlgraph.Cre
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DeploymentStatusUpsert) {
-//			SetState(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *DeploymentStatusCreate) OnConflict(opts ...sql.ConflictOption) *DeploymentStatusUpsertOne {
@@ -330,6 +425,24 @@ type (
 	}
 )
 
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentStatusUpsert) SetOrgID(v uuid.UUID) *DeploymentStatusUpsert {
+	u.Set(deploymentstatus.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentStatusUpsert) UpdateOrgID() *DeploymentStatusUpsert {
+	u.SetExcluded(deploymentstatus.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentStatusUpsert) ClearOrgID() *DeploymentStatusUpsert {
+	u.SetNull(deploymentstatus.FieldOrgID)
+	return u
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentStatusUpsert) SetState(v string) *DeploymentStatusUpsert {
 	u.Set(deploymentstatus.FieldState, v)
@@ -468,6 +581,27 @@ func (u *DeploymentStatusUpsertOne) Update(set func(*DeploymentStatusUpsert)) *D
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentStatusUpsertOne) SetOrgID(v uuid.UUID) *DeploymentStatusUpsertOne {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentStatusUpsertOne) UpdateOrgID() *DeploymentStatusUpsertOne {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentStatusUpsertOne) ClearOrgID() *DeploymentStatusUpsertOne {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentStatusUpsertOne) SetState(v string) *DeploymentStatusUpsertOne {
 	return u.Update(func(s *DeploymentStatusUpsert) {
@@ -709,7 +843,7 @@ func (_c *DeploymentStatusCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DeploymentStatusUpsert) {
-//			SetState(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *DeploymentStatusCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeploymentStatusUpsertBulk {
@@ -788,6 +922,27 @@ func (u *DeploymentStatusUpsertBulk) Update(set func(*DeploymentStatusUpsert)) *
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *DeploymentStatusUpsertBulk) SetOrgID(v uuid.UUID) *DeploymentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *DeploymentStatusUpsertBulk) UpdateOrgID() *DeploymentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *DeploymentStatusUpsertBulk) ClearOrgID() *DeploymentStatusUpsertBulk {
+	return u.Update(func(s *DeploymentStatusUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *DeploymentStatusUpsertBulk) SetState(v string) *DeploymentStatusUpsertBulk {
 	return u.Update(func(s *DeploymentStatusUpsert) {
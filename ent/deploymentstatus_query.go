@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -21,11 +23,13 @@ import (
 // DeploymentStatusQuery is the builder for querying DeploymentStatus entities.
 type DeploymentStatusQuery struct {
 	config
-	ctx            *QueryContext
-	order          []deploymentstatus.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.DeploymentStatus
-	withComponents *DeploymentComponentStatusQuery
+	ctx              *QueryContext
+	order            []deploymentstatus.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.DeploymentStatus
+	withComponents   *DeploymentComponentStatusQuery
+	withRevisions    *DeploymentRevisionQuery
+	withOrganization *OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +88,50 @@ func (_q *DeploymentStatusQuery) QueryComponents() *DeploymentComponentStatusQue
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *DeploymentStatusQuery) QueryRevisions() *DeploymentRevisionQuery {
+	query := (&DeploymentRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, selector),
+			sqlgraph.To(deploymentrevision.Table, deploymentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deploymentstatus.RevisionsTable, deploymentstatus.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *DeploymentStatusQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deploymentstatus.Table, deploymentstatus.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deploymentstatus.OrganizationTable, deploymentstatus.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DeploymentStatus entity from the query.
 // Returns a *NotFoundError when no DeploymentStatus was found.
 func (_q *DeploymentStatusQuery) First(ctx context.Context) (*DeploymentStatus, error) {
@@ -271,12 +319,14 @@ func (_q *DeploymentStatusQuery) Clone() *DeploymentStatusQuery {
 		return nil
 	}
 	return &DeploymentStatusQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]deploymentstatus.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.DeploymentStatus{}, _q.predicates...),
-		withComponents: _q.withComponents.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]deploymentstatus.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.DeploymentStatus{}, _q.predicates...),
+		withComponents:   _q.withComponents.Clone(),
+		withRevisions:    _q.withRevisions.Clone(),
+		withOrganization: _q.withOrganization.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,18 +344,40 @@ func (_q *DeploymentStatusQuery) WithComponents(opts ...func(*DeploymentComponen
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeploymentStatusQuery) WithRevisions(opts ...func(*DeploymentRevisionQuery)) *DeploymentStatusQuery {
+	query := (&DeploymentRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeploymentStatusQuery) WithOrganization(opts ...func(*OrganizationQuery)) *DeploymentStatusQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		State string `json:"state,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.DeploymentStatus.Query().
-//		GroupBy(deploymentstatus.FieldState).
+//		GroupBy(deploymentstatus.FieldOrgID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *DeploymentStatusQuery) GroupBy(field string, fields ...string) *DeploymentStatusGroupBy {
@@ -323,11 +395,11 @@ func (_q *DeploymentStatusQuery) GroupBy(field string, fields ...string) *Deploy
 // Example:
 //
 //	var v []struct {
-//		State string `json:"state,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //	}
 //
 //	client.DeploymentStatus.Query().
-//		Select(deploymentstatus.FieldState).
+//		Select(deploymentstatus.FieldOrgID).
 //		Scan(ctx, &v)
 func (_q *DeploymentStatusQuery) Select(fields ...string) *DeploymentStatusSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -372,8 +444,10 @@ func (_q *DeploymentStatusQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*DeploymentStatus{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withComponents != nil,
+			_q.withRevisions != nil,
+			_q.withOrganization != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +477,19 @@ func (_q *DeploymentStatusQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *DeploymentStatus) { n.Edges.Revisions = []*DeploymentRevision{} },
+			func(n *DeploymentStatus, e *DeploymentRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *DeploymentStatus, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -437,6 +524,65 @@ func (_q *DeploymentStatusQuery) loadComponents(ctx context.Context, query *Depl
 	}
 	return nil
 }
+func (_q *DeploymentStatusQuery) loadRevisions(ctx context.Context, query *DeploymentRevisionQuery, nodes []*DeploymentStatus, init func(*DeploymentStatus), assign func(*DeploymentStatus, *DeploymentRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*DeploymentStatus)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deploymentrevision.FieldDeploymentID)
+	}
+	query.Where(predicate.DeploymentRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(deploymentstatus.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeploymentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "deployment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DeploymentStatusQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*DeploymentStatus, init func(*DeploymentStatus), assign func(*DeploymentStatus, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DeploymentStatus)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *DeploymentStatusQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -463,6 +609,9 @@ func (_q *DeploymentStatusQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withOrganization != nil {
+			_spec.Node.AddColumnOnce(deploymentstatus.FieldOrgID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -30,6 +32,26 @@ func (_u *DeploymentStatusUpdate) Where(ps ...predicate.DeploymentStatus) *Deplo
 	return _u
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *DeploymentStatusUpdate) SetOrgID(v uuid.UUID) *DeploymentStatusUpdate {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *DeploymentStatusUpdate) SetNillableOrgID(v *uuid.UUID) *DeploymentStatusUpdate {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *DeploymentStatusUpdate) ClearOrgID() *DeploymentStatusUpdate {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetState sets the "state" field.
 func (_u *DeploymentStatusUpdate) SetState(v string) *DeploymentStatusUpdate {
 	_u.mutation.SetState(v)
@@ -139,6 +161,40 @@ func (_u *DeploymentStatusUpdate) AddComponents(v ...*DeploymentComponentStatus)
 	return _u.AddComponentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the DeploymentRevision entity by IDs.
+func (_u *DeploymentStatusUpdate) AddRevisionIDs(ids ...uuid.UUID) *DeploymentStatusUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the DeploymentRevision entity.
+func (_u *DeploymentStatusUpdate) AddRevisions(v ...*DeploymentRevision) *DeploymentStatusUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *DeploymentStatusUpdate) SetOrganizationID(id uuid.UUID) *DeploymentStatusUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *DeploymentStatusUpdate) SetNillableOrganizationID(id *uuid.UUID) *DeploymentStatusUpdate {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *DeploymentStatusUpdate) SetOrganization(v *Organization) *DeploymentStatusUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the DeploymentStatusMutation object of the builder.
 func (_u *DeploymentStatusUpdate) Mutation() *DeploymentStatusMutation {
 	return _u.mutation
@@ -165,9 +221,38 @@ func (_u *DeploymentStatusUpdate) RemoveComponents(v ...*DeploymentComponentStat
 	return _u.RemoveComponentIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the DeploymentRevision entity.
+func (_u *DeploymentStatusUpdate) ClearRevisions() *DeploymentStatusUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to DeploymentRevision entities by IDs.
+func (_u *DeploymentStatusUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *DeploymentStatusUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to DeploymentRevision entities.
+func (_u *DeploymentStatusUpdate) RemoveRevisions(v ...*DeploymentRevision) *DeploymentStatusUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *DeploymentStatusUpdate) ClearOrganization() *DeploymentStatusUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeploymentStatusUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -194,11 +279,15 @@ func (_u *DeploymentStatusUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeploymentStatusUpdate) defaults() {
+func (_u *DeploymentStatusUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if deploymentstatus.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentstatus.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := deploymentstatus.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DeploymentStatusUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -282,6 +371,80 @@ func (_u *DeploymentStatusUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentstatus.OrganizationTable,
+			Columns: []string{deploymentstatus.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentstatus.OrganizationTable,
+			Columns: []string{deploymentstatus.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{deploymentstatus.Label}
@@ -302,6 +465,26 @@ type DeploymentStatusUpdateOne struct {
 	mutation *DeploymentStatusMutation
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *DeploymentStatusUpdateOne) SetOrgID(v uuid.UUID) *DeploymentStatusUpdateOne {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *DeploymentStatusUpdateOne) SetNillableOrgID(v *uuid.UUID) *DeploymentStatusUpdateOne {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *DeploymentStatusUpdateOne) ClearOrgID() *DeploymentStatusUpdateOne {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetState sets the "state" field.
 func (_u *DeploymentStatusUpdateOne) SetState(v string) *DeploymentStatusUpdateOne {
 	_u.mutation.SetState(v)
@@ -411,6 +594,40 @@ func (_u *DeploymentStatusUpdateOne) AddComponents(v ...*DeploymentComponentStat
 	return _u.AddComponentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the DeploymentRevision entity by IDs.
+func (_u *DeploymentStatusUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *DeploymentStatusUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the DeploymentRevision entity.
+func (_u *DeploymentStatusUpdateOne) AddRevisions(v ...*DeploymentRevision) *DeploymentStatusUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *DeploymentStatusUpdateOne) SetOrganizationID(id uuid.UUID) *DeploymentStatusUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *DeploymentStatusUpdateOne) SetNillableOrganizationID(id *uuid.UUID) *DeploymentStatusUpdateOne {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *DeploymentStatusUpdateOne) SetOrganization(v *Organization) *DeploymentStatusUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the DeploymentStatusMutation object of the builder.
 func (_u *DeploymentStatusUpdateOne) Mutation() *DeploymentStatusMutation {
 	return _u.mutation
@@ -437,6 +654,33 @@ func (_u *DeploymentStatusUpdateOne) RemoveComponents(v ...*DeploymentComponentS
 	return _u.RemoveComponentIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the DeploymentRevision entity.
+func (_u *DeploymentStatusUpdateOne) ClearRevisions() *DeploymentStatusUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to DeploymentRevision entities by IDs.
+func (_u *DeploymentStatusUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *DeploymentStatusUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to DeploymentRevision entities.
+func (_u *DeploymentStatusUpdateOne) RemoveRevisions(v ...*DeploymentRevision) *DeploymentStatusUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *DeploymentStatusUpdateOne) ClearOrganization() *DeploymentStatusUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Where appends a list predicates to the DeploymentStatusUpdate builder.
 func (_u *DeploymentStatusUpdateOne) Where(ps ...predicate.DeploymentStatus) *DeploymentStatusUpdateOne {
 	_u.mutation.Where(ps...)
@@ -452,7 +696,9 @@ func (_u *DeploymentStatusUpdateOne) Select(field string, fields ...string) *Dep
 
 // Save executes the query and returns the updated DeploymentStatus entity.
 func (_u *DeploymentStatusUpdateOne) Save(ctx context.Context) (*DeploymentStatus, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -479,11 +725,15 @@ func (_u *DeploymentStatusUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeploymentStatusUpdateOne) defaults() {
+func (_u *DeploymentStatusUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if deploymentstatus.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized deploymentstatus.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := deploymentstatus.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 func (_u *DeploymentStatusUpdateOne) sqlSave(ctx context.Context) (_node *DeploymentStatus, err error) {
@@ -584,6 +834,80 @@ func (_u *DeploymentStatusUpdateOne) sqlSave(ctx context.Context) (_node *Deploy
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deploymentstatus.RevisionsTable,
+			Columns: []string{deploymentstatus.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentstatus.OrganizationTable,
+			Columns: []string{deploymentstatus.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deploymentstatus.OrganizationTable,
+			Columns: []string{deploymentstatus.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &DeploymentStatus{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
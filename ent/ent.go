@@ -12,15 +12,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/auditentry"
 	"github.com/balaji-balu/margo-hello-world/ent/component"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/ent/rolebinding"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +89,23 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apitoken.Table:                  apitoken.ValidColumn,
 			applicationdesc.Table:           applicationdesc.ValidColumn,
+			auditentry.Table:                auditentry.ValidColumn,
 			component.Table:                 component.ValidColumn,
 			deploymentcomponentstatus.Table: deploymentcomponentstatus.ValidColumn,
 			deploymentprofile.Table:         deploymentprofile.ValidColumn,
+			deploymentrevision.Table:        deploymentrevision.ValidColumn,
 			deploymentstatus.Table:          deploymentstatus.ValidColumn,
+			event.Table:                     event.ValidColumn,
 			host.Table:                      host.ValidColumn,
 			orchestrator.Table:              orchestrator.ValidColumn,
+			organization.Table:              organization.ValidColumn,
+			rolebinding.Table:               rolebinding.ValidColumn,
 			site.Table:                      site.ValidColumn,
 			user.Table:                      user.ValidColumn,
+			webhook.Table:                   webhook.ValidColumn,
+			webhookdelivery.Table:           webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -20,6 +20,8 @@ type Host struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
 	// HostID holds the value of the "host_id" field.
 	HostID string `json:"host_id,omitempty"`
 	// SiteID holds the value of the "site_id" field.
@@ -41,7 +43,7 @@ type Host struct {
 	// EdgeURL holds the value of the "edge_url" field.
 	EdgeURL string `json:"edge_url,omitempty"`
 	// Metadata holds the value of the "metadata" field.
-	Metadata struct{} `json:"metadata,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -87,7 +89,7 @@ func (*Host) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case host.FieldLastSeen, host.FieldCreatedAt, host.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case host.FieldID, host.FieldSiteID:
+		case host.FieldID, host.FieldOrgID, host.FieldSiteID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,6 +112,12 @@ func (_m *Host) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case host.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				_m.OrgID = *value
+			}
 		case host.FieldHostID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field host_id", values[i])
@@ -231,6 +239,9 @@ func (_m *Host) String() string {
 	var builder strings.Builder
 	builder.WriteString("Host(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgID))
+	builder.WriteString(", ")
 	builder.WriteString("host_id=")
 	builder.WriteString(_m.HostID)
 	builder.WriteString(", ")
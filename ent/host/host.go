@@ -3,6 +3,7 @@
 package host
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -13,6 +14,8 @@ const (
 	Label = "host"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
 	// FieldHostID holds the string denoting the host_id field in the database.
 	FieldHostID = "host_id"
 	// FieldSiteID holds the string denoting the site_id field in the database.
@@ -55,6 +58,7 @@ const (
 // Columns holds all SQL columns for host fields.
 var Columns = []string{
 	FieldID,
+	FieldOrgID,
 	FieldHostID,
 	FieldSiteID,
 	FieldRuntime,
@@ -80,7 +84,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/balaji-balu/margo-hello-world/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -93,6 +104,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
 // ByHostID orders the results by the host_id field.
 func ByHostID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHostID, opts...).ToFunc()
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.Host {
 	return predicate.Host(sql.FieldLTE(FieldID, id))
 }
 
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldEQ(FieldOrgID, v))
+}
+
 // HostID applies equality check predicate on the "host_id" field. It's identical to HostIDEQ.
 func HostID(v string) predicate.Host {
 	return predicate.Host(sql.FieldEQ(FieldHostID, v))
@@ -116,6 +121,56 @@ func UpdatedAt(v time.Time) predicate.Host {
 	return predicate.Host(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v uuid.UUID) predicate.Host {
+	return predicate.Host(sql.FieldLTE(FieldOrgID, v))
+}
+
+// OrgIDIsNil applies the IsNil predicate on the "org_id" field.
+func OrgIDIsNil() predicate.Host {
+	return predicate.Host(sql.FieldIsNull(FieldOrgID))
+}
+
+// OrgIDNotNil applies the NotNil predicate on the "org_id" field.
+func OrgIDNotNil() predicate.Host {
+	return predicate.Host(sql.FieldNotNull(FieldOrgID))
+}
+
 // HostIDEQ applies the EQ predicate on the "host_id" field.
 func HostIDEQ(v string) predicate.Host {
 	return predicate.Host(sql.FieldEQ(FieldHostID, v))
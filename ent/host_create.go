@@ -25,6 +25,20 @@ type HostCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetOrgID sets the "org_id" field.
+func (_c *HostCreate) SetOrgID(v uuid.UUID) *HostCreate {
+	_c.mutation.SetOrgID(v)
+	return _c
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_c *HostCreate) SetNillableOrgID(v *uuid.UUID) *HostCreate {
+	if v != nil {
+		_c.SetOrgID(*v)
+	}
+	return _c
+}
+
 // SetHostID sets the "host_id" field.
 func (_c *HostCreate) SetHostID(v string) *HostCreate {
 	_c.mutation.SetHostID(v)
@@ -158,19 +172,11 @@ func (_c *HostCreate) SetNillableEdgeURL(v *string) *HostCreate {
 }
 
 // SetMetadata sets the "metadata" field.
-func (_c *HostCreate) SetMetadata(v struct{}) *HostCreate {
+func (_c *HostCreate) SetMetadata(v map[string]interface{}) *HostCreate {
 	_c.mutation.SetMetadata(v)
 	return _c
 }
 
-// SetNillableMetadata sets the "metadata" field if the given value is not nil.
-func (_c *HostCreate) SetNillableMetadata(v *struct{}) *HostCreate {
-	if v != nil {
-		_c.SetMetadata(*v)
-	}
-	return _c
-}
-
 // SetCreatedAt sets the "created_at" field.
 func (_c *HostCreate) SetCreatedAt(v time.Time) *HostCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -225,7 +231,9 @@ func (_c *HostCreate) Mutation() *HostMutation {
 
 // Save creates the Host in the database.
 func (_c *HostCreate) Save(ctx context.Context) (*Host, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -252,11 +260,15 @@ func (_c *HostCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *HostCreate) defaults() {
+func (_c *HostCreate) defaults() error {
 	if _, ok := _c.mutation.ID(); !ok {
+		if host.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized host.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := host.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -300,6 +312,10 @@ func (_c *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.OrgID(); ok {
+		_spec.SetField(host.FieldOrgID, field.TypeUUID, value)
+		_node.OrgID = value
+	}
 	if value, ok := _c.mutation.HostID(); ok {
 		_spec.SetField(host.FieldHostID, field.TypeString, value)
 		_node.HostID = value
@@ -372,7 +388,7 @@ func (_c *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Host.Create().
-//		SetHostID(v).
+//		SetOrgID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -381,7 +397,7 @@ func (_c *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HostUpsert) {
-//			SetHostID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *HostCreate) OnConflict(opts ...sql.ConflictOption) *HostUpsertOne {
@@ -417,6 +433,24 @@ type (
 	}
 )
 
+// SetOrgID sets the "org_id" field.
+func (u *HostUpsert) SetOrgID(v uuid.UUID) *HostUpsert {
+	u.Set(host.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HostUpsert) UpdateOrgID() *HostUpsert {
+	u.SetExcluded(host.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *HostUpsert) ClearOrgID() *HostUpsert {
+	u.SetNull(host.FieldOrgID)
+	return u
+}
+
 // SetHostID sets the "host_id" field.
 func (u *HostUpsert) SetHostID(v string) *HostUpsert {
 	u.Set(host.FieldHostID, v)
@@ -604,7 +638,7 @@ func (u *HostUpsert) ClearEdgeURL() *HostUpsert {
 }
 
 // SetMetadata sets the "metadata" field.
-func (u *HostUpsert) SetMetadata(v struct{}) *HostUpsert {
+func (u *HostUpsert) SetMetadata(v map[string]interface{}) *HostUpsert {
 	u.Set(host.FieldMetadata, v)
 	return u
 }
@@ -705,6 +739,27 @@ func (u *HostUpsertOne) Update(set func(*HostUpsert)) *HostUpsertOne {
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *HostUpsertOne) SetOrgID(v uuid.UUID) *HostUpsertOne {
+	return u.Update(func(s *HostUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HostUpsertOne) UpdateOrgID() *HostUpsertOne {
+	return u.Update(func(s *HostUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *HostUpsertOne) ClearOrgID() *HostUpsertOne {
+	return u.Update(func(s *HostUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetHostID sets the "host_id" field.
 func (u *HostUpsertOne) SetHostID(v string) *HostUpsertOne {
 	return u.Update(func(s *HostUpsert) {
@@ -923,7 +978,7 @@ func (u *HostUpsertOne) ClearEdgeURL() *HostUpsertOne {
 }
 
 // SetMetadata sets the "metadata" field.
-func (u *HostUpsertOne) SetMetadata(v struct{}) *HostUpsertOne {
+func (u *HostUpsertOne) SetMetadata(v map[string]interface{}) *HostUpsertOne {
 	return u.Update(func(s *HostUpsert) {
 		s.SetMetadata(v)
 	})
@@ -1121,7 +1176,7 @@ func (_c *HostCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HostUpsert) {
-//			SetHostID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *HostCreateBulk) OnConflict(opts ...sql.ConflictOption) *HostUpsertBulk {
@@ -1200,6 +1255,27 @@ func (u *HostUpsertBulk) Update(set func(*HostUpsert)) *HostUpsertBulk {
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *HostUpsertBulk) SetOrgID(v uuid.UUID) *HostUpsertBulk {
+	return u.Update(func(s *HostUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HostUpsertBulk) UpdateOrgID() *HostUpsertBulk {
+	return u.Update(func(s *HostUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *HostUpsertBulk) ClearOrgID() *HostUpsertBulk {
+	return u.Update(func(s *HostUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetHostID sets the "host_id" field.
 func (u *HostUpsertBulk) SetHostID(v string) *HostUpsertBulk {
 	return u.Update(func(s *HostUpsert) {
@@ -1418,7 +1494,7 @@ func (u *HostUpsertBulk) ClearEdgeURL() *HostUpsertBulk {
 }
 
 // SetMetadata sets the "metadata" field.
-func (u *HostUpsertBulk) SetMetadata(v struct{}) *HostUpsertBulk {
+func (u *HostUpsertBulk) SetMetadata(v map[string]interface{}) *HostUpsertBulk {
 	return u.Update(func(s *HostUpsert) {
 		s.SetMetadata(v)
 	})
@@ -299,12 +299,12 @@ func (_q *HostQuery) WithSite(opts ...func(*SiteQuery)) *HostQuery {
 // Example:
 //
 //	var v []struct {
-//		HostID string `json:"host_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Host.Query().
-//		GroupBy(host.FieldHostID).
+//		GroupBy(host.FieldOrgID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *HostQuery) GroupBy(field string, fields ...string) *HostGroupBy {
@@ -322,11 +322,11 @@ func (_q *HostQuery) GroupBy(field string, fields ...string) *HostGroupBy {
 // Example:
 //
 //	var v []struct {
-//		HostID string `json:"host_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //	}
 //
 //	client.Host.Query().
-//		Select(host.FieldHostID).
+//		Select(host.FieldOrgID).
 //		Scan(ctx, &v)
 func (_q *HostQuery) Select(fields ...string) *HostSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -30,6 +30,26 @@ func (_u *HostUpdate) Where(ps ...predicate.Host) *HostUpdate {
 	return _u
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *HostUpdate) SetOrgID(v uuid.UUID) *HostUpdate {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *HostUpdate) SetNillableOrgID(v *uuid.UUID) *HostUpdate {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *HostUpdate) ClearOrgID() *HostUpdate {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetHostID sets the "host_id" field.
 func (_u *HostUpdate) SetHostID(v string) *HostUpdate {
 	_u.mutation.SetHostID(v)
@@ -239,19 +259,11 @@ func (_u *HostUpdate) ClearEdgeURL() *HostUpdate {
 }
 
 // SetMetadata sets the "metadata" field.
-func (_u *HostUpdate) SetMetadata(v struct{}) *HostUpdate {
+func (_u *HostUpdate) SetMetadata(v map[string]interface{}) *HostUpdate {
 	_u.mutation.SetMetadata(v)
 	return _u
 }
 
-// SetNillableMetadata sets the "metadata" field if the given value is not nil.
-func (_u *HostUpdate) SetNillableMetadata(v *struct{}) *HostUpdate {
-	if v != nil {
-		_u.SetMetadata(*v)
-	}
-	return _u
-}
-
 // ClearMetadata clears the value of the "metadata" field.
 func (_u *HostUpdate) ClearMetadata() *HostUpdate {
 	_u.mutation.ClearMetadata()
@@ -350,6 +362,12 @@ func (_u *HostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.OrgID(); ok {
+		_spec.SetField(host.FieldOrgID, field.TypeUUID, value)
+	}
+	if _u.mutation.OrgIDCleared() {
+		_spec.ClearField(host.FieldOrgID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.HostID(); ok {
 		_spec.SetField(host.FieldHostID, field.TypeString, value)
 	}
@@ -474,6 +492,26 @@ type HostUpdateOne struct {
 	mutation *HostMutation
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *HostUpdateOne) SetOrgID(v uuid.UUID) *HostUpdateOne {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *HostUpdateOne) SetNillableOrgID(v *uuid.UUID) *HostUpdateOne {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *HostUpdateOne) ClearOrgID() *HostUpdateOne {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetHostID sets the "host_id" field.
 func (_u *HostUpdateOne) SetHostID(v string) *HostUpdateOne {
 	_u.mutation.SetHostID(v)
@@ -683,19 +721,11 @@ func (_u *HostUpdateOne) ClearEdgeURL() *HostUpdateOne {
 }
 
 // SetMetadata sets the "metadata" field.
-func (_u *HostUpdateOne) SetMetadata(v struct{}) *HostUpdateOne {
+func (_u *HostUpdateOne) SetMetadata(v map[string]interface{}) *HostUpdateOne {
 	_u.mutation.SetMetadata(v)
 	return _u
 }
 
-// SetNillableMetadata sets the "metadata" field if the given value is not nil.
-func (_u *HostUpdateOne) SetNillableMetadata(v *struct{}) *HostUpdateOne {
-	if v != nil {
-		_u.SetMetadata(*v)
-	}
-	return _u
-}
-
 // ClearMetadata clears the value of the "metadata" field.
 func (_u *HostUpdateOne) ClearMetadata() *HostUpdateOne {
 	_u.mutation.ClearMetadata()
@@ -824,6 +854,12 @@ func (_u *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.OrgID(); ok {
+		_spec.SetField(host.FieldOrgID, field.TypeUUID, value)
+	}
+	if _u.mutation.OrgIDCleared() {
+		_spec.ClearField(host.FieldOrgID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.HostID(); ok {
 		_spec.SetField(host.FieldHostID, field.TypeString, value)
 	}
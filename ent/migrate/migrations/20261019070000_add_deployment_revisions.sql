@@ -0,0 +1,24 @@
+-- Create "deployment_revisions" table
+CREATE TABLE "deployment_revisions" (
+  "id" uuid NOT NULL,
+  "revision" bigint NOT NULL,
+  "app_id" character varying NULL,
+  "app_name" character varying NULL,
+  "app_version" character varying NULL,
+  "profile" character varying NULL,
+  "parameters" jsonb NULL,
+  "site_id" character varying NOT NULL,
+  "spec" text NOT NULL,
+  "commit_sha" character varying NULL,
+  "author" character varying NULL,
+  "redeployed_from" bigint NULL,
+  "state" character varying NOT NULL DEFAULT 'pending',
+  "outcomes" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "deployment_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "deployment_revisions_deployment_status_revisions" FOREIGN KEY ("deployment_id") REFERENCES "deployment_status" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "deploymentrevision_deployment_id_revision" to table: "deployment_revisions"
+CREATE UNIQUE INDEX "deploymentrevision_deployment_id_revision" ON "deployment_revisions" ("deployment_id", "revision");
@@ -0,0 +1,2 @@
+-- Modify "site" table
+ALTER TABLE "site" ADD COLUMN "status" character varying NULL, ADD COLUMN "last_seen" timestamptz NULL;
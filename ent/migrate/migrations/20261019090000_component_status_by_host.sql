@@ -0,0 +1,4 @@
+-- Modify "deployment_component_status" table
+ALTER TABLE "deployment_component_status" ADD COLUMN "site_id" character varying NOT NULL DEFAULT '', ADD COLUMN "host_id" character varying NOT NULL DEFAULT '', ADD COLUMN "reported_at" bigint NOT NULL DEFAULT 0;
+-- Create index "deploymentcomponentstatus_site_id_host_id_name_deployment_status_components" to table: "deployment_component_status"
+CREATE UNIQUE INDEX "deploymentcomponentstatus_site_id_host_id_name_deployment_status_components" ON "deployment_component_status" ("site_id", "host_id", "name", "deployment_status_components");
@@ -0,0 +1,35 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "username" character varying NOT NULL, ADD COLUMN "password_hash" character varying NULL, ADD COLUMN "disabled" boolean NOT NULL DEFAULT false, ADD COLUMN "last_login_at" timestamptz NULL, ADD COLUMN "created_at" timestamptz NOT NULL;
+-- Create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX "users_username_key" ON "users" ("username");
+-- Create "api_tokens" table
+CREATE TABLE "api_tokens" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "prefix" character varying NOT NULL,
+  "role" character varying NULL,
+  "scope" character varying NOT NULL DEFAULT '*',
+  "expires_at" timestamptz NULL,
+  "last_used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_tokens" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "api_tokens_users_tokens" FOREIGN KEY ("user_tokens") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "api_tokens_token_hash_key" to table: "api_tokens"
+CREATE UNIQUE INDEX "api_tokens_token_hash_key" ON "api_tokens" ("token_hash");
+-- Create "role_bindings" table
+CREATE TABLE "role_bindings" (
+  "id" uuid NOT NULL,
+  "role" character varying NOT NULL,
+  "scope" character varying NOT NULL DEFAULT '*',
+  "created_at" timestamptz NOT NULL,
+  "user_roles" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "role_bindings_users_roles" FOREIGN KEY ("user_roles") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "rolebinding_role_scope_user_roles" to table: "role_bindings"
+CREATE UNIQUE INDEX "rolebinding_role_scope_user_roles" ON "role_bindings" ("role", "scope", "user_roles");
+-- Modify "site" table
+ALTER TABLE "site" ADD COLUMN "group" character varying NULL;
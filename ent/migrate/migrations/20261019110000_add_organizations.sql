@@ -0,0 +1,26 @@
+-- Create "organizations" table
+CREATE TABLE "organizations" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "display_name" character varying NULL,
+  "max_sites" bigint NOT NULL DEFAULT 0,
+  "max_deployments" bigint NOT NULL DEFAULT 0,
+  "max_apps" bigint NOT NULL DEFAULT 0,
+  "max_users" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "organizations_name_key" to table: "organizations"
+CREATE UNIQUE INDEX "organizations_name_key" ON "organizations" ("name");
+-- Modify "application_desc" table
+ALTER TABLE "application_desc" ADD COLUMN "org_id" uuid NULL, ADD CONSTRAINT "application_desc_organizations_apps" FOREIGN KEY ("org_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "deployment_revisions" table
+ALTER TABLE "deployment_revisions" ADD COLUMN "org_id" uuid NULL;
+-- Modify "deployment_status" table
+ALTER TABLE "deployment_status" ADD COLUMN "org_id" uuid NULL, ADD CONSTRAINT "deployment_status_organizations_deployments" FOREIGN KEY ("org_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "host" table
+ALTER TABLE "host" ADD COLUMN "org_id" uuid NULL;
+-- Modify "site" table
+ALTER TABLE "site" ADD COLUMN "org_id" uuid NULL, ADD CONSTRAINT "site_organizations_sites" FOREIGN KEY ("org_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "org_id" uuid NULL, ADD CONSTRAINT "users_organizations_users" FOREIGN KEY ("org_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,28 @@
+-- Create "audit_entries" table
+CREATE TABLE "audit_entries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "org_id" uuid NULL,
+  "created_at" timestamptz NOT NULL,
+  "actor" character varying NOT NULL,
+  "action" character varying NOT NULL,
+  "target" character varying NULL,
+  "payload_hash" character varying NULL,
+  "result" character varying NOT NULL,
+  "status" bigint NOT NULL,
+  "error" character varying NULL,
+  "source_ip" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_created_at" ON "audit_entries" ("created_at");
+-- Create index "auditentry_actor" to table: "audit_entries"
+CREATE INDEX "auditentry_actor" ON "audit_entries" ("actor");
+-- Create index "auditentry_target" to table: "audit_entries"
+CREATE INDEX "auditentry_target" ON "audit_entries" ("target");
+-- Keep "audit_entries" append-only for everyone, not just the CO
+CREATE FUNCTION "audit_entries_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION 'audit entries cannot be changed or deleted';
+END;
+$$;
+CREATE TRIGGER "audit_entries_append_only" BEFORE UPDATE OR DELETE ON "audit_entries" FOR EACH STATEMENT EXECUTE FUNCTION "audit_entries_append_only"();
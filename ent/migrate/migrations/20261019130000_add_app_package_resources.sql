@@ -0,0 +1,2 @@
+-- Modify "application_desc" table
+ALTER TABLE "application_desc" ADD COLUMN "icon_data" bytea NULL, ADD COLUMN "icon_media_type" character varying NULL, ADD COLUMN "license" text NULL, ADD COLUMN "release_notes" text NULL;
@@ -0,0 +1,36 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" (
+  "id" uuid NOT NULL,
+  "org_id" uuid NULL,
+  "name" character varying NOT NULL,
+  "url" character varying NOT NULL,
+  "secret" character varying NOT NULL,
+  "event_types" jsonb NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_by" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "org_id" uuid NULL,
+  "event_id" character varying NOT NULL,
+  "event_type" character varying NOT NULL,
+  "payload" text NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "response_status" bigint NULL,
+  "error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "delivered_at" timestamptz NULL,
+  "webhook_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "webhookdelivery_webhook_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_webhook_id_created_at" ON "webhook_deliveries" ("webhook_id", "created_at");
@@ -0,0 +1,17 @@
+-- Create "events" table
+CREATE TABLE "events" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "org_id" uuid NULL,
+  "created_at" timestamptz NOT NULL,
+  "type" character varying NOT NULL,
+  "deployment_id" character varying NULL,
+  "site_id" character varying NULL,
+  "data" jsonb NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "event_type" to table: "events"
+CREATE INDEX "event_type" ON "events" ("type");
+-- Create index "event_deployment_id" to table: "events"
+CREATE INDEX "event_deployment_id" ON "events" ("deployment_id");
+-- Create index "event_site_id" to table: "events"
+CREATE INDEX "event_site_id" ON "events" ("site_id");
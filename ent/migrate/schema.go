@@ -9,6 +9,33 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "role", Type: field.TypeString, Nullable: true},
+		{Name: "scope", Type: field.TypeString, Default: "*"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_tokens", Type: field.TypeInt},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_tokens",
+				Columns:    []*schema.Column{APITokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ApplicationDescColumns holds the columns for the "application_desc" table.
 	ApplicationDescColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -24,12 +51,62 @@ var (
 		{Name: "tag_line", Type: field.TypeString, Nullable: true},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "published", Type: field.TypeString, Nullable: true},
+		{Name: "icon_data", Type: field.TypeBytes, Nullable: true},
+		{Name: "icon_media_type", Type: field.TypeString, Nullable: true},
+		{Name: "license", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "release_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// ApplicationDescTable holds the schema information for the "application_desc" table.
 	ApplicationDescTable = &schema.Table{
 		Name:       "application_desc",
 		Columns:    ApplicationDescColumns,
 		PrimaryKey: []*schema.Column{ApplicationDescColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "application_desc_organizations_apps",
+				Columns:    []*schema.Column{ApplicationDescColumns[17]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target", Type: field.TypeString, Nullable: true},
+		{Name: "payload_hash", Type: field.TypeString, Nullable: true},
+		{Name: "result", Type: field.TypeString},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "source_ip", Type: field.TypeString, Nullable: true},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[2]},
+			},
+			{
+				Name:    "auditentry_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[3]},
+			},
+			{
+				Name:    "auditentry_target",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5]},
+			},
+		},
 	}
 	// ComponentColumns holds the columns for the "component" table.
 	ComponentColumns = []*schema.Column{
@@ -56,9 +133,12 @@ var (
 	DeploymentComponentStatusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "site_id", Type: field.TypeString, Default: ""},
+		{Name: "host_id", Type: field.TypeString, Default: ""},
 		{Name: "state", Type: field.TypeString, Default: "pending"},
 		{Name: "error_code", Type: field.TypeString, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "reported_at", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deployment_status_components", Type: field.TypeUUID},
@@ -71,11 +151,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deployment_component_status_deployment_status_components",
-				Columns:    []*schema.Column{DeploymentComponentStatusColumns[7]},
+				Columns:    []*schema.Column{DeploymentComponentStatusColumns[10]},
 				RefColumns: []*schema.Column{DeploymentStatusColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deploymentcomponentstatus_site_id_host_id_name_deployment_status_components",
+				Unique:  true,
+				Columns: []*schema.Column{DeploymentComponentStatusColumns[2], DeploymentComponentStatusColumns[3], DeploymentComponentStatusColumns[1], DeploymentComponentStatusColumns[10]},
+			},
+		},
 	}
 	// DeploymentProfileColumns holds the columns for the "deployment_profile" table.
 	DeploymentProfileColumns = []*schema.Column{
@@ -104,6 +191,48 @@ var (
 			},
 		},
 	}
+	// DeploymentRevisionsColumns holds the columns for the "deployment_revisions" table.
+	DeploymentRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "app_id", Type: field.TypeString, Nullable: true},
+		{Name: "app_name", Type: field.TypeString, Nullable: true},
+		{Name: "app_version", Type: field.TypeString, Nullable: true},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "site_id", Type: field.TypeString},
+		{Name: "spec", Type: field.TypeString, Size: 2147483647},
+		{Name: "commit_sha", Type: field.TypeString, Nullable: true},
+		{Name: "author", Type: field.TypeString, Nullable: true},
+		{Name: "redeployed_from", Type: field.TypeInt, Nullable: true},
+		{Name: "state", Type: field.TypeString, Default: "pending"},
+		{Name: "outcomes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deployment_id", Type: field.TypeUUID},
+	}
+	// DeploymentRevisionsTable holds the schema information for the "deployment_revisions" table.
+	DeploymentRevisionsTable = &schema.Table{
+		Name:       "deployment_revisions",
+		Columns:    DeploymentRevisionsColumns,
+		PrimaryKey: []*schema.Column{DeploymentRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deployment_revisions_deployment_status_revisions",
+				Columns:    []*schema.Column{DeploymentRevisionsColumns[17]},
+				RefColumns: []*schema.Column{DeploymentStatusColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deploymentrevision_deployment_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{DeploymentRevisionsColumns[17], DeploymentRevisionsColumns[2]},
+			},
+		},
+	}
 	// DeploymentStatusColumns holds the columns for the "deployment_status" table.
 	DeploymentStatusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -113,16 +242,59 @@ var (
 		{Name: "host_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// DeploymentStatusTable holds the schema information for the "deployment_status" table.
 	DeploymentStatusTable = &schema.Table{
 		Name:       "deployment_status",
 		Columns:    DeploymentStatusColumns,
 		PrimaryKey: []*schema.Column{DeploymentStatusColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deployment_status_organizations_deployments",
+				Columns:    []*schema.Column{DeploymentStatusColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString},
+		{Name: "deployment_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_type",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[3]},
+			},
+			{
+				Name:    "event_deployment_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[4]},
+			},
+			{
+				Name:    "event_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[5]},
+			},
+		},
 	}
 	// HostColumns holds the columns for the "host" table.
 	HostColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "host_id", Type: field.TypeString, Unique: true},
 		{Name: "runtime", Type: field.TypeString, Nullable: true},
 		{Name: "last_seen", Type: field.TypeTime, Nullable: true},
@@ -145,7 +317,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "host_site_hosts",
-				Columns:    []*schema.Column{HostColumns[13]},
+				Columns:    []*schema.Column{HostColumns[14]},
 				RefColumns: []*schema.Column{SiteColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -167,6 +339,52 @@ var (
 		Columns:    OrchestratorColumns,
 		PrimaryKey: []*schema.Column{OrchestratorColumns[0]},
 	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "display_name", Type: field.TypeString, Nullable: true},
+		{Name: "max_sites", Type: field.TypeInt, Default: 0},
+		{Name: "max_deployments", Type: field.TypeInt, Default: 0},
+		{Name: "max_apps", Type: field.TypeInt, Default: 0},
+		{Name: "max_users", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// RoleBindingsColumns holds the columns for the "role_bindings" table.
+	RoleBindingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "deployer", "admin"}},
+		{Name: "scope", Type: field.TypeString, Default: "*"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_roles", Type: field.TypeInt},
+	}
+	// RoleBindingsTable holds the schema information for the "role_bindings" table.
+	RoleBindingsTable = &schema.Table{
+		Name:       "role_bindings",
+		Columns:    RoleBindingsColumns,
+		PrimaryKey: []*schema.Column{RoleBindingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_bindings_users_roles",
+				Columns:    []*schema.Column{RoleBindingsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolebinding_role_scope_user_roles",
+				Unique:  true,
+				Columns: []*schema.Column{RoleBindingsColumns[1], RoleBindingsColumns[2], RoleBindingsColumns[4]},
+			},
+		},
+	}
 	// SiteColumns holds the columns for the "site" table.
 	SiteColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -174,10 +392,14 @@ var (
 		{Name: "name", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "group", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "orchestrator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// SiteTable holds the schema information for the "site" table.
 	SiteTable = &schema.Table{
@@ -187,37 +409,128 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "site_orchestrator_sites",
-				Columns:    []*schema.Column{SiteColumns[8]},
+				Columns:    []*schema.Column{SiteColumns[11]},
 				RefColumns: []*schema.Column{OrchestratorColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "site_organizations_sites",
+				Columns:    []*schema.Column{SiteColumns[12]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_organizations_users",
+				Columns:    []*schema.Column{UsersColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[10]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
 		ApplicationDescTable,
+		AuditEntriesTable,
 		ComponentTable,
 		DeploymentComponentStatusTable,
 		DeploymentProfileTable,
+		DeploymentRevisionsTable,
 		DeploymentStatusTable,
+		EventsTable,
 		HostTable,
 		OrchestratorTable,
+		OrganizationsTable,
+		RoleBindingsTable,
 		SiteTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	ApplicationDescTable.ForeignKeys[0].RefTable = OrganizationsTable
 	ApplicationDescTable.Annotation = &entsql.Annotation{
 		Table: "application_desc",
 	}
@@ -230,6 +543,8 @@ func init() {
 	DeploymentProfileTable.Annotation = &entsql.Annotation{
 		Table: "deployment_profile",
 	}
+	DeploymentRevisionsTable.ForeignKeys[0].RefTable = DeploymentStatusTable
+	DeploymentStatusTable.ForeignKeys[0].RefTable = OrganizationsTable
 	HostTable.ForeignKeys[0].RefTable = SiteTable
 	HostTable.Annotation = &entsql.Annotation{
 		Table: "host",
@@ -237,8 +552,12 @@ func init() {
 	OrchestratorTable.Annotation = &entsql.Annotation{
 		Table: "orchestrator",
 	}
+	RoleBindingsTable.ForeignKeys[0].RefTable = UsersTable
 	SiteTable.ForeignKeys[0].RefTable = OrchestratorTable
+	SiteTable.ForeignKeys[1].RefTable = OrganizationsTable
 	SiteTable.Annotation = &entsql.Annotation{
 		Table: "site",
 	}
+	UsersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,16 +12,27 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/auditentry"
 	"github.com/balaji-balu/margo-hello-world/ent/component"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/balaji-balu/margo-hello-world/ent/rolebinding"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
 	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
 	"github.com/google/uuid"
 )
 
@@ -33,56 +45,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAPIToken                  = "APIToken"
 	TypeApplicationDesc           = "ApplicationDesc"
+	TypeAuditEntry                = "AuditEntry"
 	TypeComponent                 = "Component"
 	TypeDeploymentComponentStatus = "DeploymentComponentStatus"
 	TypeDeploymentProfile         = "DeploymentProfile"
+	TypeDeploymentRevision        = "DeploymentRevision"
 	TypeDeploymentStatus          = "DeploymentStatus"
+	TypeEvent                     = "Event"
 	TypeHost                      = "Host"
 	TypeOrchestrator              = "Orchestrator"
+	TypeOrganization              = "Organization"
+	TypeRoleBinding               = "RoleBinding"
 	TypeSite                      = "Site"
 	TypeUser                      = "User"
+	TypeWebhook                   = "Webhook"
+	TypeWebhookDelivery           = "WebhookDelivery"
 )
 
-// ApplicationDescMutation represents an operation that mutates the ApplicationDesc nodes in the graph.
-type ApplicationDescMutation struct {
+// APITokenMutation represents an operation that mutates the APIToken nodes in the graph.
+type APITokenMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	app_id                     *string
-	name                       *string
-	vendor                     *string
-	version                    *string
-	category                   *string
-	description                *string
-	icon                       *string
-	artifacturl                *string
-	site                       *string
-	tag_line                   *string
-	tags                       *[]string
-	appendtags                 []string
-	published                  *string
-	clearedFields              map[string]struct{}
-	deployment_profiles        map[uuid.UUID]struct{}
-	removeddeployment_profiles map[uuid.UUID]struct{}
-	cleareddeployment_profiles bool
-	done                       bool
-	oldValue                   func(context.Context) (*ApplicationDesc, error)
-	predicates                 []predicate.ApplicationDesc
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	token_hash    *string
+	prefix        *string
+	role          *string
+	scope         *string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*APIToken, error)
+	predicates    []predicate.APIToken
 }
 
-var _ ent.Mutation = (*ApplicationDescMutation)(nil)
+var _ ent.Mutation = (*APITokenMutation)(nil)
 
-// applicationdescOption allows management of the mutation configuration using functional options.
-type applicationdescOption func(*ApplicationDescMutation)
+// apitokenOption allows management of the mutation configuration using functional options.
+type apitokenOption func(*APITokenMutation)
 
-// newApplicationDescMutation creates new mutation for the ApplicationDesc entity.
-func newApplicationDescMutation(c config, op Op, opts ...applicationdescOption) *ApplicationDescMutation {
-	m := &ApplicationDescMutation{
+// newAPITokenMutation creates new mutation for the APIToken entity.
+func newAPITokenMutation(c config, op Op, opts ...apitokenOption) *APITokenMutation {
+	m := &APITokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeApplicationDesc,
+		typ:           TypeAPIToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -91,20 +105,20 @@ func newApplicationDescMutation(c config, op Op, opts ...applicationdescOption)
 	return m
 }
 
-// withApplicationDescID sets the ID field of the mutation.
-func withApplicationDescID(id uuid.UUID) applicationdescOption {
-	return func(m *ApplicationDescMutation) {
+// withAPITokenID sets the ID field of the mutation.
+func withAPITokenID(id uuid.UUID) apitokenOption {
+	return func(m *APITokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ApplicationDesc
+			value *APIToken
 		)
-		m.oldValue = func(ctx context.Context) (*ApplicationDesc, error) {
+		m.oldValue = func(ctx context.Context) (*APIToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ApplicationDesc.Get(ctx, id)
+					value, err = m.Client().APIToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -113,10 +127,10 @@ func withApplicationDescID(id uuid.UUID) applicationdescOption {
 	}
 }
 
-// withApplicationDesc sets the old ApplicationDesc of the mutation.
-func withApplicationDesc(node *ApplicationDesc) applicationdescOption {
-	return func(m *ApplicationDescMutation) {
-		m.oldValue = func(context.Context) (*ApplicationDesc, error) {
+// withAPIToken sets the old APIToken of the mutation.
+func withAPIToken(node *APIToken) apitokenOption {
+	return func(m *APITokenMutation) {
+		m.oldValue = func(context.Context) (*APIToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -125,7 +139,7 @@ func withApplicationDesc(node *ApplicationDesc) applicationdescOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ApplicationDescMutation) Client() *Client {
+func (m APITokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -133,7 +147,7 @@ func (m ApplicationDescMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ApplicationDescMutation) Tx() (*Tx, error) {
+func (m APITokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -143,14 +157,14 @@ func (m ApplicationDescMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ApplicationDesc entities.
-func (m *ApplicationDescMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIToken entities.
+func (m *APITokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ApplicationDescMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APITokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -161,7 +175,7 @@ func (m *ApplicationDescMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ApplicationDescMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APITokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -170,1450 +184,10076 @@ func (m *ApplicationDescMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ApplicationDesc.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAppID sets the "app_id" field.
-func (m *ApplicationDescMutation) SetAppID(s string) {
-	m.app_id = &s
+// SetName sets the "name" field.
+func (m *APITokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AppID returns the value of the "app_id" field in the mutation.
-func (m *ApplicationDescMutation) AppID() (r string, exists bool) {
-	v := m.app_id
+// Name returns the value of the "name" field in the mutation.
+func (m *APITokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppID returns the old "app_id" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldAppID(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AppID, nil
-}
-
-// ClearAppID clears the value of the "app_id" field.
-func (m *ApplicationDescMutation) ClearAppID() {
-	m.app_id = nil
-	m.clearedFields[applicationdesc.FieldAppID] = struct{}{}
-}
-
-// AppIDCleared returns if the "app_id" field was cleared in this mutation.
-func (m *ApplicationDescMutation) AppIDCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldAppID]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetAppID resets all changes to the "app_id" field.
-func (m *ApplicationDescMutation) ResetAppID() {
-	m.app_id = nil
-	delete(m.clearedFields, applicationdesc.FieldAppID)
+// ResetName resets all changes to the "name" field.
+func (m *APITokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetName sets the "name" field.
-func (m *ApplicationDescMutation) SetName(s string) {
-	m.name = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *APITokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ApplicationDescMutation) Name() (r string, exists bool) {
-	v := m.name
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *APITokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ClearName clears the value of the "name" field.
-func (m *ApplicationDescMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[applicationdesc.FieldName] = struct{}{}
-}
-
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *ApplicationDescMutation) NameCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldName]
-	return ok
+	return oldValue.TokenHash, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ApplicationDescMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, applicationdesc.FieldName)
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *APITokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetVendor sets the "vendor" field.
-func (m *ApplicationDescMutation) SetVendor(s string) {
-	m.vendor = &s
+// SetPrefix sets the "prefix" field.
+func (m *APITokenMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Vendor returns the value of the "vendor" field in the mutation.
-func (m *ApplicationDescMutation) Vendor() (r string, exists bool) {
-	v := m.vendor
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APITokenMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVendor returns the old "vendor" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldVendor(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVendor is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVendor requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVendor: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Vendor, nil
-}
-
-// ClearVendor clears the value of the "vendor" field.
-func (m *ApplicationDescMutation) ClearVendor() {
-	m.vendor = nil
-	m.clearedFields[applicationdesc.FieldVendor] = struct{}{}
-}
-
-// VendorCleared returns if the "vendor" field was cleared in this mutation.
-func (m *ApplicationDescMutation) VendorCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldVendor]
-	return ok
+	return oldValue.Prefix, nil
 }
 
-// ResetVendor resets all changes to the "vendor" field.
-func (m *ApplicationDescMutation) ResetVendor() {
-	m.vendor = nil
-	delete(m.clearedFields, applicationdesc.FieldVendor)
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APITokenMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *ApplicationDescMutation) SetVersion(s string) {
-	m.version = &s
+// SetRole sets the "role" field.
+func (m *APITokenMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ApplicationDescMutation) Version() (r string, exists bool) {
-	v := m.version
+// Role returns the value of the "role" field in the mutation.
+func (m *APITokenMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.Role, nil
 }
 
-// ClearVersion clears the value of the "version" field.
-func (m *ApplicationDescMutation) ClearVersion() {
-	m.version = nil
-	m.clearedFields[applicationdesc.FieldVersion] = struct{}{}
+// ClearRole clears the value of the "role" field.
+func (m *APITokenMutation) ClearRole() {
+	m.role = nil
+	m.clearedFields[apitoken.FieldRole] = struct{}{}
 }
 
-// VersionCleared returns if the "version" field was cleared in this mutation.
-func (m *ApplicationDescMutation) VersionCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldVersion]
+// RoleCleared returns if the "role" field was cleared in this mutation.
+func (m *APITokenMutation) RoleCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldRole]
 	return ok
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ApplicationDescMutation) ResetVersion() {
-	m.version = nil
-	delete(m.clearedFields, applicationdesc.FieldVersion)
+// ResetRole resets all changes to the "role" field.
+func (m *APITokenMutation) ResetRole() {
+	m.role = nil
+	delete(m.clearedFields, apitoken.FieldRole)
 }
 
-// SetCategory sets the "category" field.
-func (m *ApplicationDescMutation) SetCategory(s string) {
-	m.category = &s
+// SetScope sets the "scope" field.
+func (m *APITokenMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *ApplicationDescMutation) Category() (r string, exists bool) {
-	v := m.category
+// Scope returns the value of the "scope" field in the mutation.
+func (m *APITokenMutation) Scope() (r string, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.Category, nil
-}
-
-// ClearCategory clears the value of the "category" field.
-func (m *ApplicationDescMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[applicationdesc.FieldCategory] = struct{}{}
-}
-
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *ApplicationDescMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldCategory]
-	return ok
+	return oldValue.Scope, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *ApplicationDescMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, applicationdesc.FieldCategory)
+// ResetScope resets all changes to the "scope" field.
+func (m *APITokenMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ApplicationDescMutation) SetDescription(s string) {
-	m.description = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *APITokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ApplicationDescMutation) Description() (r string, exists bool) {
-	v := m.description
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APITokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ApplicationDescMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[applicationdesc.FieldDescription] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APITokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apitoken.FieldExpiresAt] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ApplicationDescMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldDescription]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APITokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldExpiresAt]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ApplicationDescMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, applicationdesc.FieldDescription)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APITokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apitoken.FieldExpiresAt)
 }
 
-// SetIcon sets the "icon" field.
-func (m *ApplicationDescMutation) SetIcon(s string) {
-	m.icon = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APITokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *ApplicationDescMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APITokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldIcon(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Icon, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearIcon clears the value of the "icon" field.
-func (m *ApplicationDescMutation) ClearIcon() {
-	m.icon = nil
-	m.clearedFields[applicationdesc.FieldIcon] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APITokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apitoken.FieldLastUsedAt] = struct{}{}
 }
 
-// IconCleared returns if the "icon" field was cleared in this mutation.
-func (m *ApplicationDescMutation) IconCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldIcon]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APITokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldLastUsedAt]
 	return ok
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *ApplicationDescMutation) ResetIcon() {
-	m.icon = nil
-	delete(m.clearedFields, applicationdesc.FieldIcon)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APITokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apitoken.FieldLastUsedAt)
 }
 
-// SetArtifacturl sets the "artifacturl" field.
-func (m *ApplicationDescMutation) SetArtifacturl(s string) {
-	m.artifacturl = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *APITokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Artifacturl returns the value of the "artifacturl" field in the mutation.
-func (m *ApplicationDescMutation) Artifacturl() (r string, exists bool) {
-	v := m.artifacturl
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APITokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArtifacturl returns the old "artifacturl" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldArtifacturl(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArtifacturl is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArtifacturl requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArtifacturl: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Artifacturl, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearArtifacturl clears the value of the "artifacturl" field.
-func (m *ApplicationDescMutation) ClearArtifacturl() {
-	m.artifacturl = nil
-	m.clearedFields[applicationdesc.FieldArtifacturl] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APITokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ArtifacturlCleared returns if the "artifacturl" field was cleared in this mutation.
-func (m *ApplicationDescMutation) ArtifacturlCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldArtifacturl]
-	return ok
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *APITokenMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetArtifacturl resets all changes to the "artifacturl" field.
-func (m *ApplicationDescMutation) ResetArtifacturl() {
-	m.artifacturl = nil
-	delete(m.clearedFields, applicationdesc.FieldArtifacturl)
+// ClearUser clears the "user" edge to the User entity.
+func (m *APITokenMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetSite sets the "site" field.
-func (m *ApplicationDescMutation) SetSite(s string) {
-	m.site = &s
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *APITokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Site returns the value of the "site" field in the mutation.
-func (m *ApplicationDescMutation) Site() (r string, exists bool) {
-	v := m.site
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *APITokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldSite returns the old "site" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldSite(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSite is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSite requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSite: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *APITokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Site, nil
+	return
 }
 
-// ClearSite clears the value of the "site" field.
-func (m *ApplicationDescMutation) ClearSite() {
-	m.site = nil
-	m.clearedFields[applicationdesc.FieldSite] = struct{}{}
+// ResetUser resets all changes to the "user" edge.
+func (m *APITokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SiteCleared returns if the "site" field was cleared in this mutation.
-func (m *ApplicationDescMutation) SiteCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldSite]
-	return ok
+// Where appends a list predicates to the APITokenMutation builder.
+func (m *APITokenMutation) Where(ps ...predicate.APIToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetSite resets all changes to the "site" field.
-func (m *ApplicationDescMutation) ResetSite() {
-	m.site = nil
-	delete(m.clearedFields, applicationdesc.FieldSite)
+// WhereP appends storage-level predicates to the APITokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APITokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetTagLine sets the "tag_line" field.
-func (m *ApplicationDescMutation) SetTagLine(s string) {
-	m.tag_line = &s
+// Op returns the operation name.
+func (m *APITokenMutation) Op() Op {
+	return m.op
 }
 
-// TagLine returns the value of the "tag_line" field in the mutation.
-func (m *ApplicationDescMutation) TagLine() (r string, exists bool) {
-	v := m.tag_line
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *APITokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldTagLine returns the old "tag_line" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldTagLine(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTagLine is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (APIToken).
+func (m *APITokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APITokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, apitoken.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTagLine requires an ID field in the mutation")
+	if m.token_hash != nil {
+		fields = append(fields, apitoken.FieldTokenHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTagLine: %w", err)
+	if m.prefix != nil {
+		fields = append(fields, apitoken.FieldPrefix)
 	}
-	return oldValue.TagLine, nil
+	if m.role != nil {
+		fields = append(fields, apitoken.FieldRole)
+	}
+	if m.scope != nil {
+		fields = append(fields, apitoken.FieldScope)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, apitoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apitoken.FieldLastUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apitoken.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearTagLine clears the value of the "tag_line" field.
-func (m *ApplicationDescMutation) ClearTagLine() {
-	m.tag_line = nil
-	m.clearedFields[applicationdesc.FieldTagLine] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APITokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apitoken.FieldName:
+		return m.Name()
+	case apitoken.FieldTokenHash:
+		return m.TokenHash()
+	case apitoken.FieldPrefix:
+		return m.Prefix()
+	case apitoken.FieldRole:
+		return m.Role()
+	case apitoken.FieldScope:
+		return m.Scope()
+	case apitoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apitoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apitoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// TagLineCleared returns if the "tag_line" field was cleared in this mutation.
-func (m *ApplicationDescMutation) TagLineCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldTagLine]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APITokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apitoken.FieldName:
+		return m.OldName(ctx)
+	case apitoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case apitoken.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apitoken.FieldRole:
+		return m.OldRole(ctx)
+	case apitoken.FieldScope:
+		return m.OldScope(ctx)
+	case apitoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apitoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apitoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIToken field %s", name)
 }
 
-// ResetTagLine resets all changes to the "tag_line" field.
-func (m *ApplicationDescMutation) ResetTagLine() {
-	m.tag_line = nil
-	delete(m.clearedFields, applicationdesc.FieldTagLine)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APITokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apitoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apitoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case apitoken.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apitoken.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case apitoken.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case apitoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apitoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apitoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIToken field %s", name)
 }
 
-// SetTags sets the "tags" field.
-func (m *ApplicationDescMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APITokenMutation) AddedFields() []string {
+	return nil
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *ApplicationDescMutation) Tags() (r []string, exists bool) {
-	v := m.tags
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APITokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APITokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIToken numeric field %s", name)
 }
 
-// OldTags returns the old "tags" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldTags(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APITokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apitoken.FieldRole) {
+		fields = append(fields, apitoken.FieldRole)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+	if m.FieldCleared(apitoken.FieldExpiresAt) {
+		fields = append(fields, apitoken.FieldExpiresAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	if m.FieldCleared(apitoken.FieldLastUsedAt) {
+		fields = append(fields, apitoken.FieldLastUsedAt)
 	}
-	return oldValue.Tags, nil
+	return fields
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *ApplicationDescMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APITokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *ApplicationDescMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APITokenMutation) ClearField(name string) error {
+	switch name {
+	case apitoken.FieldRole:
+		m.ClearRole()
+		return nil
+	case apitoken.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apitoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
 	}
-	return m.appendtags, true
+	return fmt.Errorf("unknown APIToken nullable field %s", name)
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *ApplicationDescMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[applicationdesc.FieldTags] = struct{}{}
-}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APITokenMutation) ResetField(name string) error {
+	switch name {
+	case apitoken.FieldName:
+		m.ResetName()
+		return nil
+	case apitoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case apitoken.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apitoken.FieldRole:
+		m.ResetRole()
+		return nil
+	case apitoken.FieldScope:
+		m.ResetScope()
+		return nil
+	case apitoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apitoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apitoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIToken field %s", name)
+}
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *ApplicationDescMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldTags]
-	return ok
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APITokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, apitoken.EdgeUser)
+	}
+	return edges
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *ApplicationDescMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, applicationdesc.FieldTags)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APITokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apitoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SetPublished sets the "published" field.
-func (m *ApplicationDescMutation) SetPublished(s string) {
-	m.published = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APITokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// Published returns the value of the "published" field in the mutation.
-func (m *ApplicationDescMutation) Published() (r string, exists bool) {
-	v := m.published
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APITokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldPublished returns the old "published" field's value of the ApplicationDesc entity.
-// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationDescMutation) OldPublished(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublished requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APITokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, apitoken.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APITokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apitoken.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.Published, nil
+	return false
 }
 
-// ClearPublished clears the value of the "published" field.
-func (m *ApplicationDescMutation) ClearPublished() {
-	m.published = nil
-	m.clearedFields[applicationdesc.FieldPublished] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APITokenMutation) ClearEdge(name string) error {
+	switch name {
+	case apitoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown APIToken unique edge %s", name)
 }
 
-// PublishedCleared returns if the "published" field was cleared in this mutation.
-func (m *ApplicationDescMutation) PublishedCleared() bool {
-	_, ok := m.clearedFields[applicationdesc.FieldPublished]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APITokenMutation) ResetEdge(name string) error {
+	switch name {
+	case apitoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown APIToken edge %s", name)
 }
 
-// ResetPublished resets all changes to the "published" field.
-func (m *ApplicationDescMutation) ResetPublished() {
-	m.published = nil
-	delete(m.clearedFields, applicationdesc.FieldPublished)
+// ApplicationDescMutation represents an operation that mutates the ApplicationDesc nodes in the graph.
+type ApplicationDescMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	app_id                     *string
+	name                       *string
+	vendor                     *string
+	version                    *string
+	category                   *string
+	description                *string
+	icon                       *string
+	artifacturl                *string
+	site                       *string
+	tag_line                   *string
+	tags                       *[]string
+	appendtags                 []string
+	published                  *string
+	icon_data                  *[]byte
+	icon_media_type            *string
+	license                    *string
+	release_notes              *string
+	clearedFields              map[string]struct{}
+	deployment_profiles        map[uuid.UUID]struct{}
+	removeddeployment_profiles map[uuid.UUID]struct{}
+	cleareddeployment_profiles bool
+	organization               *uuid.UUID
+	clearedorganization        bool
+	done                       bool
+	oldValue                   func(context.Context) (*ApplicationDesc, error)
+	predicates                 []predicate.ApplicationDesc
 }
 
-// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
-func (m *ApplicationDescMutation) AddDeploymentProfileIDs(ids ...uuid.UUID) {
-	if m.deployment_profiles == nil {
-		m.deployment_profiles = make(map[uuid.UUID]struct{})
+var _ ent.Mutation = (*ApplicationDescMutation)(nil)
+
+// applicationdescOption allows management of the mutation configuration using functional options.
+type applicationdescOption func(*ApplicationDescMutation)
+
+// newApplicationDescMutation creates new mutation for the ApplicationDesc entity.
+func newApplicationDescMutation(c config, op Op, opts ...applicationdescOption) *ApplicationDescMutation {
+	m := &ApplicationDescMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeApplicationDesc,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.deployment_profiles[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *ApplicationDescMutation) ClearDeploymentProfiles() {
-	m.cleareddeployment_profiles = true
-}
-
-// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
-func (m *ApplicationDescMutation) DeploymentProfilesCleared() bool {
-	return m.cleareddeployment_profiles
+// withApplicationDescID sets the ID field of the mutation.
+func withApplicationDescID(id uuid.UUID) applicationdescOption {
+	return func(m *ApplicationDescMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ApplicationDesc
+		)
+		m.oldValue = func(ctx context.Context) (*ApplicationDesc, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ApplicationDesc.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
-func (m *ApplicationDescMutation) RemoveDeploymentProfileIDs(ids ...uuid.UUID) {
-	if m.removeddeployment_profiles == nil {
-		m.removeddeployment_profiles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.deployment_profiles, ids[i])
-		m.removeddeployment_profiles[ids[i]] = struct{}{}
+// withApplicationDesc sets the old ApplicationDesc of the mutation.
+func withApplicationDesc(node *ApplicationDesc) applicationdescOption {
+	return func(m *ApplicationDescMutation) {
+		m.oldValue = func(context.Context) (*ApplicationDesc, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *ApplicationDescMutation) RemovedDeploymentProfilesIDs() (ids []uuid.UUID) {
-	for id := range m.removeddeployment_profiles {
-		ids = append(ids, id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ApplicationDescMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
-func (m *ApplicationDescMutation) DeploymentProfilesIDs() (ids []uuid.UUID) {
-	for id := range m.deployment_profiles {
-		ids = append(ids, id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ApplicationDescMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
-func (m *ApplicationDescMutation) ResetDeploymentProfiles() {
-	m.deployment_profiles = nil
-	m.cleareddeployment_profiles = false
-	m.removeddeployment_profiles = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ApplicationDesc entities.
+func (m *ApplicationDescMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// Where appends a list predicates to the ApplicationDescMutation builder.
-func (m *ApplicationDescMutation) Where(ps ...predicate.ApplicationDesc) {
-	m.predicates = append(m.predicates, ps...)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ApplicationDescMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// WhereP appends storage-level predicates to the ApplicationDescMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ApplicationDescMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ApplicationDesc, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ApplicationDescMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ApplicationDesc.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *ApplicationDescMutation) Op() Op {
-	return m.op
+// SetOrgID sets the "org_id" field.
+func (m *ApplicationDescMutation) SetOrgID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ApplicationDescMutation) SetOp(op Op) {
-	m.op = op
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *ApplicationDescMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (ApplicationDesc).
-func (m *ApplicationDescMutation) Type() string {
-	return m.typ
+// OldOrgID returns the old "org_id" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ApplicationDescMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.app_id != nil {
-		fields = append(fields, applicationdesc.FieldAppID)
+// ClearOrgID clears the value of the "org_id" field.
+func (m *ApplicationDescMutation) ClearOrgID() {
+	m.organization = nil
+	m.clearedFields[applicationdesc.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *ApplicationDescMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *ApplicationDescMutation) ResetOrgID() {
+	m.organization = nil
+	delete(m.clearedFields, applicationdesc.FieldOrgID)
+}
+
+// SetAppID sets the "app_id" field.
+func (m *ApplicationDescMutation) SetAppID(s string) {
+	m.app_id = &s
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *ApplicationDescMutation) AppID() (r string, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
 	}
-	if m.name != nil {
-		fields = append(fields, applicationdesc.FieldName)
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldAppID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
 	}
-	if m.vendor != nil {
-		fields = append(fields, applicationdesc.FieldVendor)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
 	}
-	if m.version != nil {
-		fields = append(fields, applicationdesc.FieldVersion)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
 	}
-	if m.category != nil {
-		fields = append(fields, applicationdesc.FieldCategory)
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *ApplicationDescMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[applicationdesc.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *ApplicationDescMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *ApplicationDescMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, applicationdesc.FieldAppID)
+}
+
+// SetName sets the "name" field.
+func (m *ApplicationDescMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ApplicationDescMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m.description != nil {
-		fields = append(fields, applicationdesc.FieldDescription)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.icon != nil {
-		fields = append(fields, applicationdesc.FieldIcon)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.artifacturl != nil {
-		fields = append(fields, applicationdesc.FieldArtifacturl)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	if m.site != nil {
-		fields = append(fields, applicationdesc.FieldSite)
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *ApplicationDescMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[applicationdesc.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *ApplicationDescMutation) NameCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ApplicationDescMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, applicationdesc.FieldName)
+}
+
+// SetVendor sets the "vendor" field.
+func (m *ApplicationDescMutation) SetVendor(s string) {
+	m.vendor = &s
+}
+
+// Vendor returns the value of the "vendor" field in the mutation.
+func (m *ApplicationDescMutation) Vendor() (r string, exists bool) {
+	v := m.vendor
+	if v == nil {
+		return
 	}
-	if m.tag_line != nil {
-		fields = append(fields, applicationdesc.FieldTagLine)
+	return *v, true
+}
+
+// OldVendor returns the old "vendor" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldVendor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVendor is only allowed on UpdateOne operations")
 	}
-	if m.tags != nil {
-		fields = append(fields, applicationdesc.FieldTags)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVendor requires an ID field in the mutation")
 	}
-	if m.published != nil {
-		fields = append(fields, applicationdesc.FieldPublished)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVendor: %w", err)
 	}
-	return fields
+	return oldValue.Vendor, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ApplicationDescMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case applicationdesc.FieldAppID:
-		return m.AppID()
-	case applicationdesc.FieldName:
-		return m.Name()
-	case applicationdesc.FieldVendor:
-		return m.Vendor()
-	case applicationdesc.FieldVersion:
-		return m.Version()
-	case applicationdesc.FieldCategory:
-		return m.Category()
-	case applicationdesc.FieldDescription:
-		return m.Description()
-	case applicationdesc.FieldIcon:
-		return m.Icon()
-	case applicationdesc.FieldArtifacturl:
-		return m.Artifacturl()
-	case applicationdesc.FieldSite:
-		return m.Site()
-	case applicationdesc.FieldTagLine:
-		return m.TagLine()
-	case applicationdesc.FieldTags:
-		return m.Tags()
-	case applicationdesc.FieldPublished:
-		return m.Published()
-	}
-	return nil, false
+// ClearVendor clears the value of the "vendor" field.
+func (m *ApplicationDescMutation) ClearVendor() {
+	m.vendor = nil
+	m.clearedFields[applicationdesc.FieldVendor] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ApplicationDescMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case applicationdesc.FieldAppID:
-		return m.OldAppID(ctx)
-	case applicationdesc.FieldName:
-		return m.OldName(ctx)
-	case applicationdesc.FieldVendor:
-		return m.OldVendor(ctx)
-	case applicationdesc.FieldVersion:
-		return m.OldVersion(ctx)
-	case applicationdesc.FieldCategory:
-		return m.OldCategory(ctx)
-	case applicationdesc.FieldDescription:
-		return m.OldDescription(ctx)
-	case applicationdesc.FieldIcon:
-		return m.OldIcon(ctx)
-	case applicationdesc.FieldArtifacturl:
-		return m.OldArtifacturl(ctx)
-	case applicationdesc.FieldSite:
-		return m.OldSite(ctx)
-	case applicationdesc.FieldTagLine:
-		return m.OldTagLine(ctx)
-	case applicationdesc.FieldTags:
-		return m.OldTags(ctx)
-	case applicationdesc.FieldPublished:
-		return m.OldPublished(ctx)
-	}
-	return nil, fmt.Errorf("unknown ApplicationDesc field %s", name)
+// VendorCleared returns if the "vendor" field was cleared in this mutation.
+func (m *ApplicationDescMutation) VendorCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldVendor]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ApplicationDescMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case applicationdesc.FieldAppID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAppID(v)
-		return nil
-	case applicationdesc.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// ResetVendor resets all changes to the "vendor" field.
+func (m *ApplicationDescMutation) ResetVendor() {
+	m.vendor = nil
+	delete(m.clearedFields, applicationdesc.FieldVendor)
+}
+
+// SetVersion sets the "version" field.
+func (m *ApplicationDescMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ApplicationDescMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *ApplicationDescMutation) ClearVersion() {
+	m.version = nil
+	m.clearedFields[applicationdesc.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *ApplicationDescMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ApplicationDescMutation) ResetVersion() {
+	m.version = nil
+	delete(m.clearedFields, applicationdesc.FieldVersion)
+}
+
+// SetCategory sets the "category" field.
+func (m *ApplicationDescMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *ApplicationDescMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *ApplicationDescMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[applicationdesc.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *ApplicationDescMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *ApplicationDescMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, applicationdesc.FieldCategory)
+}
+
+// SetDescription sets the "description" field.
+func (m *ApplicationDescMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ApplicationDescMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ApplicationDescMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[applicationdesc.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ApplicationDescMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ApplicationDescMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, applicationdesc.FieldDescription)
+}
+
+// SetIcon sets the "icon" field.
+func (m *ApplicationDescMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *ApplicationDescMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (m *ApplicationDescMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[applicationdesc.FieldIcon] = struct{}{}
+}
+
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *ApplicationDescMutation) IconCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *ApplicationDescMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, applicationdesc.FieldIcon)
+}
+
+// SetArtifacturl sets the "artifacturl" field.
+func (m *ApplicationDescMutation) SetArtifacturl(s string) {
+	m.artifacturl = &s
+}
+
+// Artifacturl returns the value of the "artifacturl" field in the mutation.
+func (m *ApplicationDescMutation) Artifacturl() (r string, exists bool) {
+	v := m.artifacturl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifacturl returns the old "artifacturl" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldArtifacturl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifacturl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifacturl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifacturl: %w", err)
+	}
+	return oldValue.Artifacturl, nil
+}
+
+// ClearArtifacturl clears the value of the "artifacturl" field.
+func (m *ApplicationDescMutation) ClearArtifacturl() {
+	m.artifacturl = nil
+	m.clearedFields[applicationdesc.FieldArtifacturl] = struct{}{}
+}
+
+// ArtifacturlCleared returns if the "artifacturl" field was cleared in this mutation.
+func (m *ApplicationDescMutation) ArtifacturlCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldArtifacturl]
+	return ok
+}
+
+// ResetArtifacturl resets all changes to the "artifacturl" field.
+func (m *ApplicationDescMutation) ResetArtifacturl() {
+	m.artifacturl = nil
+	delete(m.clearedFields, applicationdesc.FieldArtifacturl)
+}
+
+// SetSite sets the "site" field.
+func (m *ApplicationDescMutation) SetSite(s string) {
+	m.site = &s
+}
+
+// Site returns the value of the "site" field in the mutation.
+func (m *ApplicationDescMutation) Site() (r string, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSite returns the old "site" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldSite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSite: %w", err)
+	}
+	return oldValue.Site, nil
+}
+
+// ClearSite clears the value of the "site" field.
+func (m *ApplicationDescMutation) ClearSite() {
+	m.site = nil
+	m.clearedFields[applicationdesc.FieldSite] = struct{}{}
+}
+
+// SiteCleared returns if the "site" field was cleared in this mutation.
+func (m *ApplicationDescMutation) SiteCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldSite]
+	return ok
+}
+
+// ResetSite resets all changes to the "site" field.
+func (m *ApplicationDescMutation) ResetSite() {
+	m.site = nil
+	delete(m.clearedFields, applicationdesc.FieldSite)
+}
+
+// SetTagLine sets the "tag_line" field.
+func (m *ApplicationDescMutation) SetTagLine(s string) {
+	m.tag_line = &s
+}
+
+// TagLine returns the value of the "tag_line" field in the mutation.
+func (m *ApplicationDescMutation) TagLine() (r string, exists bool) {
+	v := m.tag_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagLine returns the old "tag_line" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldTagLine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagLine: %w", err)
+	}
+	return oldValue.TagLine, nil
+}
+
+// ClearTagLine clears the value of the "tag_line" field.
+func (m *ApplicationDescMutation) ClearTagLine() {
+	m.tag_line = nil
+	m.clearedFields[applicationdesc.FieldTagLine] = struct{}{}
+}
+
+// TagLineCleared returns if the "tag_line" field was cleared in this mutation.
+func (m *ApplicationDescMutation) TagLineCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldTagLine]
+	return ok
+}
+
+// ResetTagLine resets all changes to the "tag_line" field.
+func (m *ApplicationDescMutation) ResetTagLine() {
+	m.tag_line = nil
+	delete(m.clearedFields, applicationdesc.FieldTagLine)
+}
+
+// SetTags sets the "tags" field.
+func (m *ApplicationDescMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *ApplicationDescMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *ApplicationDescMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *ApplicationDescMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *ApplicationDescMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[applicationdesc.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *ApplicationDescMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *ApplicationDescMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, applicationdesc.FieldTags)
+}
+
+// SetPublished sets the "published" field.
+func (m *ApplicationDescMutation) SetPublished(s string) {
+	m.published = &s
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *ApplicationDescMutation) Published() (r string, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldPublished(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ClearPublished clears the value of the "published" field.
+func (m *ApplicationDescMutation) ClearPublished() {
+	m.published = nil
+	m.clearedFields[applicationdesc.FieldPublished] = struct{}{}
+}
+
+// PublishedCleared returns if the "published" field was cleared in this mutation.
+func (m *ApplicationDescMutation) PublishedCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldPublished]
+	return ok
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *ApplicationDescMutation) ResetPublished() {
+	m.published = nil
+	delete(m.clearedFields, applicationdesc.FieldPublished)
+}
+
+// SetIconData sets the "icon_data" field.
+func (m *ApplicationDescMutation) SetIconData(b []byte) {
+	m.icon_data = &b
+}
+
+// IconData returns the value of the "icon_data" field in the mutation.
+func (m *ApplicationDescMutation) IconData() (r []byte, exists bool) {
+	v := m.icon_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconData returns the old "icon_data" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldIconData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconData: %w", err)
+	}
+	return oldValue.IconData, nil
+}
+
+// ClearIconData clears the value of the "icon_data" field.
+func (m *ApplicationDescMutation) ClearIconData() {
+	m.icon_data = nil
+	m.clearedFields[applicationdesc.FieldIconData] = struct{}{}
+}
+
+// IconDataCleared returns if the "icon_data" field was cleared in this mutation.
+func (m *ApplicationDescMutation) IconDataCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldIconData]
+	return ok
+}
+
+// ResetIconData resets all changes to the "icon_data" field.
+func (m *ApplicationDescMutation) ResetIconData() {
+	m.icon_data = nil
+	delete(m.clearedFields, applicationdesc.FieldIconData)
+}
+
+// SetIconMediaType sets the "icon_media_type" field.
+func (m *ApplicationDescMutation) SetIconMediaType(s string) {
+	m.icon_media_type = &s
+}
+
+// IconMediaType returns the value of the "icon_media_type" field in the mutation.
+func (m *ApplicationDescMutation) IconMediaType() (r string, exists bool) {
+	v := m.icon_media_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconMediaType returns the old "icon_media_type" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldIconMediaType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconMediaType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconMediaType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconMediaType: %w", err)
+	}
+	return oldValue.IconMediaType, nil
+}
+
+// ClearIconMediaType clears the value of the "icon_media_type" field.
+func (m *ApplicationDescMutation) ClearIconMediaType() {
+	m.icon_media_type = nil
+	m.clearedFields[applicationdesc.FieldIconMediaType] = struct{}{}
+}
+
+// IconMediaTypeCleared returns if the "icon_media_type" field was cleared in this mutation.
+func (m *ApplicationDescMutation) IconMediaTypeCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldIconMediaType]
+	return ok
+}
+
+// ResetIconMediaType resets all changes to the "icon_media_type" field.
+func (m *ApplicationDescMutation) ResetIconMediaType() {
+	m.icon_media_type = nil
+	delete(m.clearedFields, applicationdesc.FieldIconMediaType)
+}
+
+// SetLicense sets the "license" field.
+func (m *ApplicationDescMutation) SetLicense(s string) {
+	m.license = &s
+}
+
+// License returns the value of the "license" field in the mutation.
+func (m *ApplicationDescMutation) License() (r string, exists bool) {
+	v := m.license
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicense returns the old "license" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldLicense(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicense is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicense requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicense: %w", err)
+	}
+	return oldValue.License, nil
+}
+
+// ClearLicense clears the value of the "license" field.
+func (m *ApplicationDescMutation) ClearLicense() {
+	m.license = nil
+	m.clearedFields[applicationdesc.FieldLicense] = struct{}{}
+}
+
+// LicenseCleared returns if the "license" field was cleared in this mutation.
+func (m *ApplicationDescMutation) LicenseCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldLicense]
+	return ok
+}
+
+// ResetLicense resets all changes to the "license" field.
+func (m *ApplicationDescMutation) ResetLicense() {
+	m.license = nil
+	delete(m.clearedFields, applicationdesc.FieldLicense)
+}
+
+// SetReleaseNotes sets the "release_notes" field.
+func (m *ApplicationDescMutation) SetReleaseNotes(s string) {
+	m.release_notes = &s
+}
+
+// ReleaseNotes returns the value of the "release_notes" field in the mutation.
+func (m *ApplicationDescMutation) ReleaseNotes() (r string, exists bool) {
+	v := m.release_notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleaseNotes returns the old "release_notes" field's value of the ApplicationDesc entity.
+// If the ApplicationDesc object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationDescMutation) OldReleaseNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseNotes: %w", err)
+	}
+	return oldValue.ReleaseNotes, nil
+}
+
+// ClearReleaseNotes clears the value of the "release_notes" field.
+func (m *ApplicationDescMutation) ClearReleaseNotes() {
+	m.release_notes = nil
+	m.clearedFields[applicationdesc.FieldReleaseNotes] = struct{}{}
+}
+
+// ReleaseNotesCleared returns if the "release_notes" field was cleared in this mutation.
+func (m *ApplicationDescMutation) ReleaseNotesCleared() bool {
+	_, ok := m.clearedFields[applicationdesc.FieldReleaseNotes]
+	return ok
+}
+
+// ResetReleaseNotes resets all changes to the "release_notes" field.
+func (m *ApplicationDescMutation) ResetReleaseNotes() {
+	m.release_notes = nil
+	delete(m.clearedFields, applicationdesc.FieldReleaseNotes)
+}
+
+// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
+func (m *ApplicationDescMutation) AddDeploymentProfileIDs(ids ...uuid.UUID) {
+	if m.deployment_profiles == nil {
+		m.deployment_profiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.deployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *ApplicationDescMutation) ClearDeploymentProfiles() {
+	m.cleareddeployment_profiles = true
+}
+
+// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
+func (m *ApplicationDescMutation) DeploymentProfilesCleared() bool {
+	return m.cleareddeployment_profiles
+}
+
+// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
+func (m *ApplicationDescMutation) RemoveDeploymentProfileIDs(ids ...uuid.UUID) {
+	if m.removeddeployment_profiles == nil {
+		m.removeddeployment_profiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.deployment_profiles, ids[i])
+		m.removeddeployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *ApplicationDescMutation) RemovedDeploymentProfilesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
+func (m *ApplicationDescMutation) DeploymentProfilesIDs() (ids []uuid.UUID) {
+	for id := range m.deployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
+func (m *ApplicationDescMutation) ResetDeploymentProfiles() {
+	m.deployment_profiles = nil
+	m.cleareddeployment_profiles = false
+	m.removeddeployment_profiles = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *ApplicationDescMutation) SetOrganizationID(id uuid.UUID) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *ApplicationDescMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[applicationdesc.FieldOrgID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *ApplicationDescMutation) OrganizationCleared() bool {
+	return m.OrgIDCleared() || m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *ApplicationDescMutation) OrganizationID() (id uuid.UUID, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *ApplicationDescMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *ApplicationDescMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the ApplicationDescMutation builder.
+func (m *ApplicationDescMutation) Where(ps ...predicate.ApplicationDesc) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ApplicationDescMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ApplicationDescMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApplicationDesc, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ApplicationDescMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ApplicationDescMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ApplicationDesc).
+func (m *ApplicationDescMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ApplicationDescMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.organization != nil {
+		fields = append(fields, applicationdesc.FieldOrgID)
+	}
+	if m.app_id != nil {
+		fields = append(fields, applicationdesc.FieldAppID)
+	}
+	if m.name != nil {
+		fields = append(fields, applicationdesc.FieldName)
+	}
+	if m.vendor != nil {
+		fields = append(fields, applicationdesc.FieldVendor)
+	}
+	if m.version != nil {
+		fields = append(fields, applicationdesc.FieldVersion)
+	}
+	if m.category != nil {
+		fields = append(fields, applicationdesc.FieldCategory)
+	}
+	if m.description != nil {
+		fields = append(fields, applicationdesc.FieldDescription)
+	}
+	if m.icon != nil {
+		fields = append(fields, applicationdesc.FieldIcon)
+	}
+	if m.artifacturl != nil {
+		fields = append(fields, applicationdesc.FieldArtifacturl)
+	}
+	if m.site != nil {
+		fields = append(fields, applicationdesc.FieldSite)
+	}
+	if m.tag_line != nil {
+		fields = append(fields, applicationdesc.FieldTagLine)
+	}
+	if m.tags != nil {
+		fields = append(fields, applicationdesc.FieldTags)
+	}
+	if m.published != nil {
+		fields = append(fields, applicationdesc.FieldPublished)
+	}
+	if m.icon_data != nil {
+		fields = append(fields, applicationdesc.FieldIconData)
+	}
+	if m.icon_media_type != nil {
+		fields = append(fields, applicationdesc.FieldIconMediaType)
+	}
+	if m.license != nil {
+		fields = append(fields, applicationdesc.FieldLicense)
+	}
+	if m.release_notes != nil {
+		fields = append(fields, applicationdesc.FieldReleaseNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ApplicationDescMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case applicationdesc.FieldOrgID:
+		return m.OrgID()
+	case applicationdesc.FieldAppID:
+		return m.AppID()
+	case applicationdesc.FieldName:
+		return m.Name()
+	case applicationdesc.FieldVendor:
+		return m.Vendor()
+	case applicationdesc.FieldVersion:
+		return m.Version()
+	case applicationdesc.FieldCategory:
+		return m.Category()
+	case applicationdesc.FieldDescription:
+		return m.Description()
+	case applicationdesc.FieldIcon:
+		return m.Icon()
+	case applicationdesc.FieldArtifacturl:
+		return m.Artifacturl()
+	case applicationdesc.FieldSite:
+		return m.Site()
+	case applicationdesc.FieldTagLine:
+		return m.TagLine()
+	case applicationdesc.FieldTags:
+		return m.Tags()
+	case applicationdesc.FieldPublished:
+		return m.Published()
+	case applicationdesc.FieldIconData:
+		return m.IconData()
+	case applicationdesc.FieldIconMediaType:
+		return m.IconMediaType()
+	case applicationdesc.FieldLicense:
+		return m.License()
+	case applicationdesc.FieldReleaseNotes:
+		return m.ReleaseNotes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ApplicationDescMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case applicationdesc.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case applicationdesc.FieldAppID:
+		return m.OldAppID(ctx)
+	case applicationdesc.FieldName:
+		return m.OldName(ctx)
+	case applicationdesc.FieldVendor:
+		return m.OldVendor(ctx)
+	case applicationdesc.FieldVersion:
+		return m.OldVersion(ctx)
+	case applicationdesc.FieldCategory:
+		return m.OldCategory(ctx)
+	case applicationdesc.FieldDescription:
+		return m.OldDescription(ctx)
+	case applicationdesc.FieldIcon:
+		return m.OldIcon(ctx)
+	case applicationdesc.FieldArtifacturl:
+		return m.OldArtifacturl(ctx)
+	case applicationdesc.FieldSite:
+		return m.OldSite(ctx)
+	case applicationdesc.FieldTagLine:
+		return m.OldTagLine(ctx)
+	case applicationdesc.FieldTags:
+		return m.OldTags(ctx)
+	case applicationdesc.FieldPublished:
+		return m.OldPublished(ctx)
+	case applicationdesc.FieldIconData:
+		return m.OldIconData(ctx)
+	case applicationdesc.FieldIconMediaType:
+		return m.OldIconMediaType(ctx)
+	case applicationdesc.FieldLicense:
+		return m.OldLicense(ctx)
+	case applicationdesc.FieldReleaseNotes:
+		return m.OldReleaseNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown ApplicationDesc field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ApplicationDescMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case applicationdesc.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case applicationdesc.FieldAppID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case applicationdesc.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case applicationdesc.FieldVendor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVendor(v)
+		return nil
+	case applicationdesc.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case applicationdesc.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case applicationdesc.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case applicationdesc.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case applicationdesc.FieldArtifacturl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifacturl(v)
+		return nil
+	case applicationdesc.FieldSite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSite(v)
+		return nil
+	case applicationdesc.FieldTagLine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagLine(v)
+		return nil
+	case applicationdesc.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case applicationdesc.FieldPublished:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	case applicationdesc.FieldIconData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconData(v)
+		return nil
+	case applicationdesc.FieldIconMediaType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconMediaType(v)
+		return nil
+	case applicationdesc.FieldLicense:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicense(v)
+		return nil
+	case applicationdesc.FieldReleaseNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ApplicationDesc field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ApplicationDescMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ApplicationDescMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ApplicationDescMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ApplicationDesc numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ApplicationDescMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(applicationdesc.FieldOrgID) {
+		fields = append(fields, applicationdesc.FieldOrgID)
+	}
+	if m.FieldCleared(applicationdesc.FieldAppID) {
+		fields = append(fields, applicationdesc.FieldAppID)
+	}
+	if m.FieldCleared(applicationdesc.FieldName) {
+		fields = append(fields, applicationdesc.FieldName)
+	}
+	if m.FieldCleared(applicationdesc.FieldVendor) {
+		fields = append(fields, applicationdesc.FieldVendor)
+	}
+	if m.FieldCleared(applicationdesc.FieldVersion) {
+		fields = append(fields, applicationdesc.FieldVersion)
+	}
+	if m.FieldCleared(applicationdesc.FieldCategory) {
+		fields = append(fields, applicationdesc.FieldCategory)
+	}
+	if m.FieldCleared(applicationdesc.FieldDescription) {
+		fields = append(fields, applicationdesc.FieldDescription)
+	}
+	if m.FieldCleared(applicationdesc.FieldIcon) {
+		fields = append(fields, applicationdesc.FieldIcon)
+	}
+	if m.FieldCleared(applicationdesc.FieldArtifacturl) {
+		fields = append(fields, applicationdesc.FieldArtifacturl)
+	}
+	if m.FieldCleared(applicationdesc.FieldSite) {
+		fields = append(fields, applicationdesc.FieldSite)
+	}
+	if m.FieldCleared(applicationdesc.FieldTagLine) {
+		fields = append(fields, applicationdesc.FieldTagLine)
+	}
+	if m.FieldCleared(applicationdesc.FieldTags) {
+		fields = append(fields, applicationdesc.FieldTags)
+	}
+	if m.FieldCleared(applicationdesc.FieldPublished) {
+		fields = append(fields, applicationdesc.FieldPublished)
+	}
+	if m.FieldCleared(applicationdesc.FieldIconData) {
+		fields = append(fields, applicationdesc.FieldIconData)
+	}
+	if m.FieldCleared(applicationdesc.FieldIconMediaType) {
+		fields = append(fields, applicationdesc.FieldIconMediaType)
+	}
+	if m.FieldCleared(applicationdesc.FieldLicense) {
+		fields = append(fields, applicationdesc.FieldLicense)
+	}
+	if m.FieldCleared(applicationdesc.FieldReleaseNotes) {
+		fields = append(fields, applicationdesc.FieldReleaseNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ApplicationDescMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ApplicationDescMutation) ClearField(name string) error {
+	switch name {
+	case applicationdesc.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case applicationdesc.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case applicationdesc.FieldName:
+		m.ClearName()
+		return nil
+	case applicationdesc.FieldVendor:
+		m.ClearVendor()
+		return nil
+	case applicationdesc.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case applicationdesc.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case applicationdesc.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case applicationdesc.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case applicationdesc.FieldArtifacturl:
+		m.ClearArtifacturl()
+		return nil
+	case applicationdesc.FieldSite:
+		m.ClearSite()
+		return nil
+	case applicationdesc.FieldTagLine:
+		m.ClearTagLine()
+		return nil
+	case applicationdesc.FieldTags:
+		m.ClearTags()
+		return nil
+	case applicationdesc.FieldPublished:
+		m.ClearPublished()
+		return nil
+	case applicationdesc.FieldIconData:
+		m.ClearIconData()
+		return nil
+	case applicationdesc.FieldIconMediaType:
+		m.ClearIconMediaType()
+		return nil
+	case applicationdesc.FieldLicense:
+		m.ClearLicense()
+		return nil
+	case applicationdesc.FieldReleaseNotes:
+		m.ClearReleaseNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplicationDesc nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ApplicationDescMutation) ResetField(name string) error {
+	switch name {
+	case applicationdesc.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case applicationdesc.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case applicationdesc.FieldName:
+		m.ResetName()
+		return nil
+	case applicationdesc.FieldVendor:
+		m.ResetVendor()
+		return nil
+	case applicationdesc.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case applicationdesc.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case applicationdesc.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case applicationdesc.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case applicationdesc.FieldArtifacturl:
+		m.ResetArtifacturl()
+		return nil
+	case applicationdesc.FieldSite:
+		m.ResetSite()
+		return nil
+	case applicationdesc.FieldTagLine:
+		m.ResetTagLine()
+		return nil
+	case applicationdesc.FieldTags:
+		m.ResetTags()
+		return nil
+	case applicationdesc.FieldPublished:
+		m.ResetPublished()
+		return nil
+	case applicationdesc.FieldIconData:
+		m.ResetIconData()
+		return nil
+	case applicationdesc.FieldIconMediaType:
+		m.ResetIconMediaType()
+		return nil
+	case applicationdesc.FieldLicense:
+		m.ResetLicense()
+		return nil
+	case applicationdesc.FieldReleaseNotes:
+		m.ResetReleaseNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplicationDesc field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ApplicationDescMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.deployment_profiles != nil {
+		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
+	}
+	if m.organization != nil {
+		edges = append(edges, applicationdesc.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ApplicationDescMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case applicationdesc.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.deployment_profiles))
+		for id := range m.deployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case applicationdesc.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ApplicationDescMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeployment_profiles != nil {
+		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ApplicationDescMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case applicationdesc.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
+		for id := range m.removeddeployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ApplicationDescMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddeployment_profiles {
+		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
+	}
+	if m.clearedorganization {
+		edges = append(edges, applicationdesc.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ApplicationDescMutation) EdgeCleared(name string) bool {
+	switch name {
+	case applicationdesc.EdgeDeploymentProfiles:
+		return m.cleareddeployment_profiles
+	case applicationdesc.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ApplicationDescMutation) ClearEdge(name string) error {
+	switch name {
+	case applicationdesc.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplicationDesc unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ApplicationDescMutation) ResetEdge(name string) error {
+	switch name {
+	case applicationdesc.EdgeDeploymentProfiles:
+		m.ResetDeploymentProfiles()
+		return nil
+	case applicationdesc.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplicationDesc edge %s", name)
+}
+
+// AuditEntryMutation represents an operation that mutates the AuditEntry nodes in the graph.
+type AuditEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	org_id        *uuid.UUID
+	created_at    *time.Time
+	actor         *string
+	action        *string
+	target        *string
+	payload_hash  *string
+	result        *string
+	status        *int
+	addstatus     *int
+	error         *string
+	source_ip     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditEntry, error)
+	predicates    []predicate.AuditEntry
+}
+
+var _ ent.Mutation = (*AuditEntryMutation)(nil)
+
+// auditentryOption allows management of the mutation configuration using functional options.
+type auditentryOption func(*AuditEntryMutation)
+
+// newAuditEntryMutation creates new mutation for the AuditEntry entity.
+func newAuditEntryMutation(c config, op Op, opts ...auditentryOption) *AuditEntryMutation {
+	m := &AuditEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEntryID sets the ID field of the mutation.
+func withAuditEntryID(id int) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEntry
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEntry sets the old AuditEntry of the mutation.
+func withAuditEntry(node *AuditEntry) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		m.oldValue = func(context.Context) (*AuditEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *AuditEntryMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *AuditEntryMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (m *AuditEntryMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[auditentry.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *AuditEntryMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *AuditEntryMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, auditentry.FieldOrgID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *AuditEntryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEntryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEntryMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AuditEntryMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEntryMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEntryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *AuditEntryMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *AuditEntryMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ClearTarget clears the value of the "target" field.
+func (m *AuditEntryMutation) ClearTarget() {
+	m.target = nil
+	m.clearedFields[auditentry.FieldTarget] = struct{}{}
+}
+
+// TargetCleared returns if the "target" field was cleared in this mutation.
+func (m *AuditEntryMutation) TargetCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldTarget]
+	return ok
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *AuditEntryMutation) ResetTarget() {
+	m.target = nil
+	delete(m.clearedFields, auditentry.FieldTarget)
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (m *AuditEntryMutation) SetPayloadHash(s string) {
+	m.payload_hash = &s
+}
+
+// PayloadHash returns the value of the "payload_hash" field in the mutation.
+func (m *AuditEntryMutation) PayloadHash() (r string, exists bool) {
+	v := m.payload_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayloadHash returns the old "payload_hash" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldPayloadHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayloadHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayloadHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayloadHash: %w", err)
+	}
+	return oldValue.PayloadHash, nil
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (m *AuditEntryMutation) ClearPayloadHash() {
+	m.payload_hash = nil
+	m.clearedFields[auditentry.FieldPayloadHash] = struct{}{}
+}
+
+// PayloadHashCleared returns if the "payload_hash" field was cleared in this mutation.
+func (m *AuditEntryMutation) PayloadHashCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldPayloadHash]
+	return ok
+}
+
+// ResetPayloadHash resets all changes to the "payload_hash" field.
+func (m *AuditEntryMutation) ResetPayloadHash() {
+	m.payload_hash = nil
+	delete(m.clearedFields, auditentry.FieldPayloadHash)
+}
+
+// SetResult sets the "result" field.
+func (m *AuditEntryMutation) SetResult(s string) {
+	m.result = &s
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *AuditEntryMutation) Result() (r string, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *AuditEntryMutation) ResetResult() {
+	m.result = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *AuditEntryMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AuditEntryMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *AuditEntryMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *AuditEntryMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AuditEntryMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetError sets the "error" field.
+func (m *AuditEntryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditEntryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditEntryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditentry.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditEntryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *AuditEntryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditentry.FieldError)
+}
+
+// SetSourceIP sets the "source_ip" field.
+func (m *AuditEntryMutation) SetSourceIP(s string) {
+	m.source_ip = &s
+}
+
+// SourceIP returns the value of the "source_ip" field in the mutation.
+func (m *AuditEntryMutation) SourceIP() (r string, exists bool) {
+	v := m.source_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceIP returns the old "source_ip" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldSourceIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceIP: %w", err)
+	}
+	return oldValue.SourceIP, nil
+}
+
+// ClearSourceIP clears the value of the "source_ip" field.
+func (m *AuditEntryMutation) ClearSourceIP() {
+	m.source_ip = nil
+	m.clearedFields[auditentry.FieldSourceIP] = struct{}{}
+}
+
+// SourceIPCleared returns if the "source_ip" field was cleared in this mutation.
+func (m *AuditEntryMutation) SourceIPCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldSourceIP]
+	return ok
+}
+
+// ResetSourceIP resets all changes to the "source_ip" field.
+func (m *AuditEntryMutation) ResetSourceIP() {
+	m.source_ip = nil
+	delete(m.clearedFields, auditentry.FieldSourceIP)
+}
+
+// Where appends a list predicates to the AuditEntryMutation builder.
+func (m *AuditEntryMutation) Where(ps ...predicate.AuditEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditEntry).
+func (m *AuditEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.org_id != nil {
+		fields = append(fields, auditentry.FieldOrgID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditentry.FieldCreatedAt)
+	}
+	if m.actor != nil {
+		fields = append(fields, auditentry.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, auditentry.FieldAction)
+	}
+	if m.target != nil {
+		fields = append(fields, auditentry.FieldTarget)
+	}
+	if m.payload_hash != nil {
+		fields = append(fields, auditentry.FieldPayloadHash)
+	}
+	if m.result != nil {
+		fields = append(fields, auditentry.FieldResult)
+	}
+	if m.status != nil {
+		fields = append(fields, auditentry.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, auditentry.FieldError)
+	}
+	if m.source_ip != nil {
+		fields = append(fields, auditentry.FieldSourceIP)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditentry.FieldOrgID:
+		return m.OrgID()
+	case auditentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditentry.FieldActor:
+		return m.Actor()
+	case auditentry.FieldAction:
+		return m.Action()
+	case auditentry.FieldTarget:
+		return m.Target()
+	case auditentry.FieldPayloadHash:
+		return m.PayloadHash()
+	case auditentry.FieldResult:
+		return m.Result()
+	case auditentry.FieldStatus:
+		return m.Status()
+	case auditentry.FieldError:
+		return m.Error()
+	case auditentry.FieldSourceIP:
+		return m.SourceIP()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditentry.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case auditentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditentry.FieldActor:
+		return m.OldActor(ctx)
+	case auditentry.FieldAction:
+		return m.OldAction(ctx)
+	case auditentry.FieldTarget:
+		return m.OldTarget(ctx)
+	case auditentry.FieldPayloadHash:
+		return m.OldPayloadHash(ctx)
+	case auditentry.FieldResult:
+		return m.OldResult(ctx)
+	case auditentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case auditentry.FieldError:
+		return m.OldError(ctx)
+	case auditentry.FieldSourceIP:
+		return m.OldSourceIP(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditentry.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case auditentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditentry.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditentry.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditentry.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case auditentry.FieldPayloadHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayloadHash(v)
+		return nil
+	case auditentry.FieldResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case auditentry.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case auditentry.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditentry.FieldSourceIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceIP(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, auditentry.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditentry.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditentry.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditentry.FieldOrgID) {
+		fields = append(fields, auditentry.FieldOrgID)
+	}
+	if m.FieldCleared(auditentry.FieldTarget) {
+		fields = append(fields, auditentry.FieldTarget)
+	}
+	if m.FieldCleared(auditentry.FieldPayloadHash) {
+		fields = append(fields, auditentry.FieldPayloadHash)
+	}
+	if m.FieldCleared(auditentry.FieldError) {
+		fields = append(fields, auditentry.FieldError)
+	}
+	if m.FieldCleared(auditentry.FieldSourceIP) {
+		fields = append(fields, auditentry.FieldSourceIP)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEntryMutation) ClearField(name string) error {
+	switch name {
+	case auditentry.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case auditentry.FieldTarget:
+		m.ClearTarget()
+		return nil
+	case auditentry.FieldPayloadHash:
+		m.ClearPayloadHash()
+		return nil
+	case auditentry.FieldError:
+		m.ClearError()
+		return nil
+	case auditentry.FieldSourceIP:
+		m.ClearSourceIP()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEntryMutation) ResetField(name string) error {
+	switch name {
+	case auditentry.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case auditentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditentry.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditentry.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditentry.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case auditentry.FieldPayloadHash:
+		m.ResetPayloadHash()
+		return nil
+	case auditentry.FieldResult:
+		m.ResetResult()
+		return nil
+	case auditentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case auditentry.FieldError:
+		m.ResetError()
+		return nil
+	case auditentry.FieldSourceIP:
+		m.ResetSourceIP()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry edge %s", name)
+}
+
+// ComponentMutation represents an operation that mutates the Component nodes in the graph.
+type ComponentMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	name                      *string
+	properties                *application.ComponentProperties
+	clearedFields             map[string]struct{}
+	deployment_profile        *uuid.UUID
+	cleareddeployment_profile bool
+	done                      bool
+	oldValue                  func(context.Context) (*Component, error)
+	predicates                []predicate.Component
+}
+
+var _ ent.Mutation = (*ComponentMutation)(nil)
+
+// componentOption allows management of the mutation configuration using functional options.
+type componentOption func(*ComponentMutation)
+
+// newComponentMutation creates new mutation for the Component entity.
+func newComponentMutation(c config, op Op, opts ...componentOption) *ComponentMutation {
+	m := &ComponentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComponent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withComponentID sets the ID field of the mutation.
+func withComponentID(id uuid.UUID) componentOption {
+	return func(m *ComponentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Component
+		)
+		m.oldValue = func(ctx context.Context) (*Component, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Component.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComponent sets the old Component of the mutation.
+func withComponent(node *Component) componentOption {
+	return func(m *ComponentMutation) {
+		m.oldValue = func(context.Context) (*Component, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ComponentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ComponentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Component entities.
+func (m *ComponentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ComponentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ComponentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Component.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeploymentProfileID sets the "deployment_profile_id" field.
+func (m *ComponentMutation) SetDeploymentProfileID(u uuid.UUID) {
+	m.deployment_profile = &u
+}
+
+// DeploymentProfileID returns the value of the "deployment_profile_id" field in the mutation.
+func (m *ComponentMutation) DeploymentProfileID() (r uuid.UUID, exists bool) {
+	v := m.deployment_profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeploymentProfileID returns the old "deployment_profile_id" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ComponentMutation) OldDeploymentProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentProfileID: %w", err)
+	}
+	return oldValue.DeploymentProfileID, nil
+}
+
+// ClearDeploymentProfileID clears the value of the "deployment_profile_id" field.
+func (m *ComponentMutation) ClearDeploymentProfileID() {
+	m.deployment_profile = nil
+	m.clearedFields[component.FieldDeploymentProfileID] = struct{}{}
+}
+
+// DeploymentProfileIDCleared returns if the "deployment_profile_id" field was cleared in this mutation.
+func (m *ComponentMutation) DeploymentProfileIDCleared() bool {
+	_, ok := m.clearedFields[component.FieldDeploymentProfileID]
+	return ok
+}
+
+// ResetDeploymentProfileID resets all changes to the "deployment_profile_id" field.
+func (m *ComponentMutation) ResetDeploymentProfileID() {
+	m.deployment_profile = nil
+	delete(m.clearedFields, component.FieldDeploymentProfileID)
+}
+
+// SetName sets the "name" field.
+func (m *ComponentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ComponentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ComponentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *ComponentMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[component.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *ComponentMutation) NameCleared() bool {
+	_, ok := m.clearedFields[component.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ComponentMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, component.FieldName)
+}
+
+// SetProperties sets the "properties" field.
+func (m *ComponentMutation) SetProperties(ap application.ComponentProperties) {
+	m.properties = &ap
+}
+
+// Properties returns the value of the "properties" field in the mutation.
+func (m *ComponentMutation) Properties() (r application.ComponentProperties, exists bool) {
+	v := m.properties
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProperties returns the old "properties" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ComponentMutation) OldProperties(ctx context.Context) (v application.ComponentProperties, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProperties requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
+	}
+	return oldValue.Properties, nil
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (m *ComponentMutation) ClearProperties() {
+	m.properties = nil
+	m.clearedFields[component.FieldProperties] = struct{}{}
+}
+
+// PropertiesCleared returns if the "properties" field was cleared in this mutation.
+func (m *ComponentMutation) PropertiesCleared() bool {
+	_, ok := m.clearedFields[component.FieldProperties]
+	return ok
+}
+
+// ResetProperties resets all changes to the "properties" field.
+func (m *ComponentMutation) ResetProperties() {
+	m.properties = nil
+	delete(m.clearedFields, component.FieldProperties)
+}
+
+// ClearDeploymentProfile clears the "deployment_profile" edge to the DeploymentProfile entity.
+func (m *ComponentMutation) ClearDeploymentProfile() {
+	m.cleareddeployment_profile = true
+	m.clearedFields[component.FieldDeploymentProfileID] = struct{}{}
+}
+
+// DeploymentProfileCleared reports if the "deployment_profile" edge to the DeploymentProfile entity was cleared.
+func (m *ComponentMutation) DeploymentProfileCleared() bool {
+	return m.DeploymentProfileIDCleared() || m.cleareddeployment_profile
+}
+
+// DeploymentProfileIDs returns the "deployment_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentProfileID instead. It exists only for internal usage by the builders.
+func (m *ComponentMutation) DeploymentProfileIDs() (ids []uuid.UUID) {
+	if id := m.deployment_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentProfile resets all changes to the "deployment_profile" edge.
+func (m *ComponentMutation) ResetDeploymentProfile() {
+	m.deployment_profile = nil
+	m.cleareddeployment_profile = false
+}
+
+// Where appends a list predicates to the ComponentMutation builder.
+func (m *ComponentMutation) Where(ps ...predicate.Component) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ComponentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ComponentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Component, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ComponentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ComponentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Component).
+func (m *ComponentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ComponentMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.deployment_profile != nil {
+		fields = append(fields, component.FieldDeploymentProfileID)
+	}
+	if m.name != nil {
+		fields = append(fields, component.FieldName)
+	}
+	if m.properties != nil {
+		fields = append(fields, component.FieldProperties)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ComponentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case component.FieldDeploymentProfileID:
+		return m.DeploymentProfileID()
+	case component.FieldName:
+		return m.Name()
+	case component.FieldProperties:
+		return m.Properties()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ComponentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case component.FieldDeploymentProfileID:
+		return m.OldDeploymentProfileID(ctx)
+	case component.FieldName:
+		return m.OldName(ctx)
+	case component.FieldProperties:
+		return m.OldProperties(ctx)
+	}
+	return nil, fmt.Errorf("unknown Component field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ComponentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case component.FieldDeploymentProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentProfileID(v)
+		return nil
+	case component.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case component.FieldProperties:
+		v, ok := value.(application.ComponentProperties)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProperties(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Component field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ComponentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ComponentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ComponentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Component numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ComponentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(component.FieldDeploymentProfileID) {
+		fields = append(fields, component.FieldDeploymentProfileID)
+	}
+	if m.FieldCleared(component.FieldName) {
+		fields = append(fields, component.FieldName)
+	}
+	if m.FieldCleared(component.FieldProperties) {
+		fields = append(fields, component.FieldProperties)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ComponentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ComponentMutation) ClearField(name string) error {
+	switch name {
+	case component.FieldDeploymentProfileID:
+		m.ClearDeploymentProfileID()
+		return nil
+	case component.FieldName:
+		m.ClearName()
+		return nil
+	case component.FieldProperties:
+		m.ClearProperties()
+		return nil
+	}
+	return fmt.Errorf("unknown Component nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ComponentMutation) ResetField(name string) error {
+	switch name {
+	case component.FieldDeploymentProfileID:
+		m.ResetDeploymentProfileID()
+		return nil
+	case component.FieldName:
+		m.ResetName()
+		return nil
+	case component.FieldProperties:
+		m.ResetProperties()
+		return nil
+	}
+	return fmt.Errorf("unknown Component field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ComponentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deployment_profile != nil {
+		edges = append(edges, component.EdgeDeploymentProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ComponentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case component.EdgeDeploymentProfile:
+		if id := m.deployment_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ComponentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ComponentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ComponentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeployment_profile {
+		edges = append(edges, component.EdgeDeploymentProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ComponentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case component.EdgeDeploymentProfile:
+		return m.cleareddeployment_profile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ComponentMutation) ClearEdge(name string) error {
+	switch name {
+	case component.EdgeDeploymentProfile:
+		m.ClearDeploymentProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Component unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ComponentMutation) ResetEdge(name string) error {
+	switch name {
+	case component.EdgeDeploymentProfile:
+		m.ResetDeploymentProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Component edge %s", name)
+}
+
+// DeploymentComponentStatusMutation represents an operation that mutates the DeploymentComponentStatus nodes in the graph.
+type DeploymentComponentStatusMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	name              *string
+	site_id           *string
+	host_id           *string
+	state             *string
+	error_code        *string
+	error_message     *string
+	reported_at       *int64
+	addreported_at    *int64
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	deployment        *uuid.UUID
+	cleareddeployment bool
+	done              bool
+	oldValue          func(context.Context) (*DeploymentComponentStatus, error)
+	predicates        []predicate.DeploymentComponentStatus
+}
+
+var _ ent.Mutation = (*DeploymentComponentStatusMutation)(nil)
+
+// deploymentcomponentstatusOption allows management of the mutation configuration using functional options.
+type deploymentcomponentstatusOption func(*DeploymentComponentStatusMutation)
+
+// newDeploymentComponentStatusMutation creates new mutation for the DeploymentComponentStatus entity.
+func newDeploymentComponentStatusMutation(c config, op Op, opts ...deploymentcomponentstatusOption) *DeploymentComponentStatusMutation {
+	m := &DeploymentComponentStatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentComponentStatus,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentComponentStatusID sets the ID field of the mutation.
+func withDeploymentComponentStatusID(id uuid.UUID) deploymentcomponentstatusOption {
+	return func(m *DeploymentComponentStatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentComponentStatus
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentComponentStatus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentComponentStatus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentComponentStatus sets the old DeploymentComponentStatus of the mutation.
+func withDeploymentComponentStatus(node *DeploymentComponentStatus) deploymentcomponentstatusOption {
+	return func(m *DeploymentComponentStatusMutation) {
+		m.oldValue = func(context.Context) (*DeploymentComponentStatus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentComponentStatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentComponentStatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentComponentStatus entities.
+func (m *DeploymentComponentStatusMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentComponentStatusMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentComponentStatusMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentComponentStatus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DeploymentComponentStatusMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeploymentComponentStatusMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DeploymentComponentStatusMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSiteID sets the "site_id" field.
+func (m *DeploymentComponentStatusMutation) SetSiteID(s string) {
+	m.site_id = &s
+}
+
+// SiteID returns the value of the "site_id" field in the mutation.
+func (m *DeploymentComponentStatusMutation) SiteID() (r string, exists bool) {
+	v := m.site_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteID returns the old "site_id" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldSiteID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+	}
+	return oldValue.SiteID, nil
+}
+
+// ResetSiteID resets all changes to the "site_id" field.
+func (m *DeploymentComponentStatusMutation) ResetSiteID() {
+	m.site_id = nil
+}
+
+// SetHostID sets the "host_id" field.
+func (m *DeploymentComponentStatusMutation) SetHostID(s string) {
+	m.host_id = &s
+}
+
+// HostID returns the value of the "host_id" field in the mutation.
+func (m *DeploymentComponentStatusMutation) HostID() (r string, exists bool) {
+	v := m.host_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostID returns the old "host_id" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldHostID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
+	}
+	return oldValue.HostID, nil
+}
+
+// ResetHostID resets all changes to the "host_id" field.
+func (m *DeploymentComponentStatusMutation) ResetHostID() {
+	m.host_id = nil
+}
+
+// SetState sets the "state" field.
+func (m *DeploymentComponentStatusMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *DeploymentComponentStatusMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *DeploymentComponentStatusMutation) ResetState() {
+	m.state = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *DeploymentComponentStatusMutation) SetErrorCode(s string) {
+	m.error_code = &s
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *DeploymentComponentStatusMutation) ErrorCode() (r string, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *DeploymentComponentStatusMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.clearedFields[deploymentcomponentstatus.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *DeploymentComponentStatusMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[deploymentcomponentstatus.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *DeploymentComponentStatusMutation) ResetErrorCode() {
+	m.error_code = nil
+	delete(m.clearedFields, deploymentcomponentstatus.FieldErrorCode)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *DeploymentComponentStatusMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *DeploymentComponentStatusMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *DeploymentComponentStatusMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[deploymentcomponentstatus.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *DeploymentComponentStatusMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[deploymentcomponentstatus.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *DeploymentComponentStatusMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, deploymentcomponentstatus.FieldErrorMessage)
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (m *DeploymentComponentStatusMutation) SetReportedAt(i int64) {
+	m.reported_at = &i
+	m.addreported_at = nil
+}
+
+// ReportedAt returns the value of the "reported_at" field in the mutation.
+func (m *DeploymentComponentStatusMutation) ReportedAt() (r int64, exists bool) {
+	v := m.reported_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportedAt returns the old "reported_at" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldReportedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportedAt: %w", err)
+	}
+	return oldValue.ReportedAt, nil
+}
+
+// AddReportedAt adds i to the "reported_at" field.
+func (m *DeploymentComponentStatusMutation) AddReportedAt(i int64) {
+	if m.addreported_at != nil {
+		*m.addreported_at += i
+	} else {
+		m.addreported_at = &i
+	}
+}
+
+// AddedReportedAt returns the value that was added to the "reported_at" field in this mutation.
+func (m *DeploymentComponentStatusMutation) AddedReportedAt() (r int64, exists bool) {
+	v := m.addreported_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReportedAt resets all changes to the "reported_at" field.
+func (m *DeploymentComponentStatusMutation) ResetReportedAt() {
+	m.reported_at = nil
+	m.addreported_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeploymentComponentStatusMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeploymentComponentStatusMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeploymentComponentStatusMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeploymentComponentStatusMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeploymentComponentStatusMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeploymentComponentStatus entity.
+// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentComponentStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeploymentComponentStatusMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeploymentID sets the "deployment" edge to the DeploymentStatus entity by id.
+func (m *DeploymentComponentStatusMutation) SetDeploymentID(id uuid.UUID) {
+	m.deployment = &id
+}
+
+// ClearDeployment clears the "deployment" edge to the DeploymentStatus entity.
+func (m *DeploymentComponentStatusMutation) ClearDeployment() {
+	m.cleareddeployment = true
+}
+
+// DeploymentCleared reports if the "deployment" edge to the DeploymentStatus entity was cleared.
+func (m *DeploymentComponentStatusMutation) DeploymentCleared() bool {
+	return m.cleareddeployment
+}
+
+// DeploymentID returns the "deployment" edge ID in the mutation.
+func (m *DeploymentComponentStatusMutation) DeploymentID() (id uuid.UUID, exists bool) {
+	if m.deployment != nil {
+		return *m.deployment, true
+	}
+	return
+}
+
+// DeploymentIDs returns the "deployment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentID instead. It exists only for internal usage by the builders.
+func (m *DeploymentComponentStatusMutation) DeploymentIDs() (ids []uuid.UUID) {
+	if id := m.deployment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeployment resets all changes to the "deployment" edge.
+func (m *DeploymentComponentStatusMutation) ResetDeployment() {
+	m.deployment = nil
+	m.cleareddeployment = false
+}
+
+// Where appends a list predicates to the DeploymentComponentStatusMutation builder.
+func (m *DeploymentComponentStatusMutation) Where(ps ...predicate.DeploymentComponentStatus) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentComponentStatusMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentComponentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentComponentStatus, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentComponentStatusMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentComponentStatusMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentComponentStatus).
+func (m *DeploymentComponentStatusMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentComponentStatusMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldName)
+	}
+	if m.site_id != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldSiteID)
+	}
+	if m.host_id != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldHostID)
+	}
+	if m.state != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldState)
+	}
+	if m.error_code != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldErrorCode)
+	}
+	if m.error_message != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldErrorMessage)
+	}
+	if m.reported_at != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldReportedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentComponentStatusMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentcomponentstatus.FieldName:
+		return m.Name()
+	case deploymentcomponentstatus.FieldSiteID:
+		return m.SiteID()
+	case deploymentcomponentstatus.FieldHostID:
+		return m.HostID()
+	case deploymentcomponentstatus.FieldState:
+		return m.State()
+	case deploymentcomponentstatus.FieldErrorCode:
+		return m.ErrorCode()
+	case deploymentcomponentstatus.FieldErrorMessage:
+		return m.ErrorMessage()
+	case deploymentcomponentstatus.FieldReportedAt:
+		return m.ReportedAt()
+	case deploymentcomponentstatus.FieldCreatedAt:
+		return m.CreatedAt()
+	case deploymentcomponentstatus.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentComponentStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentcomponentstatus.FieldName:
+		return m.OldName(ctx)
+	case deploymentcomponentstatus.FieldSiteID:
+		return m.OldSiteID(ctx)
+	case deploymentcomponentstatus.FieldHostID:
+		return m.OldHostID(ctx)
+	case deploymentcomponentstatus.FieldState:
+		return m.OldState(ctx)
+	case deploymentcomponentstatus.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case deploymentcomponentstatus.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case deploymentcomponentstatus.FieldReportedAt:
+		return m.OldReportedAt(ctx)
+	case deploymentcomponentstatus.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deploymentcomponentstatus.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentComponentStatusMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentcomponentstatus.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case deploymentcomponentstatus.FieldSiteID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteID(v)
+		return nil
+	case deploymentcomponentstatus.FieldHostID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostID(v)
+		return nil
+	case deploymentcomponentstatus.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case deploymentcomponentstatus.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case deploymentcomponentstatus.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case deploymentcomponentstatus.FieldReportedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportedAt(v)
+		return nil
+	case deploymentcomponentstatus.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deploymentcomponentstatus.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentComponentStatusMutation) AddedFields() []string {
+	var fields []string
+	if m.addreported_at != nil {
+		fields = append(fields, deploymentcomponentstatus.FieldReportedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentComponentStatusMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentcomponentstatus.FieldReportedAt:
+		return m.AddedReportedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentComponentStatusMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymentcomponentstatus.FieldReportedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReportedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentComponentStatusMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentcomponentstatus.FieldErrorCode) {
+		fields = append(fields, deploymentcomponentstatus.FieldErrorCode)
+	}
+	if m.FieldCleared(deploymentcomponentstatus.FieldErrorMessage) {
+		fields = append(fields, deploymentcomponentstatus.FieldErrorMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentComponentStatusMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentComponentStatusMutation) ClearField(name string) error {
+	switch name {
+	case deploymentcomponentstatus.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case deploymentcomponentstatus.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentComponentStatusMutation) ResetField(name string) error {
+	switch name {
+	case deploymentcomponentstatus.FieldName:
+		m.ResetName()
+		return nil
+	case deploymentcomponentstatus.FieldSiteID:
+		m.ResetSiteID()
+		return nil
+	case deploymentcomponentstatus.FieldHostID:
+		m.ResetHostID()
+		return nil
+	case deploymentcomponentstatus.FieldState:
+		m.ResetState()
+		return nil
+	case deploymentcomponentstatus.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case deploymentcomponentstatus.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case deploymentcomponentstatus.FieldReportedAt:
+		m.ResetReportedAt()
+		return nil
+	case deploymentcomponentstatus.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deploymentcomponentstatus.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentComponentStatusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deployment != nil {
+		edges = append(edges, deploymentcomponentstatus.EdgeDeployment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentComponentStatusMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentcomponentstatus.EdgeDeployment:
+		if id := m.deployment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentComponentStatusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentComponentStatusMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentComponentStatusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeployment {
+		edges = append(edges, deploymentcomponentstatus.EdgeDeployment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentComponentStatusMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentcomponentstatus.EdgeDeployment:
+		return m.cleareddeployment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentComponentStatusMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentcomponentstatus.EdgeDeployment:
+		m.ClearDeployment()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentComponentStatusMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentcomponentstatus.EdgeDeployment:
+		m.ResetDeployment()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentComponentStatus edge %s", name)
+}
+
+// DeploymentProfileMutation represents an operation that mutates the DeploymentProfile nodes in the graph.
+type DeploymentProfileMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	_type                   *string
+	description             *string
+	cpu_cores               *float64
+	addcpu_cores            *float64
+	memory                  *string
+	storage                 *string
+	cpu_architectures       *[]string
+	appendcpu_architectures []string
+	peripherals             *[]map[string]interface{}
+	appendperipherals       []map[string]interface{}
+	interfaces              *[]map[string]interface{}
+	appendinterfaces        []map[string]interface{}
+	clearedFields           map[string]struct{}
+	components              map[uuid.UUID]struct{}
+	removedcomponents       map[uuid.UUID]struct{}
+	clearedcomponents       bool
+	application_desc        *uuid.UUID
+	clearedapplication_desc bool
+	done                    bool
+	oldValue                func(context.Context) (*DeploymentProfile, error)
+	predicates              []predicate.DeploymentProfile
+}
+
+var _ ent.Mutation = (*DeploymentProfileMutation)(nil)
+
+// deploymentprofileOption allows management of the mutation configuration using functional options.
+type deploymentprofileOption func(*DeploymentProfileMutation)
+
+// newDeploymentProfileMutation creates new mutation for the DeploymentProfile entity.
+func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOption) *DeploymentProfileMutation {
+	m := &DeploymentProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentProfileID sets the ID field of the mutation.
+func withDeploymentProfileID(id uuid.UUID) deploymentprofileOption {
+	return func(m *DeploymentProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentProfile
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentProfile sets the old DeploymentProfile of the mutation.
+func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
+	return func(m *DeploymentProfileMutation) {
+		m.oldValue = func(context.Context) (*DeploymentProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentProfile entities.
+func (m *DeploymentProfileMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentProfileMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *DeploymentProfileMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *DeploymentProfileMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *DeploymentProfileMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[deploymentprofile.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *DeploymentProfileMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, deploymentprofile.FieldType)
+}
+
+// SetDescription sets the "description" field.
+func (m *DeploymentProfileMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DeploymentProfileMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DeploymentProfileMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[deploymentprofile.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DeploymentProfileMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, deploymentprofile.FieldDescription)
+}
+
+// SetCPUCores sets the "cpu_cores" field.
+func (m *DeploymentProfileMutation) SetCPUCores(f float64) {
+	m.cpu_cores = &f
+	m.addcpu_cores = nil
+}
+
+// CPUCores returns the value of the "cpu_cores" field in the mutation.
+func (m *DeploymentProfileMutation) CPUCores() (r float64, exists bool) {
+	v := m.cpu_cores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCPUCores returns the old "cpu_cores" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldCPUCores(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCPUCores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCPUCores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCPUCores: %w", err)
+	}
+	return oldValue.CPUCores, nil
+}
+
+// AddCPUCores adds f to the "cpu_cores" field.
+func (m *DeploymentProfileMutation) AddCPUCores(f float64) {
+	if m.addcpu_cores != nil {
+		*m.addcpu_cores += f
+	} else {
+		m.addcpu_cores = &f
+	}
+}
+
+// AddedCPUCores returns the value that was added to the "cpu_cores" field in this mutation.
+func (m *DeploymentProfileMutation) AddedCPUCores() (r float64, exists bool) {
+	v := m.addcpu_cores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCPUCores clears the value of the "cpu_cores" field.
+func (m *DeploymentProfileMutation) ClearCPUCores() {
+	m.cpu_cores = nil
+	m.addcpu_cores = nil
+	m.clearedFields[deploymentprofile.FieldCPUCores] = struct{}{}
+}
+
+// CPUCoresCleared returns if the "cpu_cores" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) CPUCoresCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldCPUCores]
+	return ok
+}
+
+// ResetCPUCores resets all changes to the "cpu_cores" field.
+func (m *DeploymentProfileMutation) ResetCPUCores() {
+	m.cpu_cores = nil
+	m.addcpu_cores = nil
+	delete(m.clearedFields, deploymentprofile.FieldCPUCores)
+}
+
+// SetMemory sets the "memory" field.
+func (m *DeploymentProfileMutation) SetMemory(s string) {
+	m.memory = &s
+}
+
+// Memory returns the value of the "memory" field in the mutation.
+func (m *DeploymentProfileMutation) Memory() (r string, exists bool) {
+	v := m.memory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemory returns the old "memory" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldMemory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemory: %w", err)
+	}
+	return oldValue.Memory, nil
+}
+
+// ClearMemory clears the value of the "memory" field.
+func (m *DeploymentProfileMutation) ClearMemory() {
+	m.memory = nil
+	m.clearedFields[deploymentprofile.FieldMemory] = struct{}{}
+}
+
+// MemoryCleared returns if the "memory" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) MemoryCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldMemory]
+	return ok
+}
+
+// ResetMemory resets all changes to the "memory" field.
+func (m *DeploymentProfileMutation) ResetMemory() {
+	m.memory = nil
+	delete(m.clearedFields, deploymentprofile.FieldMemory)
+}
+
+// SetStorage sets the "storage" field.
+func (m *DeploymentProfileMutation) SetStorage(s string) {
+	m.storage = &s
+}
+
+// Storage returns the value of the "storage" field in the mutation.
+func (m *DeploymentProfileMutation) Storage() (r string, exists bool) {
+	v := m.storage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorage returns the old "storage" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldStorage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorage: %w", err)
+	}
+	return oldValue.Storage, nil
+}
+
+// ClearStorage clears the value of the "storage" field.
+func (m *DeploymentProfileMutation) ClearStorage() {
+	m.storage = nil
+	m.clearedFields[deploymentprofile.FieldStorage] = struct{}{}
+}
+
+// StorageCleared returns if the "storage" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) StorageCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldStorage]
+	return ok
+}
+
+// ResetStorage resets all changes to the "storage" field.
+func (m *DeploymentProfileMutation) ResetStorage() {
+	m.storage = nil
+	delete(m.clearedFields, deploymentprofile.FieldStorage)
+}
+
+// SetCPUArchitectures sets the "cpu_architectures" field.
+func (m *DeploymentProfileMutation) SetCPUArchitectures(s []string) {
+	m.cpu_architectures = &s
+	m.appendcpu_architectures = nil
+}
+
+// CPUArchitectures returns the value of the "cpu_architectures" field in the mutation.
+func (m *DeploymentProfileMutation) CPUArchitectures() (r []string, exists bool) {
+	v := m.cpu_architectures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCPUArchitectures returns the old "cpu_architectures" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldCPUArchitectures(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCPUArchitectures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCPUArchitectures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCPUArchitectures: %w", err)
+	}
+	return oldValue.CPUArchitectures, nil
+}
+
+// AppendCPUArchitectures adds s to the "cpu_architectures" field.
+func (m *DeploymentProfileMutation) AppendCPUArchitectures(s []string) {
+	m.appendcpu_architectures = append(m.appendcpu_architectures, s...)
+}
+
+// AppendedCPUArchitectures returns the list of values that were appended to the "cpu_architectures" field in this mutation.
+func (m *DeploymentProfileMutation) AppendedCPUArchitectures() ([]string, bool) {
+	if len(m.appendcpu_architectures) == 0 {
+		return nil, false
+	}
+	return m.appendcpu_architectures, true
+}
+
+// ClearCPUArchitectures clears the value of the "cpu_architectures" field.
+func (m *DeploymentProfileMutation) ClearCPUArchitectures() {
+	m.cpu_architectures = nil
+	m.appendcpu_architectures = nil
+	m.clearedFields[deploymentprofile.FieldCPUArchitectures] = struct{}{}
+}
+
+// CPUArchitecturesCleared returns if the "cpu_architectures" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) CPUArchitecturesCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldCPUArchitectures]
+	return ok
+}
+
+// ResetCPUArchitectures resets all changes to the "cpu_architectures" field.
+func (m *DeploymentProfileMutation) ResetCPUArchitectures() {
+	m.cpu_architectures = nil
+	m.appendcpu_architectures = nil
+	delete(m.clearedFields, deploymentprofile.FieldCPUArchitectures)
+}
+
+// SetPeripherals sets the "peripherals" field.
+func (m *DeploymentProfileMutation) SetPeripherals(value []map[string]interface{}) {
+	m.peripherals = &value
+	m.appendperipherals = nil
+}
+
+// Peripherals returns the value of the "peripherals" field in the mutation.
+func (m *DeploymentProfileMutation) Peripherals() (r []map[string]interface{}, exists bool) {
+	v := m.peripherals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeripherals returns the old "peripherals" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldPeripherals(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeripherals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeripherals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeripherals: %w", err)
+	}
+	return oldValue.Peripherals, nil
+}
+
+// AppendPeripherals adds value to the "peripherals" field.
+func (m *DeploymentProfileMutation) AppendPeripherals(value []map[string]interface{}) {
+	m.appendperipherals = append(m.appendperipherals, value...)
+}
+
+// AppendedPeripherals returns the list of values that were appended to the "peripherals" field in this mutation.
+func (m *DeploymentProfileMutation) AppendedPeripherals() ([]map[string]interface{}, bool) {
+	if len(m.appendperipherals) == 0 {
+		return nil, false
+	}
+	return m.appendperipherals, true
+}
+
+// ClearPeripherals clears the value of the "peripherals" field.
+func (m *DeploymentProfileMutation) ClearPeripherals() {
+	m.peripherals = nil
+	m.appendperipherals = nil
+	m.clearedFields[deploymentprofile.FieldPeripherals] = struct{}{}
+}
+
+// PeripheralsCleared returns if the "peripherals" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) PeripheralsCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldPeripherals]
+	return ok
+}
+
+// ResetPeripherals resets all changes to the "peripherals" field.
+func (m *DeploymentProfileMutation) ResetPeripherals() {
+	m.peripherals = nil
+	m.appendperipherals = nil
+	delete(m.clearedFields, deploymentprofile.FieldPeripherals)
+}
+
+// SetInterfaces sets the "interfaces" field.
+func (m *DeploymentProfileMutation) SetInterfaces(value []map[string]interface{}) {
+	m.interfaces = &value
+	m.appendinterfaces = nil
+}
+
+// Interfaces returns the value of the "interfaces" field in the mutation.
+func (m *DeploymentProfileMutation) Interfaces() (r []map[string]interface{}, exists bool) {
+	v := m.interfaces
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterfaces returns the old "interfaces" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldInterfaces(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterfaces is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterfaces requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterfaces: %w", err)
+	}
+	return oldValue.Interfaces, nil
+}
+
+// AppendInterfaces adds value to the "interfaces" field.
+func (m *DeploymentProfileMutation) AppendInterfaces(value []map[string]interface{}) {
+	m.appendinterfaces = append(m.appendinterfaces, value...)
+}
+
+// AppendedInterfaces returns the list of values that were appended to the "interfaces" field in this mutation.
+func (m *DeploymentProfileMutation) AppendedInterfaces() ([]map[string]interface{}, bool) {
+	if len(m.appendinterfaces) == 0 {
+		return nil, false
+	}
+	return m.appendinterfaces, true
+}
+
+// ClearInterfaces clears the value of the "interfaces" field.
+func (m *DeploymentProfileMutation) ClearInterfaces() {
+	m.interfaces = nil
+	m.appendinterfaces = nil
+	m.clearedFields[deploymentprofile.FieldInterfaces] = struct{}{}
+}
+
+// InterfacesCleared returns if the "interfaces" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) InterfacesCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldInterfaces]
+	return ok
+}
+
+// ResetInterfaces resets all changes to the "interfaces" field.
+func (m *DeploymentProfileMutation) ResetInterfaces() {
+	m.interfaces = nil
+	m.appendinterfaces = nil
+	delete(m.clearedFields, deploymentprofile.FieldInterfaces)
+}
+
+// SetAppID sets the "app_id" field.
+func (m *DeploymentProfileMutation) SetAppID(u uuid.UUID) {
+	m.application_desc = &u
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *DeploymentProfileMutation) AppID() (r uuid.UUID, exists bool) {
+	v := m.application_desc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *DeploymentProfileMutation) ClearAppID() {
+	m.application_desc = nil
+	m.clearedFields[deploymentprofile.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *DeploymentProfileMutation) ResetAppID() {
+	m.application_desc = nil
+	delete(m.clearedFields, deploymentprofile.FieldAppID)
+}
+
+// AddComponentIDs adds the "components" edge to the Component entity by ids.
+func (m *DeploymentProfileMutation) AddComponentIDs(ids ...uuid.UUID) {
+	if m.components == nil {
+		m.components = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.components[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComponents clears the "components" edge to the Component entity.
+func (m *DeploymentProfileMutation) ClearComponents() {
+	m.clearedcomponents = true
+}
+
+// ComponentsCleared reports if the "components" edge to the Component entity was cleared.
+func (m *DeploymentProfileMutation) ComponentsCleared() bool {
+	return m.clearedcomponents
+}
+
+// RemoveComponentIDs removes the "components" edge to the Component entity by IDs.
+func (m *DeploymentProfileMutation) RemoveComponentIDs(ids ...uuid.UUID) {
+	if m.removedcomponents == nil {
+		m.removedcomponents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.components, ids[i])
+		m.removedcomponents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComponents returns the removed IDs of the "components" edge to the Component entity.
+func (m *DeploymentProfileMutation) RemovedComponentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomponents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ComponentsIDs returns the "components" edge IDs in the mutation.
+func (m *DeploymentProfileMutation) ComponentsIDs() (ids []uuid.UUID) {
+	for id := range m.components {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComponents resets all changes to the "components" edge.
+func (m *DeploymentProfileMutation) ResetComponents() {
+	m.components = nil
+	m.clearedcomponents = false
+	m.removedcomponents = nil
+}
+
+// SetApplicationDescID sets the "application_desc" edge to the ApplicationDesc entity by id.
+func (m *DeploymentProfileMutation) SetApplicationDescID(id uuid.UUID) {
+	m.application_desc = &id
+}
+
+// ClearApplicationDesc clears the "application_desc" edge to the ApplicationDesc entity.
+func (m *DeploymentProfileMutation) ClearApplicationDesc() {
+	m.clearedapplication_desc = true
+	m.clearedFields[deploymentprofile.FieldAppID] = struct{}{}
+}
+
+// ApplicationDescCleared reports if the "application_desc" edge to the ApplicationDesc entity was cleared.
+func (m *DeploymentProfileMutation) ApplicationDescCleared() bool {
+	return m.AppIDCleared() || m.clearedapplication_desc
+}
+
+// ApplicationDescID returns the "application_desc" edge ID in the mutation.
+func (m *DeploymentProfileMutation) ApplicationDescID() (id uuid.UUID, exists bool) {
+	if m.application_desc != nil {
+		return *m.application_desc, true
+	}
+	return
+}
+
+// ApplicationDescIDs returns the "application_desc" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationDescID instead. It exists only for internal usage by the builders.
+func (m *DeploymentProfileMutation) ApplicationDescIDs() (ids []uuid.UUID) {
+	if id := m.application_desc; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplicationDesc resets all changes to the "application_desc" edge.
+func (m *DeploymentProfileMutation) ResetApplicationDesc() {
+	m.application_desc = nil
+	m.clearedapplication_desc = false
+}
+
+// Where appends a list predicates to the DeploymentProfileMutation builder.
+func (m *DeploymentProfileMutation) Where(ps ...predicate.DeploymentProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentProfile).
+func (m *DeploymentProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentProfileMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, deploymentprofile.FieldType)
+	}
+	if m.description != nil {
+		fields = append(fields, deploymentprofile.FieldDescription)
+	}
+	if m.cpu_cores != nil {
+		fields = append(fields, deploymentprofile.FieldCPUCores)
+	}
+	if m.memory != nil {
+		fields = append(fields, deploymentprofile.FieldMemory)
+	}
+	if m.storage != nil {
+		fields = append(fields, deploymentprofile.FieldStorage)
+	}
+	if m.cpu_architectures != nil {
+		fields = append(fields, deploymentprofile.FieldCPUArchitectures)
+	}
+	if m.peripherals != nil {
+		fields = append(fields, deploymentprofile.FieldPeripherals)
+	}
+	if m.interfaces != nil {
+		fields = append(fields, deploymentprofile.FieldInterfaces)
+	}
+	if m.application_desc != nil {
+		fields = append(fields, deploymentprofile.FieldAppID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentprofile.FieldType:
+		return m.GetType()
+	case deploymentprofile.FieldDescription:
+		return m.Description()
+	case deploymentprofile.FieldCPUCores:
+		return m.CPUCores()
+	case deploymentprofile.FieldMemory:
+		return m.Memory()
+	case deploymentprofile.FieldStorage:
+		return m.Storage()
+	case deploymentprofile.FieldCPUArchitectures:
+		return m.CPUArchitectures()
+	case deploymentprofile.FieldPeripherals:
+		return m.Peripherals()
+	case deploymentprofile.FieldInterfaces:
+		return m.Interfaces()
+	case deploymentprofile.FieldAppID:
+		return m.AppID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentprofile.FieldType:
+		return m.OldType(ctx)
+	case deploymentprofile.FieldDescription:
+		return m.OldDescription(ctx)
+	case deploymentprofile.FieldCPUCores:
+		return m.OldCPUCores(ctx)
+	case deploymentprofile.FieldMemory:
+		return m.OldMemory(ctx)
+	case deploymentprofile.FieldStorage:
+		return m.OldStorage(ctx)
+	case deploymentprofile.FieldCPUArchitectures:
+		return m.OldCPUArchitectures(ctx)
+	case deploymentprofile.FieldPeripherals:
+		return m.OldPeripherals(ctx)
+	case deploymentprofile.FieldInterfaces:
+		return m.OldInterfaces(ctx)
+	case deploymentprofile.FieldAppID:
+		return m.OldAppID(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentprofile.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case deploymentprofile.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case deploymentprofile.FieldCPUCores:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCPUCores(v)
+		return nil
+	case deploymentprofile.FieldMemory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemory(v)
+		return nil
+	case deploymentprofile.FieldStorage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorage(v)
+		return nil
+	case deploymentprofile.FieldCPUArchitectures:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCPUArchitectures(v)
+		return nil
+	case deploymentprofile.FieldPeripherals:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeripherals(v)
+		return nil
+	case deploymentprofile.FieldInterfaces:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterfaces(v)
+		return nil
+	case deploymentprofile.FieldAppID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.addcpu_cores != nil {
+		fields = append(fields, deploymentprofile.FieldCPUCores)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentprofile.FieldCPUCores:
+		return m.AddedCPUCores()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymentprofile.FieldCPUCores:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCPUCores(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentprofile.FieldType) {
+		fields = append(fields, deploymentprofile.FieldType)
+	}
+	if m.FieldCleared(deploymentprofile.FieldDescription) {
+		fields = append(fields, deploymentprofile.FieldDescription)
+	}
+	if m.FieldCleared(deploymentprofile.FieldCPUCores) {
+		fields = append(fields, deploymentprofile.FieldCPUCores)
+	}
+	if m.FieldCleared(deploymentprofile.FieldMemory) {
+		fields = append(fields, deploymentprofile.FieldMemory)
+	}
+	if m.FieldCleared(deploymentprofile.FieldStorage) {
+		fields = append(fields, deploymentprofile.FieldStorage)
+	}
+	if m.FieldCleared(deploymentprofile.FieldCPUArchitectures) {
+		fields = append(fields, deploymentprofile.FieldCPUArchitectures)
+	}
+	if m.FieldCleared(deploymentprofile.FieldPeripherals) {
+		fields = append(fields, deploymentprofile.FieldPeripherals)
+	}
+	if m.FieldCleared(deploymentprofile.FieldInterfaces) {
+		fields = append(fields, deploymentprofile.FieldInterfaces)
+	}
+	if m.FieldCleared(deploymentprofile.FieldAppID) {
+		fields = append(fields, deploymentprofile.FieldAppID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentProfileMutation) ClearField(name string) error {
+	switch name {
+	case deploymentprofile.FieldType:
+		m.ClearType()
+		return nil
+	case deploymentprofile.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case deploymentprofile.FieldCPUCores:
+		m.ClearCPUCores()
+		return nil
+	case deploymentprofile.FieldMemory:
+		m.ClearMemory()
+		return nil
+	case deploymentprofile.FieldStorage:
+		m.ClearStorage()
+		return nil
+	case deploymentprofile.FieldCPUArchitectures:
+		m.ClearCPUArchitectures()
+		return nil
+	case deploymentprofile.FieldPeripherals:
+		m.ClearPeripherals()
+		return nil
+	case deploymentprofile.FieldInterfaces:
+		m.ClearInterfaces()
+		return nil
+	case deploymentprofile.FieldAppID:
+		m.ClearAppID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentProfileMutation) ResetField(name string) error {
+	switch name {
+	case deploymentprofile.FieldType:
+		m.ResetType()
+		return nil
+	case deploymentprofile.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case deploymentprofile.FieldCPUCores:
+		m.ResetCPUCores()
+		return nil
+	case deploymentprofile.FieldMemory:
+		m.ResetMemory()
+		return nil
+	case deploymentprofile.FieldStorage:
+		m.ResetStorage()
+		return nil
+	case deploymentprofile.FieldCPUArchitectures:
+		m.ResetCPUArchitectures()
+		return nil
+	case deploymentprofile.FieldPeripherals:
+		m.ResetPeripherals()
+		return nil
+	case deploymentprofile.FieldInterfaces:
+		m.ResetInterfaces()
+		return nil
+	case deploymentprofile.FieldAppID:
+		m.ResetAppID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.components != nil {
+		edges = append(edges, deploymentprofile.EdgeComponents)
+	}
+	if m.application_desc != nil {
+		edges = append(edges, deploymentprofile.EdgeApplicationDesc)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentprofile.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.components))
+		for id := range m.components {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentprofile.EdgeApplicationDesc:
+		if id := m.application_desc; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcomponents != nil {
+		edges = append(edges, deploymentprofile.EdgeComponents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentprofile.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.removedcomponents))
+		for id := range m.removedcomponents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcomponents {
+		edges = append(edges, deploymentprofile.EdgeComponents)
+	}
+	if m.clearedapplication_desc {
+		edges = append(edges, deploymentprofile.EdgeApplicationDesc)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentprofile.EdgeComponents:
+		return m.clearedcomponents
+	case deploymentprofile.EdgeApplicationDesc:
+		return m.clearedapplication_desc
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentprofile.EdgeApplicationDesc:
+		m.ClearApplicationDesc()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentprofile.EdgeComponents:
+		m.ResetComponents()
+		return nil
+	case deploymentprofile.EdgeApplicationDesc:
+		m.ResetApplicationDesc()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile edge %s", name)
+}
+
+// DeploymentRevisionMutation represents an operation that mutates the DeploymentRevision nodes in the graph.
+type DeploymentRevisionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	org_id             *uuid.UUID
+	revision           *int
+	addrevision        *int
+	app_id             *string
+	app_name           *string
+	app_version        *string
+	profile            *string
+	parameters         *[]deployment.Parameter
+	appendparameters   []deployment.Parameter
+	site_id            *string
+	spec               *string
+	commit_sha         *string
+	author             *string
+	redeployed_from    *int
+	addredeployed_from *int
+	state              *string
+	outcomes           *map[string]model.SiteOutcome
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	deployment         *uuid.UUID
+	cleareddeployment  bool
+	done               bool
+	oldValue           func(context.Context) (*DeploymentRevision, error)
+	predicates         []predicate.DeploymentRevision
+}
+
+var _ ent.Mutation = (*DeploymentRevisionMutation)(nil)
+
+// deploymentrevisionOption allows management of the mutation configuration using functional options.
+type deploymentrevisionOption func(*DeploymentRevisionMutation)
+
+// newDeploymentRevisionMutation creates new mutation for the DeploymentRevision entity.
+func newDeploymentRevisionMutation(c config, op Op, opts ...deploymentrevisionOption) *DeploymentRevisionMutation {
+	m := &DeploymentRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentRevisionID sets the ID field of the mutation.
+func withDeploymentRevisionID(id uuid.UUID) deploymentrevisionOption {
+	return func(m *DeploymentRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentRevision
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentRevision sets the old DeploymentRevision of the mutation.
+func withDeploymentRevision(node *DeploymentRevision) deploymentrevisionOption {
+	return func(m *DeploymentRevisionMutation) {
+		m.oldValue = func(context.Context) (*DeploymentRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentRevision entities.
+func (m *DeploymentRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *DeploymentRevisionMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *DeploymentRevisionMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (m *DeploymentRevisionMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[deploymentrevision.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *DeploymentRevisionMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, deploymentrevision.FieldOrgID)
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (m *DeploymentRevisionMutation) SetDeploymentID(u uuid.UUID) {
+	m.deployment = &u
+}
+
+// DeploymentID returns the value of the "deployment_id" field in the mutation.
+func (m *DeploymentRevisionMutation) DeploymentID() (r uuid.UUID, exists bool) {
+	v := m.deployment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeploymentID returns the old "deployment_id" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldDeploymentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
+	}
+	return oldValue.DeploymentID, nil
+}
+
+// ResetDeploymentID resets all changes to the "deployment_id" field.
+func (m *DeploymentRevisionMutation) ResetDeploymentID() {
+	m.deployment = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *DeploymentRevisionMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *DeploymentRevisionMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *DeploymentRevisionMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *DeploymentRevisionMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *DeploymentRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetAppID sets the "app_id" field.
+func (m *DeploymentRevisionMutation) SetAppID(s string) {
+	m.app_id = &s
+}
+
+// AppID returns the value of the "app_id" field in the mutation.
+func (m *DeploymentRevisionMutation) AppID() (r string, exists bool) {
+	v := m.app_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppID returns the old "app_id" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldAppID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+	}
+	return oldValue.AppID, nil
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (m *DeploymentRevisionMutation) ClearAppID() {
+	m.app_id = nil
+	m.clearedFields[deploymentrevision.FieldAppID] = struct{}{}
+}
+
+// AppIDCleared returns if the "app_id" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) AppIDCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldAppID]
+	return ok
+}
+
+// ResetAppID resets all changes to the "app_id" field.
+func (m *DeploymentRevisionMutation) ResetAppID() {
+	m.app_id = nil
+	delete(m.clearedFields, deploymentrevision.FieldAppID)
+}
+
+// SetAppName sets the "app_name" field.
+func (m *DeploymentRevisionMutation) SetAppName(s string) {
+	m.app_name = &s
+}
+
+// AppName returns the value of the "app_name" field in the mutation.
+func (m *DeploymentRevisionMutation) AppName() (r string, exists bool) {
+	v := m.app_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppName returns the old "app_name" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldAppName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+	}
+	return oldValue.AppName, nil
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (m *DeploymentRevisionMutation) ClearAppName() {
+	m.app_name = nil
+	m.clearedFields[deploymentrevision.FieldAppName] = struct{}{}
+}
+
+// AppNameCleared returns if the "app_name" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) AppNameCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldAppName]
+	return ok
+}
+
+// ResetAppName resets all changes to the "app_name" field.
+func (m *DeploymentRevisionMutation) ResetAppName() {
+	m.app_name = nil
+	delete(m.clearedFields, deploymentrevision.FieldAppName)
+}
+
+// SetAppVersion sets the "app_version" field.
+func (m *DeploymentRevisionMutation) SetAppVersion(s string) {
+	m.app_version = &s
+}
+
+// AppVersion returns the value of the "app_version" field in the mutation.
+func (m *DeploymentRevisionMutation) AppVersion() (r string, exists bool) {
+	v := m.app_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppVersion returns the old "app_version" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldAppVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppVersion: %w", err)
+	}
+	return oldValue.AppVersion, nil
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (m *DeploymentRevisionMutation) ClearAppVersion() {
+	m.app_version = nil
+	m.clearedFields[deploymentrevision.FieldAppVersion] = struct{}{}
+}
+
+// AppVersionCleared returns if the "app_version" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) AppVersionCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldAppVersion]
+	return ok
+}
+
+// ResetAppVersion resets all changes to the "app_version" field.
+func (m *DeploymentRevisionMutation) ResetAppVersion() {
+	m.app_version = nil
+	delete(m.clearedFields, deploymentrevision.FieldAppVersion)
+}
+
+// SetProfile sets the "profile" field.
+func (m *DeploymentRevisionMutation) SetProfile(s string) {
+	m.profile = &s
+}
+
+// Profile returns the value of the "profile" field in the mutation.
+func (m *DeploymentRevisionMutation) Profile() (r string, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfile returns the old "profile" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
+	}
+	return oldValue.Profile, nil
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (m *DeploymentRevisionMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[deploymentrevision.FieldProfile] = struct{}{}
+}
+
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldProfile]
+	return ok
+}
+
+// ResetProfile resets all changes to the "profile" field.
+func (m *DeploymentRevisionMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, deploymentrevision.FieldProfile)
+}
+
+// SetParameters sets the "parameters" field.
+func (m *DeploymentRevisionMutation) SetParameters(d []deployment.Parameter) {
+	m.parameters = &d
+	m.appendparameters = nil
+}
+
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *DeploymentRevisionMutation) Parameters() (r []deployment.Parameter, exists bool) {
+	v := m.parameters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParameters returns the old "parameters" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldParameters(ctx context.Context) (v []deployment.Parameter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParameters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
+	}
+	return oldValue.Parameters, nil
+}
+
+// AppendParameters adds d to the "parameters" field.
+func (m *DeploymentRevisionMutation) AppendParameters(d []deployment.Parameter) {
+	m.appendparameters = append(m.appendparameters, d...)
+}
+
+// AppendedParameters returns the list of values that were appended to the "parameters" field in this mutation.
+func (m *DeploymentRevisionMutation) AppendedParameters() ([]deployment.Parameter, bool) {
+	if len(m.appendparameters) == 0 {
+		return nil, false
+	}
+	return m.appendparameters, true
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (m *DeploymentRevisionMutation) ClearParameters() {
+	m.parameters = nil
+	m.appendparameters = nil
+	m.clearedFields[deploymentrevision.FieldParameters] = struct{}{}
+}
+
+// ParametersCleared returns if the "parameters" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) ParametersCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldParameters]
+	return ok
+}
+
+// ResetParameters resets all changes to the "parameters" field.
+func (m *DeploymentRevisionMutation) ResetParameters() {
+	m.parameters = nil
+	m.appendparameters = nil
+	delete(m.clearedFields, deploymentrevision.FieldParameters)
+}
+
+// SetSiteID sets the "site_id" field.
+func (m *DeploymentRevisionMutation) SetSiteID(s string) {
+	m.site_id = &s
+}
+
+// SiteID returns the value of the "site_id" field in the mutation.
+func (m *DeploymentRevisionMutation) SiteID() (r string, exists bool) {
+	v := m.site_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteID returns the old "site_id" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldSiteID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+	}
+	return oldValue.SiteID, nil
+}
+
+// ResetSiteID resets all changes to the "site_id" field.
+func (m *DeploymentRevisionMutation) ResetSiteID() {
+	m.site_id = nil
+}
+
+// SetSpec sets the "spec" field.
+func (m *DeploymentRevisionMutation) SetSpec(s string) {
+	m.spec = &s
+}
+
+// Spec returns the value of the "spec" field in the mutation.
+func (m *DeploymentRevisionMutation) Spec() (r string, exists bool) {
+	v := m.spec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpec returns the old "spec" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldSpec(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpec: %w", err)
+	}
+	return oldValue.Spec, nil
+}
+
+// ResetSpec resets all changes to the "spec" field.
+func (m *DeploymentRevisionMutation) ResetSpec() {
+	m.spec = nil
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (m *DeploymentRevisionMutation) SetCommitSha(s string) {
+	m.commit_sha = &s
+}
+
+// CommitSha returns the value of the "commit_sha" field in the mutation.
+func (m *DeploymentRevisionMutation) CommitSha() (r string, exists bool) {
+	v := m.commit_sha
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitSha returns the old "commit_sha" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldCommitSha(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitSha is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitSha requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitSha: %w", err)
+	}
+	return oldValue.CommitSha, nil
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (m *DeploymentRevisionMutation) ClearCommitSha() {
+	m.commit_sha = nil
+	m.clearedFields[deploymentrevision.FieldCommitSha] = struct{}{}
+}
+
+// CommitShaCleared returns if the "commit_sha" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) CommitShaCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldCommitSha]
+	return ok
+}
+
+// ResetCommitSha resets all changes to the "commit_sha" field.
+func (m *DeploymentRevisionMutation) ResetCommitSha() {
+	m.commit_sha = nil
+	delete(m.clearedFields, deploymentrevision.FieldCommitSha)
+}
+
+// SetAuthor sets the "author" field.
+func (m *DeploymentRevisionMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *DeploymentRevisionMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *DeploymentRevisionMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[deploymentrevision.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *DeploymentRevisionMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, deploymentrevision.FieldAuthor)
+}
+
+// SetRedeployedFrom sets the "redeployed_from" field.
+func (m *DeploymentRevisionMutation) SetRedeployedFrom(i int) {
+	m.redeployed_from = &i
+	m.addredeployed_from = nil
+}
+
+// RedeployedFrom returns the value of the "redeployed_from" field in the mutation.
+func (m *DeploymentRevisionMutation) RedeployedFrom() (r int, exists bool) {
+	v := m.redeployed_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedeployedFrom returns the old "redeployed_from" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldRedeployedFrom(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedeployedFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedeployedFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedeployedFrom: %w", err)
+	}
+	return oldValue.RedeployedFrom, nil
+}
+
+// AddRedeployedFrom adds i to the "redeployed_from" field.
+func (m *DeploymentRevisionMutation) AddRedeployedFrom(i int) {
+	if m.addredeployed_from != nil {
+		*m.addredeployed_from += i
+	} else {
+		m.addredeployed_from = &i
+	}
+}
+
+// AddedRedeployedFrom returns the value that was added to the "redeployed_from" field in this mutation.
+func (m *DeploymentRevisionMutation) AddedRedeployedFrom() (r int, exists bool) {
+	v := m.addredeployed_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRedeployedFrom clears the value of the "redeployed_from" field.
+func (m *DeploymentRevisionMutation) ClearRedeployedFrom() {
+	m.redeployed_from = nil
+	m.addredeployed_from = nil
+	m.clearedFields[deploymentrevision.FieldRedeployedFrom] = struct{}{}
+}
+
+// RedeployedFromCleared returns if the "redeployed_from" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) RedeployedFromCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldRedeployedFrom]
+	return ok
+}
+
+// ResetRedeployedFrom resets all changes to the "redeployed_from" field.
+func (m *DeploymentRevisionMutation) ResetRedeployedFrom() {
+	m.redeployed_from = nil
+	m.addredeployed_from = nil
+	delete(m.clearedFields, deploymentrevision.FieldRedeployedFrom)
+}
+
+// SetState sets the "state" field.
+func (m *DeploymentRevisionMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *DeploymentRevisionMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *DeploymentRevisionMutation) ResetState() {
+	m.state = nil
+}
+
+// SetOutcomes sets the "outcomes" field.
+func (m *DeploymentRevisionMutation) SetOutcomes(mo map[string]model.SiteOutcome) {
+	m.outcomes = &mo
+}
+
+// Outcomes returns the value of the "outcomes" field in the mutation.
+func (m *DeploymentRevisionMutation) Outcomes() (r map[string]model.SiteOutcome, exists bool) {
+	v := m.outcomes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutcomes returns the old "outcomes" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldOutcomes(ctx context.Context) (v map[string]model.SiteOutcome, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcomes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcomes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcomes: %w", err)
+	}
+	return oldValue.Outcomes, nil
+}
+
+// ClearOutcomes clears the value of the "outcomes" field.
+func (m *DeploymentRevisionMutation) ClearOutcomes() {
+	m.outcomes = nil
+	m.clearedFields[deploymentrevision.FieldOutcomes] = struct{}{}
+}
+
+// OutcomesCleared returns if the "outcomes" field was cleared in this mutation.
+func (m *DeploymentRevisionMutation) OutcomesCleared() bool {
+	_, ok := m.clearedFields[deploymentrevision.FieldOutcomes]
+	return ok
+}
+
+// ResetOutcomes resets all changes to the "outcomes" field.
+func (m *DeploymentRevisionMutation) ResetOutcomes() {
+	m.outcomes = nil
+	delete(m.clearedFields, deploymentrevision.FieldOutcomes)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeploymentRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeploymentRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeploymentRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeploymentRevisionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeploymentRevisionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeploymentRevision entity.
+// If the DeploymentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRevisionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeploymentRevisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearDeployment clears the "deployment" edge to the DeploymentStatus entity.
+func (m *DeploymentRevisionMutation) ClearDeployment() {
+	m.cleareddeployment = true
+	m.clearedFields[deploymentrevision.FieldDeploymentID] = struct{}{}
+}
+
+// DeploymentCleared reports if the "deployment" edge to the DeploymentStatus entity was cleared.
+func (m *DeploymentRevisionMutation) DeploymentCleared() bool {
+	return m.cleareddeployment
+}
+
+// DeploymentIDs returns the "deployment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentID instead. It exists only for internal usage by the builders.
+func (m *DeploymentRevisionMutation) DeploymentIDs() (ids []uuid.UUID) {
+	if id := m.deployment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeployment resets all changes to the "deployment" edge.
+func (m *DeploymentRevisionMutation) ResetDeployment() {
+	m.deployment = nil
+	m.cleareddeployment = false
+}
+
+// Where appends a list predicates to the DeploymentRevisionMutation builder.
+func (m *DeploymentRevisionMutation) Where(ps ...predicate.DeploymentRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentRevision).
+func (m *DeploymentRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.org_id != nil {
+		fields = append(fields, deploymentrevision.FieldOrgID)
+	}
+	if m.deployment != nil {
+		fields = append(fields, deploymentrevision.FieldDeploymentID)
+	}
+	if m.revision != nil {
+		fields = append(fields, deploymentrevision.FieldRevision)
+	}
+	if m.app_id != nil {
+		fields = append(fields, deploymentrevision.FieldAppID)
+	}
+	if m.app_name != nil {
+		fields = append(fields, deploymentrevision.FieldAppName)
+	}
+	if m.app_version != nil {
+		fields = append(fields, deploymentrevision.FieldAppVersion)
+	}
+	if m.profile != nil {
+		fields = append(fields, deploymentrevision.FieldProfile)
+	}
+	if m.parameters != nil {
+		fields = append(fields, deploymentrevision.FieldParameters)
+	}
+	if m.site_id != nil {
+		fields = append(fields, deploymentrevision.FieldSiteID)
+	}
+	if m.spec != nil {
+		fields = append(fields, deploymentrevision.FieldSpec)
+	}
+	if m.commit_sha != nil {
+		fields = append(fields, deploymentrevision.FieldCommitSha)
+	}
+	if m.author != nil {
+		fields = append(fields, deploymentrevision.FieldAuthor)
+	}
+	if m.redeployed_from != nil {
+		fields = append(fields, deploymentrevision.FieldRedeployedFrom)
+	}
+	if m.state != nil {
+		fields = append(fields, deploymentrevision.FieldState)
+	}
+	if m.outcomes != nil {
+		fields = append(fields, deploymentrevision.FieldOutcomes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deploymentrevision.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deploymentrevision.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentrevision.FieldOrgID:
+		return m.OrgID()
+	case deploymentrevision.FieldDeploymentID:
+		return m.DeploymentID()
+	case deploymentrevision.FieldRevision:
+		return m.Revision()
+	case deploymentrevision.FieldAppID:
+		return m.AppID()
+	case deploymentrevision.FieldAppName:
+		return m.AppName()
+	case deploymentrevision.FieldAppVersion:
+		return m.AppVersion()
+	case deploymentrevision.FieldProfile:
+		return m.Profile()
+	case deploymentrevision.FieldParameters:
+		return m.Parameters()
+	case deploymentrevision.FieldSiteID:
+		return m.SiteID()
+	case deploymentrevision.FieldSpec:
+		return m.Spec()
+	case deploymentrevision.FieldCommitSha:
+		return m.CommitSha()
+	case deploymentrevision.FieldAuthor:
+		return m.Author()
+	case deploymentrevision.FieldRedeployedFrom:
+		return m.RedeployedFrom()
+	case deploymentrevision.FieldState:
+		return m.State()
+	case deploymentrevision.FieldOutcomes:
+		return m.Outcomes()
+	case deploymentrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	case deploymentrevision.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentrevision.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case deploymentrevision.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case deploymentrevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case deploymentrevision.FieldAppID:
+		return m.OldAppID(ctx)
+	case deploymentrevision.FieldAppName:
+		return m.OldAppName(ctx)
+	case deploymentrevision.FieldAppVersion:
+		return m.OldAppVersion(ctx)
+	case deploymentrevision.FieldProfile:
+		return m.OldProfile(ctx)
+	case deploymentrevision.FieldParameters:
+		return m.OldParameters(ctx)
+	case deploymentrevision.FieldSiteID:
+		return m.OldSiteID(ctx)
+	case deploymentrevision.FieldSpec:
+		return m.OldSpec(ctx)
+	case deploymentrevision.FieldCommitSha:
+		return m.OldCommitSha(ctx)
+	case deploymentrevision.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case deploymentrevision.FieldRedeployedFrom:
+		return m.OldRedeployedFrom(ctx)
+	case deploymentrevision.FieldState:
+		return m.OldState(ctx)
+	case deploymentrevision.FieldOutcomes:
+		return m.OldOutcomes(ctx)
+	case deploymentrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deploymentrevision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentrevision.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case deploymentrevision.FieldDeploymentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentID(v)
+		return nil
+	case deploymentrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case deploymentrevision.FieldAppID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppID(v)
+		return nil
+	case deploymentrevision.FieldAppName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppName(v)
+		return nil
+	case deploymentrevision.FieldAppVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppVersion(v)
+		return nil
+	case deploymentrevision.FieldProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfile(v)
+		return nil
+	case deploymentrevision.FieldParameters:
+		v, ok := value.([]deployment.Parameter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case deploymentrevision.FieldSiteID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteID(v)
+		return nil
+	case deploymentrevision.FieldSpec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpec(v)
+		return nil
+	case deploymentrevision.FieldCommitSha:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitSha(v)
+		return nil
+	case deploymentrevision.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case deploymentrevision.FieldRedeployedFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedeployedFrom(v)
+		return nil
+	case deploymentrevision.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case deploymentrevision.FieldOutcomes:
+		v, ok := value.(map[string]model.SiteOutcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcomes(v)
+		return nil
+	case deploymentrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deploymentrevision.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, deploymentrevision.FieldRevision)
+	}
+	if m.addredeployed_from != nil {
+		fields = append(fields, deploymentrevision.FieldRedeployedFrom)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentrevision.FieldRevision:
+		return m.AddedRevision()
+	case deploymentrevision.FieldRedeployedFrom:
+		return m.AddedRedeployedFrom()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymentrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case deploymentrevision.FieldRedeployedFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRedeployedFrom(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentrevision.FieldOrgID) {
+		fields = append(fields, deploymentrevision.FieldOrgID)
+	}
+	if m.FieldCleared(deploymentrevision.FieldAppID) {
+		fields = append(fields, deploymentrevision.FieldAppID)
+	}
+	if m.FieldCleared(deploymentrevision.FieldAppName) {
+		fields = append(fields, deploymentrevision.FieldAppName)
+	}
+	if m.FieldCleared(deploymentrevision.FieldAppVersion) {
+		fields = append(fields, deploymentrevision.FieldAppVersion)
+	}
+	if m.FieldCleared(deploymentrevision.FieldProfile) {
+		fields = append(fields, deploymentrevision.FieldProfile)
+	}
+	if m.FieldCleared(deploymentrevision.FieldParameters) {
+		fields = append(fields, deploymentrevision.FieldParameters)
+	}
+	if m.FieldCleared(deploymentrevision.FieldCommitSha) {
+		fields = append(fields, deploymentrevision.FieldCommitSha)
+	}
+	if m.FieldCleared(deploymentrevision.FieldAuthor) {
+		fields = append(fields, deploymentrevision.FieldAuthor)
+	}
+	if m.FieldCleared(deploymentrevision.FieldRedeployedFrom) {
+		fields = append(fields, deploymentrevision.FieldRedeployedFrom)
+	}
+	if m.FieldCleared(deploymentrevision.FieldOutcomes) {
+		fields = append(fields, deploymentrevision.FieldOutcomes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentRevisionMutation) ClearField(name string) error {
+	switch name {
+	case deploymentrevision.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case deploymentrevision.FieldAppID:
+		m.ClearAppID()
+		return nil
+	case deploymentrevision.FieldAppName:
+		m.ClearAppName()
+		return nil
+	case deploymentrevision.FieldAppVersion:
+		m.ClearAppVersion()
+		return nil
+	case deploymentrevision.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case deploymentrevision.FieldParameters:
+		m.ClearParameters()
+		return nil
+	case deploymentrevision.FieldCommitSha:
+		m.ClearCommitSha()
+		return nil
+	case deploymentrevision.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case deploymentrevision.FieldRedeployedFrom:
+		m.ClearRedeployedFrom()
+		return nil
+	case deploymentrevision.FieldOutcomes:
+		m.ClearOutcomes()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentRevisionMutation) ResetField(name string) error {
+	switch name {
+	case deploymentrevision.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case deploymentrevision.FieldDeploymentID:
+		m.ResetDeploymentID()
+		return nil
+	case deploymentrevision.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case deploymentrevision.FieldAppID:
+		m.ResetAppID()
+		return nil
+	case deploymentrevision.FieldAppName:
+		m.ResetAppName()
+		return nil
+	case deploymentrevision.FieldAppVersion:
+		m.ResetAppVersion()
+		return nil
+	case deploymentrevision.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case deploymentrevision.FieldParameters:
+		m.ResetParameters()
+		return nil
+	case deploymentrevision.FieldSiteID:
+		m.ResetSiteID()
+		return nil
+	case deploymentrevision.FieldSpec:
+		m.ResetSpec()
+		return nil
+	case deploymentrevision.FieldCommitSha:
+		m.ResetCommitSha()
+		return nil
+	case deploymentrevision.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case deploymentrevision.FieldRedeployedFrom:
+		m.ResetRedeployedFrom()
+		return nil
+	case deploymentrevision.FieldState:
+		m.ResetState()
+		return nil
+	case deploymentrevision.FieldOutcomes:
+		m.ResetOutcomes()
+		return nil
+	case deploymentrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deploymentrevision.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deployment != nil {
+		edges = append(edges, deploymentrevision.EdgeDeployment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentrevision.EdgeDeployment:
+		if id := m.deployment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeployment {
+		edges = append(edges, deploymentrevision.EdgeDeployment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentrevision.EdgeDeployment:
+		return m.cleareddeployment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentrevision.EdgeDeployment:
+		m.ClearDeployment()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentrevision.EdgeDeployment:
+		m.ResetDeployment()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRevision edge %s", name)
+}
+
+// DeploymentStatusMutation represents an operation that mutates the DeploymentStatus nodes in the graph.
+type DeploymentStatusMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	state               *string
+	error_code          *string
+	error_message       *string
+	host_id             *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	components          map[uuid.UUID]struct{}
+	removedcomponents   map[uuid.UUID]struct{}
+	clearedcomponents   bool
+	revisions           map[uuid.UUID]struct{}
+	removedrevisions    map[uuid.UUID]struct{}
+	clearedrevisions    bool
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*DeploymentStatus, error)
+	predicates          []predicate.DeploymentStatus
+}
+
+var _ ent.Mutation = (*DeploymentStatusMutation)(nil)
+
+// deploymentstatusOption allows management of the mutation configuration using functional options.
+type deploymentstatusOption func(*DeploymentStatusMutation)
+
+// newDeploymentStatusMutation creates new mutation for the DeploymentStatus entity.
+func newDeploymentStatusMutation(c config, op Op, opts ...deploymentstatusOption) *DeploymentStatusMutation {
+	m := &DeploymentStatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentStatus,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentStatusID sets the ID field of the mutation.
+func withDeploymentStatusID(id uuid.UUID) deploymentstatusOption {
+	return func(m *DeploymentStatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentStatus
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentStatus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentStatus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentStatus sets the old DeploymentStatus of the mutation.
+func withDeploymentStatus(node *DeploymentStatus) deploymentstatusOption {
+	return func(m *DeploymentStatusMutation) {
+		m.oldValue = func(context.Context) (*DeploymentStatus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentStatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentStatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeploymentStatus entities.
+func (m *DeploymentStatusMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentStatusMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentStatusMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentStatus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *DeploymentStatusMutation) SetOrgID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *DeploymentStatusMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (m *DeploymentStatusMutation) ClearOrgID() {
+	m.organization = nil
+	m.clearedFields[deploymentstatus.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *DeploymentStatusMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[deploymentstatus.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *DeploymentStatusMutation) ResetOrgID() {
+	m.organization = nil
+	delete(m.clearedFields, deploymentstatus.FieldOrgID)
+}
+
+// SetState sets the "state" field.
+func (m *DeploymentStatusMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *DeploymentStatusMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *DeploymentStatusMutation) ResetState() {
+	m.state = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *DeploymentStatusMutation) SetErrorCode(s string) {
+	m.error_code = &s
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *DeploymentStatusMutation) ErrorCode() (r string, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *DeploymentStatusMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.clearedFields[deploymentstatus.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *DeploymentStatusMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[deploymentstatus.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *DeploymentStatusMutation) ResetErrorCode() {
+	m.error_code = nil
+	delete(m.clearedFields, deploymentstatus.FieldErrorCode)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *DeploymentStatusMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *DeploymentStatusMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *DeploymentStatusMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[deploymentstatus.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *DeploymentStatusMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[deploymentstatus.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *DeploymentStatusMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, deploymentstatus.FieldErrorMessage)
+}
+
+// SetHostID sets the "host_id" field.
+func (m *DeploymentStatusMutation) SetHostID(s string) {
+	m.host_id = &s
+}
+
+// HostID returns the value of the "host_id" field in the mutation.
+func (m *DeploymentStatusMutation) HostID() (r string, exists bool) {
+	v := m.host_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostID returns the old "host_id" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldHostID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
+	}
+	return oldValue.HostID, nil
+}
+
+// ClearHostID clears the value of the "host_id" field.
+func (m *DeploymentStatusMutation) ClearHostID() {
+	m.host_id = nil
+	m.clearedFields[deploymentstatus.FieldHostID] = struct{}{}
+}
+
+// HostIDCleared returns if the "host_id" field was cleared in this mutation.
+func (m *DeploymentStatusMutation) HostIDCleared() bool {
+	_, ok := m.clearedFields[deploymentstatus.FieldHostID]
+	return ok
+}
+
+// ResetHostID resets all changes to the "host_id" field.
+func (m *DeploymentStatusMutation) ResetHostID() {
+	m.host_id = nil
+	delete(m.clearedFields, deploymentstatus.FieldHostID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeploymentStatusMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeploymentStatusMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeploymentStatusMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeploymentStatusMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeploymentStatusMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeploymentStatus entity.
+// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeploymentStatusMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddComponentIDs adds the "components" edge to the DeploymentComponentStatus entity by ids.
+func (m *DeploymentStatusMutation) AddComponentIDs(ids ...uuid.UUID) {
+	if m.components == nil {
+		m.components = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.components[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComponents clears the "components" edge to the DeploymentComponentStatus entity.
+func (m *DeploymentStatusMutation) ClearComponents() {
+	m.clearedcomponents = true
+}
+
+// ComponentsCleared reports if the "components" edge to the DeploymentComponentStatus entity was cleared.
+func (m *DeploymentStatusMutation) ComponentsCleared() bool {
+	return m.clearedcomponents
+}
+
+// RemoveComponentIDs removes the "components" edge to the DeploymentComponentStatus entity by IDs.
+func (m *DeploymentStatusMutation) RemoveComponentIDs(ids ...uuid.UUID) {
+	if m.removedcomponents == nil {
+		m.removedcomponents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.components, ids[i])
+		m.removedcomponents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComponents returns the removed IDs of the "components" edge to the DeploymentComponentStatus entity.
+func (m *DeploymentStatusMutation) RemovedComponentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomponents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ComponentsIDs returns the "components" edge IDs in the mutation.
+func (m *DeploymentStatusMutation) ComponentsIDs() (ids []uuid.UUID) {
+	for id := range m.components {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComponents resets all changes to the "components" edge.
+func (m *DeploymentStatusMutation) ResetComponents() {
+	m.components = nil
+	m.clearedcomponents = false
+	m.removedcomponents = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the DeploymentRevision entity by ids.
+func (m *DeploymentStatusMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the DeploymentRevision entity.
+func (m *DeploymentStatusMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the DeploymentRevision entity was cleared.
+func (m *DeploymentStatusMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the DeploymentRevision entity by IDs.
+func (m *DeploymentStatusMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the DeploymentRevision entity.
+func (m *DeploymentStatusMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *DeploymentStatusMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *DeploymentStatusMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *DeploymentStatusMutation) SetOrganizationID(id uuid.UUID) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *DeploymentStatusMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[deploymentstatus.FieldOrgID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *DeploymentStatusMutation) OrganizationCleared() bool {
+	return m.OrgIDCleared() || m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *DeploymentStatusMutation) OrganizationID() (id uuid.UUID, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *DeploymentStatusMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *DeploymentStatusMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the DeploymentStatusMutation builder.
+func (m *DeploymentStatusMutation) Where(ps ...predicate.DeploymentStatus) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentStatusMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentStatus, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentStatusMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentStatusMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentStatus).
+func (m *DeploymentStatusMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentStatusMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.organization != nil {
+		fields = append(fields, deploymentstatus.FieldOrgID)
+	}
+	if m.state != nil {
+		fields = append(fields, deploymentstatus.FieldState)
+	}
+	if m.error_code != nil {
+		fields = append(fields, deploymentstatus.FieldErrorCode)
+	}
+	if m.error_message != nil {
+		fields = append(fields, deploymentstatus.FieldErrorMessage)
+	}
+	if m.host_id != nil {
+		fields = append(fields, deploymentstatus.FieldHostID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deploymentstatus.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deploymentstatus.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentStatusMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentstatus.FieldOrgID:
+		return m.OrgID()
+	case deploymentstatus.FieldState:
+		return m.State()
+	case deploymentstatus.FieldErrorCode:
+		return m.ErrorCode()
+	case deploymentstatus.FieldErrorMessage:
+		return m.ErrorMessage()
+	case deploymentstatus.FieldHostID:
+		return m.HostID()
+	case deploymentstatus.FieldCreatedAt:
+		return m.CreatedAt()
+	case deploymentstatus.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentstatus.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case deploymentstatus.FieldState:
+		return m.OldState(ctx)
+	case deploymentstatus.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case deploymentstatus.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case deploymentstatus.FieldHostID:
+		return m.OldHostID(ctx)
+	case deploymentstatus.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deploymentstatus.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentStatus field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentStatusMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentstatus.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetOrgID(v)
+		return nil
+	case deploymentstatus.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case deploymentstatus.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case deploymentstatus.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case deploymentstatus.FieldHostID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostID(v)
+		return nil
+	case deploymentstatus.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deploymentstatus.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentStatus field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentStatusMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentStatusMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentStatusMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeploymentStatus numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentStatusMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentstatus.FieldOrgID) {
+		fields = append(fields, deploymentstatus.FieldOrgID)
+	}
+	if m.FieldCleared(deploymentstatus.FieldErrorCode) {
+		fields = append(fields, deploymentstatus.FieldErrorCode)
+	}
+	if m.FieldCleared(deploymentstatus.FieldErrorMessage) {
+		fields = append(fields, deploymentstatus.FieldErrorMessage)
+	}
+	if m.FieldCleared(deploymentstatus.FieldHostID) {
+		fields = append(fields, deploymentstatus.FieldHostID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentStatusMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentStatusMutation) ClearField(name string) error {
+	switch name {
+	case deploymentstatus.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case deploymentstatus.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case deploymentstatus.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case deploymentstatus.FieldHostID:
+		m.ClearHostID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentStatus nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentStatusMutation) ResetField(name string) error {
+	switch name {
+	case deploymentstatus.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case deploymentstatus.FieldState:
+		m.ResetState()
+		return nil
+	case deploymentstatus.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case deploymentstatus.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case deploymentstatus.FieldHostID:
+		m.ResetHostID()
+		return nil
+	case deploymentstatus.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deploymentstatus.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentStatus field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentStatusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.components != nil {
+		edges = append(edges, deploymentstatus.EdgeComponents)
+	}
+	if m.revisions != nil {
+		edges = append(edges, deploymentstatus.EdgeRevisions)
+	}
+	if m.organization != nil {
+		edges = append(edges, deploymentstatus.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentStatusMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentstatus.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.components))
+		for id := range m.components {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentstatus.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentstatus.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentStatusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedcomponents != nil {
+		edges = append(edges, deploymentstatus.EdgeComponents)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, deploymentstatus.EdgeRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentStatusMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentstatus.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.removedcomponents))
+		for id := range m.removedcomponents {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentstatus.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentStatusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcomponents {
+		edges = append(edges, deploymentstatus.EdgeComponents)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, deploymentstatus.EdgeRevisions)
+	}
+	if m.clearedorganization {
+		edges = append(edges, deploymentstatus.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentStatusMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentstatus.EdgeComponents:
+		return m.clearedcomponents
+	case deploymentstatus.EdgeRevisions:
+		return m.clearedrevisions
+	case deploymentstatus.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentStatusMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentstatus.EdgeOrganization:
+		m.ClearOrganization()
 		return nil
-	case applicationdesc.FieldVendor:
-		v, ok := value.(string)
+	}
+	return fmt.Errorf("unknown DeploymentStatus unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentStatusMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentstatus.EdgeComponents:
+		m.ResetComponents()
+		return nil
+	case deploymentstatus.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case deploymentstatus.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentStatus edge %s", name)
+}
+
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	org_id        *uuid.UUID
+	created_at    *time.Time
+	_type         *string
+	deployment_id *string
+	site_id       *string
+	data          *jsontext.Value
+	appenddata    jsontext.Value
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Event, error)
+	predicates    []predicate.Event
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the ID field of the mutation.
+func withEventID(id int) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *EventMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *EventMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (m *EventMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[event.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *EventMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *EventMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, event.FieldOrgID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetType sets the "type" field.
+func (m *EventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (m *EventMutation) SetDeploymentID(s string) {
+	m.deployment_id = &s
+}
+
+// DeploymentID returns the value of the "deployment_id" field in the mutation.
+func (m *EventMutation) DeploymentID() (r string, exists bool) {
+	v := m.deployment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeploymentID returns the old "deployment_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
+	}
+	return oldValue.DeploymentID, nil
+}
+
+// ClearDeploymentID clears the value of the "deployment_id" field.
+func (m *EventMutation) ClearDeploymentID() {
+	m.deployment_id = nil
+	m.clearedFields[event.FieldDeploymentID] = struct{}{}
+}
+
+// DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
+func (m *EventMutation) DeploymentIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldDeploymentID]
+	return ok
+}
+
+// ResetDeploymentID resets all changes to the "deployment_id" field.
+func (m *EventMutation) ResetDeploymentID() {
+	m.deployment_id = nil
+	delete(m.clearedFields, event.FieldDeploymentID)
+}
+
+// SetSiteID sets the "site_id" field.
+func (m *EventMutation) SetSiteID(s string) {
+	m.site_id = &s
+}
+
+// SiteID returns the value of the "site_id" field in the mutation.
+func (m *EventMutation) SiteID() (r string, exists bool) {
+	v := m.site_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteID returns the old "site_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSiteID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+	}
+	return oldValue.SiteID, nil
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (m *EventMutation) ClearSiteID() {
+	m.site_id = nil
+	m.clearedFields[event.FieldSiteID] = struct{}{}
+}
+
+// SiteIDCleared returns if the "site_id" field was cleared in this mutation.
+func (m *EventMutation) SiteIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldSiteID]
+	return ok
+}
+
+// ResetSiteID resets all changes to the "site_id" field.
+func (m *EventMutation) ResetSiteID() {
+	m.site_id = nil
+	delete(m.clearedFields, event.FieldSiteID)
+}
+
+// SetData sets the "data" field.
+func (m *EventMutation) SetData(j jsontext.Value) {
+	m.data = &j
+	m.appenddata = nil
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *EventMutation) Data() (r jsontext.Value, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldData(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// AppendData adds j to the "data" field.
+func (m *EventMutation) AppendData(j jsontext.Value) {
+	m.appenddata = append(m.appenddata, j...)
+}
+
+// AppendedData returns the list of values that were appended to the "data" field in this mutation.
+func (m *EventMutation) AppendedData() (jsontext.Value, bool) {
+	if len(m.appenddata) == 0 {
+		return nil, false
+	}
+	return m.appenddata, true
+}
+
+// ClearData clears the value of the "data" field.
+func (m *EventMutation) ClearData() {
+	m.data = nil
+	m.appenddata = nil
+	m.clearedFields[event.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *EventMutation) DataCleared() bool {
+	_, ok := m.clearedFields[event.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *EventMutation) ResetData() {
+	m.data = nil
+	m.appenddata = nil
+	delete(m.clearedFields, event.FieldData)
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.org_id != nil {
+		fields = append(fields, event.FieldOrgID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, event.FieldType)
+	}
+	if m.deployment_id != nil {
+		fields = append(fields, event.FieldDeploymentID)
+	}
+	if m.site_id != nil {
+		fields = append(fields, event.FieldSiteID)
+	}
+	if m.data != nil {
+		fields = append(fields, event.FieldData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldOrgID:
+		return m.OrgID()
+	case event.FieldCreatedAt:
+		return m.CreatedAt()
+	case event.FieldType:
+		return m.GetType()
+	case event.FieldDeploymentID:
+		return m.DeploymentID()
+	case event.FieldSiteID:
+		return m.SiteID()
+	case event.FieldData:
+		return m.Data()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case event.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case event.FieldType:
+		return m.OldType(ctx)
+	case event.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case event.FieldSiteID:
+		return m.OldSiteID(ctx)
+	case event.FieldData:
+		return m.OldData(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldOrgID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVendor(v)
+		m.SetOrgID(v)
 		return nil
-	case applicationdesc.FieldVersion:
-		v, ok := value.(string)
+	case event.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetCreatedAt(v)
 		return nil
-	case applicationdesc.FieldCategory:
+	case event.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetType(v)
 		return nil
-	case applicationdesc.FieldDescription:
+	case event.FieldDeploymentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetDeploymentID(v)
 		return nil
-	case applicationdesc.FieldIcon:
+	case event.FieldSiteID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetSiteID(v)
 		return nil
-	case applicationdesc.FieldArtifacturl:
-		v, ok := value.(string)
+	case event.FieldData:
+		v, ok := value.(jsontext.Value)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArtifacturl(v)
+		m.SetData(v)
 		return nil
-	case applicationdesc.FieldSite:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSite(v)
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldOrgID) {
+		fields = append(fields, event.FieldOrgID)
+	}
+	if m.FieldCleared(event.FieldDeploymentID) {
+		fields = append(fields, event.FieldDeploymentID)
+	}
+	if m.FieldCleared(event.FieldSiteID) {
+		fields = append(fields, event.FieldSiteID)
+	}
+	if m.FieldCleared(event.FieldData) {
+		fields = append(fields, event.FieldData)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldOrgID:
+		m.ClearOrgID()
 		return nil
-	case applicationdesc.FieldTagLine:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case event.FieldDeploymentID:
+		m.ClearDeploymentID()
+		return nil
+	case event.FieldSiteID:
+		m.ClearSiteID()
+		return nil
+	case event.FieldData:
+		m.ClearData()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case event.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case event.FieldType:
+		m.ResetType()
+		return nil
+	case event.FieldDeploymentID:
+		m.ResetDeploymentID()
+		return nil
+	case event.FieldSiteID:
+		m.ResetSiteID()
+		return nil
+	case event.FieldData:
+		m.ResetData()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// HostMutation represents an operation that mutates the Host nodes in the graph.
+type HostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	org_id        *uuid.UUID
+	host_id       *string
+	runtime       *string
+	last_seen     *time.Time
+	misses        *int
+	addmisses     *int
+	cpu_free      *float64
+	addcpu_free   *float64
+	status        *string
+	hostname      *string
+	ip_address    *string
+	edge_url      *string
+	metadata      *map[string]interface{}
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	site          *uuid.UUID
+	clearedsite   bool
+	done          bool
+	oldValue      func(context.Context) (*Host, error)
+	predicates    []predicate.Host
+}
+
+var _ ent.Mutation = (*HostMutation)(nil)
+
+// hostOption allows management of the mutation configuration using functional options.
+type hostOption func(*HostMutation)
+
+// newHostMutation creates new mutation for the Host entity.
+func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
+	m := &HostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHostID sets the ID field of the mutation.
+func withHostID(id uuid.UUID) hostOption {
+	return func(m *HostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Host
+		)
+		m.oldValue = func(ctx context.Context) (*Host, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Host.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetTagLine(v)
-		return nil
-	case applicationdesc.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &id
+	}
+}
+
+// withHost sets the old Host of the mutation.
+func withHost(node *Host) hostOption {
+	return func(m *HostMutation) {
+		m.oldValue = func(context.Context) (*Host, error) {
+			return node, nil
 		}
-		m.SetTags(v)
-		return nil
-	case applicationdesc.FieldPublished:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Host entities.
+func (m *HostMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HostMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		m.SetPublished(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Host.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown ApplicationDesc field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ApplicationDescMutation) AddedFields() []string {
-	return nil
+// SetOrgID sets the "org_id" field.
+func (m *HostMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *HostMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (m *HostMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[host.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *HostMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[host.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *HostMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, host.FieldOrgID)
+}
+
+// SetHostID sets the "host_id" field.
+func (m *HostMutation) SetHostID(s string) {
+	m.host_id = &s
+}
+
+// HostID returns the value of the "host_id" field in the mutation.
+func (m *HostMutation) HostID() (r string, exists bool) {
+	v := m.host_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostID returns the old "host_id" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldHostID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
+	}
+	return oldValue.HostID, nil
+}
+
+// ResetHostID resets all changes to the "host_id" field.
+func (m *HostMutation) ResetHostID() {
+	m.host_id = nil
+}
+
+// SetSiteID sets the "site_id" field.
+func (m *HostMutation) SetSiteID(u uuid.UUID) {
+	m.site = &u
+}
+
+// SiteID returns the value of the "site_id" field in the mutation.
+func (m *HostMutation) SiteID() (r uuid.UUID, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteID returns the old "site_id" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldSiteID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+	}
+	return oldValue.SiteID, nil
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (m *HostMutation) ClearSiteID() {
+	m.site = nil
+	m.clearedFields[host.FieldSiteID] = struct{}{}
+}
+
+// SiteIDCleared returns if the "site_id" field was cleared in this mutation.
+func (m *HostMutation) SiteIDCleared() bool {
+	_, ok := m.clearedFields[host.FieldSiteID]
+	return ok
+}
+
+// ResetSiteID resets all changes to the "site_id" field.
+func (m *HostMutation) ResetSiteID() {
+	m.site = nil
+	delete(m.clearedFields, host.FieldSiteID)
+}
+
+// SetRuntime sets the "runtime" field.
+func (m *HostMutation) SetRuntime(s string) {
+	m.runtime = &s
+}
+
+// Runtime returns the value of the "runtime" field in the mutation.
+func (m *HostMutation) Runtime() (r string, exists bool) {
+	v := m.runtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuntime returns the old "runtime" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldRuntime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuntime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuntime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuntime: %w", err)
+	}
+	return oldValue.Runtime, nil
+}
+
+// ClearRuntime clears the value of the "runtime" field.
+func (m *HostMutation) ClearRuntime() {
+	m.runtime = nil
+	m.clearedFields[host.FieldRuntime] = struct{}{}
+}
+
+// RuntimeCleared returns if the "runtime" field was cleared in this mutation.
+func (m *HostMutation) RuntimeCleared() bool {
+	_, ok := m.clearedFields[host.FieldRuntime]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ApplicationDescMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetRuntime resets all changes to the "runtime" field.
+func (m *HostMutation) ResetRuntime() {
+	m.runtime = nil
+	delete(m.clearedFields, host.FieldRuntime)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ApplicationDescMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown ApplicationDesc numeric field %s", name)
+// SetLastSeen sets the "last_seen" field.
+func (m *HostMutation) SetLastSeen(t time.Time) {
+	m.last_seen = &t
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ApplicationDescMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(applicationdesc.FieldAppID) {
-		fields = append(fields, applicationdesc.FieldAppID)
-	}
-	if m.FieldCleared(applicationdesc.FieldName) {
-		fields = append(fields, applicationdesc.FieldName)
-	}
-	if m.FieldCleared(applicationdesc.FieldVendor) {
-		fields = append(fields, applicationdesc.FieldVendor)
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *HostMutation) LastSeen() (r time.Time, exists bool) {
+	v := m.last_seen
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(applicationdesc.FieldVersion) {
-		fields = append(fields, applicationdesc.FieldVersion)
+	return *v, true
+}
+
+// OldLastSeen returns the old "last_seen" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldLastSeen(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(applicationdesc.FieldCategory) {
-		fields = append(fields, applicationdesc.FieldCategory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
 	}
-	if m.FieldCleared(applicationdesc.FieldDescription) {
-		fields = append(fields, applicationdesc.FieldDescription)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
 	}
-	if m.FieldCleared(applicationdesc.FieldIcon) {
-		fields = append(fields, applicationdesc.FieldIcon)
+	return oldValue.LastSeen, nil
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (m *HostMutation) ClearLastSeen() {
+	m.last_seen = nil
+	m.clearedFields[host.FieldLastSeen] = struct{}{}
+}
+
+// LastSeenCleared returns if the "last_seen" field was cleared in this mutation.
+func (m *HostMutation) LastSeenCleared() bool {
+	_, ok := m.clearedFields[host.FieldLastSeen]
+	return ok
+}
+
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *HostMutation) ResetLastSeen() {
+	m.last_seen = nil
+	delete(m.clearedFields, host.FieldLastSeen)
+}
+
+// SetMisses sets the "misses" field.
+func (m *HostMutation) SetMisses(i int) {
+	m.misses = &i
+	m.addmisses = nil
+}
+
+// Misses returns the value of the "misses" field in the mutation.
+func (m *HostMutation) Misses() (r int, exists bool) {
+	v := m.misses
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(applicationdesc.FieldArtifacturl) {
-		fields = append(fields, applicationdesc.FieldArtifacturl)
+	return *v, true
+}
+
+// OldMisses returns the old "misses" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldMisses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMisses is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(applicationdesc.FieldSite) {
-		fields = append(fields, applicationdesc.FieldSite)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMisses requires an ID field in the mutation")
 	}
-	if m.FieldCleared(applicationdesc.FieldTagLine) {
-		fields = append(fields, applicationdesc.FieldTagLine)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMisses: %w", err)
 	}
-	if m.FieldCleared(applicationdesc.FieldTags) {
-		fields = append(fields, applicationdesc.FieldTags)
+	return oldValue.Misses, nil
+}
+
+// AddMisses adds i to the "misses" field.
+func (m *HostMutation) AddMisses(i int) {
+	if m.addmisses != nil {
+		*m.addmisses += i
+	} else {
+		m.addmisses = &i
 	}
-	if m.FieldCleared(applicationdesc.FieldPublished) {
-		fields = append(fields, applicationdesc.FieldPublished)
+}
+
+// AddedMisses returns the value that was added to the "misses" field in this mutation.
+func (m *HostMutation) AddedMisses() (r int, exists bool) {
+	v := m.addmisses
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ApplicationDescMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearMisses clears the value of the "misses" field.
+func (m *HostMutation) ClearMisses() {
+	m.misses = nil
+	m.addmisses = nil
+	m.clearedFields[host.FieldMisses] = struct{}{}
+}
+
+// MissesCleared returns if the "misses" field was cleared in this mutation.
+func (m *HostMutation) MissesCleared() bool {
+	_, ok := m.clearedFields[host.FieldMisses]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ApplicationDescMutation) ClearField(name string) error {
-	switch name {
-	case applicationdesc.FieldAppID:
-		m.ClearAppID()
-		return nil
-	case applicationdesc.FieldName:
-		m.ClearName()
-		return nil
-	case applicationdesc.FieldVendor:
-		m.ClearVendor()
-		return nil
-	case applicationdesc.FieldVersion:
-		m.ClearVersion()
-		return nil
-	case applicationdesc.FieldCategory:
-		m.ClearCategory()
-		return nil
-	case applicationdesc.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case applicationdesc.FieldIcon:
-		m.ClearIcon()
-		return nil
-	case applicationdesc.FieldArtifacturl:
-		m.ClearArtifacturl()
-		return nil
-	case applicationdesc.FieldSite:
-		m.ClearSite()
-		return nil
-	case applicationdesc.FieldTagLine:
-		m.ClearTagLine()
-		return nil
-	case applicationdesc.FieldTags:
-		m.ClearTags()
-		return nil
-	case applicationdesc.FieldPublished:
-		m.ClearPublished()
-		return nil
+// ResetMisses resets all changes to the "misses" field.
+func (m *HostMutation) ResetMisses() {
+	m.misses = nil
+	m.addmisses = nil
+	delete(m.clearedFields, host.FieldMisses)
+}
+
+// SetCPUFree sets the "cpu_free" field.
+func (m *HostMutation) SetCPUFree(f float64) {
+	m.cpu_free = &f
+	m.addcpu_free = nil
+}
+
+// CPUFree returns the value of the "cpu_free" field in the mutation.
+func (m *HostMutation) CPUFree() (r float64, exists bool) {
+	v := m.cpu_free
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ApplicationDesc nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ApplicationDescMutation) ResetField(name string) error {
-	switch name {
-	case applicationdesc.FieldAppID:
-		m.ResetAppID()
-		return nil
-	case applicationdesc.FieldName:
-		m.ResetName()
-		return nil
-	case applicationdesc.FieldVendor:
-		m.ResetVendor()
-		return nil
-	case applicationdesc.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case applicationdesc.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case applicationdesc.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case applicationdesc.FieldIcon:
-		m.ResetIcon()
-		return nil
-	case applicationdesc.FieldArtifacturl:
-		m.ResetArtifacturl()
-		return nil
-	case applicationdesc.FieldSite:
-		m.ResetSite()
-		return nil
-	case applicationdesc.FieldTagLine:
-		m.ResetTagLine()
-		return nil
-	case applicationdesc.FieldTags:
-		m.ResetTags()
-		return nil
-	case applicationdesc.FieldPublished:
-		m.ResetPublished()
-		return nil
+// OldCPUFree returns the old "cpu_free" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldCPUFree(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCPUFree is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ApplicationDesc field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCPUFree requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCPUFree: %w", err)
+	}
+	return oldValue.CPUFree, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ApplicationDescMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.deployment_profiles != nil {
-		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
+// AddCPUFree adds f to the "cpu_free" field.
+func (m *HostMutation) AddCPUFree(f float64) {
+	if m.addcpu_free != nil {
+		*m.addcpu_free += f
+	} else {
+		m.addcpu_free = &f
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ApplicationDescMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case applicationdesc.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.deployment_profiles))
-		for id := range m.deployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
+// AddedCPUFree returns the value that was added to the "cpu_free" field in this mutation.
+func (m *HostMutation) AddedCPUFree() (r float64, exists bool) {
+	v := m.addcpu_free
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ApplicationDescMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removeddeployment_profiles != nil {
-		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
-	}
-	return edges
+// ClearCPUFree clears the value of the "cpu_free" field.
+func (m *HostMutation) ClearCPUFree() {
+	m.cpu_free = nil
+	m.addcpu_free = nil
+	m.clearedFields[host.FieldCPUFree] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ApplicationDescMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case applicationdesc.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
-		for id := range m.removeddeployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// CPUFreeCleared returns if the "cpu_free" field was cleared in this mutation.
+func (m *HostMutation) CPUFreeCleared() bool {
+	_, ok := m.clearedFields[host.FieldCPUFree]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ApplicationDescMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddeployment_profiles {
-		edges = append(edges, applicationdesc.EdgeDeploymentProfiles)
+// ResetCPUFree resets all changes to the "cpu_free" field.
+func (m *HostMutation) ResetCPUFree() {
+	m.cpu_free = nil
+	m.addcpu_free = nil
+	delete(m.clearedFields, host.FieldCPUFree)
+}
+
+// SetStatus sets the "status" field.
+func (m *HostMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *HostMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ApplicationDescMutation) EdgeCleared(name string) bool {
-	switch name {
-	case applicationdesc.EdgeDeploymentProfiles:
-		return m.cleareddeployment_profiles
+// OldStatus returns the old "status" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ApplicationDescMutation) ClearEdge(name string) error {
-	switch name {
+// ClearStatus clears the value of the "status" field.
+func (m *HostMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[host.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *HostMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[host.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *HostMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, host.FieldStatus)
+}
+
+// SetHostname sets the "hostname" field.
+func (m *HostMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *HostMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ApplicationDesc unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ApplicationDescMutation) ResetEdge(name string) error {
-	switch name {
-	case applicationdesc.EdgeDeploymentProfiles:
-		m.ResetDeploymentProfiles()
-		return nil
+// OldHostname returns the old "hostname" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ApplicationDesc edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
 }
 
-// ComponentMutation represents an operation that mutates the Component nodes in the graph.
-type ComponentMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	name                      *string
-	properties                *application.ComponentProperties
-	clearedFields             map[string]struct{}
-	deployment_profile        *uuid.UUID
-	cleareddeployment_profile bool
-	done                      bool
-	oldValue                  func(context.Context) (*Component, error)
-	predicates                []predicate.Component
+// ClearHostname clears the value of the "hostname" field.
+func (m *HostMutation) ClearHostname() {
+	m.hostname = nil
+	m.clearedFields[host.FieldHostname] = struct{}{}
 }
 
-var _ ent.Mutation = (*ComponentMutation)(nil)
+// HostnameCleared returns if the "hostname" field was cleared in this mutation.
+func (m *HostMutation) HostnameCleared() bool {
+	_, ok := m.clearedFields[host.FieldHostname]
+	return ok
+}
 
-// componentOption allows management of the mutation configuration using functional options.
-type componentOption func(*ComponentMutation)
+// ResetHostname resets all changes to the "hostname" field.
+func (m *HostMutation) ResetHostname() {
+	m.hostname = nil
+	delete(m.clearedFields, host.FieldHostname)
+}
 
-// newComponentMutation creates new mutation for the Component entity.
-func newComponentMutation(c config, op Op, opts ...componentOption) *ComponentMutation {
-	m := &ComponentMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeComponent,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// SetIPAddress sets the "ip_address" field.
+func (m *HostMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *HostMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withComponentID sets the ID field of the mutation.
-func withComponentID(id uuid.UUID) componentOption {
-	return func(m *ComponentMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Component
-		)
-		m.oldValue = func(ctx context.Context) (*Component, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Component.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldIPAddress returns the old "ip_address" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
 	}
+	return oldValue.IPAddress, nil
 }
 
-// withComponent sets the old Component of the mutation.
-func withComponent(node *Component) componentOption {
-	return func(m *ComponentMutation) {
-		m.oldValue = func(context.Context) (*Component, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *HostMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[host.FieldIPAddress] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ComponentMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *HostMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[host.FieldIPAddress]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ComponentMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *HostMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, host.FieldIPAddress)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Component entities.
-func (m *ComponentMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetEdgeURL sets the "edge_url" field.
+func (m *HostMutation) SetEdgeURL(s string) {
+	m.edge_url = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ComponentMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// EdgeURL returns the value of the "edge_url" field in the mutation.
+func (m *HostMutation) EdgeURL() (r string, exists bool) {
+	v := m.edge_url
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ComponentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Component.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldEdgeURL returns the old "edge_url" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldEdgeURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEdgeURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEdgeURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEdgeURL: %w", err)
 	}
+	return oldValue.EdgeURL, nil
 }
 
-// SetDeploymentProfileID sets the "deployment_profile_id" field.
-func (m *ComponentMutation) SetDeploymentProfileID(u uuid.UUID) {
-	m.deployment_profile = &u
+// ClearEdgeURL clears the value of the "edge_url" field.
+func (m *HostMutation) ClearEdgeURL() {
+	m.edge_url = nil
+	m.clearedFields[host.FieldEdgeURL] = struct{}{}
 }
 
-// DeploymentProfileID returns the value of the "deployment_profile_id" field in the mutation.
-func (m *ComponentMutation) DeploymentProfileID() (r uuid.UUID, exists bool) {
-	v := m.deployment_profile
+// EdgeURLCleared returns if the "edge_url" field was cleared in this mutation.
+func (m *HostMutation) EdgeURLCleared() bool {
+	_, ok := m.clearedFields[host.FieldEdgeURL]
+	return ok
+}
+
+// ResetEdgeURL resets all changes to the "edge_url" field.
+func (m *HostMutation) ResetEdgeURL() {
+	m.edge_url = nil
+	delete(m.clearedFields, host.FieldEdgeURL)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *HostMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *HostMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeploymentProfileID returns the old "deployment_profile_id" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldDeploymentProfileID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *HostMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeploymentProfileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeploymentProfileID requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeploymentProfileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.DeploymentProfileID, nil
+	return oldValue.Metadata, nil
 }
 
-// ClearDeploymentProfileID clears the value of the "deployment_profile_id" field.
-func (m *ComponentMutation) ClearDeploymentProfileID() {
-	m.deployment_profile = nil
-	m.clearedFields[component.FieldDeploymentProfileID] = struct{}{}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *HostMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[host.FieldMetadata] = struct{}{}
 }
 
-// DeploymentProfileIDCleared returns if the "deployment_profile_id" field was cleared in this mutation.
-func (m *ComponentMutation) DeploymentProfileIDCleared() bool {
-	_, ok := m.clearedFields[component.FieldDeploymentProfileID]
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *HostMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[host.FieldMetadata]
 	return ok
 }
 
-// ResetDeploymentProfileID resets all changes to the "deployment_profile_id" field.
-func (m *ComponentMutation) ResetDeploymentProfileID() {
-	m.deployment_profile = nil
-	delete(m.clearedFields, component.FieldDeploymentProfileID)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *HostMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, host.FieldMetadata)
 }
 
-// SetName sets the "name" field.
-func (m *ComponentMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *HostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ComponentMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *HostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *ComponentMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[component.FieldName] = struct{}{}
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *HostMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[host.FieldCreatedAt] = struct{}{}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *ComponentMutation) NameCleared() bool {
-	_, ok := m.clearedFields[component.FieldName]
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *HostMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[host.FieldCreatedAt]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ComponentMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, component.FieldName)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HostMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, host.FieldCreatedAt)
 }
 
-// SetProperties sets the "properties" field.
-func (m *ComponentMutation) SetProperties(ap application.ComponentProperties) {
-	m.properties = &ap
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Properties returns the value of the "properties" field in the mutation.
-func (m *ComponentMutation) Properties() (r application.ComponentProperties, exists bool) {
-	v := m.properties
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProperties returns the old "properties" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldProperties(ctx context.Context) (v application.ComponentProperties, err error) {
+func (m *HostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProperties requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Properties, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearProperties clears the value of the "properties" field.
-func (m *ComponentMutation) ClearProperties() {
-	m.properties = nil
-	m.clearedFields[component.FieldProperties] = struct{}{}
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *HostMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[host.FieldUpdatedAt] = struct{}{}
 }
 
-// PropertiesCleared returns if the "properties" field was cleared in this mutation.
-func (m *ComponentMutation) PropertiesCleared() bool {
-	_, ok := m.clearedFields[component.FieldProperties]
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *HostMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[host.FieldUpdatedAt]
 	return ok
 }
 
-// ResetProperties resets all changes to the "properties" field.
-func (m *ComponentMutation) ResetProperties() {
-	m.properties = nil
-	delete(m.clearedFields, component.FieldProperties)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, host.FieldUpdatedAt)
 }
 
-// ClearDeploymentProfile clears the "deployment_profile" edge to the DeploymentProfile entity.
-func (m *ComponentMutation) ClearDeploymentProfile() {
-	m.cleareddeployment_profile = true
-	m.clearedFields[component.FieldDeploymentProfileID] = struct{}{}
+// ClearSite clears the "site" edge to the Site entity.
+func (m *HostMutation) ClearSite() {
+	m.clearedsite = true
+	m.clearedFields[host.FieldSiteID] = struct{}{}
 }
 
-// DeploymentProfileCleared reports if the "deployment_profile" edge to the DeploymentProfile entity was cleared.
-func (m *ComponentMutation) DeploymentProfileCleared() bool {
-	return m.DeploymentProfileIDCleared() || m.cleareddeployment_profile
+// SiteCleared reports if the "site" edge to the Site entity was cleared.
+func (m *HostMutation) SiteCleared() bool {
+	return m.SiteIDCleared() || m.clearedsite
 }
 
-// DeploymentProfileIDs returns the "deployment_profile" edge IDs in the mutation.
+// SiteIDs returns the "site" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentProfileID instead. It exists only for internal usage by the builders.
-func (m *ComponentMutation) DeploymentProfileIDs() (ids []uuid.UUID) {
-	if id := m.deployment_profile; id != nil {
+// SiteID instead. It exists only for internal usage by the builders.
+func (m *HostMutation) SiteIDs() (ids []uuid.UUID) {
+	if id := m.site; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDeploymentProfile resets all changes to the "deployment_profile" edge.
-func (m *ComponentMutation) ResetDeploymentProfile() {
-	m.deployment_profile = nil
-	m.cleareddeployment_profile = false
+// ResetSite resets all changes to the "site" edge.
+func (m *HostMutation) ResetSite() {
+	m.site = nil
+	m.clearedsite = false
 }
 
-// Where appends a list predicates to the ComponentMutation builder.
-func (m *ComponentMutation) Where(ps ...predicate.Component) {
+// Where appends a list predicates to the HostMutation builder.
+func (m *HostMutation) Where(ps ...predicate.Host) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ComponentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the HostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ComponentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Component, len(ps))
+func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Host, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1621,33 +10261,66 @@ func (m *ComponentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ComponentMutation) Op() Op {
+func (m *HostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ComponentMutation) SetOp(op Op) {
+func (m *HostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Component).
-func (m *ComponentMutation) Type() string {
+// Type returns the node type of this mutation (Host).
+func (m *HostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ComponentMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.deployment_profile != nil {
-		fields = append(fields, component.FieldDeploymentProfileID)
+func (m *HostMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.org_id != nil {
+		fields = append(fields, host.FieldOrgID)
 	}
-	if m.name != nil {
-		fields = append(fields, component.FieldName)
+	if m.host_id != nil {
+		fields = append(fields, host.FieldHostID)
 	}
-	if m.properties != nil {
-		fields = append(fields, component.FieldProperties)
+	if m.site != nil {
+		fields = append(fields, host.FieldSiteID)
+	}
+	if m.runtime != nil {
+		fields = append(fields, host.FieldRuntime)
+	}
+	if m.last_seen != nil {
+		fields = append(fields, host.FieldLastSeen)
+	}
+	if m.misses != nil {
+		fields = append(fields, host.FieldMisses)
+	}
+	if m.cpu_free != nil {
+		fields = append(fields, host.FieldCPUFree)
+	}
+	if m.status != nil {
+		fields = append(fields, host.FieldStatus)
+	}
+	if m.hostname != nil {
+		fields = append(fields, host.FieldHostname)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, host.FieldIPAddress)
+	}
+	if m.edge_url != nil {
+		fields = append(fields, host.FieldEdgeURL)
+	}
+	if m.metadata != nil {
+		fields = append(fields, host.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, host.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, host.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1655,14 +10328,36 @@ func (m *ComponentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ComponentMutation) Field(name string) (ent.Value, bool) {
+func (m *HostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case component.FieldDeploymentProfileID:
-		return m.DeploymentProfileID()
-	case component.FieldName:
-		return m.Name()
-	case component.FieldProperties:
-		return m.Properties()
+	case host.FieldOrgID:
+		return m.OrgID()
+	case host.FieldHostID:
+		return m.HostID()
+	case host.FieldSiteID:
+		return m.SiteID()
+	case host.FieldRuntime:
+		return m.Runtime()
+	case host.FieldLastSeen:
+		return m.LastSeen()
+	case host.FieldMisses:
+		return m.Misses()
+	case host.FieldCPUFree:
+		return m.CPUFree()
+	case host.FieldStatus:
+		return m.Status()
+	case host.FieldHostname:
+		return m.Hostname()
+	case host.FieldIPAddress:
+		return m.IPAddress()
+	case host.FieldEdgeURL:
+		return m.EdgeURL()
+	case host.FieldMetadata:
+		return m.Metadata()
+	case host.FieldCreatedAt:
+		return m.CreatedAt()
+	case host.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1670,142 +10365,361 @@ func (m *ComponentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ComponentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case component.FieldDeploymentProfileID:
-		return m.OldDeploymentProfileID(ctx)
-	case component.FieldName:
-		return m.OldName(ctx)
-	case component.FieldProperties:
-		return m.OldProperties(ctx)
+	case host.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case host.FieldHostID:
+		return m.OldHostID(ctx)
+	case host.FieldSiteID:
+		return m.OldSiteID(ctx)
+	case host.FieldRuntime:
+		return m.OldRuntime(ctx)
+	case host.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	case host.FieldMisses:
+		return m.OldMisses(ctx)
+	case host.FieldCPUFree:
+		return m.OldCPUFree(ctx)
+	case host.FieldStatus:
+		return m.OldStatus(ctx)
+	case host.FieldHostname:
+		return m.OldHostname(ctx)
+	case host.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case host.FieldEdgeURL:
+		return m.OldEdgeURL(ctx)
+	case host.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case host.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case host.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Component field %s", name)
+	return nil, fmt.Errorf("unknown Host field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ComponentMutation) SetField(name string, value ent.Value) error {
+func (m *HostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case component.FieldDeploymentProfileID:
+	case host.FieldOrgID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeploymentProfileID(v)
+		m.SetOrgID(v)
 		return nil
-	case component.FieldName:
+	case host.FieldHostID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetHostID(v)
 		return nil
-	case component.FieldProperties:
-		v, ok := value.(application.ComponentProperties)
+	case host.FieldSiteID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProperties(v)
+		m.SetSiteID(v)
+		return nil
+	case host.FieldRuntime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuntime(v)
+		return nil
+	case host.FieldLastSeen:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeen(v)
+		return nil
+	case host.FieldMisses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMisses(v)
+		return nil
+	case host.FieldCPUFree:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCPUFree(v)
+		return nil
+	case host.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case host.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case host.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case host.FieldEdgeURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEdgeURL(v)
+		return nil
+	case host.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case host.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case host.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Component field %s", name)
+	return fmt.Errorf("unknown Host field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ComponentMutation) AddedFields() []string {
-	return nil
+func (m *HostMutation) AddedFields() []string {
+	var fields []string
+	if m.addmisses != nil {
+		fields = append(fields, host.FieldMisses)
+	}
+	if m.addcpu_free != nil {
+		fields = append(fields, host.FieldCPUFree)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ComponentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *HostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case host.FieldMisses:
+		return m.AddedMisses()
+	case host.FieldCPUFree:
+		return m.AddedCPUFree()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ComponentMutation) AddField(name string, value ent.Value) error {
+func (m *HostMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case host.FieldMisses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMisses(v)
+		return nil
+	case host.FieldCPUFree:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCPUFree(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Component numeric field %s", name)
+	return fmt.Errorf("unknown Host numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ComponentMutation) ClearedFields() []string {
+func (m *HostMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(component.FieldDeploymentProfileID) {
-		fields = append(fields, component.FieldDeploymentProfileID)
+	if m.FieldCleared(host.FieldOrgID) {
+		fields = append(fields, host.FieldOrgID)
 	}
-	if m.FieldCleared(component.FieldName) {
-		fields = append(fields, component.FieldName)
+	if m.FieldCleared(host.FieldSiteID) {
+		fields = append(fields, host.FieldSiteID)
 	}
-	if m.FieldCleared(component.FieldProperties) {
-		fields = append(fields, component.FieldProperties)
+	if m.FieldCleared(host.FieldRuntime) {
+		fields = append(fields, host.FieldRuntime)
+	}
+	if m.FieldCleared(host.FieldLastSeen) {
+		fields = append(fields, host.FieldLastSeen)
+	}
+	if m.FieldCleared(host.FieldMisses) {
+		fields = append(fields, host.FieldMisses)
+	}
+	if m.FieldCleared(host.FieldCPUFree) {
+		fields = append(fields, host.FieldCPUFree)
+	}
+	if m.FieldCleared(host.FieldStatus) {
+		fields = append(fields, host.FieldStatus)
+	}
+	if m.FieldCleared(host.FieldHostname) {
+		fields = append(fields, host.FieldHostname)
+	}
+	if m.FieldCleared(host.FieldIPAddress) {
+		fields = append(fields, host.FieldIPAddress)
+	}
+	if m.FieldCleared(host.FieldEdgeURL) {
+		fields = append(fields, host.FieldEdgeURL)
+	}
+	if m.FieldCleared(host.FieldMetadata) {
+		fields = append(fields, host.FieldMetadata)
+	}
+	if m.FieldCleared(host.FieldCreatedAt) {
+		fields = append(fields, host.FieldCreatedAt)
+	}
+	if m.FieldCleared(host.FieldUpdatedAt) {
+		fields = append(fields, host.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ComponentMutation) FieldCleared(name string) bool {
+func (m *HostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ComponentMutation) ClearField(name string) error {
+func (m *HostMutation) ClearField(name string) error {
 	switch name {
-	case component.FieldDeploymentProfileID:
-		m.ClearDeploymentProfileID()
+	case host.FieldOrgID:
+		m.ClearOrgID()
 		return nil
-	case component.FieldName:
-		m.ClearName()
+	case host.FieldSiteID:
+		m.ClearSiteID()
 		return nil
-	case component.FieldProperties:
-		m.ClearProperties()
+	case host.FieldRuntime:
+		m.ClearRuntime()
+		return nil
+	case host.FieldLastSeen:
+		m.ClearLastSeen()
+		return nil
+	case host.FieldMisses:
+		m.ClearMisses()
+		return nil
+	case host.FieldCPUFree:
+		m.ClearCPUFree()
+		return nil
+	case host.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case host.FieldHostname:
+		m.ClearHostname()
+		return nil
+	case host.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case host.FieldEdgeURL:
+		m.ClearEdgeURL()
+		return nil
+	case host.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case host.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case host.FieldUpdatedAt:
+		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Component nullable field %s", name)
+	return fmt.Errorf("unknown Host nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ComponentMutation) ResetField(name string) error {
+func (m *HostMutation) ResetField(name string) error {
 	switch name {
-	case component.FieldDeploymentProfileID:
-		m.ResetDeploymentProfileID()
+	case host.FieldOrgID:
+		m.ResetOrgID()
 		return nil
-	case component.FieldName:
-		m.ResetName()
+	case host.FieldHostID:
+		m.ResetHostID()
 		return nil
-	case component.FieldProperties:
-		m.ResetProperties()
+	case host.FieldSiteID:
+		m.ResetSiteID()
+		return nil
+	case host.FieldRuntime:
+		m.ResetRuntime()
+		return nil
+	case host.FieldLastSeen:
+		m.ResetLastSeen()
+		return nil
+	case host.FieldMisses:
+		m.ResetMisses()
+		return nil
+	case host.FieldCPUFree:
+		m.ResetCPUFree()
+		return nil
+	case host.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case host.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case host.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case host.FieldEdgeURL:
+		m.ResetEdgeURL()
+		return nil
+	case host.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case host.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case host.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Component field %s", name)
+	return fmt.Errorf("unknown Host field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ComponentMutation) AddedEdges() []string {
+func (m *HostMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.deployment_profile != nil {
-		edges = append(edges, component.EdgeDeploymentProfile)
+	if m.site != nil {
+		edges = append(edges, host.EdgeSite)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ComponentMutation) AddedIDs(name string) []ent.Value {
+func (m *HostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case component.EdgeDeploymentProfile:
-		if id := m.deployment_profile; id != nil {
+	case host.EdgeSite:
+		if id := m.site; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1813,89 +10727,90 @@ func (m *ComponentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ComponentMutation) RemovedEdges() []string {
+func (m *HostMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ComponentMutation) RemovedIDs(name string) []ent.Value {
+func (m *HostMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ComponentMutation) ClearedEdges() []string {
+func (m *HostMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddeployment_profile {
-		edges = append(edges, component.EdgeDeploymentProfile)
+	if m.clearedsite {
+		edges = append(edges, host.EdgeSite)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ComponentMutation) EdgeCleared(name string) bool {
+func (m *HostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case component.EdgeDeploymentProfile:
-		return m.cleareddeployment_profile
+	case host.EdgeSite:
+		return m.clearedsite
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ComponentMutation) ClearEdge(name string) error {
+func (m *HostMutation) ClearEdge(name string) error {
 	switch name {
-	case component.EdgeDeploymentProfile:
-		m.ClearDeploymentProfile()
+	case host.EdgeSite:
+		m.ClearSite()
 		return nil
 	}
-	return fmt.Errorf("unknown Component unique edge %s", name)
+	return fmt.Errorf("unknown Host unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ComponentMutation) ResetEdge(name string) error {
+func (m *HostMutation) ResetEdge(name string) error {
 	switch name {
-	case component.EdgeDeploymentProfile:
-		m.ResetDeploymentProfile()
+	case host.EdgeSite:
+		m.ResetSite()
 		return nil
 	}
-	return fmt.Errorf("unknown Component edge %s", name)
+	return fmt.Errorf("unknown Host edge %s", name)
 }
 
-// DeploymentComponentStatusMutation represents an operation that mutates the DeploymentComponentStatus nodes in the graph.
-type DeploymentComponentStatusMutation struct {
+// OrchestratorMutation represents an operation that mutates the Orchestrator nodes in the graph.
+type OrchestratorMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	name              *string
-	state             *string
-	error_code        *string
-	error_message     *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	deployment        *uuid.UUID
-	cleareddeployment bool
-	done              bool
-	oldValue          func(context.Context) (*DeploymentComponentStatus, error)
-	predicates        []predicate.DeploymentComponentStatus
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	_type         *string
+	region        *string
+	api_endpoint  *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	sites         map[uuid.UUID]struct{}
+	removedsites  map[uuid.UUID]struct{}
+	clearedsites  bool
+	done          bool
+	oldValue      func(context.Context) (*Orchestrator, error)
+	predicates    []predicate.Orchestrator
 }
 
-var _ ent.Mutation = (*DeploymentComponentStatusMutation)(nil)
+var _ ent.Mutation = (*OrchestratorMutation)(nil)
 
-// deploymentcomponentstatusOption allows management of the mutation configuration using functional options.
-type deploymentcomponentstatusOption func(*DeploymentComponentStatusMutation)
+// orchestratorOption allows management of the mutation configuration using functional options.
+type orchestratorOption func(*OrchestratorMutation)
 
-// newDeploymentComponentStatusMutation creates new mutation for the DeploymentComponentStatus entity.
-func newDeploymentComponentStatusMutation(c config, op Op, opts ...deploymentcomponentstatusOption) *DeploymentComponentStatusMutation {
-	m := &DeploymentComponentStatusMutation{
+// newOrchestratorMutation creates new mutation for the Orchestrator entity.
+func newOrchestratorMutation(c config, op Op, opts ...orchestratorOption) *OrchestratorMutation {
+	m := &OrchestratorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentComponentStatus,
+		typ:           TypeOrchestrator,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1904,20 +10819,20 @@ func newDeploymentComponentStatusMutation(c config, op Op, opts ...deploymentcom
 	return m
 }
 
-// withDeploymentComponentStatusID sets the ID field of the mutation.
-func withDeploymentComponentStatusID(id uuid.UUID) deploymentcomponentstatusOption {
-	return func(m *DeploymentComponentStatusMutation) {
+// withOrchestratorID sets the ID field of the mutation.
+func withOrchestratorID(id uuid.UUID) orchestratorOption {
+	return func(m *OrchestratorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentComponentStatus
+			value *Orchestrator
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentComponentStatus, error) {
+		m.oldValue = func(ctx context.Context) (*Orchestrator, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentComponentStatus.Get(ctx, id)
+					value, err = m.Client().Orchestrator.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1926,10 +10841,10 @@ func withDeploymentComponentStatusID(id uuid.UUID) deploymentcomponentstatusOpti
 	}
 }
 
-// withDeploymentComponentStatus sets the old DeploymentComponentStatus of the mutation.
-func withDeploymentComponentStatus(node *DeploymentComponentStatus) deploymentcomponentstatusOption {
-	return func(m *DeploymentComponentStatusMutation) {
-		m.oldValue = func(context.Context) (*DeploymentComponentStatus, error) {
+// withOrchestrator sets the old Orchestrator of the mutation.
+func withOrchestrator(node *Orchestrator) orchestratorOption {
+	return func(m *OrchestratorMutation) {
+		m.oldValue = func(context.Context) (*Orchestrator, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1938,7 +10853,7 @@ func withDeploymentComponentStatus(node *DeploymentComponentStatus) deploymentco
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentComponentStatusMutation) Client() *Client {
+func (m OrchestratorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1946,7 +10861,7 @@ func (m DeploymentComponentStatusMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentComponentStatusMutation) Tx() (*Tx, error) {
+func (m OrchestratorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1956,14 +10871,14 @@ func (m DeploymentComponentStatusMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentComponentStatus entities.
-func (m *DeploymentComponentStatusMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Orchestrator entities.
+func (m *OrchestratorMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentComponentStatusMutation) ID() (id uuid.UUID, exists bool) {
+func (m *OrchestratorMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1974,7 +10889,7 @@ func (m *DeploymentComponentStatusMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentComponentStatusMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *OrchestratorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1983,19 +10898,19 @@ func (m *DeploymentComponentStatusMutation) IDs(ctx context.Context) ([]uuid.UUI
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentComponentStatus.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Orchestrator.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *DeploymentComponentStatusMutation) SetName(s string) {
+func (m *OrchestratorMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DeploymentComponentStatusMutation) Name() (r string, exists bool) {
+func (m *OrchestratorMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -2003,10 +10918,10 @@ func (m *DeploymentComponentStatusMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *OrchestratorMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -2020,152 +10935,178 @@ func (m *DeploymentComponentStatusMutation) OldName(ctx context.Context) (v stri
 	return oldValue.Name, nil
 }
 
+// ClearName clears the value of the "name" field.
+func (m *OrchestratorMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[orchestrator.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *OrchestratorMutation) NameCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldName]
+	return ok
+}
+
 // ResetName resets all changes to the "name" field.
-func (m *DeploymentComponentStatusMutation) ResetName() {
+func (m *OrchestratorMutation) ResetName() {
 	m.name = nil
+	delete(m.clearedFields, orchestrator.FieldName)
 }
 
-// SetState sets the "state" field.
-func (m *DeploymentComponentStatusMutation) SetState(s string) {
-	m.state = &s
+// SetType sets the "type" field.
+func (m *OrchestratorMutation) SetType(s string) {
+	m._type = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *DeploymentComponentStatusMutation) State() (r string, exists bool) {
-	v := m.state
+// GetType returns the value of the "type" field in the mutation.
+func (m *OrchestratorMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *OrchestratorMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.Type, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *DeploymentComponentStatusMutation) ResetState() {
-	m.state = nil
+// ClearType clears the value of the "type" field.
+func (m *OrchestratorMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[orchestrator.FieldType] = struct{}{}
 }
 
-// SetErrorCode sets the "error_code" field.
-func (m *DeploymentComponentStatusMutation) SetErrorCode(s string) {
-	m.error_code = &s
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *OrchestratorMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldType]
+	return ok
 }
 
-// ErrorCode returns the value of the "error_code" field in the mutation.
-func (m *DeploymentComponentStatusMutation) ErrorCode() (r string, exists bool) {
-	v := m.error_code
+// ResetType resets all changes to the "type" field.
+func (m *OrchestratorMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, orchestrator.FieldType)
+}
+
+// SetRegion sets the "region" field.
+func (m *OrchestratorMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *OrchestratorMutation) Region() (r string, exists bool) {
+	v := m.region
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorCode returns the old "error_code" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldRegion returns the old "region" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+func (m *OrchestratorMutation) OldRegion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+		return v, errors.New("OldRegion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
 	}
-	return oldValue.ErrorCode, nil
+	return oldValue.Region, nil
 }
 
-// ClearErrorCode clears the value of the "error_code" field.
-func (m *DeploymentComponentStatusMutation) ClearErrorCode() {
-	m.error_code = nil
-	m.clearedFields[deploymentcomponentstatus.FieldErrorCode] = struct{}{}
+// ClearRegion clears the value of the "region" field.
+func (m *OrchestratorMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[orchestrator.FieldRegion] = struct{}{}
 }
 
-// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
-func (m *DeploymentComponentStatusMutation) ErrorCodeCleared() bool {
-	_, ok := m.clearedFields[deploymentcomponentstatus.FieldErrorCode]
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *OrchestratorMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldRegion]
 	return ok
 }
 
-// ResetErrorCode resets all changes to the "error_code" field.
-func (m *DeploymentComponentStatusMutation) ResetErrorCode() {
-	m.error_code = nil
-	delete(m.clearedFields, deploymentcomponentstatus.FieldErrorCode)
+// ResetRegion resets all changes to the "region" field.
+func (m *OrchestratorMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, orchestrator.FieldRegion)
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *DeploymentComponentStatusMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetAPIEndpoint sets the "api_endpoint" field.
+func (m *OrchestratorMutation) SetAPIEndpoint(s string) {
+	m.api_endpoint = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *DeploymentComponentStatusMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// APIEndpoint returns the value of the "api_endpoint" field in the mutation.
+func (m *OrchestratorMutation) APIEndpoint() (r string, exists bool) {
+	v := m.api_endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIEndpoint returns the old "api_endpoint" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *OrchestratorMutation) OldAPIEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldAPIEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIEndpoint: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.APIEndpoint, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *DeploymentComponentStatusMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[deploymentcomponentstatus.FieldErrorMessage] = struct{}{}
+// ClearAPIEndpoint clears the value of the "api_endpoint" field.
+func (m *OrchestratorMutation) ClearAPIEndpoint() {
+	m.api_endpoint = nil
+	m.clearedFields[orchestrator.FieldAPIEndpoint] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *DeploymentComponentStatusMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[deploymentcomponentstatus.FieldErrorMessage]
+// APIEndpointCleared returns if the "api_endpoint" field was cleared in this mutation.
+func (m *OrchestratorMutation) APIEndpointCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldAPIEndpoint]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *DeploymentComponentStatusMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, deploymentcomponentstatus.FieldErrorMessage)
+// ResetAPIEndpoint resets all changes to the "api_endpoint" field.
+func (m *OrchestratorMutation) ResetAPIEndpoint() {
+	m.api_endpoint = nil
+	delete(m.clearedFields, orchestrator.FieldAPIEndpoint)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DeploymentComponentStatusMutation) SetCreatedAt(t time.Time) {
+func (m *OrchestratorMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeploymentComponentStatusMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *OrchestratorMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2173,10 +11114,10 @@ func (m *DeploymentComponentStatusMutation) CreatedAt() (r time.Time, exists boo
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OrchestratorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2190,18 +11131,31 @@ func (m *DeploymentComponentStatusMutation) OldCreatedAt(ctx context.Context) (v
 	return oldValue.CreatedAt, nil
 }
 
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *OrchestratorMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[orchestrator.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *OrchestratorMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldCreatedAt]
+	return ok
+}
+
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeploymentComponentStatusMutation) ResetCreatedAt() {
+func (m *OrchestratorMutation) ResetCreatedAt() {
 	m.created_at = nil
+	delete(m.clearedFields, orchestrator.FieldCreatedAt)
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DeploymentComponentStatusMutation) SetUpdatedAt(t time.Time) {
+func (m *OrchestratorMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeploymentComponentStatusMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *OrchestratorMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2209,10 +11163,10 @@ func (m *DeploymentComponentStatusMutation) UpdatedAt() (r time.Time, exists boo
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DeploymentComponentStatus entity.
-// If the DeploymentComponentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Orchestrator entity.
+// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentComponentStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OrchestratorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2226,59 +11180,87 @@ func (m *DeploymentComponentStatusMutation) OldUpdatedAt(ctx context.Context) (v
 	return oldValue.UpdatedAt, nil
 }
 
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *OrchestratorMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[orchestrator.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *OrchestratorMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[orchestrator.FieldUpdatedAt]
+	return ok
+}
+
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeploymentComponentStatusMutation) ResetUpdatedAt() {
+func (m *OrchestratorMutation) ResetUpdatedAt() {
 	m.updated_at = nil
+	delete(m.clearedFields, orchestrator.FieldUpdatedAt)
 }
 
-// SetDeploymentID sets the "deployment" edge to the DeploymentStatus entity by id.
-func (m *DeploymentComponentStatusMutation) SetDeploymentID(id uuid.UUID) {
-	m.deployment = &id
+// AddSiteIDs adds the "sites" edge to the Site entity by ids.
+func (m *OrchestratorMutation) AddSiteIDs(ids ...uuid.UUID) {
+	if m.sites == nil {
+		m.sites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sites[ids[i]] = struct{}{}
+	}
 }
 
-// ClearDeployment clears the "deployment" edge to the DeploymentStatus entity.
-func (m *DeploymentComponentStatusMutation) ClearDeployment() {
-	m.cleareddeployment = true
+// ClearSites clears the "sites" edge to the Site entity.
+func (m *OrchestratorMutation) ClearSites() {
+	m.clearedsites = true
 }
 
-// DeploymentCleared reports if the "deployment" edge to the DeploymentStatus entity was cleared.
-func (m *DeploymentComponentStatusMutation) DeploymentCleared() bool {
-	return m.cleareddeployment
+// SitesCleared reports if the "sites" edge to the Site entity was cleared.
+func (m *OrchestratorMutation) SitesCleared() bool {
+	return m.clearedsites
 }
 
-// DeploymentID returns the "deployment" edge ID in the mutation.
-func (m *DeploymentComponentStatusMutation) DeploymentID() (id uuid.UUID, exists bool) {
-	if m.deployment != nil {
-		return *m.deployment, true
+// RemoveSiteIDs removes the "sites" edge to the Site entity by IDs.
+func (m *OrchestratorMutation) RemoveSiteIDs(ids ...uuid.UUID) {
+	if m.removedsites == nil {
+		m.removedsites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sites, ids[i])
+		m.removedsites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSites returns the removed IDs of the "sites" edge to the Site entity.
+func (m *OrchestratorMutation) RemovedSitesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsites {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// DeploymentIDs returns the "deployment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentID instead. It exists only for internal usage by the builders.
-func (m *DeploymentComponentStatusMutation) DeploymentIDs() (ids []uuid.UUID) {
-	if id := m.deployment; id != nil {
-		ids = append(ids, *id)
+// SitesIDs returns the "sites" edge IDs in the mutation.
+func (m *OrchestratorMutation) SitesIDs() (ids []uuid.UUID) {
+	for id := range m.sites {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDeployment resets all changes to the "deployment" edge.
-func (m *DeploymentComponentStatusMutation) ResetDeployment() {
-	m.deployment = nil
-	m.cleareddeployment = false
+// ResetSites resets all changes to the "sites" edge.
+func (m *OrchestratorMutation) ResetSites() {
+	m.sites = nil
+	m.clearedsites = false
+	m.removedsites = nil
 }
 
-// Where appends a list predicates to the DeploymentComponentStatusMutation builder.
-func (m *DeploymentComponentStatusMutation) Where(ps ...predicate.DeploymentComponentStatus) {
+// Where appends a list predicates to the OrchestratorMutation builder.
+func (m *OrchestratorMutation) Where(ps ...predicate.Orchestrator) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentComponentStatusMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OrchestratorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentComponentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentComponentStatus, len(ps))
+func (m *OrchestratorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Orchestrator, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2286,42 +11268,42 @@ func (m *DeploymentComponentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentComponentStatusMutation) Op() Op {
+func (m *OrchestratorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentComponentStatusMutation) SetOp(op Op) {
+func (m *OrchestratorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentComponentStatus).
-func (m *DeploymentComponentStatusMutation) Type() string {
+// Type returns the node type of this mutation (Orchestrator).
+func (m *OrchestratorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentComponentStatusMutation) Fields() []string {
+func (m *OrchestratorMutation) Fields() []string {
 	fields := make([]string, 0, 6)
 	if m.name != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldName)
+		fields = append(fields, orchestrator.FieldName)
 	}
-	if m.state != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldState)
+	if m._type != nil {
+		fields = append(fields, orchestrator.FieldType)
 	}
-	if m.error_code != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldErrorCode)
+	if m.region != nil {
+		fields = append(fields, orchestrator.FieldRegion)
 	}
-	if m.error_message != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldErrorMessage)
+	if m.api_endpoint != nil {
+		fields = append(fields, orchestrator.FieldAPIEndpoint)
 	}
 	if m.created_at != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldCreatedAt)
+		fields = append(fields, orchestrator.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, deploymentcomponentstatus.FieldUpdatedAt)
+		fields = append(fields, orchestrator.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2329,19 +11311,19 @@ func (m *DeploymentComponentStatusMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentComponentStatusMutation) Field(name string) (ent.Value, bool) {
+func (m *OrchestratorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentcomponentstatus.FieldName:
+	case orchestrator.FieldName:
 		return m.Name()
-	case deploymentcomponentstatus.FieldState:
-		return m.State()
-	case deploymentcomponentstatus.FieldErrorCode:
-		return m.ErrorCode()
-	case deploymentcomponentstatus.FieldErrorMessage:
-		return m.ErrorMessage()
-	case deploymentcomponentstatus.FieldCreatedAt:
+	case orchestrator.FieldType:
+		return m.GetType()
+	case orchestrator.FieldRegion:
+		return m.Region()
+	case orchestrator.FieldAPIEndpoint:
+		return m.APIEndpoint()
+	case orchestrator.FieldCreatedAt:
 		return m.CreatedAt()
-	case deploymentcomponentstatus.FieldUpdatedAt:
+	case orchestrator.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2350,65 +11332,65 @@ func (m *DeploymentComponentStatusMutation) Field(name string) (ent.Value, bool)
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentComponentStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OrchestratorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentcomponentstatus.FieldName:
+	case orchestrator.FieldName:
 		return m.OldName(ctx)
-	case deploymentcomponentstatus.FieldState:
-		return m.OldState(ctx)
-	case deploymentcomponentstatus.FieldErrorCode:
-		return m.OldErrorCode(ctx)
-	case deploymentcomponentstatus.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case deploymentcomponentstatus.FieldCreatedAt:
+	case orchestrator.FieldType:
+		return m.OldType(ctx)
+	case orchestrator.FieldRegion:
+		return m.OldRegion(ctx)
+	case orchestrator.FieldAPIEndpoint:
+		return m.OldAPIEndpoint(ctx)
+	case orchestrator.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case deploymentcomponentstatus.FieldUpdatedAt:
+	case orchestrator.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+	return nil, fmt.Errorf("unknown Orchestrator field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentComponentStatusMutation) SetField(name string, value ent.Value) error {
+func (m *OrchestratorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentcomponentstatus.FieldName:
+	case orchestrator.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case deploymentcomponentstatus.FieldState:
+	case orchestrator.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.SetType(v)
 		return nil
-	case deploymentcomponentstatus.FieldErrorCode:
+	case orchestrator.FieldRegion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorCode(v)
+		m.SetRegion(v)
 		return nil
-	case deploymentcomponentstatus.FieldErrorMessage:
+	case orchestrator.FieldAPIEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetAPIEndpoint(v)
 		return nil
-	case deploymentcomponentstatus.FieldCreatedAt:
+	case orchestrator.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case deploymentcomponentstatus.FieldUpdatedAt:
+	case orchestrator.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2416,205 +11398,245 @@ func (m *DeploymentComponentStatusMutation) SetField(name string, value ent.Valu
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+	return fmt.Errorf("unknown Orchestrator field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentComponentStatusMutation) AddedFields() []string {
+func (m *OrchestratorMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentComponentStatusMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OrchestratorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentComponentStatusMutation) AddField(name string, value ent.Value) error {
+func (m *OrchestratorMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus numeric field %s", name)
+	return fmt.Errorf("unknown Orchestrator numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentComponentStatusMutation) ClearedFields() []string {
+func (m *OrchestratorMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deploymentcomponentstatus.FieldErrorCode) {
-		fields = append(fields, deploymentcomponentstatus.FieldErrorCode)
+	if m.FieldCleared(orchestrator.FieldName) {
+		fields = append(fields, orchestrator.FieldName)
 	}
-	if m.FieldCleared(deploymentcomponentstatus.FieldErrorMessage) {
-		fields = append(fields, deploymentcomponentstatus.FieldErrorMessage)
+	if m.FieldCleared(orchestrator.FieldType) {
+		fields = append(fields, orchestrator.FieldType)
+	}
+	if m.FieldCleared(orchestrator.FieldRegion) {
+		fields = append(fields, orchestrator.FieldRegion)
+	}
+	if m.FieldCleared(orchestrator.FieldAPIEndpoint) {
+		fields = append(fields, orchestrator.FieldAPIEndpoint)
+	}
+	if m.FieldCleared(orchestrator.FieldCreatedAt) {
+		fields = append(fields, orchestrator.FieldCreatedAt)
+	}
+	if m.FieldCleared(orchestrator.FieldUpdatedAt) {
+		fields = append(fields, orchestrator.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentComponentStatusMutation) FieldCleared(name string) bool {
+func (m *OrchestratorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentComponentStatusMutation) ClearField(name string) error {
+func (m *OrchestratorMutation) ClearField(name string) error {
 	switch name {
-	case deploymentcomponentstatus.FieldErrorCode:
-		m.ClearErrorCode()
+	case orchestrator.FieldName:
+		m.ClearName()
 		return nil
-	case deploymentcomponentstatus.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case orchestrator.FieldType:
+		m.ClearType()
+		return nil
+	case orchestrator.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case orchestrator.FieldAPIEndpoint:
+		m.ClearAPIEndpoint()
+		return nil
+	case orchestrator.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case orchestrator.FieldUpdatedAt:
+		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus nullable field %s", name)
+	return fmt.Errorf("unknown Orchestrator nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentComponentStatusMutation) ResetField(name string) error {
+func (m *OrchestratorMutation) ResetField(name string) error {
 	switch name {
-	case deploymentcomponentstatus.FieldName:
+	case orchestrator.FieldName:
 		m.ResetName()
 		return nil
-	case deploymentcomponentstatus.FieldState:
-		m.ResetState()
+	case orchestrator.FieldType:
+		m.ResetType()
 		return nil
-	case deploymentcomponentstatus.FieldErrorCode:
-		m.ResetErrorCode()
+	case orchestrator.FieldRegion:
+		m.ResetRegion()
 		return nil
-	case deploymentcomponentstatus.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case orchestrator.FieldAPIEndpoint:
+		m.ResetAPIEndpoint()
 		return nil
-	case deploymentcomponentstatus.FieldCreatedAt:
+	case orchestrator.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case deploymentcomponentstatus.FieldUpdatedAt:
+	case orchestrator.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus field %s", name)
+	return fmt.Errorf("unknown Orchestrator field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentComponentStatusMutation) AddedEdges() []string {
+func (m *OrchestratorMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.deployment != nil {
-		edges = append(edges, deploymentcomponentstatus.EdgeDeployment)
+	if m.sites != nil {
+		edges = append(edges, orchestrator.EdgeSites)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentComponentStatusMutation) AddedIDs(name string) []ent.Value {
+func (m *OrchestratorMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentcomponentstatus.EdgeDeployment:
-		if id := m.deployment; id != nil {
-			return []ent.Value{*id}
+	case orchestrator.EdgeSites:
+		ids := make([]ent.Value, 0, len(m.sites))
+		for id := range m.sites {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentComponentStatusMutation) RemovedEdges() []string {
+func (m *OrchestratorMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedsites != nil {
+		edges = append(edges, orchestrator.EdgeSites)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentComponentStatusMutation) RemovedIDs(name string) []ent.Value {
+func (m *OrchestratorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case orchestrator.EdgeSites:
+		ids := make([]ent.Value, 0, len(m.removedsites))
+		for id := range m.removedsites {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentComponentStatusMutation) ClearedEdges() []string {
+func (m *OrchestratorMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddeployment {
-		edges = append(edges, deploymentcomponentstatus.EdgeDeployment)
+	if m.clearedsites {
+		edges = append(edges, orchestrator.EdgeSites)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentComponentStatusMutation) EdgeCleared(name string) bool {
+func (m *OrchestratorMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentcomponentstatus.EdgeDeployment:
-		return m.cleareddeployment
+	case orchestrator.EdgeSites:
+		return m.clearedsites
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentComponentStatusMutation) ClearEdge(name string) error {
+func (m *OrchestratorMutation) ClearEdge(name string) error {
 	switch name {
-	case deploymentcomponentstatus.EdgeDeployment:
-		m.ClearDeployment()
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus unique edge %s", name)
+	return fmt.Errorf("unknown Orchestrator unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentComponentStatusMutation) ResetEdge(name string) error {
+func (m *OrchestratorMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentcomponentstatus.EdgeDeployment:
-		m.ResetDeployment()
+	case orchestrator.EdgeSites:
+		m.ResetSites()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentComponentStatus edge %s", name)
+	return fmt.Errorf("unknown Orchestrator edge %s", name)
 }
 
-// DeploymentProfileMutation represents an operation that mutates the DeploymentProfile nodes in the graph.
-type DeploymentProfileMutation struct {
+// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
+type OrganizationMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	_type                   *string
-	description             *string
-	cpu_cores               *float64
-	addcpu_cores            *float64
-	memory                  *string
-	storage                 *string
-	cpu_architectures       *[]string
-	appendcpu_architectures []string
-	peripherals             *[]map[string]interface{}
-	appendperipherals       []map[string]interface{}
-	interfaces              *[]map[string]interface{}
-	appendinterfaces        []map[string]interface{}
-	clearedFields           map[string]struct{}
-	components              map[uuid.UUID]struct{}
-	removedcomponents       map[uuid.UUID]struct{}
-	clearedcomponents       bool
-	application_desc        *uuid.UUID
-	clearedapplication_desc bool
-	done                    bool
-	oldValue                func(context.Context) (*DeploymentProfile, error)
-	predicates              []predicate.DeploymentProfile
-}
-
-var _ ent.Mutation = (*DeploymentProfileMutation)(nil)
-
-// deploymentprofileOption allows management of the mutation configuration using functional options.
-type deploymentprofileOption func(*DeploymentProfileMutation)
-
-// newDeploymentProfileMutation creates new mutation for the DeploymentProfile entity.
-func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOption) *DeploymentProfileMutation {
-	m := &DeploymentProfileMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	name               *string
+	display_name       *string
+	max_sites          *int
+	addmax_sites       *int
+	max_deployments    *int
+	addmax_deployments *int
+	max_apps           *int
+	addmax_apps        *int
+	max_users          *int
+	addmax_users       *int
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	apps               map[uuid.UUID]struct{}
+	removedapps        map[uuid.UUID]struct{}
+	clearedapps        bool
+	sites              map[uuid.UUID]struct{}
+	removedsites       map[uuid.UUID]struct{}
+	clearedsites       bool
+	deployments        map[uuid.UUID]struct{}
+	removeddeployments map[uuid.UUID]struct{}
+	cleareddeployments bool
+	users              map[int]struct{}
+	removedusers       map[int]struct{}
+	clearedusers       bool
+	done               bool
+	oldValue           func(context.Context) (*Organization, error)
+	predicates         []predicate.Organization
+}
+
+var _ ent.Mutation = (*OrganizationMutation)(nil)
+
+// organizationOption allows management of the mutation configuration using functional options.
+type organizationOption func(*OrganizationMutation)
+
+// newOrganizationMutation creates new mutation for the Organization entity.
+func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
+	m := &OrganizationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentProfile,
+		typ:           TypeOrganization,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2623,20 +11645,20 @@ func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOpti
 	return m
 }
 
-// withDeploymentProfileID sets the ID field of the mutation.
-func withDeploymentProfileID(id uuid.UUID) deploymentprofileOption {
-	return func(m *DeploymentProfileMutation) {
+// withOrganizationID sets the ID field of the mutation.
+func withOrganizationID(id uuid.UUID) organizationOption {
+	return func(m *OrganizationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentProfile
+			value *Organization
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentProfile, error) {
+		m.oldValue = func(ctx context.Context) (*Organization, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentProfile.Get(ctx, id)
+					value, err = m.Client().Organization.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2645,10 +11667,10 @@ func withDeploymentProfileID(id uuid.UUID) deploymentprofileOption {
 	}
 }
 
-// withDeploymentProfile sets the old DeploymentProfile of the mutation.
-func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
-	return func(m *DeploymentProfileMutation) {
-		m.oldValue = func(context.Context) (*DeploymentProfile, error) {
+// withOrganization sets the old Organization of the mutation.
+func withOrganization(node *Organization) organizationOption {
+	return func(m *OrganizationMutation) {
+		m.oldValue = func(context.Context) (*Organization, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2657,7 +11679,7 @@ func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentProfileMutation) Client() *Client {
+func (m OrganizationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2665,7 +11687,7 @@ func (m DeploymentProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentProfileMutation) Tx() (*Tx, error) {
+func (m OrganizationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2675,14 +11697,14 @@ func (m DeploymentProfileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentProfile entities.
-func (m *DeploymentProfileMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Organization entities.
+func (m *OrganizationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentProfileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2693,7 +11715,7 @@ func (m *DeploymentProfileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2702,625 +11724,582 @@ func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentProfile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *DeploymentProfileMutation) SetType(s string) {
-	m._type = &s
+// SetName sets the "name" field.
+func (m *OrganizationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *DeploymentProfileMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ClearType clears the value of the "type" field.
-func (m *DeploymentProfileMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[deploymentprofile.FieldType] = struct{}{}
-}
-
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldType]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *DeploymentProfileMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, deploymentprofile.FieldType)
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *DeploymentProfileMutation) SetDescription(s string) {
-	m.description = &s
+// SetDisplayName sets the "display_name" field.
+func (m *OrganizationMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DeploymentProfileMutation) Description() (r string, exists bool) {
-	v := m.description
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *OrganizationMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayName returns the old "display_name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *OrganizationMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.DisplayName, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *DeploymentProfileMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[deploymentprofile.FieldDescription] = struct{}{}
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *OrganizationMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[organization.FieldDisplayName] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldDescription]
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *OrganizationMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[organization.FieldDisplayName]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DeploymentProfileMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, deploymentprofile.FieldDescription)
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *OrganizationMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, organization.FieldDisplayName)
 }
 
-// SetCPUCores sets the "cpu_cores" field.
-func (m *DeploymentProfileMutation) SetCPUCores(f float64) {
-	m.cpu_cores = &f
-	m.addcpu_cores = nil
+// SetMaxSites sets the "max_sites" field.
+func (m *OrganizationMutation) SetMaxSites(i int) {
+	m.max_sites = &i
+	m.addmax_sites = nil
 }
 
-// CPUCores returns the value of the "cpu_cores" field in the mutation.
-func (m *DeploymentProfileMutation) CPUCores() (r float64, exists bool) {
-	v := m.cpu_cores
+// MaxSites returns the value of the "max_sites" field in the mutation.
+func (m *OrganizationMutation) MaxSites() (r int, exists bool) {
+	v := m.max_sites
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCPUCores returns the old "cpu_cores" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxSites returns the old "max_sites" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldCPUCores(ctx context.Context) (v float64, err error) {
+func (m *OrganizationMutation) OldMaxSites(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCPUCores is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxSites is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCPUCores requires an ID field in the mutation")
+		return v, errors.New("OldMaxSites requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCPUCores: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxSites: %w", err)
 	}
-	return oldValue.CPUCores, nil
+	return oldValue.MaxSites, nil
 }
 
-// AddCPUCores adds f to the "cpu_cores" field.
-func (m *DeploymentProfileMutation) AddCPUCores(f float64) {
-	if m.addcpu_cores != nil {
-		*m.addcpu_cores += f
+// AddMaxSites adds i to the "max_sites" field.
+func (m *OrganizationMutation) AddMaxSites(i int) {
+	if m.addmax_sites != nil {
+		*m.addmax_sites += i
 	} else {
-		m.addcpu_cores = &f
-	}
-}
-
-// AddedCPUCores returns the value that was added to the "cpu_cores" field in this mutation.
-func (m *DeploymentProfileMutation) AddedCPUCores() (r float64, exists bool) {
-	v := m.addcpu_cores
-	if v == nil {
-		return
+		m.addmax_sites = &i
 	}
-	return *v, true
-}
-
-// ClearCPUCores clears the value of the "cpu_cores" field.
-func (m *DeploymentProfileMutation) ClearCPUCores() {
-	m.cpu_cores = nil
-	m.addcpu_cores = nil
-	m.clearedFields[deploymentprofile.FieldCPUCores] = struct{}{}
-}
-
-// CPUCoresCleared returns if the "cpu_cores" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) CPUCoresCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldCPUCores]
-	return ok
-}
-
-// ResetCPUCores resets all changes to the "cpu_cores" field.
-func (m *DeploymentProfileMutation) ResetCPUCores() {
-	m.cpu_cores = nil
-	m.addcpu_cores = nil
-	delete(m.clearedFields, deploymentprofile.FieldCPUCores)
-}
-
-// SetMemory sets the "memory" field.
-func (m *DeploymentProfileMutation) SetMemory(s string) {
-	m.memory = &s
 }
 
-// Memory returns the value of the "memory" field in the mutation.
-func (m *DeploymentProfileMutation) Memory() (r string, exists bool) {
-	v := m.memory
+// AddedMaxSites returns the value that was added to the "max_sites" field in this mutation.
+func (m *OrganizationMutation) AddedMaxSites() (r int, exists bool) {
+	v := m.addmax_sites
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMemory returns the old "memory" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldMemory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMemory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMemory requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMemory: %w", err)
-	}
-	return oldValue.Memory, nil
-}
-
-// ClearMemory clears the value of the "memory" field.
-func (m *DeploymentProfileMutation) ClearMemory() {
-	m.memory = nil
-	m.clearedFields[deploymentprofile.FieldMemory] = struct{}{}
-}
-
-// MemoryCleared returns if the "memory" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) MemoryCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldMemory]
-	return ok
-}
-
-// ResetMemory resets all changes to the "memory" field.
-func (m *DeploymentProfileMutation) ResetMemory() {
-	m.memory = nil
-	delete(m.clearedFields, deploymentprofile.FieldMemory)
+// ResetMaxSites resets all changes to the "max_sites" field.
+func (m *OrganizationMutation) ResetMaxSites() {
+	m.max_sites = nil
+	m.addmax_sites = nil
 }
 
-// SetStorage sets the "storage" field.
-func (m *DeploymentProfileMutation) SetStorage(s string) {
-	m.storage = &s
+// SetMaxDeployments sets the "max_deployments" field.
+func (m *OrganizationMutation) SetMaxDeployments(i int) {
+	m.max_deployments = &i
+	m.addmax_deployments = nil
 }
 
-// Storage returns the value of the "storage" field in the mutation.
-func (m *DeploymentProfileMutation) Storage() (r string, exists bool) {
-	v := m.storage
+// MaxDeployments returns the value of the "max_deployments" field in the mutation.
+func (m *OrganizationMutation) MaxDeployments() (r int, exists bool) {
+	v := m.max_deployments
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStorage returns the old "storage" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxDeployments returns the old "max_deployments" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldStorage(ctx context.Context) (v string, err error) {
+func (m *OrganizationMutation) OldMaxDeployments(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorage is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxDeployments is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorage requires an ID field in the mutation")
+		return v, errors.New("OldMaxDeployments requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorage: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxDeployments: %w", err)
 	}
-	return oldValue.Storage, nil
+	return oldValue.MaxDeployments, nil
 }
-
-// ClearStorage clears the value of the "storage" field.
-func (m *DeploymentProfileMutation) ClearStorage() {
-	m.storage = nil
-	m.clearedFields[deploymentprofile.FieldStorage] = struct{}{}
+
+// AddMaxDeployments adds i to the "max_deployments" field.
+func (m *OrganizationMutation) AddMaxDeployments(i int) {
+	if m.addmax_deployments != nil {
+		*m.addmax_deployments += i
+	} else {
+		m.addmax_deployments = &i
+	}
 }
 
-// StorageCleared returns if the "storage" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) StorageCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldStorage]
-	return ok
+// AddedMaxDeployments returns the value that was added to the "max_deployments" field in this mutation.
+func (m *OrganizationMutation) AddedMaxDeployments() (r int, exists bool) {
+	v := m.addmax_deployments
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetStorage resets all changes to the "storage" field.
-func (m *DeploymentProfileMutation) ResetStorage() {
-	m.storage = nil
-	delete(m.clearedFields, deploymentprofile.FieldStorage)
+// ResetMaxDeployments resets all changes to the "max_deployments" field.
+func (m *OrganizationMutation) ResetMaxDeployments() {
+	m.max_deployments = nil
+	m.addmax_deployments = nil
 }
 
-// SetCPUArchitectures sets the "cpu_architectures" field.
-func (m *DeploymentProfileMutation) SetCPUArchitectures(s []string) {
-	m.cpu_architectures = &s
-	m.appendcpu_architectures = nil
+// SetMaxApps sets the "max_apps" field.
+func (m *OrganizationMutation) SetMaxApps(i int) {
+	m.max_apps = &i
+	m.addmax_apps = nil
 }
 
-// CPUArchitectures returns the value of the "cpu_architectures" field in the mutation.
-func (m *DeploymentProfileMutation) CPUArchitectures() (r []string, exists bool) {
-	v := m.cpu_architectures
+// MaxApps returns the value of the "max_apps" field in the mutation.
+func (m *OrganizationMutation) MaxApps() (r int, exists bool) {
+	v := m.max_apps
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCPUArchitectures returns the old "cpu_architectures" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxApps returns the old "max_apps" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldCPUArchitectures(ctx context.Context) (v []string, err error) {
+func (m *OrganizationMutation) OldMaxApps(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCPUArchitectures is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxApps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCPUArchitectures requires an ID field in the mutation")
+		return v, errors.New("OldMaxApps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCPUArchitectures: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxApps: %w", err)
 	}
-	return oldValue.CPUArchitectures, nil
-}
-
-// AppendCPUArchitectures adds s to the "cpu_architectures" field.
-func (m *DeploymentProfileMutation) AppendCPUArchitectures(s []string) {
-	m.appendcpu_architectures = append(m.appendcpu_architectures, s...)
+	return oldValue.MaxApps, nil
 }
 
-// AppendedCPUArchitectures returns the list of values that were appended to the "cpu_architectures" field in this mutation.
-func (m *DeploymentProfileMutation) AppendedCPUArchitectures() ([]string, bool) {
-	if len(m.appendcpu_architectures) == 0 {
-		return nil, false
+// AddMaxApps adds i to the "max_apps" field.
+func (m *OrganizationMutation) AddMaxApps(i int) {
+	if m.addmax_apps != nil {
+		*m.addmax_apps += i
+	} else {
+		m.addmax_apps = &i
 	}
-	return m.appendcpu_architectures, true
 }
 
-// ClearCPUArchitectures clears the value of the "cpu_architectures" field.
-func (m *DeploymentProfileMutation) ClearCPUArchitectures() {
-	m.cpu_architectures = nil
-	m.appendcpu_architectures = nil
-	m.clearedFields[deploymentprofile.FieldCPUArchitectures] = struct{}{}
-}
-
-// CPUArchitecturesCleared returns if the "cpu_architectures" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) CPUArchitecturesCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldCPUArchitectures]
-	return ok
+// AddedMaxApps returns the value that was added to the "max_apps" field in this mutation.
+func (m *OrganizationMutation) AddedMaxApps() (r int, exists bool) {
+	v := m.addmax_apps
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCPUArchitectures resets all changes to the "cpu_architectures" field.
-func (m *DeploymentProfileMutation) ResetCPUArchitectures() {
-	m.cpu_architectures = nil
-	m.appendcpu_architectures = nil
-	delete(m.clearedFields, deploymentprofile.FieldCPUArchitectures)
+// ResetMaxApps resets all changes to the "max_apps" field.
+func (m *OrganizationMutation) ResetMaxApps() {
+	m.max_apps = nil
+	m.addmax_apps = nil
 }
 
-// SetPeripherals sets the "peripherals" field.
-func (m *DeploymentProfileMutation) SetPeripherals(value []map[string]interface{}) {
-	m.peripherals = &value
-	m.appendperipherals = nil
+// SetMaxUsers sets the "max_users" field.
+func (m *OrganizationMutation) SetMaxUsers(i int) {
+	m.max_users = &i
+	m.addmax_users = nil
 }
 
-// Peripherals returns the value of the "peripherals" field in the mutation.
-func (m *DeploymentProfileMutation) Peripherals() (r []map[string]interface{}, exists bool) {
-	v := m.peripherals
+// MaxUsers returns the value of the "max_users" field in the mutation.
+func (m *OrganizationMutation) MaxUsers() (r int, exists bool) {
+	v := m.max_users
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeripherals returns the old "peripherals" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxUsers returns the old "max_users" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldPeripherals(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *OrganizationMutation) OldMaxUsers(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeripherals is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxUsers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeripherals requires an ID field in the mutation")
+		return v, errors.New("OldMaxUsers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeripherals: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
 	}
-	return oldValue.Peripherals, nil
-}
-
-// AppendPeripherals adds value to the "peripherals" field.
-func (m *DeploymentProfileMutation) AppendPeripherals(value []map[string]interface{}) {
-	m.appendperipherals = append(m.appendperipherals, value...)
+	return oldValue.MaxUsers, nil
 }
 
-// AppendedPeripherals returns the list of values that were appended to the "peripherals" field in this mutation.
-func (m *DeploymentProfileMutation) AppendedPeripherals() ([]map[string]interface{}, bool) {
-	if len(m.appendperipherals) == 0 {
-		return nil, false
+// AddMaxUsers adds i to the "max_users" field.
+func (m *OrganizationMutation) AddMaxUsers(i int) {
+	if m.addmax_users != nil {
+		*m.addmax_users += i
+	} else {
+		m.addmax_users = &i
 	}
-	return m.appendperipherals, true
-}
-
-// ClearPeripherals clears the value of the "peripherals" field.
-func (m *DeploymentProfileMutation) ClearPeripherals() {
-	m.peripherals = nil
-	m.appendperipherals = nil
-	m.clearedFields[deploymentprofile.FieldPeripherals] = struct{}{}
 }
 
-// PeripheralsCleared returns if the "peripherals" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) PeripheralsCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldPeripherals]
-	return ok
+// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
+func (m *OrganizationMutation) AddedMaxUsers() (r int, exists bool) {
+	v := m.addmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPeripherals resets all changes to the "peripherals" field.
-func (m *DeploymentProfileMutation) ResetPeripherals() {
-	m.peripherals = nil
-	m.appendperipherals = nil
-	delete(m.clearedFields, deploymentprofile.FieldPeripherals)
+// ResetMaxUsers resets all changes to the "max_users" field.
+func (m *OrganizationMutation) ResetMaxUsers() {
+	m.max_users = nil
+	m.addmax_users = nil
 }
 
-// SetInterfaces sets the "interfaces" field.
-func (m *DeploymentProfileMutation) SetInterfaces(value []map[string]interface{}) {
-	m.interfaces = &value
-	m.appendinterfaces = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Interfaces returns the value of the "interfaces" field in the mutation.
-func (m *DeploymentProfileMutation) Interfaces() (r []map[string]interface{}, exists bool) {
-	v := m.interfaces
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInterfaces returns the old "interfaces" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldInterfaces(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterfaces is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterfaces requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterfaces: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Interfaces, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AppendInterfaces adds value to the "interfaces" field.
-func (m *DeploymentProfileMutation) AppendInterfaces(value []map[string]interface{}) {
-	m.appendinterfaces = append(m.appendinterfaces, value...)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AppendedInterfaces returns the list of values that were appended to the "interfaces" field in this mutation.
-func (m *DeploymentProfileMutation) AppendedInterfaces() ([]map[string]interface{}, bool) {
-	if len(m.appendinterfaces) == 0 {
-		return nil, false
+// AddAppIDs adds the "apps" edge to the ApplicationDesc entity by ids.
+func (m *OrganizationMutation) AddAppIDs(ids ...uuid.UUID) {
+	if m.apps == nil {
+		m.apps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.apps[ids[i]] = struct{}{}
 	}
-	return m.appendinterfaces, true
 }
 
-// ClearInterfaces clears the value of the "interfaces" field.
-func (m *DeploymentProfileMutation) ClearInterfaces() {
-	m.interfaces = nil
-	m.appendinterfaces = nil
-	m.clearedFields[deploymentprofile.FieldInterfaces] = struct{}{}
+// ClearApps clears the "apps" edge to the ApplicationDesc entity.
+func (m *OrganizationMutation) ClearApps() {
+	m.clearedapps = true
 }
 
-// InterfacesCleared returns if the "interfaces" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) InterfacesCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldInterfaces]
-	return ok
+// AppsCleared reports if the "apps" edge to the ApplicationDesc entity was cleared.
+func (m *OrganizationMutation) AppsCleared() bool {
+	return m.clearedapps
 }
 
-// ResetInterfaces resets all changes to the "interfaces" field.
-func (m *DeploymentProfileMutation) ResetInterfaces() {
-	m.interfaces = nil
-	m.appendinterfaces = nil
-	delete(m.clearedFields, deploymentprofile.FieldInterfaces)
+// RemoveAppIDs removes the "apps" edge to the ApplicationDesc entity by IDs.
+func (m *OrganizationMutation) RemoveAppIDs(ids ...uuid.UUID) {
+	if m.removedapps == nil {
+		m.removedapps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.apps, ids[i])
+		m.removedapps[ids[i]] = struct{}{}
+	}
 }
 
-// SetAppID sets the "app_id" field.
-func (m *DeploymentProfileMutation) SetAppID(u uuid.UUID) {
-	m.application_desc = &u
+// RemovedApps returns the removed IDs of the "apps" edge to the ApplicationDesc entity.
+func (m *OrganizationMutation) RemovedAppsIDs() (ids []uuid.UUID) {
+	for id := range m.removedapps {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AppID returns the value of the "app_id" field in the mutation.
-func (m *DeploymentProfileMutation) AppID() (r uuid.UUID, exists bool) {
-	v := m.application_desc
-	if v == nil {
-		return
+// AppsIDs returns the "apps" edge IDs in the mutation.
+func (m *OrganizationMutation) AppsIDs() (ids []uuid.UUID) {
+	for id := range m.apps {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldAppID returns the old "app_id" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldAppID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppID is only allowed on UpdateOne operations")
+// ResetApps resets all changes to the "apps" edge.
+func (m *OrganizationMutation) ResetApps() {
+	m.apps = nil
+	m.clearedapps = false
+	m.removedapps = nil
+}
+
+// AddSiteIDs adds the "sites" edge to the Site entity by ids.
+func (m *OrganizationMutation) AddSiteIDs(ids ...uuid.UUID) {
+	if m.sites == nil {
+		m.sites = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppID requires an ID field in the mutation")
+	for i := range ids {
+		m.sites[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppID: %w", err)
+}
+
+// ClearSites clears the "sites" edge to the Site entity.
+func (m *OrganizationMutation) ClearSites() {
+	m.clearedsites = true
+}
+
+// SitesCleared reports if the "sites" edge to the Site entity was cleared.
+func (m *OrganizationMutation) SitesCleared() bool {
+	return m.clearedsites
+}
+
+// RemoveSiteIDs removes the "sites" edge to the Site entity by IDs.
+func (m *OrganizationMutation) RemoveSiteIDs(ids ...uuid.UUID) {
+	if m.removedsites == nil {
+		m.removedsites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sites, ids[i])
+		m.removedsites[ids[i]] = struct{}{}
 	}
-	return oldValue.AppID, nil
 }
 
-// ClearAppID clears the value of the "app_id" field.
-func (m *DeploymentProfileMutation) ClearAppID() {
-	m.application_desc = nil
-	m.clearedFields[deploymentprofile.FieldAppID] = struct{}{}
+// RemovedSites returns the removed IDs of the "sites" edge to the Site entity.
+func (m *OrganizationMutation) RemovedSitesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsites {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AppIDCleared returns if the "app_id" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) AppIDCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldAppID]
-	return ok
+// SitesIDs returns the "sites" edge IDs in the mutation.
+func (m *OrganizationMutation) SitesIDs() (ids []uuid.UUID) {
+	for id := range m.sites {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetAppID resets all changes to the "app_id" field.
-func (m *DeploymentProfileMutation) ResetAppID() {
-	m.application_desc = nil
-	delete(m.clearedFields, deploymentprofile.FieldAppID)
+// ResetSites resets all changes to the "sites" edge.
+func (m *OrganizationMutation) ResetSites() {
+	m.sites = nil
+	m.clearedsites = false
+	m.removedsites = nil
 }
 
-// AddComponentIDs adds the "components" edge to the Component entity by ids.
-func (m *DeploymentProfileMutation) AddComponentIDs(ids ...uuid.UUID) {
-	if m.components == nil {
-		m.components = make(map[uuid.UUID]struct{})
+// AddDeploymentIDs adds the "deployments" edge to the DeploymentStatus entity by ids.
+func (m *OrganizationMutation) AddDeploymentIDs(ids ...uuid.UUID) {
+	if m.deployments == nil {
+		m.deployments = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.components[ids[i]] = struct{}{}
+		m.deployments[ids[i]] = struct{}{}
 	}
 }
 
-// ClearComponents clears the "components" edge to the Component entity.
-func (m *DeploymentProfileMutation) ClearComponents() {
-	m.clearedcomponents = true
+// ClearDeployments clears the "deployments" edge to the DeploymentStatus entity.
+func (m *OrganizationMutation) ClearDeployments() {
+	m.cleareddeployments = true
 }
 
-// ComponentsCleared reports if the "components" edge to the Component entity was cleared.
-func (m *DeploymentProfileMutation) ComponentsCleared() bool {
-	return m.clearedcomponents
+// DeploymentsCleared reports if the "deployments" edge to the DeploymentStatus entity was cleared.
+func (m *OrganizationMutation) DeploymentsCleared() bool {
+	return m.cleareddeployments
 }
 
-// RemoveComponentIDs removes the "components" edge to the Component entity by IDs.
-func (m *DeploymentProfileMutation) RemoveComponentIDs(ids ...uuid.UUID) {
-	if m.removedcomponents == nil {
-		m.removedcomponents = make(map[uuid.UUID]struct{})
+// RemoveDeploymentIDs removes the "deployments" edge to the DeploymentStatus entity by IDs.
+func (m *OrganizationMutation) RemoveDeploymentIDs(ids ...uuid.UUID) {
+	if m.removeddeployments == nil {
+		m.removeddeployments = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.components, ids[i])
-		m.removedcomponents[ids[i]] = struct{}{}
+		delete(m.deployments, ids[i])
+		m.removeddeployments[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedComponents returns the removed IDs of the "components" edge to the Component entity.
-func (m *DeploymentProfileMutation) RemovedComponentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomponents {
+// RemovedDeployments returns the removed IDs of the "deployments" edge to the DeploymentStatus entity.
+func (m *OrganizationMutation) RemovedDeploymentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeployments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ComponentsIDs returns the "components" edge IDs in the mutation.
-func (m *DeploymentProfileMutation) ComponentsIDs() (ids []uuid.UUID) {
-	for id := range m.components {
+// DeploymentsIDs returns the "deployments" edge IDs in the mutation.
+func (m *OrganizationMutation) DeploymentsIDs() (ids []uuid.UUID) {
+	for id := range m.deployments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetComponents resets all changes to the "components" edge.
-func (m *DeploymentProfileMutation) ResetComponents() {
-	m.components = nil
-	m.clearedcomponents = false
-	m.removedcomponents = nil
+// ResetDeployments resets all changes to the "deployments" edge.
+func (m *OrganizationMutation) ResetDeployments() {
+	m.deployments = nil
+	m.cleareddeployments = false
+	m.removeddeployments = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *OrganizationMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
 }
 
-// SetApplicationDescID sets the "application_desc" edge to the ApplicationDesc entity by id.
-func (m *DeploymentProfileMutation) SetApplicationDescID(id uuid.UUID) {
-	m.application_desc = &id
+// ClearUsers clears the "users" edge to the User entity.
+func (m *OrganizationMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// ClearApplicationDesc clears the "application_desc" edge to the ApplicationDesc entity.
-func (m *DeploymentProfileMutation) ClearApplicationDesc() {
-	m.clearedapplication_desc = true
-	m.clearedFields[deploymentprofile.FieldAppID] = struct{}{}
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *OrganizationMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// ApplicationDescCleared reports if the "application_desc" edge to the ApplicationDesc entity was cleared.
-func (m *DeploymentProfileMutation) ApplicationDescCleared() bool {
-	return m.AppIDCleared() || m.clearedapplication_desc
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *OrganizationMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
 }
 
-// ApplicationDescID returns the "application_desc" edge ID in the mutation.
-func (m *DeploymentProfileMutation) ApplicationDescID() (id uuid.UUID, exists bool) {
-	if m.application_desc != nil {
-		return *m.application_desc, true
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *OrganizationMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ApplicationDescIDs returns the "application_desc" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApplicationDescID instead. It exists only for internal usage by the builders.
-func (m *DeploymentProfileMutation) ApplicationDescIDs() (ids []uuid.UUID) {
-	if id := m.application_desc; id != nil {
-		ids = append(ids, *id)
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *OrganizationMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetApplicationDesc resets all changes to the "application_desc" edge.
-func (m *DeploymentProfileMutation) ResetApplicationDesc() {
-	m.application_desc = nil
-	m.clearedapplication_desc = false
+// ResetUsers resets all changes to the "users" edge.
+func (m *OrganizationMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// Where appends a list predicates to the DeploymentProfileMutation builder.
-func (m *DeploymentProfileMutation) Where(ps ...predicate.DeploymentProfile) {
+// Where appends a list predicates to the OrganizationMutation builder.
+func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentProfile, len(ps))
+func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Organization, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3328,51 +12307,45 @@ func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentProfileMutation) Op() Op {
+func (m *OrganizationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentProfileMutation) SetOp(op Op) {
+func (m *OrganizationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentProfile).
-func (m *DeploymentProfileMutation) Type() string {
+// Type returns the node type of this mutation (Organization).
+func (m *OrganizationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentProfileMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m._type != nil {
-		fields = append(fields, deploymentprofile.FieldType)
-	}
-	if m.description != nil {
-		fields = append(fields, deploymentprofile.FieldDescription)
-	}
-	if m.cpu_cores != nil {
-		fields = append(fields, deploymentprofile.FieldCPUCores)
+func (m *OrganizationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, organization.FieldName)
 	}
-	if m.memory != nil {
-		fields = append(fields, deploymentprofile.FieldMemory)
+	if m.display_name != nil {
+		fields = append(fields, organization.FieldDisplayName)
 	}
-	if m.storage != nil {
-		fields = append(fields, deploymentprofile.FieldStorage)
+	if m.max_sites != nil {
+		fields = append(fields, organization.FieldMaxSites)
 	}
-	if m.cpu_architectures != nil {
-		fields = append(fields, deploymentprofile.FieldCPUArchitectures)
+	if m.max_deployments != nil {
+		fields = append(fields, organization.FieldMaxDeployments)
 	}
-	if m.peripherals != nil {
-		fields = append(fields, deploymentprofile.FieldPeripherals)
+	if m.max_apps != nil {
+		fields = append(fields, organization.FieldMaxApps)
 	}
-	if m.interfaces != nil {
-		fields = append(fields, deploymentprofile.FieldInterfaces)
+	if m.max_users != nil {
+		fields = append(fields, organization.FieldMaxUsers)
 	}
-	if m.application_desc != nil {
-		fields = append(fields, deploymentprofile.FieldAppID)
+	if m.created_at != nil {
+		fields = append(fields, organization.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3380,26 +12353,22 @@ func (m *DeploymentProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentprofile.FieldType:
-		return m.GetType()
-	case deploymentprofile.FieldDescription:
-		return m.Description()
-	case deploymentprofile.FieldCPUCores:
-		return m.CPUCores()
-	case deploymentprofile.FieldMemory:
-		return m.Memory()
-	case deploymentprofile.FieldStorage:
-		return m.Storage()
-	case deploymentprofile.FieldCPUArchitectures:
-		return m.CPUArchitectures()
-	case deploymentprofile.FieldPeripherals:
-		return m.Peripherals()
-	case deploymentprofile.FieldInterfaces:
-		return m.Interfaces()
-	case deploymentprofile.FieldAppID:
-		return m.AppID()
+	case organization.FieldName:
+		return m.Name()
+	case organization.FieldDisplayName:
+		return m.DisplayName()
+	case organization.FieldMaxSites:
+		return m.MaxSites()
+	case organization.FieldMaxDeployments:
+		return m.MaxDeployments()
+	case organization.FieldMaxApps:
+		return m.MaxApps()
+	case organization.FieldMaxUsers:
+		return m.MaxUsers()
+	case organization.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3407,108 +12376,99 @@ func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentprofile.FieldType:
-		return m.OldType(ctx)
-	case deploymentprofile.FieldDescription:
-		return m.OldDescription(ctx)
-	case deploymentprofile.FieldCPUCores:
-		return m.OldCPUCores(ctx)
-	case deploymentprofile.FieldMemory:
-		return m.OldMemory(ctx)
-	case deploymentprofile.FieldStorage:
-		return m.OldStorage(ctx)
-	case deploymentprofile.FieldCPUArchitectures:
-		return m.OldCPUArchitectures(ctx)
-	case deploymentprofile.FieldPeripherals:
-		return m.OldPeripherals(ctx)
-	case deploymentprofile.FieldInterfaces:
-		return m.OldInterfaces(ctx)
-	case deploymentprofile.FieldAppID:
-		return m.OldAppID(ctx)
+	case organization.FieldName:
+		return m.OldName(ctx)
+	case organization.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case organization.FieldMaxSites:
+		return m.OldMaxSites(ctx)
+	case organization.FieldMaxDeployments:
+		return m.OldMaxDeployments(ctx)
+	case organization.FieldMaxApps:
+		return m.OldMaxApps(ctx)
+	case organization.FieldMaxUsers:
+		return m.OldMaxUsers(ctx)
+	case organization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return nil, fmt.Errorf("unknown Organization field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentProfileMutation) SetField(name string, value ent.Value) error {
+func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentprofile.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case deploymentprofile.FieldDescription:
+	case organization.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case deploymentprofile.FieldCPUCores:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCPUCores(v)
+		m.SetName(v)
 		return nil
-	case deploymentprofile.FieldMemory:
+	case organization.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMemory(v)
+		m.SetDisplayName(v)
 		return nil
-	case deploymentprofile.FieldStorage:
-		v, ok := value.(string)
+	case organization.FieldMaxSites:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStorage(v)
+		m.SetMaxSites(v)
 		return nil
-	case deploymentprofile.FieldCPUArchitectures:
-		v, ok := value.([]string)
+	case organization.FieldMaxDeployments:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCPUArchitectures(v)
+		m.SetMaxDeployments(v)
 		return nil
-	case deploymentprofile.FieldPeripherals:
-		v, ok := value.([]map[string]interface{})
+	case organization.FieldMaxApps:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeripherals(v)
+		m.SetMaxApps(v)
 		return nil
-	case deploymentprofile.FieldInterfaces:
-		v, ok := value.([]map[string]interface{})
+	case organization.FieldMaxUsers:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInterfaces(v)
+		m.SetMaxUsers(v)
 		return nil
-	case deploymentprofile.FieldAppID:
-		v, ok := value.(uuid.UUID)
+	case organization.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppID(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return fmt.Errorf("unknown Organization field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentProfileMutation) AddedFields() []string {
+func (m *OrganizationMutation) AddedFields() []string {
 	var fields []string
-	if m.addcpu_cores != nil {
-		fields = append(fields, deploymentprofile.FieldCPUCores)
+	if m.addmax_sites != nil {
+		fields = append(fields, organization.FieldMaxSites)
+	}
+	if m.addmax_deployments != nil {
+		fields = append(fields, organization.FieldMaxDeployments)
+	}
+	if m.addmax_apps != nil {
+		fields = append(fields, organization.FieldMaxApps)
+	}
+	if m.addmax_users != nil {
+		fields = append(fields, organization.FieldMaxUsers)
 	}
 	return fields
 }
@@ -3516,10 +12476,16 @@ func (m *DeploymentProfileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentprofile.FieldCPUCores:
-		return m.AddedCPUCores()
+	case organization.FieldMaxSites:
+		return m.AddedMaxSites()
+	case organization.FieldMaxDeployments:
+		return m.AddedMaxDeployments()
+	case organization.FieldMaxApps:
+		return m.AddedMaxApps()
+	case organization.FieldMaxUsers:
+		return m.AddedMaxUsers()
 	}
 	return nil, false
 }
@@ -3527,176 +12493,190 @@ func (m *DeploymentProfileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentProfileMutation) AddField(name string, value ent.Value) error {
+func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case deploymentprofile.FieldCPUCores:
-		v, ok := value.(float64)
+	case organization.FieldMaxSites:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCPUCores(v)
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentProfile numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DeploymentProfileMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(deploymentprofile.FieldType) {
-		fields = append(fields, deploymentprofile.FieldType)
-	}
-	if m.FieldCleared(deploymentprofile.FieldDescription) {
-		fields = append(fields, deploymentprofile.FieldDescription)
-	}
-	if m.FieldCleared(deploymentprofile.FieldCPUCores) {
-		fields = append(fields, deploymentprofile.FieldCPUCores)
-	}
-	if m.FieldCleared(deploymentprofile.FieldMemory) {
-		fields = append(fields, deploymentprofile.FieldMemory)
-	}
-	if m.FieldCleared(deploymentprofile.FieldStorage) {
-		fields = append(fields, deploymentprofile.FieldStorage)
-	}
-	if m.FieldCleared(deploymentprofile.FieldCPUArchitectures) {
-		fields = append(fields, deploymentprofile.FieldCPUArchitectures)
-	}
-	if m.FieldCleared(deploymentprofile.FieldPeripherals) {
-		fields = append(fields, deploymentprofile.FieldPeripherals)
-	}
-	if m.FieldCleared(deploymentprofile.FieldInterfaces) {
-		fields = append(fields, deploymentprofile.FieldInterfaces)
-	}
-	if m.FieldCleared(deploymentprofile.FieldAppID) {
-		fields = append(fields, deploymentprofile.FieldAppID)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DeploymentProfileMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DeploymentProfileMutation) ClearField(name string) error {
-	switch name {
-	case deploymentprofile.FieldType:
-		m.ClearType()
-		return nil
-	case deploymentprofile.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case deploymentprofile.FieldCPUCores:
-		m.ClearCPUCores()
-		return nil
-	case deploymentprofile.FieldMemory:
-		m.ClearMemory()
-		return nil
-	case deploymentprofile.FieldStorage:
-		m.ClearStorage()
+		m.AddMaxSites(v)
 		return nil
-	case deploymentprofile.FieldCPUArchitectures:
-		m.ClearCPUArchitectures()
+	case organization.FieldMaxDeployments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxDeployments(v)
 		return nil
-	case deploymentprofile.FieldPeripherals:
-		m.ClearPeripherals()
+	case organization.FieldMaxApps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxApps(v)
 		return nil
-	case deploymentprofile.FieldInterfaces:
-		m.ClearInterfaces()
+	case organization.FieldMaxUsers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUsers(v)
 		return nil
-	case deploymentprofile.FieldAppID:
-		m.ClearAppID()
+	}
+	return fmt.Errorf("unknown Organization numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organization.FieldDisplayName) {
+		fields = append(fields, organization.FieldDisplayName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMutation) ClearField(name string) error {
+	switch name {
+	case organization.FieldDisplayName:
+		m.ClearDisplayName()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile nullable field %s", name)
+	return fmt.Errorf("unknown Organization nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentProfileMutation) ResetField(name string) error {
+func (m *OrganizationMutation) ResetField(name string) error {
 	switch name {
-	case deploymentprofile.FieldType:
-		m.ResetType()
-		return nil
-	case deploymentprofile.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case deploymentprofile.FieldCPUCores:
-		m.ResetCPUCores()
+	case organization.FieldName:
+		m.ResetName()
 		return nil
-	case deploymentprofile.FieldMemory:
-		m.ResetMemory()
+	case organization.FieldDisplayName:
+		m.ResetDisplayName()
 		return nil
-	case deploymentprofile.FieldStorage:
-		m.ResetStorage()
+	case organization.FieldMaxSites:
+		m.ResetMaxSites()
 		return nil
-	case deploymentprofile.FieldCPUArchitectures:
-		m.ResetCPUArchitectures()
+	case organization.FieldMaxDeployments:
+		m.ResetMaxDeployments()
 		return nil
-	case deploymentprofile.FieldPeripherals:
-		m.ResetPeripherals()
+	case organization.FieldMaxApps:
+		m.ResetMaxApps()
 		return nil
-	case deploymentprofile.FieldInterfaces:
-		m.ResetInterfaces()
+	case organization.FieldMaxUsers:
+		m.ResetMaxUsers()
 		return nil
-	case deploymentprofile.FieldAppID:
-		m.ResetAppID()
+	case organization.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return fmt.Errorf("unknown Organization field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentProfileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.components != nil {
-		edges = append(edges, deploymentprofile.EdgeComponents)
+func (m *OrganizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.apps != nil {
+		edges = append(edges, organization.EdgeApps)
 	}
-	if m.application_desc != nil {
-		edges = append(edges, deploymentprofile.EdgeApplicationDesc)
+	if m.sites != nil {
+		edges = append(edges, organization.EdgeSites)
+	}
+	if m.deployments != nil {
+		edges = append(edges, organization.EdgeDeployments)
+	}
+	if m.users != nil {
+		edges = append(edges, organization.EdgeUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentprofile.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.components))
-		for id := range m.components {
+	case organization.EdgeApps:
+		ids := make([]ent.Value, 0, len(m.apps))
+		for id := range m.apps {
 			ids = append(ids, id)
 		}
 		return ids
-	case deploymentprofile.EdgeApplicationDesc:
-		if id := m.application_desc; id != nil {
-			return []ent.Value{*id}
+	case organization.EdgeSites:
+		ids := make([]ent.Value, 0, len(m.sites))
+		for id := range m.sites {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.deployments))
+		for id := range m.deployments {
+			ids = append(ids, id)
 		}
+		return ids
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentProfileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedcomponents != nil {
-		edges = append(edges, deploymentprofile.EdgeComponents)
+func (m *OrganizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedapps != nil {
+		edges = append(edges, organization.EdgeApps)
+	}
+	if m.removedsites != nil {
+		edges = append(edges, organization.EdgeSites)
+	}
+	if m.removeddeployments != nil {
+		edges = append(edges, organization.EdgeDeployments)
+	}
+	if m.removedusers != nil {
+		edges = append(edges, organization.EdgeUsers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentprofile.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.removedcomponents))
-		for id := range m.removedcomponents {
+	case organization.EdgeApps:
+		ids := make([]ent.Value, 0, len(m.removedapps))
+		for id := range m.removedapps {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeSites:
+		ids := make([]ent.Value, 0, len(m.removedsites))
+		for id := range m.removedsites {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.removeddeployments))
+		for id := range m.removeddeployments {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3705,86 +12685,95 @@ func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentProfileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcomponents {
-		edges = append(edges, deploymentprofile.EdgeComponents)
+func (m *OrganizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedapps {
+		edges = append(edges, organization.EdgeApps)
 	}
-	if m.clearedapplication_desc {
-		edges = append(edges, deploymentprofile.EdgeApplicationDesc)
+	if m.clearedsites {
+		edges = append(edges, organization.EdgeSites)
+	}
+	if m.cleareddeployments {
+		edges = append(edges, organization.EdgeDeployments)
+	}
+	if m.clearedusers {
+		edges = append(edges, organization.EdgeUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentProfileMutation) EdgeCleared(name string) bool {
+func (m *OrganizationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentprofile.EdgeComponents:
-		return m.clearedcomponents
-	case deploymentprofile.EdgeApplicationDesc:
-		return m.clearedapplication_desc
+	case organization.EdgeApps:
+		return m.clearedapps
+	case organization.EdgeSites:
+		return m.clearedsites
+	case organization.EdgeDeployments:
+		return m.cleareddeployments
+	case organization.EdgeUsers:
+		return m.clearedusers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentProfileMutation) ClearEdge(name string) error {
+func (m *OrganizationMutation) ClearEdge(name string) error {
 	switch name {
-	case deploymentprofile.EdgeApplicationDesc:
-		m.ClearApplicationDesc()
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile unique edge %s", name)
+	return fmt.Errorf("unknown Organization unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentProfileMutation) ResetEdge(name string) error {
+func (m *OrganizationMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentprofile.EdgeComponents:
-		m.ResetComponents()
+	case organization.EdgeApps:
+		m.ResetApps()
 		return nil
-	case deploymentprofile.EdgeApplicationDesc:
-		m.ResetApplicationDesc()
+	case organization.EdgeSites:
+		m.ResetSites()
+		return nil
+	case organization.EdgeDeployments:
+		m.ResetDeployments()
+		return nil
+	case organization.EdgeUsers:
+		m.ResetUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile edge %s", name)
+	return fmt.Errorf("unknown Organization edge %s", name)
 }
 
-// DeploymentStatusMutation represents an operation that mutates the DeploymentStatus nodes in the graph.
-type DeploymentStatusMutation struct {
+// RoleBindingMutation represents an operation that mutates the RoleBinding nodes in the graph.
+type RoleBindingMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	state             *string
-	error_code        *string
-	error_message     *string
-	host_id           *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	components        map[uuid.UUID]struct{}
-	removedcomponents map[uuid.UUID]struct{}
-	clearedcomponents bool
-	done              bool
-	oldValue          func(context.Context) (*DeploymentStatus, error)
-	predicates        []predicate.DeploymentStatus
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	role          *rolebinding.Role
+	scope         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RoleBinding, error)
+	predicates    []predicate.RoleBinding
 }
 
-var _ ent.Mutation = (*DeploymentStatusMutation)(nil)
+var _ ent.Mutation = (*RoleBindingMutation)(nil)
 
-// deploymentstatusOption allows management of the mutation configuration using functional options.
-type deploymentstatusOption func(*DeploymentStatusMutation)
+// rolebindingOption allows management of the mutation configuration using functional options.
+type rolebindingOption func(*RoleBindingMutation)
 
-// newDeploymentStatusMutation creates new mutation for the DeploymentStatus entity.
-func newDeploymentStatusMutation(c config, op Op, opts ...deploymentstatusOption) *DeploymentStatusMutation {
-	m := &DeploymentStatusMutation{
+// newRoleBindingMutation creates new mutation for the RoleBinding entity.
+func newRoleBindingMutation(c config, op Op, opts ...rolebindingOption) *RoleBindingMutation {
+	m := &RoleBindingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentStatus,
+		typ:           TypeRoleBinding,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3793,20 +12782,20 @@ func newDeploymentStatusMutation(c config, op Op, opts ...deploymentstatusOption
 	return m
 }
 
-// withDeploymentStatusID sets the ID field of the mutation.
-func withDeploymentStatusID(id uuid.UUID) deploymentstatusOption {
-	return func(m *DeploymentStatusMutation) {
+// withRoleBindingID sets the ID field of the mutation.
+func withRoleBindingID(id uuid.UUID) rolebindingOption {
+	return func(m *RoleBindingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentStatus
+			value *RoleBinding
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentStatus, error) {
+		m.oldValue = func(ctx context.Context) (*RoleBinding, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentStatus.Get(ctx, id)
+					value, err = m.Client().RoleBinding.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3815,10 +12804,10 @@ func withDeploymentStatusID(id uuid.UUID) deploymentstatusOption {
 	}
 }
 
-// withDeploymentStatus sets the old DeploymentStatus of the mutation.
-func withDeploymentStatus(node *DeploymentStatus) deploymentstatusOption {
-	return func(m *DeploymentStatusMutation) {
-		m.oldValue = func(context.Context) (*DeploymentStatus, error) {
+// withRoleBinding sets the old RoleBinding of the mutation.
+func withRoleBinding(node *RoleBinding) rolebindingOption {
+	return func(m *RoleBindingMutation) {
+		m.oldValue = func(context.Context) (*RoleBinding, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3827,7 +12816,7 @@ func withDeploymentStatus(node *DeploymentStatus) deploymentstatusOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentStatusMutation) Client() *Client {
+func (m RoleBindingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3835,7 +12824,7 @@ func (m DeploymentStatusMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentStatusMutation) Tx() (*Tx, error) {
+func (m RoleBindingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3845,14 +12834,14 @@ func (m DeploymentStatusMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeploymentStatus entities.
-func (m *DeploymentStatusMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of RoleBinding entities.
+func (m *RoleBindingMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentStatusMutation) ID() (id uuid.UUID, exists bool) {
+func (m *RoleBindingMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3863,7 +12852,7 @@ func (m *DeploymentStatusMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentStatusMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *RoleBindingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3872,202 +12861,91 @@ func (m *DeploymentStatusMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentStatus.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RoleBinding.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetState sets the "state" field.
-func (m *DeploymentStatusMutation) SetState(s string) {
-	m.state = &s
-}
-
-// State returns the value of the "state" field in the mutation.
-func (m *DeploymentStatusMutation) State() (r string, exists bool) {
-	v := m.state
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldState returns the old "state" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldState(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
-	}
-	return oldValue.State, nil
-}
-
-// ResetState resets all changes to the "state" field.
-func (m *DeploymentStatusMutation) ResetState() {
-	m.state = nil
-}
-
-// SetErrorCode sets the "error_code" field.
-func (m *DeploymentStatusMutation) SetErrorCode(s string) {
-	m.error_code = &s
-}
-
-// ErrorCode returns the value of the "error_code" field in the mutation.
-func (m *DeploymentStatusMutation) ErrorCode() (r string, exists bool) {
-	v := m.error_code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldErrorCode returns the old "error_code" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldErrorCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
-	}
-	return oldValue.ErrorCode, nil
-}
-
-// ClearErrorCode clears the value of the "error_code" field.
-func (m *DeploymentStatusMutation) ClearErrorCode() {
-	m.error_code = nil
-	m.clearedFields[deploymentstatus.FieldErrorCode] = struct{}{}
-}
-
-// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
-func (m *DeploymentStatusMutation) ErrorCodeCleared() bool {
-	_, ok := m.clearedFields[deploymentstatus.FieldErrorCode]
-	return ok
-}
-
-// ResetErrorCode resets all changes to the "error_code" field.
-func (m *DeploymentStatusMutation) ResetErrorCode() {
-	m.error_code = nil
-	delete(m.clearedFields, deploymentstatus.FieldErrorCode)
-}
-
-// SetErrorMessage sets the "error_message" field.
-func (m *DeploymentStatusMutation) SetErrorMessage(s string) {
-	m.error_message = &s
-}
-
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *DeploymentStatusMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// SetRole sets the "role" field.
+func (m *RoleBindingMutation) SetRole(r rolebinding.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RoleBindingMutation) Role() (r rolebinding.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *RoleBindingMutation) OldRole(ctx context.Context) (v rolebinding.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *DeploymentStatusMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[deploymentstatus.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *DeploymentStatusMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[deploymentstatus.FieldErrorMessage]
-	return ok
+	return oldValue.Role, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *DeploymentStatusMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, deploymentstatus.FieldErrorMessage)
+// ResetRole resets all changes to the "role" field.
+func (m *RoleBindingMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetHostID sets the "host_id" field.
-func (m *DeploymentStatusMutation) SetHostID(s string) {
-	m.host_id = &s
+// SetScope sets the "scope" field.
+func (m *RoleBindingMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// HostID returns the value of the "host_id" field in the mutation.
-func (m *DeploymentStatusMutation) HostID() (r string, exists bool) {
-	v := m.host_id
+// Scope returns the value of the "scope" field in the mutation.
+func (m *RoleBindingMutation) Scope() (r string, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostID returns the old "host_id" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldHostID(ctx context.Context) (v string, err error) {
+func (m *RoleBindingMutation) OldScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostID requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.HostID, nil
-}
-
-// ClearHostID clears the value of the "host_id" field.
-func (m *DeploymentStatusMutation) ClearHostID() {
-	m.host_id = nil
-	m.clearedFields[deploymentstatus.FieldHostID] = struct{}{}
-}
-
-// HostIDCleared returns if the "host_id" field was cleared in this mutation.
-func (m *DeploymentStatusMutation) HostIDCleared() bool {
-	_, ok := m.clearedFields[deploymentstatus.FieldHostID]
-	return ok
+	return oldValue.Scope, nil
 }
 
-// ResetHostID resets all changes to the "host_id" field.
-func (m *DeploymentStatusMutation) ResetHostID() {
-	m.host_id = nil
-	delete(m.clearedFields, deploymentstatus.FieldHostID)
+// ResetScope resets all changes to the "scope" field.
+func (m *RoleBindingMutation) ResetScope() {
+	m.scope = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DeploymentStatusMutation) SetCreatedAt(t time.Time) {
+func (m *RoleBindingMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeploymentStatusMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RoleBindingMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4075,10 +12953,10 @@ func (m *DeploymentStatusMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RoleBinding entity.
+// If the RoleBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoleBindingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4093,109 +12971,58 @@ func (m *DeploymentStatusMutation) OldCreatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeploymentStatusMutation) ResetCreatedAt() {
+func (m *RoleBindingMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DeploymentStatusMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeploymentStatusMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the DeploymentStatus entity.
-// If the DeploymentStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeploymentStatusMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddComponentIDs adds the "components" edge to the DeploymentComponentStatus entity by ids.
-func (m *DeploymentStatusMutation) AddComponentIDs(ids ...uuid.UUID) {
-	if m.components == nil {
-		m.components = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.components[ids[i]] = struct{}{}
-	}
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RoleBindingMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearComponents clears the "components" edge to the DeploymentComponentStatus entity.
-func (m *DeploymentStatusMutation) ClearComponents() {
-	m.clearedcomponents = true
-}
-
-// ComponentsCleared reports if the "components" edge to the DeploymentComponentStatus entity was cleared.
-func (m *DeploymentStatusMutation) ComponentsCleared() bool {
-	return m.clearedcomponents
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoleBindingMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// RemoveComponentIDs removes the "components" edge to the DeploymentComponentStatus entity by IDs.
-func (m *DeploymentStatusMutation) RemoveComponentIDs(ids ...uuid.UUID) {
-	if m.removedcomponents == nil {
-		m.removedcomponents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.components, ids[i])
-		m.removedcomponents[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoleBindingMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedComponents returns the removed IDs of the "components" edge to the DeploymentComponentStatus entity.
-func (m *DeploymentStatusMutation) RemovedComponentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomponents {
-		ids = append(ids, id)
+// UserID returns the "user" edge ID in the mutation.
+func (m *RoleBindingMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// ComponentsIDs returns the "components" edge IDs in the mutation.
-func (m *DeploymentStatusMutation) ComponentsIDs() (ids []uuid.UUID) {
-	for id := range m.components {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoleBindingMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetComponents resets all changes to the "components" edge.
-func (m *DeploymentStatusMutation) ResetComponents() {
-	m.components = nil
-	m.clearedcomponents = false
-	m.removedcomponents = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *RoleBindingMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the DeploymentStatusMutation builder.
-func (m *DeploymentStatusMutation) Where(ps ...predicate.DeploymentStatus) {
+// Where appends a list predicates to the RoleBindingMutation builder.
+func (m *RoleBindingMutation) Where(ps ...predicate.RoleBinding) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentStatusMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleBindingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentStatus, len(ps))
+func (m *RoleBindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleBinding, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4203,42 +13030,33 @@ func (m *DeploymentStatusMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentStatusMutation) Op() Op {
+func (m *RoleBindingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentStatusMutation) SetOp(op Op) {
+func (m *RoleBindingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentStatus).
-func (m *DeploymentStatusMutation) Type() string {
+// Type returns the node type of this mutation (RoleBinding).
+func (m *RoleBindingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentStatusMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.state != nil {
-		fields = append(fields, deploymentstatus.FieldState)
-	}
-	if m.error_code != nil {
-		fields = append(fields, deploymentstatus.FieldErrorCode)
-	}
-	if m.error_message != nil {
-		fields = append(fields, deploymentstatus.FieldErrorMessage)
+func (m *RoleBindingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role != nil {
+		fields = append(fields, rolebinding.FieldRole)
 	}
-	if m.host_id != nil {
-		fields = append(fields, deploymentstatus.FieldHostID)
+	if m.scope != nil {
+		fields = append(fields, rolebinding.FieldScope)
 	}
 	if m.created_at != nil {
-		fields = append(fields, deploymentstatus.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, deploymentstatus.FieldUpdatedAt)
+		fields = append(fields, rolebinding.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4246,20 +13064,14 @@ func (m *DeploymentStatusMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentStatusMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleBindingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentstatus.FieldState:
-		return m.State()
-	case deploymentstatus.FieldErrorCode:
-		return m.ErrorCode()
-	case deploymentstatus.FieldErrorMessage:
-		return m.ErrorMessage()
-	case deploymentstatus.FieldHostID:
-		return m.HostID()
-	case deploymentstatus.FieldCreatedAt:
+	case rolebinding.FieldRole:
+		return m.Role()
+	case rolebinding.FieldScope:
+		return m.Scope()
+	case rolebinding.FieldCreatedAt:
 		return m.CreatedAt()
-	case deploymentstatus.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4267,286 +13079,220 @@ func (m *DeploymentStatusMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentstatus.FieldState:
-		return m.OldState(ctx)
-	case deploymentstatus.FieldErrorCode:
-		return m.OldErrorCode(ctx)
-	case deploymentstatus.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case deploymentstatus.FieldHostID:
-		return m.OldHostID(ctx)
-	case deploymentstatus.FieldCreatedAt:
+	case rolebinding.FieldRole:
+		return m.OldRole(ctx)
+	case rolebinding.FieldScope:
+		return m.OldScope(ctx)
+	case rolebinding.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case deploymentstatus.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentStatus field %s", name)
+	return nil, fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentStatusMutation) SetField(name string, value ent.Value) error {
+func (m *RoleBindingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentstatus.FieldState:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetState(v)
-		return nil
-	case deploymentstatus.FieldErrorCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorCode(v)
-		return nil
-	case deploymentstatus.FieldErrorMessage:
-		v, ok := value.(string)
+	case rolebinding.FieldRole:
+		v, ok := value.(rolebinding.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetRole(v)
 		return nil
-	case deploymentstatus.FieldHostID:
+	case rolebinding.FieldScope:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHostID(v)
+		m.SetScope(v)
 		return nil
-	case deploymentstatus.FieldCreatedAt:
+	case rolebinding.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case deploymentstatus.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentStatus field %s", name)
+	return fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentStatusMutation) AddedFields() []string {
+func (m *RoleBindingMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentStatusMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleBindingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentStatusMutation) AddField(name string, value ent.Value) error {
+func (m *RoleBindingMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeploymentStatus numeric field %s", name)
+	return fmt.Errorf("unknown RoleBinding numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentStatusMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(deploymentstatus.FieldErrorCode) {
-		fields = append(fields, deploymentstatus.FieldErrorCode)
-	}
-	if m.FieldCleared(deploymentstatus.FieldErrorMessage) {
-		fields = append(fields, deploymentstatus.FieldErrorMessage)
-	}
-	if m.FieldCleared(deploymentstatus.FieldHostID) {
-		fields = append(fields, deploymentstatus.FieldHostID)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DeploymentStatusMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+func (m *RoleBindingMutation) ClearedFields() []string {
+	return nil
 }
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DeploymentStatusMutation) ClearField(name string) error {
-	switch name {
-	case deploymentstatus.FieldErrorCode:
-		m.ClearErrorCode()
-		return nil
-	case deploymentstatus.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case deploymentstatus.FieldHostID:
-		m.ClearHostID()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentStatus nullable field %s", name)
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleBindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleBindingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleBinding nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentStatusMutation) ResetField(name string) error {
+func (m *RoleBindingMutation) ResetField(name string) error {
 	switch name {
-	case deploymentstatus.FieldState:
-		m.ResetState()
-		return nil
-	case deploymentstatus.FieldErrorCode:
-		m.ResetErrorCode()
-		return nil
-	case deploymentstatus.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case rolebinding.FieldRole:
+		m.ResetRole()
 		return nil
-	case deploymentstatus.FieldHostID:
-		m.ResetHostID()
+	case rolebinding.FieldScope:
+		m.ResetScope()
 		return nil
-	case deploymentstatus.FieldCreatedAt:
+	case rolebinding.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case deploymentstatus.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentStatus field %s", name)
+	return fmt.Errorf("unknown RoleBinding field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentStatusMutation) AddedEdges() []string {
+func (m *RoleBindingMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.components != nil {
-		edges = append(edges, deploymentstatus.EdgeComponents)
+	if m.user != nil {
+		edges = append(edges, rolebinding.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentStatusMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleBindingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentstatus.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.components))
-		for id := range m.components {
-			ids = append(ids, id)
+	case rolebinding.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentStatusMutation) RemovedEdges() []string {
+func (m *RoleBindingMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedcomponents != nil {
-		edges = append(edges, deploymentstatus.EdgeComponents)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentStatusMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deploymentstatus.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.removedcomponents))
-		for id := range m.removedcomponents {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RoleBindingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentStatusMutation) ClearedEdges() []string {
+func (m *RoleBindingMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedcomponents {
-		edges = append(edges, deploymentstatus.EdgeComponents)
+	if m.cleareduser {
+		edges = append(edges, rolebinding.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentStatusMutation) EdgeCleared(name string) bool {
+func (m *RoleBindingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentstatus.EdgeComponents:
-		return m.clearedcomponents
+	case rolebinding.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentStatusMutation) ClearEdge(name string) error {
+func (m *RoleBindingMutation) ClearEdge(name string) error {
 	switch name {
+	case rolebinding.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown DeploymentStatus unique edge %s", name)
+	return fmt.Errorf("unknown RoleBinding unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentStatusMutation) ResetEdge(name string) error {
+func (m *RoleBindingMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentstatus.EdgeComponents:
-		m.ResetComponents()
+	case rolebinding.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentStatus edge %s", name)
+	return fmt.Errorf("unknown RoleBinding edge %s", name)
 }
 
-// HostMutation represents an operation that mutates the Host nodes in the graph.
-type HostMutation struct {
+// SiteMutation represents an operation that mutates the Site nodes in the graph.
+type SiteMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	host_id       *string
-	runtime       *string
-	last_seen     *time.Time
-	misses        *int
-	addmisses     *int
-	cpu_free      *float64
-	addcpu_free   *float64
-	status        *string
-	hostname      *string
-	ip_address    *string
-	edge_url      *string
-	metadata      *struct{}
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	site          *uuid.UUID
-	clearedsite   bool
-	done          bool
-	oldValue      func(context.Context) (*Host, error)
-	predicates    []predicate.Host
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	site_id             *string
+	name                *string
+	description         *string
+	location            *string
+	group               *string
+	metadata            *struct{}
+	status              *string
+	last_seen           *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	hosts               map[uuid.UUID]struct{}
+	removedhosts        map[uuid.UUID]struct{}
+	clearedhosts        bool
+	orchestrator        *uuid.UUID
+	clearedorchestrator bool
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*Site, error)
+	predicates          []predicate.Site
 }
 
-var _ ent.Mutation = (*HostMutation)(nil)
+var _ ent.Mutation = (*SiteMutation)(nil)
 
-// hostOption allows management of the mutation configuration using functional options.
-type hostOption func(*HostMutation)
+// siteOption allows management of the mutation configuration using functional options.
+type siteOption func(*SiteMutation)
 
-// newHostMutation creates new mutation for the Host entity.
-func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
-	m := &HostMutation{
+// newSiteMutation creates new mutation for the Site entity.
+func newSiteMutation(c config, op Op, opts ...siteOption) *SiteMutation {
+	m := &SiteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHost,
+		typ:           TypeSite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4555,20 +13301,20 @@ func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
 	return m
 }
 
-// withHostID sets the ID field of the mutation.
-func withHostID(id uuid.UUID) hostOption {
-	return func(m *HostMutation) {
+// withSiteID sets the ID field of the mutation.
+func withSiteID(id uuid.UUID) siteOption {
+	return func(m *SiteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Host
+			value *Site
 		)
-		m.oldValue = func(ctx context.Context) (*Host, error) {
+		m.oldValue = func(ctx context.Context) (*Site, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Host.Get(ctx, id)
+					value, err = m.Client().Site.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4577,10 +13323,10 @@ func withHostID(id uuid.UUID) hostOption {
 	}
 }
 
-// withHost sets the old Host of the mutation.
-func withHost(node *Host) hostOption {
-	return func(m *HostMutation) {
-		m.oldValue = func(context.Context) (*Host, error) {
+// withSite sets the old Site of the mutation.
+func withSite(node *Site) siteOption {
+	return func(m *SiteMutation) {
+		m.oldValue = func(context.Context) (*Site, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4589,7 +13335,7 @@ func withHost(node *Host) hostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HostMutation) Client() *Client {
+func (m SiteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4597,7 +13343,7 @@ func (m HostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HostMutation) Tx() (*Tx, error) {
+func (m SiteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4607,14 +13353,14 @@ func (m HostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Host entities.
-func (m *HostMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Site entities.
+func (m *SiteMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HostMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SiteMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4625,7 +13371,7 @@ func (m *HostMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SiteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4634,66 +13380,79 @@ func (m *HostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Host.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Site.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetHostID sets the "host_id" field.
-func (m *HostMutation) SetHostID(s string) {
-	m.host_id = &s
+// SetOrgID sets the "org_id" field.
+func (m *SiteMutation) SetOrgID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// HostID returns the value of the "host_id" field in the mutation.
-func (m *HostMutation) HostID() (r string, exists bool) {
-	v := m.host_id
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *SiteMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostID returns the old "host_id" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldOrgID returns the old "org_id" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldHostID(ctx context.Context) (v string, err error) {
+func (m *SiteMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostID requires an ID field in the mutation")
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
 	}
-	return oldValue.HostID, nil
+	return oldValue.OrgID, nil
 }
 
-// ResetHostID resets all changes to the "host_id" field.
-func (m *HostMutation) ResetHostID() {
-	m.host_id = nil
+// ClearOrgID clears the value of the "org_id" field.
+func (m *SiteMutation) ClearOrgID() {
+	m.organization = nil
+	m.clearedFields[site.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *SiteMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[site.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *SiteMutation) ResetOrgID() {
+	m.organization = nil
+	delete(m.clearedFields, site.FieldOrgID)
 }
 
 // SetSiteID sets the "site_id" field.
-func (m *HostMutation) SetSiteID(u uuid.UUID) {
-	m.site = &u
+func (m *SiteMutation) SetSiteID(s string) {
+	m.site_id = &s
 }
 
 // SiteID returns the value of the "site_id" field in the mutation.
-func (m *HostMutation) SiteID() (r uuid.UUID, exists bool) {
-	v := m.site
+func (m *SiteMutation) SiteID() (r string, exists bool) {
+	v := m.site_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSiteID returns the old "site_id" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldSiteID returns the old "site_id" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldSiteID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SiteMutation) OldSiteID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
 	}
@@ -4707,514 +13466,410 @@ func (m *HostMutation) OldSiteID(ctx context.Context) (v uuid.UUID, err error) {
 	return oldValue.SiteID, nil
 }
 
-// ClearSiteID clears the value of the "site_id" field.
-func (m *HostMutation) ClearSiteID() {
-	m.site = nil
-	m.clearedFields[host.FieldSiteID] = struct{}{}
-}
-
-// SiteIDCleared returns if the "site_id" field was cleared in this mutation.
-func (m *HostMutation) SiteIDCleared() bool {
-	_, ok := m.clearedFields[host.FieldSiteID]
-	return ok
-}
-
 // ResetSiteID resets all changes to the "site_id" field.
-func (m *HostMutation) ResetSiteID() {
-	m.site = nil
-	delete(m.clearedFields, host.FieldSiteID)
-}
-
-// SetRuntime sets the "runtime" field.
-func (m *HostMutation) SetRuntime(s string) {
-	m.runtime = &s
-}
-
-// Runtime returns the value of the "runtime" field in the mutation.
-func (m *HostMutation) Runtime() (r string, exists bool) {
-	v := m.runtime
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRuntime returns the old "runtime" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldRuntime(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuntime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuntime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuntime: %w", err)
-	}
-	return oldValue.Runtime, nil
-}
-
-// ClearRuntime clears the value of the "runtime" field.
-func (m *HostMutation) ClearRuntime() {
-	m.runtime = nil
-	m.clearedFields[host.FieldRuntime] = struct{}{}
-}
-
-// RuntimeCleared returns if the "runtime" field was cleared in this mutation.
-func (m *HostMutation) RuntimeCleared() bool {
-	_, ok := m.clearedFields[host.FieldRuntime]
-	return ok
-}
-
-// ResetRuntime resets all changes to the "runtime" field.
-func (m *HostMutation) ResetRuntime() {
-	m.runtime = nil
-	delete(m.clearedFields, host.FieldRuntime)
+func (m *SiteMutation) ResetSiteID() {
+	m.site_id = nil
 }
 
-// SetLastSeen sets the "last_seen" field.
-func (m *HostMutation) SetLastSeen(t time.Time) {
-	m.last_seen = &t
+// SetName sets the "name" field.
+func (m *SiteMutation) SetName(s string) {
+	m.name = &s
 }
 
-// LastSeen returns the value of the "last_seen" field in the mutation.
-func (m *HostMutation) LastSeen() (r time.Time, exists bool) {
-	v := m.last_seen
+// Name returns the value of the "name" field in the mutation.
+func (m *SiteMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSeen returns the old "last_seen" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldLastSeen(ctx context.Context) (v time.Time, err error) {
+func (m *SiteMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.LastSeen, nil
+	return oldValue.Name, nil
 }
 
-// ClearLastSeen clears the value of the "last_seen" field.
-func (m *HostMutation) ClearLastSeen() {
-	m.last_seen = nil
-	m.clearedFields[host.FieldLastSeen] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *SiteMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[site.FieldName] = struct{}{}
 }
 
-// LastSeenCleared returns if the "last_seen" field was cleared in this mutation.
-func (m *HostMutation) LastSeenCleared() bool {
-	_, ok := m.clearedFields[host.FieldLastSeen]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *SiteMutation) NameCleared() bool {
+	_, ok := m.clearedFields[site.FieldName]
 	return ok
 }
 
-// ResetLastSeen resets all changes to the "last_seen" field.
-func (m *HostMutation) ResetLastSeen() {
-	m.last_seen = nil
-	delete(m.clearedFields, host.FieldLastSeen)
+// ResetName resets all changes to the "name" field.
+func (m *SiteMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, site.FieldName)
 }
 
-// SetMisses sets the "misses" field.
-func (m *HostMutation) SetMisses(i int) {
-	m.misses = &i
-	m.addmisses = nil
+// SetDescription sets the "description" field.
+func (m *SiteMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Misses returns the value of the "misses" field in the mutation.
-func (m *HostMutation) Misses() (r int, exists bool) {
-	v := m.misses
+// Description returns the value of the "description" field in the mutation.
+func (m *SiteMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMisses returns the old "misses" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldMisses(ctx context.Context) (v int, err error) {
+func (m *SiteMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMisses is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMisses requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMisses: %w", err)
-	}
-	return oldValue.Misses, nil
-}
-
-// AddMisses adds i to the "misses" field.
-func (m *HostMutation) AddMisses(i int) {
-	if m.addmisses != nil {
-		*m.addmisses += i
-	} else {
-		m.addmisses = &i
-	}
-}
-
-// AddedMisses returns the value that was added to the "misses" field in this mutation.
-func (m *HostMutation) AddedMisses() (r int, exists bool) {
-	v := m.addmisses
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return *v, true
+	return oldValue.Description, nil
 }
 
-// ClearMisses clears the value of the "misses" field.
-func (m *HostMutation) ClearMisses() {
-	m.misses = nil
-	m.addmisses = nil
-	m.clearedFields[host.FieldMisses] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *SiteMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[site.FieldDescription] = struct{}{}
 }
 
-// MissesCleared returns if the "misses" field was cleared in this mutation.
-func (m *HostMutation) MissesCleared() bool {
-	_, ok := m.clearedFields[host.FieldMisses]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SiteMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[site.FieldDescription]
 	return ok
 }
 
-// ResetMisses resets all changes to the "misses" field.
-func (m *HostMutation) ResetMisses() {
-	m.misses = nil
-	m.addmisses = nil
-	delete(m.clearedFields, host.FieldMisses)
+// ResetDescription resets all changes to the "description" field.
+func (m *SiteMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, site.FieldDescription)
 }
 
-// SetCPUFree sets the "cpu_free" field.
-func (m *HostMutation) SetCPUFree(f float64) {
-	m.cpu_free = &f
-	m.addcpu_free = nil
+// SetLocation sets the "location" field.
+func (m *SiteMutation) SetLocation(s string) {
+	m.location = &s
 }
 
-// CPUFree returns the value of the "cpu_free" field in the mutation.
-func (m *HostMutation) CPUFree() (r float64, exists bool) {
-	v := m.cpu_free
+// Location returns the value of the "location" field in the mutation.
+func (m *SiteMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCPUFree returns the old "cpu_free" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldCPUFree(ctx context.Context) (v float64, err error) {
+func (m *SiteMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCPUFree is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCPUFree requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCPUFree: %w", err)
-	}
-	return oldValue.CPUFree, nil
-}
-
-// AddCPUFree adds f to the "cpu_free" field.
-func (m *HostMutation) AddCPUFree(f float64) {
-	if m.addcpu_free != nil {
-		*m.addcpu_free += f
-	} else {
-		m.addcpu_free = &f
-	}
-}
-
-// AddedCPUFree returns the value that was added to the "cpu_free" field in this mutation.
-func (m *HostMutation) AddedCPUFree() (r float64, exists bool) {
-	v := m.addcpu_free
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return *v, true
+	return oldValue.Location, nil
 }
 
-// ClearCPUFree clears the value of the "cpu_free" field.
-func (m *HostMutation) ClearCPUFree() {
-	m.cpu_free = nil
-	m.addcpu_free = nil
-	m.clearedFields[host.FieldCPUFree] = struct{}{}
+// ClearLocation clears the value of the "location" field.
+func (m *SiteMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[site.FieldLocation] = struct{}{}
 }
 
-// CPUFreeCleared returns if the "cpu_free" field was cleared in this mutation.
-func (m *HostMutation) CPUFreeCleared() bool {
-	_, ok := m.clearedFields[host.FieldCPUFree]
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *SiteMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[site.FieldLocation]
 	return ok
 }
 
-// ResetCPUFree resets all changes to the "cpu_free" field.
-func (m *HostMutation) ResetCPUFree() {
-	m.cpu_free = nil
-	m.addcpu_free = nil
-	delete(m.clearedFields, host.FieldCPUFree)
+// ResetLocation resets all changes to the "location" field.
+func (m *SiteMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, site.FieldLocation)
 }
 
-// SetStatus sets the "status" field.
-func (m *HostMutation) SetStatus(s string) {
-	m.status = &s
+// SetGroup sets the "group" field.
+func (m *SiteMutation) SetGroup(s string) {
+	m.group = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *HostMutation) Status() (r string, exists bool) {
-	v := m.status
+// Group returns the value of the "group" field in the mutation.
+func (m *SiteMutation) Group() (r string, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldGroup returns the old "group" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *SiteMutation) OldGroup(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldGroup requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Group, nil
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *HostMutation) ClearStatus() {
-	m.status = nil
-	m.clearedFields[host.FieldStatus] = struct{}{}
+// ClearGroup clears the value of the "group" field.
+func (m *SiteMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[site.FieldGroup] = struct{}{}
 }
 
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *HostMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[host.FieldStatus]
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *SiteMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[site.FieldGroup]
 	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *HostMutation) ResetStatus() {
-	m.status = nil
-	delete(m.clearedFields, host.FieldStatus)
+// ResetGroup resets all changes to the "group" field.
+func (m *SiteMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, site.FieldGroup)
 }
 
-// SetHostname sets the "hostname" field.
-func (m *HostMutation) SetHostname(s string) {
-	m.hostname = &s
+// SetOrchestratorID sets the "orchestrator_id" field.
+func (m *SiteMutation) SetOrchestratorID(u uuid.UUID) {
+	m.orchestrator = &u
 }
 
-// Hostname returns the value of the "hostname" field in the mutation.
-func (m *HostMutation) Hostname() (r string, exists bool) {
-	v := m.hostname
+// OrchestratorID returns the value of the "orchestrator_id" field in the mutation.
+func (m *SiteMutation) OrchestratorID() (r uuid.UUID, exists bool) {
+	v := m.orchestrator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostname returns the old "hostname" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldOrchestratorID returns the old "orchestrator_id" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldHostname(ctx context.Context) (v string, err error) {
+func (m *SiteMutation) OldOrchestratorID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrchestratorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostname requires an ID field in the mutation")
+		return v, errors.New("OldOrchestratorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrchestratorID: %w", err)
 	}
-	return oldValue.Hostname, nil
+	return oldValue.OrchestratorID, nil
 }
 
-// ClearHostname clears the value of the "hostname" field.
-func (m *HostMutation) ClearHostname() {
-	m.hostname = nil
-	m.clearedFields[host.FieldHostname] = struct{}{}
+// ClearOrchestratorID clears the value of the "orchestrator_id" field.
+func (m *SiteMutation) ClearOrchestratorID() {
+	m.orchestrator = nil
+	m.clearedFields[site.FieldOrchestratorID] = struct{}{}
 }
 
-// HostnameCleared returns if the "hostname" field was cleared in this mutation.
-func (m *HostMutation) HostnameCleared() bool {
-	_, ok := m.clearedFields[host.FieldHostname]
+// OrchestratorIDCleared returns if the "orchestrator_id" field was cleared in this mutation.
+func (m *SiteMutation) OrchestratorIDCleared() bool {
+	_, ok := m.clearedFields[site.FieldOrchestratorID]
 	return ok
 }
 
-// ResetHostname resets all changes to the "hostname" field.
-func (m *HostMutation) ResetHostname() {
-	m.hostname = nil
-	delete(m.clearedFields, host.FieldHostname)
+// ResetOrchestratorID resets all changes to the "orchestrator_id" field.
+func (m *SiteMutation) ResetOrchestratorID() {
+	m.orchestrator = nil
+	delete(m.clearedFields, site.FieldOrchestratorID)
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *HostMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetMetadata sets the "metadata" field.
+func (m *SiteMutation) SetMetadata(s struct{}) {
+	m.metadata = &s
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *HostMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SiteMutation) Metadata() (r struct{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *SiteMutation) OldMetadata(ctx context.Context) (v struct{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.Metadata, nil
 }
 
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *HostMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[host.FieldIPAddress] = struct{}{}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SiteMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[site.FieldMetadata] = struct{}{}
 }
 
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *HostMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[host.FieldIPAddress]
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SiteMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[site.FieldMetadata]
 	return ok
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *HostMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, host.FieldIPAddress)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SiteMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, site.FieldMetadata)
 }
 
-// SetEdgeURL sets the "edge_url" field.
-func (m *HostMutation) SetEdgeURL(s string) {
-	m.edge_url = &s
+// SetStatus sets the "status" field.
+func (m *SiteMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// EdgeURL returns the value of the "edge_url" field in the mutation.
-func (m *HostMutation) EdgeURL() (r string, exists bool) {
-	v := m.edge_url
+// Status returns the value of the "status" field in the mutation.
+func (m *SiteMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEdgeURL returns the old "edge_url" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldEdgeURL(ctx context.Context) (v string, err error) {
+func (m *SiteMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEdgeURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEdgeURL requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEdgeURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.EdgeURL, nil
+	return oldValue.Status, nil
 }
 
-// ClearEdgeURL clears the value of the "edge_url" field.
-func (m *HostMutation) ClearEdgeURL() {
-	m.edge_url = nil
-	m.clearedFields[host.FieldEdgeURL] = struct{}{}
+// ClearStatus clears the value of the "status" field.
+func (m *SiteMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[site.FieldStatus] = struct{}{}
 }
 
-// EdgeURLCleared returns if the "edge_url" field was cleared in this mutation.
-func (m *HostMutation) EdgeURLCleared() bool {
-	_, ok := m.clearedFields[host.FieldEdgeURL]
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *SiteMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[site.FieldStatus]
 	return ok
 }
 
-// ResetEdgeURL resets all changes to the "edge_url" field.
-func (m *HostMutation) ResetEdgeURL() {
-	m.edge_url = nil
-	delete(m.clearedFields, host.FieldEdgeURL)
+// ResetStatus resets all changes to the "status" field.
+func (m *SiteMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, site.FieldStatus)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *HostMutation) SetMetadata(s struct{}) {
-	m.metadata = &s
+// SetLastSeen sets the "last_seen" field.
+func (m *SiteMutation) SetLastSeen(t time.Time) {
+	m.last_seen = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *HostMutation) Metadata() (r struct{}, exists bool) {
-	v := m.metadata
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *SiteMutation) LastSeen() (r time.Time, exists bool) {
+	v := m.last_seen
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeen returns the old "last_seen" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldMetadata(ctx context.Context) (v struct{}, err error) {
+func (m *SiteMutation) OldLastSeen(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.LastSeen, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *HostMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[host.FieldMetadata] = struct{}{}
+// ClearLastSeen clears the value of the "last_seen" field.
+func (m *SiteMutation) ClearLastSeen() {
+	m.last_seen = nil
+	m.clearedFields[site.FieldLastSeen] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *HostMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[host.FieldMetadata]
+// LastSeenCleared returns if the "last_seen" field was cleared in this mutation.
+func (m *SiteMutation) LastSeenCleared() bool {
+	_, ok := m.clearedFields[site.FieldLastSeen]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *HostMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, host.FieldMetadata)
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *SiteMutation) ResetLastSeen() {
+	m.last_seen = nil
+	delete(m.clearedFields, site.FieldLastSeen)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *HostMutation) SetCreatedAt(t time.Time) {
+func (m *SiteMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HostMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SiteMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5222,10 +13877,10 @@ func (m *HostMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SiteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5240,30 +13895,30 @@ func (m *HostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ClearCreatedAt clears the value of the "created_at" field.
-func (m *HostMutation) ClearCreatedAt() {
+func (m *SiteMutation) ClearCreatedAt() {
 	m.created_at = nil
-	m.clearedFields[host.FieldCreatedAt] = struct{}{}
+	m.clearedFields[site.FieldCreatedAt] = struct{}{}
 }
 
 // CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *HostMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[host.FieldCreatedAt]
+func (m *SiteMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[site.FieldCreatedAt]
 	return ok
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HostMutation) ResetCreatedAt() {
+func (m *SiteMutation) ResetCreatedAt() {
 	m.created_at = nil
-	delete(m.clearedFields, host.FieldCreatedAt)
+	delete(m.clearedFields, site.FieldCreatedAt)
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *HostMutation) SetUpdatedAt(t time.Time) {
+func (m *SiteMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HostMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SiteMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5271,10 +13926,10 @@ func (m *HostMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Site entity.
+// If the Site object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SiteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5289,59 +13944,153 @@ func (m *HostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *HostMutation) ClearUpdatedAt() {
+func (m *SiteMutation) ClearUpdatedAt() {
 	m.updated_at = nil
-	m.clearedFields[host.FieldUpdatedAt] = struct{}{}
+	m.clearedFields[site.FieldUpdatedAt] = struct{}{}
 }
 
 // UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *HostMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[host.FieldUpdatedAt]
+func (m *SiteMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[site.FieldUpdatedAt]
 	return ok
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HostMutation) ResetUpdatedAt() {
+func (m *SiteMutation) ResetUpdatedAt() {
 	m.updated_at = nil
-	delete(m.clearedFields, host.FieldUpdatedAt)
+	delete(m.clearedFields, site.FieldUpdatedAt)
 }
 
-// ClearSite clears the "site" edge to the Site entity.
-func (m *HostMutation) ClearSite() {
-	m.clearedsite = true
-	m.clearedFields[host.FieldSiteID] = struct{}{}
+// AddHostIDs adds the "hosts" edge to the Host entity by ids.
+func (m *SiteMutation) AddHostIDs(ids ...uuid.UUID) {
+	if m.hosts == nil {
+		m.hosts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hosts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHosts clears the "hosts" edge to the Host entity.
+func (m *SiteMutation) ClearHosts() {
+	m.clearedhosts = true
+}
+
+// HostsCleared reports if the "hosts" edge to the Host entity was cleared.
+func (m *SiteMutation) HostsCleared() bool {
+	return m.clearedhosts
+}
+
+// RemoveHostIDs removes the "hosts" edge to the Host entity by IDs.
+func (m *SiteMutation) RemoveHostIDs(ids ...uuid.UUID) {
+	if m.removedhosts == nil {
+		m.removedhosts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hosts, ids[i])
+		m.removedhosts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHosts returns the removed IDs of the "hosts" edge to the Host entity.
+func (m *SiteMutation) RemovedHostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedhosts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HostsIDs returns the "hosts" edge IDs in the mutation.
+func (m *SiteMutation) HostsIDs() (ids []uuid.UUID) {
+	for id := range m.hosts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHosts resets all changes to the "hosts" edge.
+func (m *SiteMutation) ResetHosts() {
+	m.hosts = nil
+	m.clearedhosts = false
+	m.removedhosts = nil
+}
+
+// ClearOrchestrator clears the "orchestrator" edge to the Orchestrator entity.
+func (m *SiteMutation) ClearOrchestrator() {
+	m.clearedorchestrator = true
+	m.clearedFields[site.FieldOrchestratorID] = struct{}{}
+}
+
+// OrchestratorCleared reports if the "orchestrator" edge to the Orchestrator entity was cleared.
+func (m *SiteMutation) OrchestratorCleared() bool {
+	return m.OrchestratorIDCleared() || m.clearedorchestrator
+}
+
+// OrchestratorIDs returns the "orchestrator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrchestratorID instead. It exists only for internal usage by the builders.
+func (m *SiteMutation) OrchestratorIDs() (ids []uuid.UUID) {
+	if id := m.orchestrator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrchestrator resets all changes to the "orchestrator" edge.
+func (m *SiteMutation) ResetOrchestrator() {
+	m.orchestrator = nil
+	m.clearedorchestrator = false
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *SiteMutation) SetOrganizationID(id uuid.UUID) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *SiteMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[site.FieldOrgID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *SiteMutation) OrganizationCleared() bool {
+	return m.OrgIDCleared() || m.clearedorganization
 }
 
-// SiteCleared reports if the "site" edge to the Site entity was cleared.
-func (m *HostMutation) SiteCleared() bool {
-	return m.SiteIDCleared() || m.clearedsite
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *SiteMutation) OrganizationID() (id uuid.UUID, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
 }
 
-// SiteIDs returns the "site" edge IDs in the mutation.
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SiteID instead. It exists only for internal usage by the builders.
-func (m *HostMutation) SiteIDs() (ids []uuid.UUID) {
-	if id := m.site; id != nil {
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *SiteMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSite resets all changes to the "site" edge.
-func (m *HostMutation) ResetSite() {
-	m.site = nil
-	m.clearedsite = false
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *SiteMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// Where appends a list predicates to the HostMutation builder.
-func (m *HostMutation) Where(ps ...predicate.Host) {
+// Where appends a list predicates to the SiteMutation builder.
+func (m *SiteMutation) Where(ps ...predicate.Site) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SiteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Host, len(ps))
+func (m *SiteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Site, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5349,63 +14098,60 @@ func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HostMutation) Op() Op {
+func (m *SiteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HostMutation) SetOp(op Op) {
+func (m *SiteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Host).
-func (m *HostMutation) Type() string {
+// Type returns the node type of this mutation (Site).
+func (m *SiteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HostMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.host_id != nil {
-		fields = append(fields, host.FieldHostID)
-	}
-	if m.site != nil {
-		fields = append(fields, host.FieldSiteID)
+func (m *SiteMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.organization != nil {
+		fields = append(fields, site.FieldOrgID)
 	}
-	if m.runtime != nil {
-		fields = append(fields, host.FieldRuntime)
+	if m.site_id != nil {
+		fields = append(fields, site.FieldSiteID)
 	}
-	if m.last_seen != nil {
-		fields = append(fields, host.FieldLastSeen)
+	if m.name != nil {
+		fields = append(fields, site.FieldName)
 	}
-	if m.misses != nil {
-		fields = append(fields, host.FieldMisses)
+	if m.description != nil {
+		fields = append(fields, site.FieldDescription)
 	}
-	if m.cpu_free != nil {
-		fields = append(fields, host.FieldCPUFree)
+	if m.location != nil {
+		fields = append(fields, site.FieldLocation)
 	}
-	if m.status != nil {
-		fields = append(fields, host.FieldStatus)
+	if m.group != nil {
+		fields = append(fields, site.FieldGroup)
 	}
-	if m.hostname != nil {
-		fields = append(fields, host.FieldHostname)
+	if m.orchestrator != nil {
+		fields = append(fields, site.FieldOrchestratorID)
 	}
-	if m.ip_address != nil {
-		fields = append(fields, host.FieldIPAddress)
+	if m.metadata != nil {
+		fields = append(fields, site.FieldMetadata)
 	}
-	if m.edge_url != nil {
-		fields = append(fields, host.FieldEdgeURL)
+	if m.status != nil {
+		fields = append(fields, site.FieldStatus)
 	}
-	if m.metadata != nil {
-		fields = append(fields, host.FieldMetadata)
+	if m.last_seen != nil {
+		fields = append(fields, site.FieldLastSeen)
 	}
 	if m.created_at != nil {
-		fields = append(fields, host.FieldCreatedAt)
+		fields = append(fields, site.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, host.FieldUpdatedAt)
+		fields = append(fields, site.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5413,33 +14159,31 @@ func (m *HostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HostMutation) Field(name string) (ent.Value, bool) {
+func (m *SiteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case host.FieldHostID:
-		return m.HostID()
-	case host.FieldSiteID:
+	case site.FieldOrgID:
+		return m.OrgID()
+	case site.FieldSiteID:
 		return m.SiteID()
-	case host.FieldRuntime:
-		return m.Runtime()
-	case host.FieldLastSeen:
-		return m.LastSeen()
-	case host.FieldMisses:
-		return m.Misses()
-	case host.FieldCPUFree:
-		return m.CPUFree()
-	case host.FieldStatus:
-		return m.Status()
-	case host.FieldHostname:
-		return m.Hostname()
-	case host.FieldIPAddress:
-		return m.IPAddress()
-	case host.FieldEdgeURL:
-		return m.EdgeURL()
-	case host.FieldMetadata:
+	case site.FieldName:
+		return m.Name()
+	case site.FieldDescription:
+		return m.Description()
+	case site.FieldLocation:
+		return m.Location()
+	case site.FieldGroup:
+		return m.Group()
+	case site.FieldOrchestratorID:
+		return m.OrchestratorID()
+	case site.FieldMetadata:
 		return m.Metadata()
-	case host.FieldCreatedAt:
+	case site.FieldStatus:
+		return m.Status()
+	case site.FieldLastSeen:
+		return m.LastSeen()
+	case site.FieldCreatedAt:
 		return m.CreatedAt()
-	case host.FieldUpdatedAt:
+	case site.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5448,128 +14192,119 @@ func (m *HostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SiteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case host.FieldHostID:
-		return m.OldHostID(ctx)
-	case host.FieldSiteID:
+	case site.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case site.FieldSiteID:
 		return m.OldSiteID(ctx)
-	case host.FieldRuntime:
-		return m.OldRuntime(ctx)
-	case host.FieldLastSeen:
-		return m.OldLastSeen(ctx)
-	case host.FieldMisses:
-		return m.OldMisses(ctx)
-	case host.FieldCPUFree:
-		return m.OldCPUFree(ctx)
-	case host.FieldStatus:
-		return m.OldStatus(ctx)
-	case host.FieldHostname:
-		return m.OldHostname(ctx)
-	case host.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case host.FieldEdgeURL:
-		return m.OldEdgeURL(ctx)
-	case host.FieldMetadata:
+	case site.FieldName:
+		return m.OldName(ctx)
+	case site.FieldDescription:
+		return m.OldDescription(ctx)
+	case site.FieldLocation:
+		return m.OldLocation(ctx)
+	case site.FieldGroup:
+		return m.OldGroup(ctx)
+	case site.FieldOrchestratorID:
+		return m.OldOrchestratorID(ctx)
+	case site.FieldMetadata:
 		return m.OldMetadata(ctx)
-	case host.FieldCreatedAt:
+	case site.FieldStatus:
+		return m.OldStatus(ctx)
+	case site.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	case site.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case host.FieldUpdatedAt:
+	case site.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Host field %s", name)
+	return nil, fmt.Errorf("unknown Site field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HostMutation) SetField(name string, value ent.Value) error {
+func (m *SiteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case host.FieldHostID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHostID(v)
-		return nil
-	case host.FieldSiteID:
+	case site.FieldOrgID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSiteID(v)
+		m.SetOrgID(v)
 		return nil
-	case host.FieldRuntime:
+	case site.FieldSiteID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRuntime(v)
+		m.SetSiteID(v)
 		return nil
-	case host.FieldLastSeen:
-		v, ok := value.(time.Time)
+	case site.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSeen(v)
+		m.SetName(v)
 		return nil
-	case host.FieldMisses:
-		v, ok := value.(int)
+	case site.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMisses(v)
+		m.SetDescription(v)
 		return nil
-	case host.FieldCPUFree:
-		v, ok := value.(float64)
+	case site.FieldLocation:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCPUFree(v)
+		m.SetLocation(v)
 		return nil
-	case host.FieldStatus:
+	case site.FieldGroup:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetGroup(v)
 		return nil
-	case host.FieldHostname:
-		v, ok := value.(string)
+	case site.FieldOrchestratorID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHostname(v)
+		m.SetOrchestratorID(v)
 		return nil
-	case host.FieldIPAddress:
-		v, ok := value.(string)
+	case site.FieldMetadata:
+		v, ok := value.(struct{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetMetadata(v)
 		return nil
-	case host.FieldEdgeURL:
+	case site.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEdgeURL(v)
+		m.SetStatus(v)
 		return nil
-	case host.FieldMetadata:
-		v, ok := value.(struct{})
+	case site.FieldLastSeen:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetLastSeen(v)
 		return nil
-	case host.FieldCreatedAt:
+	case site.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case host.FieldUpdatedAt:
+	case site.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5577,214 +14312,194 @@ func (m *HostMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Host field %s", name)
+	return fmt.Errorf("unknown Site field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HostMutation) AddedFields() []string {
-	var fields []string
-	if m.addmisses != nil {
-		fields = append(fields, host.FieldMisses)
-	}
-	if m.addcpu_free != nil {
-		fields = append(fields, host.FieldCPUFree)
-	}
-	return fields
+func (m *SiteMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case host.FieldMisses:
-		return m.AddedMisses()
-	case host.FieldCPUFree:
-		return m.AddedCPUFree()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HostMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case host.FieldMisses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMisses(v)
-		return nil
-	case host.FieldCPUFree:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCPUFree(v)
-		return nil
+func (m *SiteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SiteMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return fmt.Errorf("unknown Host numeric field %s", name)
+	return fmt.Errorf("unknown Site numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HostMutation) ClearedFields() []string {
+func (m *SiteMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(host.FieldSiteID) {
-		fields = append(fields, host.FieldSiteID)
-	}
-	if m.FieldCleared(host.FieldRuntime) {
-		fields = append(fields, host.FieldRuntime)
+	if m.FieldCleared(site.FieldOrgID) {
+		fields = append(fields, site.FieldOrgID)
 	}
-	if m.FieldCleared(host.FieldLastSeen) {
-		fields = append(fields, host.FieldLastSeen)
+	if m.FieldCleared(site.FieldName) {
+		fields = append(fields, site.FieldName)
 	}
-	if m.FieldCleared(host.FieldMisses) {
-		fields = append(fields, host.FieldMisses)
+	if m.FieldCleared(site.FieldDescription) {
+		fields = append(fields, site.FieldDescription)
 	}
-	if m.FieldCleared(host.FieldCPUFree) {
-		fields = append(fields, host.FieldCPUFree)
+	if m.FieldCleared(site.FieldLocation) {
+		fields = append(fields, site.FieldLocation)
 	}
-	if m.FieldCleared(host.FieldStatus) {
-		fields = append(fields, host.FieldStatus)
+	if m.FieldCleared(site.FieldGroup) {
+		fields = append(fields, site.FieldGroup)
 	}
-	if m.FieldCleared(host.FieldHostname) {
-		fields = append(fields, host.FieldHostname)
+	if m.FieldCleared(site.FieldOrchestratorID) {
+		fields = append(fields, site.FieldOrchestratorID)
 	}
-	if m.FieldCleared(host.FieldIPAddress) {
-		fields = append(fields, host.FieldIPAddress)
+	if m.FieldCleared(site.FieldMetadata) {
+		fields = append(fields, site.FieldMetadata)
 	}
-	if m.FieldCleared(host.FieldEdgeURL) {
-		fields = append(fields, host.FieldEdgeURL)
+	if m.FieldCleared(site.FieldStatus) {
+		fields = append(fields, site.FieldStatus)
 	}
-	if m.FieldCleared(host.FieldMetadata) {
-		fields = append(fields, host.FieldMetadata)
+	if m.FieldCleared(site.FieldLastSeen) {
+		fields = append(fields, site.FieldLastSeen)
 	}
-	if m.FieldCleared(host.FieldCreatedAt) {
-		fields = append(fields, host.FieldCreatedAt)
+	if m.FieldCleared(site.FieldCreatedAt) {
+		fields = append(fields, site.FieldCreatedAt)
 	}
-	if m.FieldCleared(host.FieldUpdatedAt) {
-		fields = append(fields, host.FieldUpdatedAt)
+	if m.FieldCleared(site.FieldUpdatedAt) {
+		fields = append(fields, site.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HostMutation) FieldCleared(name string) bool {
+func (m *SiteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HostMutation) ClearField(name string) error {
+func (m *SiteMutation) ClearField(name string) error {
 	switch name {
-	case host.FieldSiteID:
-		m.ClearSiteID()
-		return nil
-	case host.FieldRuntime:
-		m.ClearRuntime()
+	case site.FieldOrgID:
+		m.ClearOrgID()
 		return nil
-	case host.FieldLastSeen:
-		m.ClearLastSeen()
+	case site.FieldName:
+		m.ClearName()
 		return nil
-	case host.FieldMisses:
-		m.ClearMisses()
+	case site.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case host.FieldCPUFree:
-		m.ClearCPUFree()
+	case site.FieldLocation:
+		m.ClearLocation()
 		return nil
-	case host.FieldStatus:
-		m.ClearStatus()
+	case site.FieldGroup:
+		m.ClearGroup()
 		return nil
-	case host.FieldHostname:
-		m.ClearHostname()
+	case site.FieldOrchestratorID:
+		m.ClearOrchestratorID()
 		return nil
-	case host.FieldIPAddress:
-		m.ClearIPAddress()
+	case site.FieldMetadata:
+		m.ClearMetadata()
 		return nil
-	case host.FieldEdgeURL:
-		m.ClearEdgeURL()
+	case site.FieldStatus:
+		m.ClearStatus()
 		return nil
-	case host.FieldMetadata:
-		m.ClearMetadata()
+	case site.FieldLastSeen:
+		m.ClearLastSeen()
 		return nil
-	case host.FieldCreatedAt:
+	case site.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case host.FieldUpdatedAt:
+	case site.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Host nullable field %s", name)
+	return fmt.Errorf("unknown Site nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HostMutation) ResetField(name string) error {
+func (m *SiteMutation) ResetField(name string) error {
 	switch name {
-	case host.FieldHostID:
-		m.ResetHostID()
+	case site.FieldOrgID:
+		m.ResetOrgID()
 		return nil
-	case host.FieldSiteID:
+	case site.FieldSiteID:
 		m.ResetSiteID()
 		return nil
-	case host.FieldRuntime:
-		m.ResetRuntime()
-		return nil
-	case host.FieldLastSeen:
-		m.ResetLastSeen()
+	case site.FieldName:
+		m.ResetName()
 		return nil
-	case host.FieldMisses:
-		m.ResetMisses()
+	case site.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case host.FieldCPUFree:
-		m.ResetCPUFree()
+	case site.FieldLocation:
+		m.ResetLocation()
 		return nil
-	case host.FieldStatus:
-		m.ResetStatus()
+	case site.FieldGroup:
+		m.ResetGroup()
 		return nil
-	case host.FieldHostname:
-		m.ResetHostname()
+	case site.FieldOrchestratorID:
+		m.ResetOrchestratorID()
 		return nil
-	case host.FieldIPAddress:
-		m.ResetIPAddress()
+	case site.FieldMetadata:
+		m.ResetMetadata()
 		return nil
-	case host.FieldEdgeURL:
-		m.ResetEdgeURL()
+	case site.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case host.FieldMetadata:
-		m.ResetMetadata()
+	case site.FieldLastSeen:
+		m.ResetLastSeen()
 		return nil
-	case host.FieldCreatedAt:
+	case site.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case host.FieldUpdatedAt:
+	case site.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Host field %s", name)
+	return fmt.Errorf("unknown Site field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.site != nil {
-		edges = append(edges, host.EdgeSite)
+func (m *SiteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.hosts != nil {
+		edges = append(edges, site.EdgeHosts)
+	}
+	if m.orchestrator != nil {
+		edges = append(edges, site.EdgeOrchestrator)
+	}
+	if m.organization != nil {
+		edges = append(edges, site.EdgeOrganization)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HostMutation) AddedIDs(name string) []ent.Value {
+func (m *SiteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case host.EdgeSite:
-		if id := m.site; id != nil {
+	case site.EdgeHosts:
+		ids := make([]ent.Value, 0, len(m.hosts))
+		for id := range m.hosts {
+			ids = append(ids, id)
+		}
+		return ids
+	case site.EdgeOrchestrator:
+		if id := m.orchestrator; id != nil {
+			return []ent.Value{*id}
+		}
+	case site.EdgeOrganization:
+		if id := m.organization; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5792,90 +14507,124 @@ func (m *HostMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SiteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedhosts != nil {
+		edges = append(edges, site.EdgeHosts)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HostMutation) RemovedIDs(name string) []ent.Value {
+func (m *SiteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case site.EdgeHosts:
+		ids := make([]ent.Value, 0, len(m.removedhosts))
+		for id := range m.removedhosts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsite {
-		edges = append(edges, host.EdgeSite)
+func (m *SiteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedhosts {
+		edges = append(edges, site.EdgeHosts)
+	}
+	if m.clearedorchestrator {
+		edges = append(edges, site.EdgeOrchestrator)
+	}
+	if m.clearedorganization {
+		edges = append(edges, site.EdgeOrganization)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HostMutation) EdgeCleared(name string) bool {
+func (m *SiteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case host.EdgeSite:
-		return m.clearedsite
+	case site.EdgeHosts:
+		return m.clearedhosts
+	case site.EdgeOrchestrator:
+		return m.clearedorchestrator
+	case site.EdgeOrganization:
+		return m.clearedorganization
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HostMutation) ClearEdge(name string) error {
+func (m *SiteMutation) ClearEdge(name string) error {
 	switch name {
-	case host.EdgeSite:
-		m.ClearSite()
+	case site.EdgeOrchestrator:
+		m.ClearOrchestrator()
+		return nil
+	case site.EdgeOrganization:
+		m.ClearOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Host unique edge %s", name)
+	return fmt.Errorf("unknown Site unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HostMutation) ResetEdge(name string) error {
+func (m *SiteMutation) ResetEdge(name string) error {
 	switch name {
-	case host.EdgeSite:
-		m.ResetSite()
+	case site.EdgeHosts:
+		m.ResetHosts()
+		return nil
+	case site.EdgeOrchestrator:
+		m.ResetOrchestrator()
+		return nil
+	case site.EdgeOrganization:
+		m.ResetOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Host edge %s", name)
+	return fmt.Errorf("unknown Site edge %s", name)
 }
 
-// OrchestratorMutation represents an operation that mutates the Orchestrator nodes in the graph.
-type OrchestratorMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	name          *string
-	_type         *string
-	region        *string
-	api_endpoint  *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	sites         map[uuid.UUID]struct{}
-	removedsites  map[uuid.UUID]struct{}
-	clearedsites  bool
-	done          bool
-	oldValue      func(context.Context) (*Orchestrator, error)
-	predicates    []predicate.Orchestrator
+	op                  Op
+	typ                 string
+	id                  *int
+	username            *string
+	password_hash       *string
+	disabled            *bool
+	last_login_at       *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	roles               map[uuid.UUID]struct{}
+	removedroles        map[uuid.UUID]struct{}
+	clearedroles        bool
+	tokens              map[uuid.UUID]struct{}
+	removedtokens       map[uuid.UUID]struct{}
+	clearedtokens       bool
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*User, error)
+	predicates          []predicate.User
 }
 
-var _ ent.Mutation = (*OrchestratorMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// orchestratorOption allows management of the mutation configuration using functional options.
-type orchestratorOption func(*OrchestratorMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newOrchestratorMutation creates new mutation for the Orchestrator entity.
-func newOrchestratorMutation(c config, op Op, opts ...orchestratorOption) *OrchestratorMutation {
-	m := &OrchestratorMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrchestrator,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5884,20 +14633,20 @@ func newOrchestratorMutation(c config, op Op, opts ...orchestratorOption) *Orche
 	return m
 }
 
-// withOrchestratorID sets the ID field of the mutation.
-func withOrchestratorID(id uuid.UUID) orchestratorOption {
-	return func(m *OrchestratorMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Orchestrator
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Orchestrator, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Orchestrator.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5906,10 +14655,10 @@ func withOrchestratorID(id uuid.UUID) orchestratorOption {
 	}
 }
 
-// withOrchestrator sets the old Orchestrator of the mutation.
-func withOrchestrator(node *Orchestrator) orchestratorOption {
-	return func(m *OrchestratorMutation) {
-		m.oldValue = func(context.Context) (*Orchestrator, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5918,7 +14667,7 @@ func withOrchestrator(node *Orchestrator) orchestratorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrchestratorMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5926,7 +14675,7 @@ func (m OrchestratorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrchestratorMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5935,15 +14684,9 @@ func (m OrchestratorMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Orchestrator entities.
-func (m *OrchestratorMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrchestratorMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5954,224 +14697,247 @@ func (m *OrchestratorMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrchestratorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Orchestrator.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *OrchestratorMutation) SetName(s string) {
-	m.name = &s
+// SetOrgID sets the "org_id" field.
+func (m *UserMutation) SetOrgID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OrchestratorMutation) Name() (r string, exists bool) {
-	v := m.name
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *UserMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
+// OldOrgID returns the old "org_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OrgID, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *OrchestratorMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[orchestrator.FieldName] = struct{}{}
+// ClearOrgID clears the value of the "org_id" field.
+func (m *UserMutation) ClearOrgID() {
+	m.organization = nil
+	m.clearedFields[user.FieldOrgID] = struct{}{}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *OrchestratorMutation) NameCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldName]
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *UserMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldOrgID]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OrchestratorMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, orchestrator.FieldName)
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *UserMutation) ResetOrgID() {
+	m.organization = nil
+	delete(m.clearedFields, user.FieldOrgID)
 }
 
-// SetType sets the "type" field.
-func (m *OrchestratorMutation) SetType(s string) {
-	m._type = &s
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *OrchestratorMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ClearType clears the value of the "type" field.
-func (m *OrchestratorMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[orchestrator.FieldType] = struct{}{}
-}
-
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *OrchestratorMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldType]
-	return ok
+	return oldValue.Username, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *OrchestratorMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, orchestrator.FieldType)
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetRegion sets the "region" field.
-func (m *OrchestratorMutation) SetRegion(s string) {
-	m.region = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Region returns the value of the "region" field in the mutation.
-func (m *OrchestratorMutation) Region() (r string, exists bool) {
-	v := m.region
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegion returns the old "region" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldRegion(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegion requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Region, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ClearRegion clears the value of the "region" field.
-func (m *OrchestratorMutation) ClearRegion() {
-	m.region = nil
-	m.clearedFields[orchestrator.FieldRegion] = struct{}{}
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *UserMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[user.FieldPasswordHash] = struct{}{}
 }
 
-// RegionCleared returns if the "region" field was cleared in this mutation.
-func (m *OrchestratorMutation) RegionCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldRegion]
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *UserMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHash]
 	return ok
 }
 
-// ResetRegion resets all changes to the "region" field.
-func (m *OrchestratorMutation) ResetRegion() {
-	m.region = nil
-	delete(m.clearedFields, orchestrator.FieldRegion)
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, user.FieldPasswordHash)
 }
 
-// SetAPIEndpoint sets the "api_endpoint" field.
-func (m *OrchestratorMutation) SetAPIEndpoint(s string) {
-	m.api_endpoint = &s
+// SetDisabled sets the "disabled" field.
+func (m *UserMutation) SetDisabled(b bool) {
+	m.disabled = &b
 }
 
-// APIEndpoint returns the value of the "api_endpoint" field in the mutation.
-func (m *OrchestratorMutation) APIEndpoint() (r string, exists bool) {
-	v := m.api_endpoint
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *UserMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIEndpoint returns the old "api_endpoint" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabled returns the old "disabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldAPIEndpoint(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldDisabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
 	}
-	return oldValue.APIEndpoint, nil
+	return oldValue.Disabled, nil
 }
 
-// ClearAPIEndpoint clears the value of the "api_endpoint" field.
-func (m *OrchestratorMutation) ClearAPIEndpoint() {
-	m.api_endpoint = nil
-	m.clearedFields[orchestrator.FieldAPIEndpoint] = struct{}{}
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *UserMutation) ResetDisabled() {
+	m.disabled = nil
 }
 
-// APIEndpointCleared returns if the "api_endpoint" field was cleared in this mutation.
-func (m *OrchestratorMutation) APIEndpointCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldAPIEndpoint]
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
 	return ok
 }
 
-// ResetAPIEndpoint resets all changes to the "api_endpoint" field.
-func (m *OrchestratorMutation) ResetAPIEndpoint() {
-	m.api_endpoint = nil
-	delete(m.clearedFields, orchestrator.FieldAPIEndpoint)
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *OrchestratorMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrchestratorMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6179,10 +14945,10 @@ func (m *OrchestratorMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6196,136 +14962,168 @@ func (m *OrchestratorMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 	return oldValue.CreatedAt, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *OrchestratorMutation) ClearCreatedAt() {
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
-	m.clearedFields[orchestrator.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *OrchestratorMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldCreatedAt]
-	return ok
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrchestratorMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, orchestrator.FieldCreatedAt)
+// AddRoleIDs adds the "roles" edge to the RoleBinding entity by ids.
+func (m *UserMutation) AddRoleIDs(ids ...uuid.UUID) {
+	if m.roles == nil {
+		m.roles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OrchestratorMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearRoles clears the "roles" edge to the RoleBinding entity.
+func (m *UserMutation) ClearRoles() {
+	m.clearedroles = true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrchestratorMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// RolesCleared reports if the "roles" edge to the RoleBinding entity was cleared.
+func (m *UserMutation) RolesCleared() bool {
+	return m.clearedroles
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Orchestrator entity.
-// If the Orchestrator object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrchestratorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemoveRoleIDs removes the "roles" edge to the RoleBinding entity by IDs.
+func (m *UserMutation) RemoveRoleIDs(ids ...uuid.UUID) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *OrchestratorMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[orchestrator.FieldUpdatedAt] = struct{}{}
+// RemovedRoles returns the removed IDs of the "roles" edge to the RoleBinding entity.
+func (m *UserMutation) RemovedRolesIDs() (ids []uuid.UUID) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *OrchestratorMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[orchestrator.FieldUpdatedAt]
-	return ok
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *UserMutation) RolesIDs() (ids []uuid.UUID) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrchestratorMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, orchestrator.FieldUpdatedAt)
+// ResetRoles resets all changes to the "roles" edge.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
 }
 
-// AddSiteIDs adds the "sites" edge to the Site entity by ids.
-func (m *OrchestratorMutation) AddSiteIDs(ids ...uuid.UUID) {
-	if m.sites == nil {
-		m.sites = make(map[uuid.UUID]struct{})
+// AddTokenIDs adds the "tokens" edge to the APIToken entity by ids.
+func (m *UserMutation) AddTokenIDs(ids ...uuid.UUID) {
+	if m.tokens == nil {
+		m.tokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.sites[ids[i]] = struct{}{}
+		m.tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearSites clears the "sites" edge to the Site entity.
-func (m *OrchestratorMutation) ClearSites() {
-	m.clearedsites = true
+// ClearTokens clears the "tokens" edge to the APIToken entity.
+func (m *UserMutation) ClearTokens() {
+	m.clearedtokens = true
 }
 
-// SitesCleared reports if the "sites" edge to the Site entity was cleared.
-func (m *OrchestratorMutation) SitesCleared() bool {
-	return m.clearedsites
+// TokensCleared reports if the "tokens" edge to the APIToken entity was cleared.
+func (m *UserMutation) TokensCleared() bool {
+	return m.clearedtokens
 }
 
-// RemoveSiteIDs removes the "sites" edge to the Site entity by IDs.
-func (m *OrchestratorMutation) RemoveSiteIDs(ids ...uuid.UUID) {
-	if m.removedsites == nil {
-		m.removedsites = make(map[uuid.UUID]struct{})
+// RemoveTokenIDs removes the "tokens" edge to the APIToken entity by IDs.
+func (m *UserMutation) RemoveTokenIDs(ids ...uuid.UUID) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.sites, ids[i])
-		m.removedsites[ids[i]] = struct{}{}
+		delete(m.tokens, ids[i])
+		m.removedtokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokens returns the removed IDs of the "tokens" edge to the APIToken entity.
+func (m *UserMutation) RemovedTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokensIDs returns the "tokens" edge IDs in the mutation.
+func (m *UserMutation) TokensIDs() (ids []uuid.UUID) {
+	for id := range m.tokens {
+		ids = append(ids, id)
 	}
+	return
+}
+
+// ResetTokens resets all changes to the "tokens" edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *UserMutation) SetOrganizationID(id uuid.UUID) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *UserMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[user.FieldOrgID] = struct{}{}
 }
 
-// RemovedSites returns the removed IDs of the "sites" edge to the Site entity.
-func (m *OrchestratorMutation) RemovedSitesIDs() (ids []uuid.UUID) {
-	for id := range m.removedsites {
-		ids = append(ids, id)
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *UserMutation) OrganizationCleared() bool {
+	return m.OrgIDCleared() || m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *UserMutation) OrganizationID() (id uuid.UUID, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
 	}
 	return
 }
 
-// SitesIDs returns the "sites" edge IDs in the mutation.
-func (m *OrchestratorMutation) SitesIDs() (ids []uuid.UUID) {
-	for id := range m.sites {
-		ids = append(ids, id)
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSites resets all changes to the "sites" edge.
-func (m *OrchestratorMutation) ResetSites() {
-	m.sites = nil
-	m.clearedsites = false
-	m.removedsites = nil
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *UserMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// Where appends a list predicates to the OrchestratorMutation builder.
-func (m *OrchestratorMutation) Where(ps ...predicate.Orchestrator) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrchestratorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrchestratorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Orchestrator, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6333,42 +15131,42 @@ func (m *OrchestratorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrchestratorMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrchestratorMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Orchestrator).
-func (m *OrchestratorMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrchestratorMutation) Fields() []string {
+func (m *UserMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, orchestrator.FieldName)
+	if m.organization != nil {
+		fields = append(fields, user.FieldOrgID)
 	}
-	if m._type != nil {
-		fields = append(fields, orchestrator.FieldType)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.region != nil {
-		fields = append(fields, orchestrator.FieldRegion)
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.api_endpoint != nil {
-		fields = append(fields, orchestrator.FieldAPIEndpoint)
+	if m.disabled != nil {
+		fields = append(fields, user.FieldDisabled)
 	}
-	if m.created_at != nil {
-		fields = append(fields, orchestrator.FieldCreatedAt)
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, orchestrator.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6376,20 +15174,20 @@ func (m *OrchestratorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrchestratorMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case orchestrator.FieldName:
-		return m.Name()
-	case orchestrator.FieldType:
-		return m.GetType()
-	case orchestrator.FieldRegion:
-		return m.Region()
-	case orchestrator.FieldAPIEndpoint:
-		return m.APIEndpoint()
-	case orchestrator.FieldCreatedAt:
+	case user.FieldOrgID:
+		return m.OrgID()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldDisabled:
+		return m.Disabled()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case orchestrator.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6397,220 +15195,227 @@ func (m *OrchestratorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrchestratorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case orchestrator.FieldName:
-		return m.OldName(ctx)
-	case orchestrator.FieldType:
-		return m.OldType(ctx)
-	case orchestrator.FieldRegion:
-		return m.OldRegion(ctx)
-	case orchestrator.FieldAPIEndpoint:
-		return m.OldAPIEndpoint(ctx)
-	case orchestrator.FieldCreatedAt:
+	case user.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case orchestrator.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Orchestrator field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrchestratorMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case orchestrator.FieldName:
-		v, ok := value.(string)
+	case user.FieldOrgID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetOrgID(v)
 		return nil
-	case orchestrator.FieldType:
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUsername(v)
 		return nil
-	case orchestrator.FieldRegion:
+	case user.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegion(v)
+		m.SetPasswordHash(v)
 		return nil
-	case orchestrator.FieldAPIEndpoint:
-		v, ok := value.(string)
+	case user.FieldDisabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIEndpoint(v)
+		m.SetDisabled(v)
 		return nil
-	case orchestrator.FieldCreatedAt:
+	case user.FieldLastLoginAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastLoginAt(v)
 		return nil
-	case orchestrator.FieldUpdatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Orchestrator field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrchestratorMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrchestratorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrchestratorMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Orchestrator numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrchestratorMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(orchestrator.FieldName) {
-		fields = append(fields, orchestrator.FieldName)
-	}
-	if m.FieldCleared(orchestrator.FieldType) {
-		fields = append(fields, orchestrator.FieldType)
-	}
-	if m.FieldCleared(orchestrator.FieldRegion) {
-		fields = append(fields, orchestrator.FieldRegion)
-	}
-	if m.FieldCleared(orchestrator.FieldAPIEndpoint) {
-		fields = append(fields, orchestrator.FieldAPIEndpoint)
+	if m.FieldCleared(user.FieldOrgID) {
+		fields = append(fields, user.FieldOrgID)
 	}
-	if m.FieldCleared(orchestrator.FieldCreatedAt) {
-		fields = append(fields, orchestrator.FieldCreatedAt)
+	if m.FieldCleared(user.FieldPasswordHash) {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.FieldCleared(orchestrator.FieldUpdatedAt) {
-		fields = append(fields, orchestrator.FieldUpdatedAt)
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrchestratorMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrchestratorMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case orchestrator.FieldName:
-		m.ClearName()
-		return nil
-	case orchestrator.FieldType:
-		m.ClearType()
-		return nil
-	case orchestrator.FieldRegion:
-		m.ClearRegion()
+	case user.FieldOrgID:
+		m.ClearOrgID()
 		return nil
-	case orchestrator.FieldAPIEndpoint:
-		m.ClearAPIEndpoint()
-		return nil
-	case orchestrator.FieldCreatedAt:
-		m.ClearCreatedAt()
+	case user.FieldPasswordHash:
+		m.ClearPasswordHash()
 		return nil
-	case orchestrator.FieldUpdatedAt:
-		m.ClearUpdatedAt()
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Orchestrator nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrchestratorMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case orchestrator.FieldName:
-		m.ResetName()
+	case user.FieldOrgID:
+		m.ResetOrgID()
 		return nil
-	case orchestrator.FieldType:
-		m.ResetType()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case orchestrator.FieldRegion:
-		m.ResetRegion()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case orchestrator.FieldAPIEndpoint:
-		m.ResetAPIEndpoint()
+	case user.FieldDisabled:
+		m.ResetDisabled()
 		return nil
-	case orchestrator.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
-	case orchestrator.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Orchestrator field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrchestratorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.sites != nil {
-		edges = append(edges, orchestrator.EdgeSites)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.roles != nil {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.organization != nil {
+		edges = append(edges, user.EdgeOrganization)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrchestratorMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case orchestrator.EdgeSites:
-		ids := make([]ent.Value, 0, len(m.sites))
-		for id := range m.sites {
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrchestratorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedsites != nil {
-		edges = append(edges, orchestrator.EdgeSites)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedroles != nil {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrchestratorMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case orchestrator.EdgeSites:
-		ids := make([]ent.Value, 0, len(m.removedsites))
-		for id := range m.removedsites {
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6619,78 +15424,98 @@ func (m *OrchestratorMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrchestratorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsites {
-		edges = append(edges, orchestrator.EdgeSites)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroles {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.clearedtokens {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.clearedorganization {
+		edges = append(edges, user.EdgeOrganization)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrchestratorMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case orchestrator.EdgeSites:
-		return m.clearedsites
+	case user.EdgeRoles:
+		return m.clearedroles
+	case user.EdgeTokens:
+		return m.clearedtokens
+	case user.EdgeOrganization:
+		return m.clearedorganization
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrchestratorMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
 	}
-	return fmt.Errorf("unknown Orchestrator unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrchestratorMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case orchestrator.EdgeSites:
-		m.ResetSites()
+	case user.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeOrganization:
+		m.ResetOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Orchestrator edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SiteMutation represents an operation that mutates the Site nodes in the graph.
-type SiteMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	site_id             *string
-	name                *string
-	description         *string
-	location            *string
-	metadata            *struct{}
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	hosts               map[uuid.UUID]struct{}
-	removedhosts        map[uuid.UUID]struct{}
-	clearedhosts        bool
-	orchestrator        *uuid.UUID
-	clearedorchestrator bool
-	done                bool
-	oldValue            func(context.Context) (*Site, error)
-	predicates          []predicate.Site
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	org_id            *uuid.UUID
+	name              *string
+	url               *string
+	secret            *string
+	event_types       *[]string
+	appendevent_types []string
+	active            *bool
+	created_by        *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
 }
 
-var _ ent.Mutation = (*SiteMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// siteOption allows management of the mutation configuration using functional options.
-type siteOption func(*SiteMutation)
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newSiteMutation creates new mutation for the Site entity.
-func newSiteMutation(c config, op Op, opts ...siteOption) *SiteMutation {
-	m := &SiteMutation{
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSite,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6699,20 +15524,20 @@ func newSiteMutation(c config, op Op, opts ...siteOption) *SiteMutation {
 	return m
 }
 
-// withSiteID sets the ID field of the mutation.
-func withSiteID(id uuid.UUID) siteOption {
-	return func(m *SiteMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Site
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Site, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Site.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6721,10 +15546,10 @@ func withSiteID(id uuid.UUID) siteOption {
 	}
 }
 
-// withSite sets the old Site of the mutation.
-func withSite(node *Site) siteOption {
-	return func(m *SiteMutation) {
-		m.oldValue = func(context.Context) (*Site, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6733,7 +15558,7 @@ func withSite(node *Site) siteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SiteMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6741,7 +15566,7 @@ func (m SiteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SiteMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6751,14 +15576,14 @@ func (m SiteMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Site entities.
-func (m *SiteMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SiteMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6769,7 +15594,7 @@ func (m *SiteMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SiteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6778,55 +15603,68 @@ func (m *SiteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Site.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSiteID sets the "site_id" field.
-func (m *SiteMutation) SetSiteID(s string) {
-	m.site_id = &s
+// SetOrgID sets the "org_id" field.
+func (m *WebhookMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
 }
 
-// SiteID returns the value of the "site_id" field in the mutation.
-func (m *SiteMutation) SiteID() (r string, exists bool) {
-	v := m.site_id
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *WebhookMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSiteID returns the old "site_id" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// OldOrgID returns the old "org_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldSiteID(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSiteID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSiteID requires an ID field in the mutation")
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSiteID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
 	}
-	return oldValue.SiteID, nil
+	return oldValue.OrgID, nil
 }
 
-// ResetSiteID resets all changes to the "site_id" field.
-func (m *SiteMutation) ResetSiteID() {
-	m.site_id = nil
+// ClearOrgID clears the value of the "org_id" field.
+func (m *WebhookMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[webhook.FieldOrgID] = struct{}{}
+}
+
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *WebhookMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldOrgID]
+	return ok
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *WebhookMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, webhook.FieldOrgID)
 }
 
 // SetName sets the "name" field.
-func (m *SiteMutation) SetName(s string) {
+func (m *WebhookMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *SiteMutation) Name() (r string, exists bool) {
+func (m *WebhookMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -6834,10 +15672,10 @@ func (m *SiteMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -6851,915 +15689,1417 @@ func (m *SiteMutation) OldName(ctx context.Context) (v string, err error) {
 	return oldValue.Name, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *SiteMutation) ClearName() {
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
 	m.name = nil
-	m.clearedFields[site.FieldName] = struct{}{}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *SiteMutation) NameCleared() bool {
-	_, ok := m.clearedFields[site.FieldName]
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
+}
+
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventTypes returns the old "event_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
+	}
+	return oldValue.EventTypes, nil
+}
+
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
+}
+
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
+	}
+	return m.appendevent_types, true
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *WebhookMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[webhook.FieldEventTypes] = struct{}{}
+}
+
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *WebhookMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEventTypes]
+	return ok
+}
+
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, webhook.FieldEventTypes)
+}
+
+// SetActive sets the "active" field.
+func (m *WebhookMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *WebhookMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *WebhookMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *WebhookMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *WebhookMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *WebhookMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[webhook.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *WebhookMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCreatedBy]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SiteMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, site.FieldName)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *WebhookMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, webhook.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
 }
 
-// SetDescription sets the "description" field.
-func (m *SiteMutation) SetDescription(s string) {
-	m.description = &s
+// SetOp allows setting the mutation operation.
+func (m *WebhookMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SiteMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
 }
 
-// OldDescription returns the old "description" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.org_id != nil {
+		fields = append(fields, webhook.FieldOrgID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	return oldValue.Description, nil
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhook.FieldEventTypes)
+	}
+	if m.active != nil {
+		fields = append(fields, webhook.FieldActive)
+	}
+	if m.created_by != nil {
+		fields = append(fields, webhook.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *SiteMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[site.FieldDescription] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldOrgID:
+		return m.OrgID()
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEventTypes:
+		return m.EventTypes()
+	case webhook.FieldActive:
+		return m.Active()
+	case webhook.FieldCreatedBy:
+		return m.CreatedBy()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SiteMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[site.FieldDescription]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhook.FieldActive:
+		return m.OldActive(ctx)
+	case webhook.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SiteMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, site.FieldDescription)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case webhook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhook.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case webhook.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// SetLocation sets the "location" field.
-func (m *SiteMutation) SetLocation(s string) {
-	m.location = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	return nil
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *SiteMutation) Location() (r string, exists bool) {
-	v := m.location
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
-// OldLocation returns the old "location" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldLocation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldOrgID) {
+		fields = append(fields, webhook.FieldOrgID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+	if m.FieldCleared(webhook.FieldEventTypes) {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	if m.FieldCleared(webhook.FieldCreatedBy) {
+		fields = append(fields, webhook.FieldCreatedBy)
 	}
-	return oldValue.Location, nil
+	return fields
 }
 
-// ClearLocation clears the value of the "location" field.
-func (m *SiteMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[site.FieldLocation] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *SiteMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[site.FieldLocation]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case webhook.FieldEventTypes:
+		m.ClearEventTypes()
+		return nil
+	case webhook.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *SiteMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, site.FieldLocation)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhook.FieldActive:
+		m.ResetActive()
+		return nil
+	case webhook.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// SetOrchestratorID sets the "orchestrator_id" field.
-func (m *SiteMutation) SetOrchestratorID(u uuid.UUID) {
-	m.orchestrator = &u
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
 }
 
-// OrchestratorID returns the value of the "orchestrator_id" field in the mutation.
-func (m *SiteMutation) OrchestratorID() (r uuid.UUID, exists bool) {
-	v := m.orchestrator
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldOrchestratorID returns the old "orchestrator_id" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldOrchestratorID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrchestratorID is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrchestratorID requires an ID field in the mutation")
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrchestratorID: %w", err)
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
-	return oldValue.OrchestratorID, nil
+	return false
 }
 
-// ClearOrchestratorID clears the value of the "orchestrator_id" field.
-func (m *SiteMutation) ClearOrchestratorID() {
-	m.orchestrator = nil
-	m.clearedFields[site.FieldOrchestratorID] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
-// OrchestratorIDCleared returns if the "orchestrator_id" field was cleared in this mutation.
-func (m *SiteMutation) OrchestratorIDCleared() bool {
-	_, ok := m.clearedFields[site.FieldOrchestratorID]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	switch name {
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// ResetOrchestratorID resets all changes to the "orchestrator_id" field.
-func (m *SiteMutation) ResetOrchestratorID() {
-	m.orchestrator = nil
-	delete(m.clearedFields, site.FieldOrchestratorID)
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	org_id             *uuid.UUID
+	event_id           *string
+	event_type         *string
+	payload            *string
+	status             *string
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	response_status    *int
+	addresponse_status *int
+	error              *string
+	created_at         *time.Time
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *uuid.UUID
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SiteMutation) SetMetadata(s struct{}) {
-	m.metadata = &s
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SiteMutation) Metadata() (r struct{}, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldMetadata(ctx context.Context) (v struct{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
-	}
-	return oldValue.Metadata, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SiteMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[site.FieldMetadata] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SiteMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[site.FieldMetadata]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SiteMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, site.FieldMetadata)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SiteMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOrgID sets the "org_id" field.
+func (m *WebhookDeliveryMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SiteMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *WebhookDeliveryMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// OldOrgID returns the old "org_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.OrgID, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *SiteMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[site.FieldCreatedAt] = struct{}{}
+// ClearOrgID clears the value of the "org_id" field.
+func (m *WebhookDeliveryMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[webhookdelivery.FieldOrgID] = struct{}{}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *SiteMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[site.FieldCreatedAt]
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldOrgID]
 	return ok
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SiteMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, site.FieldCreatedAt)
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *WebhookDeliveryMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, webhookdelivery.FieldOrgID)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SiteMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SiteMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Site entity.
-// If the Site object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SiteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *SiteMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[site.FieldUpdatedAt] = struct{}{}
-}
-
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *SiteMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[site.FieldUpdatedAt]
-	return ok
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SiteMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, site.FieldUpdatedAt)
-}
-
-// AddHostIDs adds the "hosts" edge to the Host entity by ids.
-func (m *SiteMutation) AddHostIDs(ids ...uuid.UUID) {
-	if m.hosts == nil {
-		m.hosts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.hosts[ids[i]] = struct{}{}
-	}
-}
-
-// ClearHosts clears the "hosts" edge to the Host entity.
-func (m *SiteMutation) ClearHosts() {
-	m.clearedhosts = true
-}
-
-// HostsCleared reports if the "hosts" edge to the Host entity was cleared.
-func (m *SiteMutation) HostsCleared() bool {
-	return m.clearedhosts
-}
-
-// RemoveHostIDs removes the "hosts" edge to the Host entity by IDs.
-func (m *SiteMutation) RemoveHostIDs(ids ...uuid.UUID) {
-	if m.removedhosts == nil {
-		m.removedhosts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.hosts, ids[i])
-		m.removedhosts[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedHosts returns the removed IDs of the "hosts" edge to the Host entity.
-func (m *SiteMutation) RemovedHostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedhosts {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// HostsIDs returns the "hosts" edge IDs in the mutation.
-func (m *SiteMutation) HostsIDs() (ids []uuid.UUID) {
-	for id := range m.hosts {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetHosts resets all changes to the "hosts" edge.
-func (m *SiteMutation) ResetHosts() {
-	m.hosts = nil
-	m.clearedhosts = false
-	m.removedhosts = nil
-}
-
-// ClearOrchestrator clears the "orchestrator" edge to the Orchestrator entity.
-func (m *SiteMutation) ClearOrchestrator() {
-	m.clearedorchestrator = true
-	m.clearedFields[site.FieldOrchestratorID] = struct{}{}
-}
-
-// OrchestratorCleared reports if the "orchestrator" edge to the Orchestrator entity was cleared.
-func (m *SiteMutation) OrchestratorCleared() bool {
-	return m.OrchestratorIDCleared() || m.clearedorchestrator
-}
-
-// OrchestratorIDs returns the "orchestrator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrchestratorID instead. It exists only for internal usage by the builders.
-func (m *SiteMutation) OrchestratorIDs() (ids []uuid.UUID) {
-	if id := m.orchestrator; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return
-}
-
-// ResetOrchestrator resets all changes to the "orchestrator" edge.
-func (m *SiteMutation) ResetOrchestrator() {
-	m.orchestrator = nil
-	m.clearedorchestrator = false
+	return oldValue.WebhookID, nil
 }
 
-// Where appends a list predicates to the SiteMutation builder.
-func (m *SiteMutation) Where(ps ...predicate.Site) {
-	m.predicates = append(m.predicates, ps...)
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// WhereP appends storage-level predicates to the SiteMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SiteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Site, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *SiteMutation) Op() Op {
-	return m.op
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SiteMutation) SetOp(op Op) {
-	m.op = op
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// Type returns the node type of this mutation (Site).
-func (m *SiteMutation) Type() string {
-	return m.typ
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SiteMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.site_id != nil {
-		fields = append(fields, site.FieldSiteID)
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
 	}
-	if m.name != nil {
-		fields = append(fields, site.FieldName)
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
-	if m.description != nil {
-		fields = append(fields, site.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
-	if m.location != nil {
-		fields = append(fields, site.FieldLocation)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	if m.orchestrator != nil {
-		fields = append(fields, site.FieldOrchestratorID)
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	if m.metadata != nil {
-		fields = append(fields, site.FieldMetadata)
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, site.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, site.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return fields
+	return oldValue.Payload, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SiteMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case site.FieldSiteID:
-		return m.SiteID()
-	case site.FieldName:
-		return m.Name()
-	case site.FieldDescription:
-		return m.Description()
-	case site.FieldLocation:
-		return m.Location()
-	case site.FieldOrchestratorID:
-		return m.OrchestratorID()
-	case site.FieldMetadata:
-		return m.Metadata()
-	case site.FieldCreatedAt:
-		return m.CreatedAt()
-	case site.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SiteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case site.FieldSiteID:
-		return m.OldSiteID(ctx)
-	case site.FieldName:
-		return m.OldName(ctx)
-	case site.FieldDescription:
-		return m.OldDescription(ctx)
-	case site.FieldLocation:
-		return m.OldLocation(ctx)
-	case site.FieldOrchestratorID:
-		return m.OldOrchestratorID(ctx)
-	case site.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case site.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case site.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Site field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SiteMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case site.FieldSiteID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSiteID(v)
-		return nil
-	case site.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case site.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case site.FieldLocation:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLocation(v)
-		return nil
-	case site.FieldOrchestratorID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrchestratorID(v)
-		return nil
-	case site.FieldMetadata:
-		v, ok := value.(struct{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case site.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case site.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Site field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SiteMutation) AddedFields() []string {
-	return nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SiteMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SiteMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Site numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SiteMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(site.FieldName) {
-		fields = append(fields, site.FieldName)
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(site.FieldDescription) {
-		fields = append(fields, site.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
-	if m.FieldCleared(site.FieldLocation) {
-		fields = append(fields, site.FieldLocation)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	if m.FieldCleared(site.FieldOrchestratorID) {
-		fields = append(fields, site.FieldOrchestratorID)
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(site.FieldMetadata) {
-		fields = append(fields, site.FieldMetadata)
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(site.FieldCreatedAt) {
-		fields = append(fields, site.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(site.FieldUpdatedAt) {
-		fields = append(fields, site.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return fields
+	return oldValue.NextAttemptAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SiteMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SiteMutation) ClearField(name string) error {
-	switch name {
-	case site.FieldName:
-		m.ClearName()
-		return nil
-	case site.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case site.FieldLocation:
-		m.ClearLocation()
-		return nil
-	case site.FieldOrchestratorID:
-		m.ClearOrchestratorID()
-		return nil
-	case site.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case site.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	case site.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Site nullable field %s", name)
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SiteMutation) ResetField(name string) error {
-	switch name {
-	case site.FieldSiteID:
-		m.ResetSiteID()
-		return nil
-	case site.FieldName:
-		m.ResetName()
-		return nil
-	case site.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case site.FieldLocation:
-		m.ResetLocation()
-		return nil
-	case site.FieldOrchestratorID:
-		m.ResetOrchestratorID()
-		return nil
-	case site.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case site.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case site.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Site field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SiteMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.hosts != nil {
-		edges = append(edges, site.EdgeHosts)
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
-	if m.orchestrator != nil {
-		edges = append(edges, site.EdgeOrchestrator)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SiteMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case site.EdgeHosts:
-		ids := make([]ent.Value, 0, len(m.hosts))
-		for id := range m.hosts {
-			ids = append(ids, id)
-		}
-		return ids
-	case site.EdgeOrchestrator:
-		if id := m.orchestrator; id != nil {
-			return []ent.Value{*id}
-		}
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SiteMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedhosts != nil {
-		edges = append(edges, site.EdgeHosts)
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SiteMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case site.EdgeHosts:
-		ids := make([]ent.Value, 0, len(m.removedhosts))
-		for id := range m.removedhosts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SiteMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedhosts {
-		edges = append(edges, site.EdgeHosts)
-	}
-	if m.clearedorchestrator {
-		edges = append(edges, site.EdgeOrchestrator)
-	}
-	return edges
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SiteMutation) EdgeCleared(name string) bool {
-	switch name {
-	case site.EdgeHosts:
-		return m.clearedhosts
-	case site.EdgeOrchestrator:
-		return m.clearedorchestrator
-	}
-	return false
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SiteMutation) ClearEdge(name string) error {
-	switch name {
-	case site.EdgeOrchestrator:
-		m.ClearOrchestrator()
-		return nil
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Site unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SiteMutation) ResetEdge(name string) error {
-	switch name {
-	case site.EdgeHosts:
-		m.ResetHosts()
-		return nil
-	case site.EdgeOrchestrator:
-		m.ResetOrchestrator()
-		return nil
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Site edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
+	return oldValue.DeliveredAt, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdelivery.FieldWebhookID] = struct{}{}
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7767,140 +17107,432 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.org_id != nil {
+		fields = append(fields, webhookdelivery.FieldOrgID)
+	}
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
+	}
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldOrgID:
+		return m.OrgID()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown User field %s", name)
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdelivery.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
+		return nil
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown User numeric field %s", name)
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldOrgID) {
+		fields = append(fields, webhookdelivery.FieldOrgID)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown User field %s", name)
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case webhookdelivery.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
+		return nil
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/google/uuid"
+)
+
+// Organization is the model entity for the Organization schema.
+type Organization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// MaxSites holds the value of the "max_sites" field.
+	MaxSites int `json:"max_sites,omitempty"`
+	// MaxDeployments holds the value of the "max_deployments" field.
+	MaxDeployments int `json:"max_deployments,omitempty"`
+	// MaxApps holds the value of the "max_apps" field.
+	MaxApps int `json:"max_apps,omitempty"`
+	// MaxUsers holds the value of the "max_users" field.
+	MaxUsers int `json:"max_users,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrganizationQuery when eager-loading is set.
+	Edges        OrganizationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrganizationEdges holds the relations/edges for other nodes in the graph.
+type OrganizationEdges struct {
+	// Apps holds the value of the apps edge.
+	Apps []*ApplicationDesc `json:"apps,omitempty"`
+	// Sites holds the value of the sites edge.
+	Sites []*Site `json:"sites,omitempty"`
+	// Deployments holds the value of the deployments edge.
+	Deployments []*DeploymentStatus `json:"deployments,omitempty"`
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// AppsOrErr returns the Apps value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) AppsOrErr() ([]*ApplicationDesc, error) {
+	if e.loadedTypes[0] {
+		return e.Apps, nil
+	}
+	return nil, &NotLoadedError{edge: "apps"}
+}
+
+// SitesOrErr returns the Sites value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) SitesOrErr() ([]*Site, error) {
+	if e.loadedTypes[1] {
+		return e.Sites, nil
+	}
+	return nil, &NotLoadedError{edge: "sites"}
+}
+
+// DeploymentsOrErr returns the Deployments value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) DeploymentsOrErr() ([]*DeploymentStatus, error) {
+	if e.loadedTypes[2] {
+		return e.Deployments, nil
+	}
+	return nil, &NotLoadedError{edge: "deployments"}
+}
+
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[3] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Organization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldMaxSites, organization.FieldMaxDeployments, organization.FieldMaxApps, organization.FieldMaxUsers:
+			values[i] = new(sql.NullInt64)
+		case organization.FieldName, organization.FieldDisplayName:
+			values[i] = new(sql.NullString)
+		case organization.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case organization.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Organization fields.
+func (_m *Organization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case organization.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case organization.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case organization.FieldMaxSites:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_sites", values[i])
+			} else if value.Valid {
+				_m.MaxSites = int(value.Int64)
+			}
+		case organization.FieldMaxDeployments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_deployments", values[i])
+			} else if value.Valid {
+				_m.MaxDeployments = int(value.Int64)
+			}
+		case organization.FieldMaxApps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_apps", values[i])
+			} else if value.Valid {
+				_m.MaxApps = int(value.Int64)
+			}
+		case organization.FieldMaxUsers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_users", values[i])
+			} else if value.Valid {
+				_m.MaxUsers = int(value.Int64)
+			}
+		case organization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Organization.
+// This includes values selected through modifiers, order, etc.
+func (_m *Organization) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryApps queries the "apps" edge of the Organization entity.
+func (_m *Organization) QueryApps() *ApplicationDescQuery {
+	return NewOrganizationClient(_m.config).QueryApps(_m)
+}
+
+// QuerySites queries the "sites" edge of the Organization entity.
+func (_m *Organization) QuerySites() *SiteQuery {
+	return NewOrganizationClient(_m.config).QuerySites(_m)
+}
+
+// QueryDeployments queries the "deployments" edge of the Organization entity.
+func (_m *Organization) QueryDeployments() *DeploymentStatusQuery {
+	return NewOrganizationClient(_m.config).QueryDeployments(_m)
+}
+
+// QueryUsers queries the "users" edge of the Organization entity.
+func (_m *Organization) QueryUsers() *UserQuery {
+	return NewOrganizationClient(_m.config).QueryUsers(_m)
+}
+
+// Update returns a builder for updating this Organization.
+// Note that you need to call Organization.Unwrap() before calling this method if this Organization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Organization) Update() *OrganizationUpdateOne {
+	return NewOrganizationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Organization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Organization) Unwrap() *Organization {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Organization is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Organization) String() string {
+	var builder strings.Builder
+	builder.WriteString("Organization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("max_sites=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxSites))
+	builder.WriteString(", ")
+	builder.WriteString("max_deployments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxDeployments))
+	builder.WriteString(", ")
+	builder.WriteString("max_apps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxApps))
+	builder.WriteString(", ")
+	builder.WriteString("max_users=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxUsers))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Organizations is a parsable slice of Organization.
+type Organizations []*Organization
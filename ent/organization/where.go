@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organization
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldName, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// MaxSites applies equality check predicate on the "max_sites" field. It's identical to MaxSitesEQ.
+func MaxSites(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxSites, v))
+}
+
+// MaxDeployments applies equality check predicate on the "max_deployments" field. It's identical to MaxDeploymentsEQ.
+func MaxDeployments(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxDeployments, v))
+}
+
+// MaxApps applies equality check predicate on the "max_apps" field. It's identical to MaxAppsEQ.
+func MaxApps(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxApps, v))
+}
+
+// MaxUsers applies equality check predicate on the "max_users" field. It's identical to MaxUsersEQ.
+func MaxUsers(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldContainsFold(FieldName, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.Organization {
+	return predicate.Organization(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.Organization {
+	return predicate.Organization(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.Organization {
+	return predicate.Organization(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// MaxSitesEQ applies the EQ predicate on the "max_sites" field.
+func MaxSitesEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxSites, v))
+}
+
+// MaxSitesNEQ applies the NEQ predicate on the "max_sites" field.
+func MaxSitesNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaxSites, v))
+}
+
+// MaxSitesIn applies the In predicate on the "max_sites" field.
+func MaxSitesIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldMaxSites, vs...))
+}
+
+// MaxSitesNotIn applies the NotIn predicate on the "max_sites" field.
+func MaxSitesNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldMaxSites, vs...))
+}
+
+// MaxSitesGT applies the GT predicate on the "max_sites" field.
+func MaxSitesGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldMaxSites, v))
+}
+
+// MaxSitesGTE applies the GTE predicate on the "max_sites" field.
+func MaxSitesGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldMaxSites, v))
+}
+
+// MaxSitesLT applies the LT predicate on the "max_sites" field.
+func MaxSitesLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldMaxSites, v))
+}
+
+// MaxSitesLTE applies the LTE predicate on the "max_sites" field.
+func MaxSitesLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldMaxSites, v))
+}
+
+// MaxDeploymentsEQ applies the EQ predicate on the "max_deployments" field.
+func MaxDeploymentsEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxDeployments, v))
+}
+
+// MaxDeploymentsNEQ applies the NEQ predicate on the "max_deployments" field.
+func MaxDeploymentsNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaxDeployments, v))
+}
+
+// MaxDeploymentsIn applies the In predicate on the "max_deployments" field.
+func MaxDeploymentsIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldMaxDeployments, vs...))
+}
+
+// MaxDeploymentsNotIn applies the NotIn predicate on the "max_deployments" field.
+func MaxDeploymentsNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldMaxDeployments, vs...))
+}
+
+// MaxDeploymentsGT applies the GT predicate on the "max_deployments" field.
+func MaxDeploymentsGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldMaxDeployments, v))
+}
+
+// MaxDeploymentsGTE applies the GTE predicate on the "max_deployments" field.
+func MaxDeploymentsGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldMaxDeployments, v))
+}
+
+// MaxDeploymentsLT applies the LT predicate on the "max_deployments" field.
+func MaxDeploymentsLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldMaxDeployments, v))
+}
+
+// MaxDeploymentsLTE applies the LTE predicate on the "max_deployments" field.
+func MaxDeploymentsLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldMaxDeployments, v))
+}
+
+// MaxAppsEQ applies the EQ predicate on the "max_apps" field.
+func MaxAppsEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxApps, v))
+}
+
+// MaxAppsNEQ applies the NEQ predicate on the "max_apps" field.
+func MaxAppsNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaxApps, v))
+}
+
+// MaxAppsIn applies the In predicate on the "max_apps" field.
+func MaxAppsIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldMaxApps, vs...))
+}
+
+// MaxAppsNotIn applies the NotIn predicate on the "max_apps" field.
+func MaxAppsNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldMaxApps, vs...))
+}
+
+// MaxAppsGT applies the GT predicate on the "max_apps" field.
+func MaxAppsGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldMaxApps, v))
+}
+
+// MaxAppsGTE applies the GTE predicate on the "max_apps" field.
+func MaxAppsGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldMaxApps, v))
+}
+
+// MaxAppsLT applies the LT predicate on the "max_apps" field.
+func MaxAppsLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldMaxApps, v))
+}
+
+// MaxAppsLTE applies the LTE predicate on the "max_apps" field.
+func MaxAppsLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldMaxApps, v))
+}
+
+// MaxUsersEQ applies the EQ predicate on the "max_users" field.
+func MaxUsersEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersNEQ applies the NEQ predicate on the "max_users" field.
+func MaxUsersNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersIn applies the In predicate on the "max_users" field.
+func MaxUsersIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersNotIn applies the NotIn predicate on the "max_users" field.
+func MaxUsersNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersGT applies the GT predicate on the "max_users" field.
+func MaxUsersGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldMaxUsers, v))
+}
+
+// MaxUsersGTE applies the GTE predicate on the "max_users" field.
+func MaxUsersGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldMaxUsers, v))
+}
+
+// MaxUsersLT applies the LT predicate on the "max_users" field.
+func MaxUsersLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldMaxUsers, v))
+}
+
+// MaxUsersLTE applies the LTE predicate on the "max_users" field.
+func MaxUsersLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldMaxUsers, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasApps applies the HasEdge predicate on the "apps" edge.
+func HasApps() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AppsTable, AppsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppsWith applies the HasEdge predicate on the "apps" edge with a given conditions (other predicates).
+func HasAppsWith(preds ...predicate.ApplicationDesc) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newAppsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSites applies the HasEdge predicate on the "sites" edge.
+func HasSites() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SitesTable, SitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSitesWith applies the HasEdge predicate on the "sites" edge with a given conditions (other predicates).
+func HasSitesWith(preds ...predicate.Site) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newSitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeployments applies the HasEdge predicate on the "deployments" edge.
+func HasDeployments() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeploymentsTable, DeploymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeploymentsWith applies the HasEdge predicate on the "deployments" edge with a given conditions (other predicates).
+func HasDeploymentsWith(preds ...predicate.DeploymentStatus) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newDeploymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUsers applies the HasEdge predicate on the "users" edge.
+func HasUsers() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsersTable, UsersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsersWith applies the HasEdge predicate on the "users" edge with a given conditions (other predicates).
+func HasUsersWith(preds ...predicate.User) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Organization) predicate.Organization {
+	return predicate.Organization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Organization) predicate.Organization {
+	return predicate.Organization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Organization) predicate.Organization {
+	return predicate.Organization(sql.NotPredicates(p))
+}
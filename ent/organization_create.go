@@ -0,0 +1,1077 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrganizationCreate is the builder for creating a Organization entity.
+type OrganizationCreate struct {
+	config
+	mutation *OrganizationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *OrganizationCreate) SetName(v string) *OrganizationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *OrganizationCreate) SetDisplayName(v string) *OrganizationCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableDisplayName(v *string) *OrganizationCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (_c *OrganizationCreate) SetMaxSites(v int) *OrganizationCreate {
+	_c.mutation.SetMaxSites(v)
+	return _c
+}
+
+// SetNillableMaxSites sets the "max_sites" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableMaxSites(v *int) *OrganizationCreate {
+	if v != nil {
+		_c.SetMaxSites(*v)
+	}
+	return _c
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (_c *OrganizationCreate) SetMaxDeployments(v int) *OrganizationCreate {
+	_c.mutation.SetMaxDeployments(v)
+	return _c
+}
+
+// SetNillableMaxDeployments sets the "max_deployments" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableMaxDeployments(v *int) *OrganizationCreate {
+	if v != nil {
+		_c.SetMaxDeployments(*v)
+	}
+	return _c
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (_c *OrganizationCreate) SetMaxApps(v int) *OrganizationCreate {
+	_c.mutation.SetMaxApps(v)
+	return _c
+}
+
+// SetNillableMaxApps sets the "max_apps" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableMaxApps(v *int) *OrganizationCreate {
+	if v != nil {
+		_c.SetMaxApps(*v)
+	}
+	return _c
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_c *OrganizationCreate) SetMaxUsers(v int) *OrganizationCreate {
+	_c.mutation.SetMaxUsers(v)
+	return _c
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableMaxUsers(v *int) *OrganizationCreate {
+	if v != nil {
+		_c.SetMaxUsers(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrganizationCreate) SetCreatedAt(v time.Time) *OrganizationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableCreatedAt(v *time.Time) *OrganizationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrganizationCreate) SetID(v uuid.UUID) *OrganizationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableID(v *uuid.UUID) *OrganizationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddAppIDs adds the "apps" edge to the ApplicationDesc entity by IDs.
+func (_c *OrganizationCreate) AddAppIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddAppIDs(ids...)
+	return _c
+}
+
+// AddApps adds the "apps" edges to the ApplicationDesc entity.
+func (_c *OrganizationCreate) AddApps(v ...*ApplicationDesc) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAppIDs(ids...)
+}
+
+// AddSiteIDs adds the "sites" edge to the Site entity by IDs.
+func (_c *OrganizationCreate) AddSiteIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddSiteIDs(ids...)
+	return _c
+}
+
+// AddSites adds the "sites" edges to the Site entity.
+func (_c *OrganizationCreate) AddSites(v ...*Site) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSiteIDs(ids...)
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the DeploymentStatus entity by IDs.
+func (_c *OrganizationCreate) AddDeploymentIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddDeploymentIDs(ids...)
+	return _c
+}
+
+// AddDeployments adds the "deployments" edges to the DeploymentStatus entity.
+func (_c *OrganizationCreate) AddDeployments(v ...*DeploymentStatus) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeploymentIDs(ids...)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_c *OrganizationCreate) AddUserIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddUserIDs(ids...)
+	return _c
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_c *OrganizationCreate) AddUsers(v ...*User) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUserIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (_c *OrganizationCreate) Mutation() *OrganizationMutation {
+	return _c.mutation
+}
+
+// Save creates the Organization in the database.
+func (_c *OrganizationCreate) Save(ctx context.Context) (*Organization, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrganizationCreate) SaveX(ctx context.Context) *Organization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrganizationCreate) defaults() {
+	if _, ok := _c.mutation.MaxSites(); !ok {
+		v := organization.DefaultMaxSites
+		_c.mutation.SetMaxSites(v)
+	}
+	if _, ok := _c.mutation.MaxDeployments(); !ok {
+		v := organization.DefaultMaxDeployments
+		_c.mutation.SetMaxDeployments(v)
+	}
+	if _, ok := _c.mutation.MaxApps(); !ok {
+		v := organization.DefaultMaxApps
+		_c.mutation.SetMaxApps(v)
+	}
+	if _, ok := _c.mutation.MaxUsers(); !ok {
+		v := organization.DefaultMaxUsers
+		_c.mutation.SetMaxUsers(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := organization.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := organization.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrganizationCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Organization.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := organization.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Organization.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxSites(); !ok {
+		return &ValidationError{Name: "max_sites", err: errors.New(`ent: missing required field "Organization.max_sites"`)}
+	}
+	if v, ok := _c.mutation.MaxSites(); ok {
+		if err := organization.MaxSitesValidator(v); err != nil {
+			return &ValidationError{Name: "max_sites", err: fmt.Errorf(`ent: validator failed for field "Organization.max_sites": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxDeployments(); !ok {
+		return &ValidationError{Name: "max_deployments", err: errors.New(`ent: missing required field "Organization.max_deployments"`)}
+	}
+	if v, ok := _c.mutation.MaxDeployments(); ok {
+		if err := organization.MaxDeploymentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_deployments", err: fmt.Errorf(`ent: validator failed for field "Organization.max_deployments": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxApps(); !ok {
+		return &ValidationError{Name: "max_apps", err: errors.New(`ent: missing required field "Organization.max_apps"`)}
+	}
+	if v, ok := _c.mutation.MaxApps(); ok {
+		if err := organization.MaxAppsValidator(v); err != nil {
+			return &ValidationError{Name: "max_apps", err: fmt.Errorf(`ent: validator failed for field "Organization.max_apps": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxUsers(); !ok {
+		return &ValidationError{Name: "max_users", err: errors.New(`ent: missing required field "Organization.max_users"`)}
+	}
+	if v, ok := _c.mutation.MaxUsers(); ok {
+		if err := organization.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Organization.max_users": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Organization.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OrganizationCreate) sqlSave(ctx context.Context) (*Organization, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Organization{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(organization.Table, sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(organization.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(organization.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.MaxSites(); ok {
+		_spec.SetField(organization.FieldMaxSites, field.TypeInt, value)
+		_node.MaxSites = value
+	}
+	if value, ok := _c.mutation.MaxDeployments(); ok {
+		_spec.SetField(organization.FieldMaxDeployments, field.TypeInt, value)
+		_node.MaxDeployments = value
+	}
+	if value, ok := _c.mutation.MaxApps(); ok {
+		_spec.SetField(organization.FieldMaxApps, field.TypeInt, value)
+		_node.MaxApps = value
+	}
+	if value, ok := _c.mutation.MaxUsers(); ok {
+		_spec.SetField(organization.FieldMaxUsers, field.TypeInt, value)
+		_node.MaxUsers = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(organization.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Organization.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OrganizationCreate) OnConflict(opts ...sql.ConflictOption) *OrganizationUpsertOne {
+	_c.conflict = opts
+	return &OrganizationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OrganizationCreate) OnConflictColumns(columns ...string) *OrganizationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OrganizationUpsertOne is the builder for "upsert"-ing
+	//  one Organization node.
+	OrganizationUpsertOne struct {
+		create *OrganizationCreate
+	}
+
+	// OrganizationUpsert is the "OnConflict" setter.
+	OrganizationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDisplayName sets the "display_name" field.
+func (u *OrganizationUpsert) SetDisplayName(v string) *OrganizationUpsert {
+	u.Set(organization.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateDisplayName() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OrganizationUpsert) ClearDisplayName() *OrganizationUpsert {
+	u.SetNull(organization.FieldDisplayName)
+	return u
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (u *OrganizationUpsert) SetMaxSites(v int) *OrganizationUpsert {
+	u.Set(organization.FieldMaxSites, v)
+	return u
+}
+
+// UpdateMaxSites sets the "max_sites" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateMaxSites() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldMaxSites)
+	return u
+}
+
+// AddMaxSites adds v to the "max_sites" field.
+func (u *OrganizationUpsert) AddMaxSites(v int) *OrganizationUpsert {
+	u.Add(organization.FieldMaxSites, v)
+	return u
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (u *OrganizationUpsert) SetMaxDeployments(v int) *OrganizationUpsert {
+	u.Set(organization.FieldMaxDeployments, v)
+	return u
+}
+
+// UpdateMaxDeployments sets the "max_deployments" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateMaxDeployments() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldMaxDeployments)
+	return u
+}
+
+// AddMaxDeployments adds v to the "max_deployments" field.
+func (u *OrganizationUpsert) AddMaxDeployments(v int) *OrganizationUpsert {
+	u.Add(organization.FieldMaxDeployments, v)
+	return u
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (u *OrganizationUpsert) SetMaxApps(v int) *OrganizationUpsert {
+	u.Set(organization.FieldMaxApps, v)
+	return u
+}
+
+// UpdateMaxApps sets the "max_apps" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateMaxApps() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldMaxApps)
+	return u
+}
+
+// AddMaxApps adds v to the "max_apps" field.
+func (u *OrganizationUpsert) AddMaxApps(v int) *OrganizationUpsert {
+	u.Add(organization.FieldMaxApps, v)
+	return u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *OrganizationUpsert) SetMaxUsers(v int) *OrganizationUpsert {
+	u.Set(organization.FieldMaxUsers, v)
+	return u
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateMaxUsers() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldMaxUsers)
+	return u
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *OrganizationUpsert) AddMaxUsers(v int) *OrganizationUpsert {
+	u.Add(organization.FieldMaxUsers, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(organization.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrganizationUpsertOne) UpdateNewValues() *OrganizationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(organization.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(organization.FieldName)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(organization.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrganizationUpsertOne) Ignore() *OrganizationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationUpsertOne) DoNothing() *OrganizationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationCreate.OnConflict
+// documentation for more info.
+func (u *OrganizationUpsertOne) Update(set func(*OrganizationUpsert)) *OrganizationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *OrganizationUpsertOne) SetDisplayName(v string) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateDisplayName() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OrganizationUpsertOne) ClearDisplayName() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (u *OrganizationUpsertOne) SetMaxSites(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxSites(v)
+	})
+}
+
+// AddMaxSites adds v to the "max_sites" field.
+func (u *OrganizationUpsertOne) AddMaxSites(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxSites(v)
+	})
+}
+
+// UpdateMaxSites sets the "max_sites" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateMaxSites() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxSites()
+	})
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (u *OrganizationUpsertOne) SetMaxDeployments(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxDeployments(v)
+	})
+}
+
+// AddMaxDeployments adds v to the "max_deployments" field.
+func (u *OrganizationUpsertOne) AddMaxDeployments(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxDeployments(v)
+	})
+}
+
+// UpdateMaxDeployments sets the "max_deployments" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateMaxDeployments() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxDeployments()
+	})
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (u *OrganizationUpsertOne) SetMaxApps(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxApps(v)
+	})
+}
+
+// AddMaxApps adds v to the "max_apps" field.
+func (u *OrganizationUpsertOne) AddMaxApps(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxApps(v)
+	})
+}
+
+// UpdateMaxApps sets the "max_apps" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateMaxApps() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxApps()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *OrganizationUpsertOne) SetMaxUsers(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *OrganizationUpsertOne) AddMaxUsers(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateMaxUsers() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrganizationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OrganizationUpsertOne.ID is not supported by MySQL driver. Use OrganizationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrganizationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrganizationCreateBulk is the builder for creating many Organization entities in bulk.
+type OrganizationCreateBulk struct {
+	config
+	err      error
+	builders []*OrganizationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Organization entities in the database.
+func (_c *OrganizationCreateBulk) Save(ctx context.Context) ([]*Organization, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Organization, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrganizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrganizationCreateBulk) SaveX(ctx context.Context) []*Organization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Organization.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OrganizationCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrganizationUpsertBulk {
+	_c.conflict = opts
+	return &OrganizationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OrganizationCreateBulk) OnConflictColumns(columns ...string) *OrganizationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OrganizationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Organization nodes.
+type OrganizationUpsertBulk struct {
+	create *OrganizationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(organization.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrganizationUpsertBulk) UpdateNewValues() *OrganizationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(organization.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(organization.FieldName)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(organization.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Organization.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrganizationUpsertBulk) Ignore() *OrganizationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationUpsertBulk) DoNothing() *OrganizationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrganizationUpsertBulk) Update(set func(*OrganizationUpsert)) *OrganizationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *OrganizationUpsertBulk) SetDisplayName(v string) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateDisplayName() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OrganizationUpsertBulk) ClearDisplayName() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (u *OrganizationUpsertBulk) SetMaxSites(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxSites(v)
+	})
+}
+
+// AddMaxSites adds v to the "max_sites" field.
+func (u *OrganizationUpsertBulk) AddMaxSites(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxSites(v)
+	})
+}
+
+// UpdateMaxSites sets the "max_sites" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateMaxSites() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxSites()
+	})
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (u *OrganizationUpsertBulk) SetMaxDeployments(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxDeployments(v)
+	})
+}
+
+// AddMaxDeployments adds v to the "max_deployments" field.
+func (u *OrganizationUpsertBulk) AddMaxDeployments(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxDeployments(v)
+	})
+}
+
+// UpdateMaxDeployments sets the "max_deployments" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateMaxDeployments() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxDeployments()
+	})
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (u *OrganizationUpsertBulk) SetMaxApps(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxApps(v)
+	})
+}
+
+// AddMaxApps adds v to the "max_apps" field.
+func (u *OrganizationUpsertBulk) AddMaxApps(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxApps(v)
+	})
+}
+
+// UpdateMaxApps sets the "max_apps" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateMaxApps() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxApps()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *OrganizationUpsertBulk) SetMaxUsers(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *OrganizationUpsertBulk) AddMaxUsers(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateMaxUsers() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrganizationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/google/uuid"
+)
+
+// OrganizationUpdate is the builder for updating Organization entities.
+type OrganizationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationMutation
+}
+
+// Where appends a list predicates to the OrganizationUpdate builder.
+func (_u *OrganizationUpdate) Where(ps ...predicate.Organization) *OrganizationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OrganizationUpdate) SetDisplayName(v string) *OrganizationUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableDisplayName(v *string) *OrganizationUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OrganizationUpdate) ClearDisplayName() *OrganizationUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (_u *OrganizationUpdate) SetMaxSites(v int) *OrganizationUpdate {
+	_u.mutation.ResetMaxSites()
+	_u.mutation.SetMaxSites(v)
+	return _u
+}
+
+// SetNillableMaxSites sets the "max_sites" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableMaxSites(v *int) *OrganizationUpdate {
+	if v != nil {
+		_u.SetMaxSites(*v)
+	}
+	return _u
+}
+
+// AddMaxSites adds value to the "max_sites" field.
+func (_u *OrganizationUpdate) AddMaxSites(v int) *OrganizationUpdate {
+	_u.mutation.AddMaxSites(v)
+	return _u
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (_u *OrganizationUpdate) SetMaxDeployments(v int) *OrganizationUpdate {
+	_u.mutation.ResetMaxDeployments()
+	_u.mutation.SetMaxDeployments(v)
+	return _u
+}
+
+// SetNillableMaxDeployments sets the "max_deployments" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableMaxDeployments(v *int) *OrganizationUpdate {
+	if v != nil {
+		_u.SetMaxDeployments(*v)
+	}
+	return _u
+}
+
+// AddMaxDeployments adds value to the "max_deployments" field.
+func (_u *OrganizationUpdate) AddMaxDeployments(v int) *OrganizationUpdate {
+	_u.mutation.AddMaxDeployments(v)
+	return _u
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (_u *OrganizationUpdate) SetMaxApps(v int) *OrganizationUpdate {
+	_u.mutation.ResetMaxApps()
+	_u.mutation.SetMaxApps(v)
+	return _u
+}
+
+// SetNillableMaxApps sets the "max_apps" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableMaxApps(v *int) *OrganizationUpdate {
+	if v != nil {
+		_u.SetMaxApps(*v)
+	}
+	return _u
+}
+
+// AddMaxApps adds value to the "max_apps" field.
+func (_u *OrganizationUpdate) AddMaxApps(v int) *OrganizationUpdate {
+	_u.mutation.AddMaxApps(v)
+	return _u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_u *OrganizationUpdate) SetMaxUsers(v int) *OrganizationUpdate {
+	_u.mutation.ResetMaxUsers()
+	_u.mutation.SetMaxUsers(v)
+	return _u
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableMaxUsers(v *int) *OrganizationUpdate {
+	if v != nil {
+		_u.SetMaxUsers(*v)
+	}
+	return _u
+}
+
+// AddMaxUsers adds value to the "max_users" field.
+func (_u *OrganizationUpdate) AddMaxUsers(v int) *OrganizationUpdate {
+	_u.mutation.AddMaxUsers(v)
+	return _u
+}
+
+// AddAppIDs adds the "apps" edge to the ApplicationDesc entity by IDs.
+func (_u *OrganizationUpdate) AddAppIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.AddAppIDs(ids...)
+	return _u
+}
+
+// AddApps adds the "apps" edges to the ApplicationDesc entity.
+func (_u *OrganizationUpdate) AddApps(v ...*ApplicationDesc) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppIDs(ids...)
+}
+
+// AddSiteIDs adds the "sites" edge to the Site entity by IDs.
+func (_u *OrganizationUpdate) AddSiteIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.AddSiteIDs(ids...)
+	return _u
+}
+
+// AddSites adds the "sites" edges to the Site entity.
+func (_u *OrganizationUpdate) AddSites(v ...*Site) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSiteIDs(ids...)
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the DeploymentStatus entity by IDs.
+func (_u *OrganizationUpdate) AddDeploymentIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.AddDeploymentIDs(ids...)
+	return _u
+}
+
+// AddDeployments adds the "deployments" edges to the DeploymentStatus entity.
+func (_u *OrganizationUpdate) AddDeployments(v ...*DeploymentStatus) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeploymentIDs(ids...)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *OrganizationUpdate) AddUserIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *OrganizationUpdate) AddUsers(v ...*User) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (_u *OrganizationUpdate) Mutation() *OrganizationMutation {
+	return _u.mutation
+}
+
+// ClearApps clears all "apps" edges to the ApplicationDesc entity.
+func (_u *OrganizationUpdate) ClearApps() *OrganizationUpdate {
+	_u.mutation.ClearApps()
+	return _u
+}
+
+// RemoveAppIDs removes the "apps" edge to ApplicationDesc entities by IDs.
+func (_u *OrganizationUpdate) RemoveAppIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.RemoveAppIDs(ids...)
+	return _u
+}
+
+// RemoveApps removes "apps" edges to ApplicationDesc entities.
+func (_u *OrganizationUpdate) RemoveApps(v ...*ApplicationDesc) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppIDs(ids...)
+}
+
+// ClearSites clears all "sites" edges to the Site entity.
+func (_u *OrganizationUpdate) ClearSites() *OrganizationUpdate {
+	_u.mutation.ClearSites()
+	return _u
+}
+
+// RemoveSiteIDs removes the "sites" edge to Site entities by IDs.
+func (_u *OrganizationUpdate) RemoveSiteIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.RemoveSiteIDs(ids...)
+	return _u
+}
+
+// RemoveSites removes "sites" edges to Site entities.
+func (_u *OrganizationUpdate) RemoveSites(v ...*Site) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSiteIDs(ids...)
+}
+
+// ClearDeployments clears all "deployments" edges to the DeploymentStatus entity.
+func (_u *OrganizationUpdate) ClearDeployments() *OrganizationUpdate {
+	_u.mutation.ClearDeployments()
+	return _u
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to DeploymentStatus entities by IDs.
+func (_u *OrganizationUpdate) RemoveDeploymentIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	_u.mutation.RemoveDeploymentIDs(ids...)
+	return _u
+}
+
+// RemoveDeployments removes "deployments" edges to DeploymentStatus entities.
+func (_u *OrganizationUpdate) RemoveDeployments(v ...*DeploymentStatus) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeploymentIDs(ids...)
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *OrganizationUpdate) ClearUsers() *OrganizationUpdate {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *OrganizationUpdate) RemoveUserIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *OrganizationUpdate) RemoveUsers(v ...*User) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationUpdate) check() error {
+	if v, ok := _u.mutation.MaxSites(); ok {
+		if err := organization.MaxSitesValidator(v); err != nil {
+			return &ValidationError{Name: "max_sites", err: fmt.Errorf(`ent: validator failed for field "Organization.max_sites": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxDeployments(); ok {
+		if err := organization.MaxDeploymentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_deployments", err: fmt.Errorf(`ent: validator failed for field "Organization.max_deployments": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxApps(); ok {
+		if err := organization.MaxAppsValidator(v); err != nil {
+			return &ValidationError{Name: "max_apps", err: fmt.Errorf(`ent: validator failed for field "Organization.max_apps": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUsers(); ok {
+		if err := organization.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Organization.max_users": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organization.Table, organization.Columns, sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(organization.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(organization.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxSites(); ok {
+		_spec.SetField(organization.FieldMaxSites, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSites(); ok {
+		_spec.AddField(organization.FieldMaxSites, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxDeployments(); ok {
+		_spec.SetField(organization.FieldMaxDeployments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDeployments(); ok {
+		_spec.AddField(organization.FieldMaxDeployments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxApps(); ok {
+		_spec.SetField(organization.FieldMaxApps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxApps(); ok {
+		_spec.AddField(organization.FieldMaxApps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxUsers(); ok {
+		_spec.SetField(organization.FieldMaxUsers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(organization.FieldMaxUsers, field.TypeInt, value)
+	}
+	if _u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppsIDs(); len(nodes) > 0 && !_u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSitesIDs(); len(nodes) > 0 && !_u.mutation.SitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !_u.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationUpdateOne is the builder for updating a single Organization entity.
+type OrganizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationMutation
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OrganizationUpdateOne) SetDisplayName(v string) *OrganizationUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableDisplayName(v *string) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OrganizationUpdateOne) ClearDisplayName() *OrganizationUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetMaxSites sets the "max_sites" field.
+func (_u *OrganizationUpdateOne) SetMaxSites(v int) *OrganizationUpdateOne {
+	_u.mutation.ResetMaxSites()
+	_u.mutation.SetMaxSites(v)
+	return _u
+}
+
+// SetNillableMaxSites sets the "max_sites" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableMaxSites(v *int) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetMaxSites(*v)
+	}
+	return _u
+}
+
+// AddMaxSites adds value to the "max_sites" field.
+func (_u *OrganizationUpdateOne) AddMaxSites(v int) *OrganizationUpdateOne {
+	_u.mutation.AddMaxSites(v)
+	return _u
+}
+
+// SetMaxDeployments sets the "max_deployments" field.
+func (_u *OrganizationUpdateOne) SetMaxDeployments(v int) *OrganizationUpdateOne {
+	_u.mutation.ResetMaxDeployments()
+	_u.mutation.SetMaxDeployments(v)
+	return _u
+}
+
+// SetNillableMaxDeployments sets the "max_deployments" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableMaxDeployments(v *int) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetMaxDeployments(*v)
+	}
+	return _u
+}
+
+// AddMaxDeployments adds value to the "max_deployments" field.
+func (_u *OrganizationUpdateOne) AddMaxDeployments(v int) *OrganizationUpdateOne {
+	_u.mutation.AddMaxDeployments(v)
+	return _u
+}
+
+// SetMaxApps sets the "max_apps" field.
+func (_u *OrganizationUpdateOne) SetMaxApps(v int) *OrganizationUpdateOne {
+	_u.mutation.ResetMaxApps()
+	_u.mutation.SetMaxApps(v)
+	return _u
+}
+
+// SetNillableMaxApps sets the "max_apps" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableMaxApps(v *int) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetMaxApps(*v)
+	}
+	return _u
+}
+
+// AddMaxApps adds value to the "max_apps" field.
+func (_u *OrganizationUpdateOne) AddMaxApps(v int) *OrganizationUpdateOne {
+	_u.mutation.AddMaxApps(v)
+	return _u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_u *OrganizationUpdateOne) SetMaxUsers(v int) *OrganizationUpdateOne {
+	_u.mutation.ResetMaxUsers()
+	_u.mutation.SetMaxUsers(v)
+	return _u
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableMaxUsers(v *int) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetMaxUsers(*v)
+	}
+	return _u
+}
+
+// AddMaxUsers adds value to the "max_users" field.
+func (_u *OrganizationUpdateOne) AddMaxUsers(v int) *OrganizationUpdateOne {
+	_u.mutation.AddMaxUsers(v)
+	return _u
+}
+
+// AddAppIDs adds the "apps" edge to the ApplicationDesc entity by IDs.
+func (_u *OrganizationUpdateOne) AddAppIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.AddAppIDs(ids...)
+	return _u
+}
+
+// AddApps adds the "apps" edges to the ApplicationDesc entity.
+func (_u *OrganizationUpdateOne) AddApps(v ...*ApplicationDesc) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppIDs(ids...)
+}
+
+// AddSiteIDs adds the "sites" edge to the Site entity by IDs.
+func (_u *OrganizationUpdateOne) AddSiteIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.AddSiteIDs(ids...)
+	return _u
+}
+
+// AddSites adds the "sites" edges to the Site entity.
+func (_u *OrganizationUpdateOne) AddSites(v ...*Site) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSiteIDs(ids...)
+}
+
+// AddDeploymentIDs adds the "deployments" edge to the DeploymentStatus entity by IDs.
+func (_u *OrganizationUpdateOne) AddDeploymentIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.AddDeploymentIDs(ids...)
+	return _u
+}
+
+// AddDeployments adds the "deployments" edges to the DeploymentStatus entity.
+func (_u *OrganizationUpdateOne) AddDeployments(v ...*DeploymentStatus) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeploymentIDs(ids...)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *OrganizationUpdateOne) AddUserIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *OrganizationUpdateOne) AddUsers(v ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (_u *OrganizationUpdateOne) Mutation() *OrganizationMutation {
+	return _u.mutation
+}
+
+// ClearApps clears all "apps" edges to the ApplicationDesc entity.
+func (_u *OrganizationUpdateOne) ClearApps() *OrganizationUpdateOne {
+	_u.mutation.ClearApps()
+	return _u
+}
+
+// RemoveAppIDs removes the "apps" edge to ApplicationDesc entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveAppIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.RemoveAppIDs(ids...)
+	return _u
+}
+
+// RemoveApps removes "apps" edges to ApplicationDesc entities.
+func (_u *OrganizationUpdateOne) RemoveApps(v ...*ApplicationDesc) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppIDs(ids...)
+}
+
+// ClearSites clears all "sites" edges to the Site entity.
+func (_u *OrganizationUpdateOne) ClearSites() *OrganizationUpdateOne {
+	_u.mutation.ClearSites()
+	return _u
+}
+
+// RemoveSiteIDs removes the "sites" edge to Site entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveSiteIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.RemoveSiteIDs(ids...)
+	return _u
+}
+
+// RemoveSites removes "sites" edges to Site entities.
+func (_u *OrganizationUpdateOne) RemoveSites(v ...*Site) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSiteIDs(ids...)
+}
+
+// ClearDeployments clears all "deployments" edges to the DeploymentStatus entity.
+func (_u *OrganizationUpdateOne) ClearDeployments() *OrganizationUpdateOne {
+	_u.mutation.ClearDeployments()
+	return _u
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to DeploymentStatus entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveDeploymentIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	_u.mutation.RemoveDeploymentIDs(ids...)
+	return _u
+}
+
+// RemoveDeployments removes "deployments" edges to DeploymentStatus entities.
+func (_u *OrganizationUpdateOne) RemoveDeployments(v ...*DeploymentStatus) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeploymentIDs(ids...)
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *OrganizationUpdateOne) ClearUsers() *OrganizationUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveUserIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *OrganizationUpdateOne) RemoveUsers(v ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// Where appends a list predicates to the OrganizationUpdate builder.
+func (_u *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationUpdateOne) Select(field string, fields ...string) *OrganizationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Organization entity.
+func (_u *OrganizationUpdateOne) Save(ctx context.Context) (*Organization, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationUpdateOne) SaveX(ctx context.Context) *Organization {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxSites(); ok {
+		if err := organization.MaxSitesValidator(v); err != nil {
+			return &ValidationError{Name: "max_sites", err: fmt.Errorf(`ent: validator failed for field "Organization.max_sites": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxDeployments(); ok {
+		if err := organization.MaxDeploymentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_deployments", err: fmt.Errorf(`ent: validator failed for field "Organization.max_deployments": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxApps(); ok {
+		if err := organization.MaxAppsValidator(v); err != nil {
+			return &ValidationError{Name: "max_apps", err: fmt.Errorf(`ent: validator failed for field "Organization.max_apps": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUsers(); ok {
+		if err := organization.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Organization.max_users": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organization, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organization.Table, organization.Columns, sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Organization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organization.FieldID)
+		for _, f := range fields {
+			if !organization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(organization.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(organization.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxSites(); ok {
+		_spec.SetField(organization.FieldMaxSites, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSites(); ok {
+		_spec.AddField(organization.FieldMaxSites, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxDeployments(); ok {
+		_spec.SetField(organization.FieldMaxDeployments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDeployments(); ok {
+		_spec.AddField(organization.FieldMaxDeployments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxApps(); ok {
+		_spec.SetField(organization.FieldMaxApps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxApps(); ok {
+		_spec.AddField(organization.FieldMaxApps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxUsers(); ok {
+		_spec.SetField(organization.FieldMaxUsers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(organization.FieldMaxUsers, field.TypeInt, value)
+	}
+	if _u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppsIDs(); len(nodes) > 0 && !_u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AppsTable,
+			Columns: []string{organization.AppsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applicationdesc.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSitesIDs(); len(nodes) > 0 && !_u.mutation.SitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SitesTable,
+			Columns: []string{organization.SitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(site.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !_u.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DeploymentsTable,
+			Columns: []string{organization.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deploymentstatus.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Organization{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,9 +6,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIToken is the predicate function for apitoken builders.
+type APIToken func(*sql.Selector)
+
 // ApplicationDesc is the predicate function for applicationdesc builders.
 type ApplicationDesc func(*sql.Selector)
 
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
 // Component is the predicate function for component builders.
 type Component func(*sql.Selector)
 
@@ -18,17 +24,35 @@ type DeploymentComponentStatus func(*sql.Selector)
 // DeploymentProfile is the predicate function for deploymentprofile builders.
 type DeploymentProfile func(*sql.Selector)
 
+// DeploymentRevision is the predicate function for deploymentrevision builders.
+type DeploymentRevision func(*sql.Selector)
+
 // DeploymentStatus is the predicate function for deploymentstatus builders.
 type DeploymentStatus func(*sql.Selector)
 
+// Event is the predicate function for event builders.
+type Event func(*sql.Selector)
+
 // Host is the predicate function for host builders.
 type Host func(*sql.Selector)
 
 // Orchestrator is the predicate function for orchestrator builders.
 type Orchestrator func(*sql.Selector)
 
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
+// RoleBinding is the predicate function for rolebinding builders.
+type RoleBinding func(*sql.Selector)
+
 // Site is the predicate function for site builders.
 type Site func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
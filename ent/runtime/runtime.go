@@ -2,7 +2,340 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/balaji-balu/margo-hello-world/ent/runtime.go
+import (
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
+	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
+	"github.com/balaji-balu/margo-hello-world/ent/auditentry"
+	"github.com/balaji-balu/margo-hello-world/ent/component"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/ent/rolebinding"
+	"github.com/balaji-balu/margo-hello-world/ent/schema"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[1].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = apitokenDescName.Validators[0].(func(string) error)
+	// apitokenDescScope is the schema descriptor for scope field.
+	apitokenDescScope := apitokenFields[5].Descriptor()
+	// apitoken.DefaultScope holds the default value on creation for the scope field.
+	apitoken.DefaultScope = apitokenDescScope.Default.(string)
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[8].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescID is the schema descriptor for id field.
+	apitokenDescID := apitokenFields[0].Descriptor()
+	// apitoken.DefaultID holds the default value on creation for the id field.
+	apitoken.DefaultID = apitokenDescID.Default.(func() uuid.UUID)
+	applicationdescMixin := schema.ApplicationDesc{}.Mixin()
+	applicationdescMixinHooks0 := applicationdescMixin[0].Hooks()
+	applicationdesc.Hooks[0] = applicationdescMixinHooks0[0]
+	applicationdescMixinInters0 := applicationdescMixin[0].Interceptors()
+	applicationdesc.Interceptors[0] = applicationdescMixinInters0[0]
+	applicationdescFields := schema.ApplicationDesc{}.Fields()
+	_ = applicationdescFields
+	// applicationdescDescID is the schema descriptor for id field.
+	applicationdescDescID := applicationdescFields[0].Descriptor()
+	// applicationdesc.DefaultID holds the default value on creation for the id field.
+	applicationdesc.DefaultID = applicationdescDescID.Default.(func() uuid.UUID)
+	auditentryMixin := schema.AuditEntry{}.Mixin()
+	auditentryMixinHooks0 := auditentryMixin[0].Hooks()
+	auditentryHooks := schema.AuditEntry{}.Hooks()
+	auditentry.Hooks[0] = auditentryMixinHooks0[0]
+	auditentry.Hooks[1] = auditentryHooks[0]
+	auditentryMixinInters0 := auditentryMixin[0].Interceptors()
+	auditentry.Interceptors[0] = auditentryMixinInters0[0]
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[0].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	componentFields := schema.Component{}.Fields()
+	_ = componentFields
+	// componentDescID is the schema descriptor for id field.
+	componentDescID := componentFields[0].Descriptor()
+	// component.DefaultID holds the default value on creation for the id field.
+	component.DefaultID = componentDescID.Default.(func() uuid.UUID)
+	deploymentcomponentstatusFields := schema.DeploymentComponentStatus{}.Fields()
+	_ = deploymentcomponentstatusFields
+	// deploymentcomponentstatusDescName is the schema descriptor for name field.
+	deploymentcomponentstatusDescName := deploymentcomponentstatusFields[1].Descriptor()
+	// deploymentcomponentstatus.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deploymentcomponentstatus.NameValidator = deploymentcomponentstatusDescName.Validators[0].(func(string) error)
+	// deploymentcomponentstatusDescSiteID is the schema descriptor for site_id field.
+	deploymentcomponentstatusDescSiteID := deploymentcomponentstatusFields[2].Descriptor()
+	// deploymentcomponentstatus.DefaultSiteID holds the default value on creation for the site_id field.
+	deploymentcomponentstatus.DefaultSiteID = deploymentcomponentstatusDescSiteID.Default.(string)
+	// deploymentcomponentstatusDescHostID is the schema descriptor for host_id field.
+	deploymentcomponentstatusDescHostID := deploymentcomponentstatusFields[3].Descriptor()
+	// deploymentcomponentstatus.DefaultHostID holds the default value on creation for the host_id field.
+	deploymentcomponentstatus.DefaultHostID = deploymentcomponentstatusDescHostID.Default.(string)
+	// deploymentcomponentstatusDescState is the schema descriptor for state field.
+	deploymentcomponentstatusDescState := deploymentcomponentstatusFields[4].Descriptor()
+	// deploymentcomponentstatus.DefaultState holds the default value on creation for the state field.
+	deploymentcomponentstatus.DefaultState = deploymentcomponentstatusDescState.Default.(string)
+	// deploymentcomponentstatusDescReportedAt is the schema descriptor for reported_at field.
+	deploymentcomponentstatusDescReportedAt := deploymentcomponentstatusFields[7].Descriptor()
+	// deploymentcomponentstatus.DefaultReportedAt holds the default value on creation for the reported_at field.
+	deploymentcomponentstatus.DefaultReportedAt = deploymentcomponentstatusDescReportedAt.Default.(int64)
+	// deploymentcomponentstatusDescCreatedAt is the schema descriptor for created_at field.
+	deploymentcomponentstatusDescCreatedAt := deploymentcomponentstatusFields[8].Descriptor()
+	// deploymentcomponentstatus.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deploymentcomponentstatus.DefaultCreatedAt = deploymentcomponentstatusDescCreatedAt.Default.(func() time.Time)
+	// deploymentcomponentstatusDescUpdatedAt is the schema descriptor for updated_at field.
+	deploymentcomponentstatusDescUpdatedAt := deploymentcomponentstatusFields[9].Descriptor()
+	// deploymentcomponentstatus.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deploymentcomponentstatus.DefaultUpdatedAt = deploymentcomponentstatusDescUpdatedAt.Default.(func() time.Time)
+	// deploymentcomponentstatus.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deploymentcomponentstatus.UpdateDefaultUpdatedAt = deploymentcomponentstatusDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deploymentcomponentstatusDescID is the schema descriptor for id field.
+	deploymentcomponentstatusDescID := deploymentcomponentstatusFields[0].Descriptor()
+	// deploymentcomponentstatus.DefaultID holds the default value on creation for the id field.
+	deploymentcomponentstatus.DefaultID = deploymentcomponentstatusDescID.Default.(func() uuid.UUID)
+	deploymentprofileFields := schema.DeploymentProfile{}.Fields()
+	_ = deploymentprofileFields
+	// deploymentprofileDescID is the schema descriptor for id field.
+	deploymentprofileDescID := deploymentprofileFields[0].Descriptor()
+	// deploymentprofile.DefaultID holds the default value on creation for the id field.
+	deploymentprofile.DefaultID = deploymentprofileDescID.Default.(func() uuid.UUID)
+	deploymentrevisionMixin := schema.DeploymentRevision{}.Mixin()
+	deploymentrevisionMixinHooks0 := deploymentrevisionMixin[0].Hooks()
+	deploymentrevision.Hooks[0] = deploymentrevisionMixinHooks0[0]
+	deploymentrevisionMixinInters0 := deploymentrevisionMixin[0].Interceptors()
+	deploymentrevision.Interceptors[0] = deploymentrevisionMixinInters0[0]
+	deploymentrevisionFields := schema.DeploymentRevision{}.Fields()
+	_ = deploymentrevisionFields
+	// deploymentrevisionDescRevision is the schema descriptor for revision field.
+	deploymentrevisionDescRevision := deploymentrevisionFields[2].Descriptor()
+	// deploymentrevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	deploymentrevision.RevisionValidator = deploymentrevisionDescRevision.Validators[0].(func(int) error)
+	// deploymentrevisionDescState is the schema descriptor for state field.
+	deploymentrevisionDescState := deploymentrevisionFields[13].Descriptor()
+	// deploymentrevision.DefaultState holds the default value on creation for the state field.
+	deploymentrevision.DefaultState = deploymentrevisionDescState.Default.(string)
+	// deploymentrevisionDescCreatedAt is the schema descriptor for created_at field.
+	deploymentrevisionDescCreatedAt := deploymentrevisionFields[15].Descriptor()
+	// deploymentrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deploymentrevision.DefaultCreatedAt = deploymentrevisionDescCreatedAt.Default.(func() time.Time)
+	// deploymentrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	deploymentrevisionDescUpdatedAt := deploymentrevisionFields[16].Descriptor()
+	// deploymentrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deploymentrevision.DefaultUpdatedAt = deploymentrevisionDescUpdatedAt.Default.(func() time.Time)
+	// deploymentrevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deploymentrevision.UpdateDefaultUpdatedAt = deploymentrevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deploymentrevisionDescID is the schema descriptor for id field.
+	deploymentrevisionDescID := deploymentrevisionFields[0].Descriptor()
+	// deploymentrevision.DefaultID holds the default value on creation for the id field.
+	deploymentrevision.DefaultID = deploymentrevisionDescID.Default.(func() uuid.UUID)
+	deploymentstatusMixin := schema.DeploymentStatus{}.Mixin()
+	deploymentstatusMixinHooks0 := deploymentstatusMixin[0].Hooks()
+	deploymentstatus.Hooks[0] = deploymentstatusMixinHooks0[0]
+	deploymentstatusMixinInters0 := deploymentstatusMixin[0].Interceptors()
+	deploymentstatus.Interceptors[0] = deploymentstatusMixinInters0[0]
+	deploymentstatusFields := schema.DeploymentStatus{}.Fields()
+	_ = deploymentstatusFields
+	// deploymentstatusDescState is the schema descriptor for state field.
+	deploymentstatusDescState := deploymentstatusFields[1].Descriptor()
+	// deploymentstatus.DefaultState holds the default value on creation for the state field.
+	deploymentstatus.DefaultState = deploymentstatusDescState.Default.(string)
+	// deploymentstatusDescCreatedAt is the schema descriptor for created_at field.
+	deploymentstatusDescCreatedAt := deploymentstatusFields[5].Descriptor()
+	// deploymentstatus.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deploymentstatus.DefaultCreatedAt = deploymentstatusDescCreatedAt.Default.(func() time.Time)
+	// deploymentstatusDescUpdatedAt is the schema descriptor for updated_at field.
+	deploymentstatusDescUpdatedAt := deploymentstatusFields[6].Descriptor()
+	// deploymentstatus.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deploymentstatus.DefaultUpdatedAt = deploymentstatusDescUpdatedAt.Default.(func() time.Time)
+	// deploymentstatus.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deploymentstatus.UpdateDefaultUpdatedAt = deploymentstatusDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deploymentstatusDescID is the schema descriptor for id field.
+	deploymentstatusDescID := deploymentstatusFields[0].Descriptor()
+	// deploymentstatus.DefaultID holds the default value on creation for the id field.
+	deploymentstatus.DefaultID = deploymentstatusDescID.Default.(func() uuid.UUID)
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinHooks0 := eventMixin[0].Hooks()
+	eventHooks := schema.Event{}.Hooks()
+	event.Hooks[0] = eventMixinHooks0[0]
+	event.Hooks[1] = eventHooks[0]
+	eventMixinInters0 := eventMixin[0].Interceptors()
+	event.Interceptors[0] = eventMixinInters0[0]
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescCreatedAt is the schema descriptor for created_at field.
+	eventDescCreatedAt := eventFields[0].Descriptor()
+	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
+	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
+	hostMixin := schema.Host{}.Mixin()
+	hostMixinHooks0 := hostMixin[0].Hooks()
+	host.Hooks[0] = hostMixinHooks0[0]
+	hostMixinInters0 := hostMixin[0].Interceptors()
+	host.Interceptors[0] = hostMixinInters0[0]
+	hostFields := schema.Host{}.Fields()
+	_ = hostFields
+	// hostDescID is the schema descriptor for id field.
+	hostDescID := hostFields[0].Descriptor()
+	// host.DefaultID holds the default value on creation for the id field.
+	host.DefaultID = hostDescID.Default.(func() uuid.UUID)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescMaxSites is the schema descriptor for max_sites field.
+	organizationDescMaxSites := organizationFields[3].Descriptor()
+	// organization.DefaultMaxSites holds the default value on creation for the max_sites field.
+	organization.DefaultMaxSites = organizationDescMaxSites.Default.(int)
+	// organization.MaxSitesValidator is a validator for the "max_sites" field. It is called by the builders before save.
+	organization.MaxSitesValidator = organizationDescMaxSites.Validators[0].(func(int) error)
+	// organizationDescMaxDeployments is the schema descriptor for max_deployments field.
+	organizationDescMaxDeployments := organizationFields[4].Descriptor()
+	// organization.DefaultMaxDeployments holds the default value on creation for the max_deployments field.
+	organization.DefaultMaxDeployments = organizationDescMaxDeployments.Default.(int)
+	// organization.MaxDeploymentsValidator is a validator for the "max_deployments" field. It is called by the builders before save.
+	organization.MaxDeploymentsValidator = organizationDescMaxDeployments.Validators[0].(func(int) error)
+	// organizationDescMaxApps is the schema descriptor for max_apps field.
+	organizationDescMaxApps := organizationFields[5].Descriptor()
+	// organization.DefaultMaxApps holds the default value on creation for the max_apps field.
+	organization.DefaultMaxApps = organizationDescMaxApps.Default.(int)
+	// organization.MaxAppsValidator is a validator for the "max_apps" field. It is called by the builders before save.
+	organization.MaxAppsValidator = organizationDescMaxApps.Validators[0].(func(int) error)
+	// organizationDescMaxUsers is the schema descriptor for max_users field.
+	organizationDescMaxUsers := organizationFields[6].Descriptor()
+	// organization.DefaultMaxUsers holds the default value on creation for the max_users field.
+	organization.DefaultMaxUsers = organizationDescMaxUsers.Default.(int)
+	// organization.MaxUsersValidator is a validator for the "max_users" field. It is called by the builders before save.
+	organization.MaxUsersValidator = organizationDescMaxUsers.Validators[0].(func(int) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[7].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescID is the schema descriptor for id field.
+	organizationDescID := organizationFields[0].Descriptor()
+	// organization.DefaultID holds the default value on creation for the id field.
+	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
+	rolebindingFields := schema.RoleBinding{}.Fields()
+	_ = rolebindingFields
+	// rolebindingDescScope is the schema descriptor for scope field.
+	rolebindingDescScope := rolebindingFields[2].Descriptor()
+	// rolebinding.DefaultScope holds the default value on creation for the scope field.
+	rolebinding.DefaultScope = rolebindingDescScope.Default.(string)
+	// rolebindingDescCreatedAt is the schema descriptor for created_at field.
+	rolebindingDescCreatedAt := rolebindingFields[3].Descriptor()
+	// rolebinding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolebinding.DefaultCreatedAt = rolebindingDescCreatedAt.Default.(func() time.Time)
+	// rolebindingDescID is the schema descriptor for id field.
+	rolebindingDescID := rolebindingFields[0].Descriptor()
+	// rolebinding.DefaultID holds the default value on creation for the id field.
+	rolebinding.DefaultID = rolebindingDescID.Default.(func() uuid.UUID)
+	siteMixin := schema.Site{}.Mixin()
+	siteMixinHooks0 := siteMixin[0].Hooks()
+	site.Hooks[0] = siteMixinHooks0[0]
+	siteMixinInters0 := siteMixin[0].Interceptors()
+	site.Interceptors[0] = siteMixinInters0[0]
+	siteFields := schema.Site{}.Fields()
+	_ = siteFields
+	// siteDescID is the schema descriptor for id field.
+	siteDescID := siteFields[0].Descriptor()
+	// site.DefaultID holds the default value on creation for the id field.
+	site.DefaultID = siteDescID.Default.(func() uuid.UUID)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks0 := userMixin[0].Hooks()
+	user.Hooks[0] = userMixinHooks0[0]
+	userMixinInters0 := userMixin[0].Interceptors()
+	user.Interceptors[0] = userMixinInters0[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescDisabled is the schema descriptor for disabled field.
+	userDescDisabled := userFields[2].Descriptor()
+	// user.DefaultDisabled holds the default value on creation for the disabled field.
+	user.DefaultDisabled = userDescDisabled.Default.(bool)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinHooks0 := webhookMixin[0].Hooks()
+	webhook.Hooks[0] = webhookMixinHooks0[0]
+	webhookMixinInters0 := webhookMixin[0].Interceptors()
+	webhook.Interceptors[0] = webhookMixinInters0[0]
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = webhookDescName.Validators[0].(func(string) error)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[5].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[8].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdeliveryMixinInters0 := webhookdeliveryMixin[0].Interceptors()
+	webhookdelivery.Interceptors[0] = webhookdeliveryMixinInters0[0]
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
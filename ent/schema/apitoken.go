@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIToken is a bearer token acting for its user. Only its hash is kept. A
+// token may be narrowed to a lower role and a smaller scope than its user
+// has, never widened.
+type APIToken struct {
+	ent.Schema
+}
+
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		UUIDField(),
+		field.String("name").NotEmpty(),
+		// sha256 of the token, hex
+		field.String("token_hash").Unique().Sensitive().Immutable(),
+		// prefix is the start of the token, to tell tokens apart
+		field.String("prefix").Immutable(),
+		// role and scope limit what the token may do; empty role means the
+		// user's roles
+		field.String("role").Optional().Immutable(),
+		field.String("scope").Default("*").Immutable(),
+		field.Time("expires_at").Optional().Nillable().Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("tokens").
+			Unique().
+			Required(),
+	}
+}
@@ -31,17 +31,28 @@ func (ApplicationDesc) Fields() []ent.Field {
 		field.String("site").Optional(),
 		field.String("tag_line").Optional(), 
 		field.JSON("tags", []string{}).Optional(), 
-		field.String("published").Optional()}
+		field.String("published").Optional(),
+		// the package resources an OCI import brings along; the icon is
+		// served by GET /apps/:id/icon rather than in the app's JSON
+		field.Bytes("icon_data").Optional().StructTag(`json:"-"`),
+		field.String("icon_media_type").Optional(),
+		field.Text("license").Optional(),
+		field.Text("release_notes").Optional()}
 
 	//field.JSON("tags").Optional(struct{}{})}
 
 }
 
 func (ApplicationDesc) Edges() []ent.Edge {
-	return []ent.Edge{edge.To("deployment_profiles", DeploymentProfile.Type)}
+	return []ent.Edge{edge.To("deployment_profiles", DeploymentProfile.Type),
+		edge.From("organization", Organization.Type).Ref("apps").Unique().Field("org_id")}
 
 }
 
+func (ApplicationDesc) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 func (ApplicationDesc) Annotations() []schema.Annotation {
 	return []schema.Annotation{entsql.Annotation{Table: "application_desc"}}
 }
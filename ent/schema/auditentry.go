@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEntry records one mutating API request: who made it, what it did
+// and to what, and how it ended. Entries are append-only; ids increase with
+// time.
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// actor is the user the request acted for
+		field.String("actor").Immutable(),
+		// action is the route, e.g. "POST /api/v1/deployments"
+		field.String("action").Immutable(),
+		// target is what the action was on: a deployment, app, site or
+		// user, or the request path when the handler does not say
+		field.String("target").Optional().Immutable(),
+		// payload_hash is the sha256 of the request body, if it had one
+		field.String("payload_hash").Optional().Immutable(),
+		// result is "success" or "failure", by status
+		field.String("result").Immutable(),
+		field.Int("status").Immutable(),
+		field.String("error").Optional().Immutable(),
+		field.String("source_ip").Optional().Immutable(),
+	}
+}
+
+// Mixin scopes entries to the organization the request acted within.
+func (AuditEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
+// Hooks keeps the log append-only.
+func (AuditEntry) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpCreate) {
+					return nil, fmt.Errorf("audit entries cannot be changed or deleted")
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor"),
+		index.Fields("target"),
+	}
+}
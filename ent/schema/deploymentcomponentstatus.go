@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/index"
 )
 
 // DeploymentComponentStatus holds the schema definition for the DeploymentComponentStatus entity.
@@ -18,9 +19,15 @@ func (DeploymentComponentStatus) Fields() []ent.Field {
 		// field.UUID("id", uuid.UUID{}).
 		// 	Default(uuid.New),
 		field.String("name").NotEmpty(),
+		// where the component runs; empty until a host reports it
+		field.String("site_id").Default(""),
+		field.String("host_id").Default(""),
 		field.String("state").Default("pending"),
 		field.String("error_code").Optional(),
 		field.String("error_message").Optional(),
+		// reported_at is when the host observed the state (unix ns);
+		// older reports are ignored so the state never goes back
+		field.Int64("reported_at").Default(0),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		//field.UUID("deployment_id", uuid.UUID{}),
@@ -35,3 +42,11 @@ func (DeploymentComponentStatus) Edges() []ent.Edge {
 			Required(),
 	}
 }
+
+func (DeploymentComponentStatus) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("site_id", "host_id", "name").
+			Edges("deployment").
+			Unique(),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// DeploymentRevision is one version of a deployment's desired state, as it
+// was written to the deployments repo. Everything but the commit and the
+// outcome is fixed when the revision is created; a change, or a rollback, is
+// a new revision. The commit is set once the spec is committed, in the
+// transaction that created the revision.
+type DeploymentRevision struct {
+	ent.Schema
+}
+
+func (DeploymentRevision) Fields() []ent.Field {
+	return []ent.Field{
+		UUIDField(),
+		field.UUID("deployment_id", uuid.UUID{}).Immutable(),
+		field.Int("revision").Positive().Immutable(),
+		field.String("app_id").Optional().Immutable(),
+		field.String("app_name").Optional().Immutable(),
+		field.String("app_version").Optional().Immutable(),
+		// profile is the deployment profile type, e.g. compose or helm.chart
+		field.String("profile").Optional().Immutable(),
+		field.JSON("parameters", []deployment.Parameter{}).Optional().Immutable(),
+		field.String("site_id").Immutable(),
+		// spec is the desiredstate.yaml written for this revision
+		field.Text("spec").Immutable(),
+		field.String("commit_sha").Optional(),
+		field.String("author").Optional().Immutable(),
+		// redeployed_from is the revision this one restored, if any
+		field.Int("redeployed_from").Optional().Immutable(),
+		field.String("state").Default("pending"),
+		field.JSON("outcomes", map[string]model.SiteOutcome{}).Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Mixin scopes revisions like their deployment; org_id is the deployment's.
+func (DeploymentRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
+func (DeploymentRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deployment", DeploymentStatus.Type).
+			Ref("revisions").
+			Field("deployment_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (DeploymentRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deployment_id", "revision").Unique(),
+	}
+}
@@ -30,5 +30,14 @@ func (DeploymentStatus) Fields() []ent.Field {
 func (DeploymentStatus) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("components", DeploymentComponentStatus.Type),
+		edge.To("revisions", DeploymentRevision.Type),
+		edge.From("organization", Organization.Type).
+			Ref("deployments").
+			Unique().
+			Field("org_id"),
 	}
 }
+
+func (DeploymentStatus) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
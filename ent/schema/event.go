@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Event is one entry of the CO's event log: something that happened in the
+// fleet, e.g. a deployment's state changing or a host going offline. The
+// log is append-only and ids increase, so a client that saw event n resumes
+// after it.
+type Event struct {
+	ent.Schema
+}
+
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// type is a webhook event type, e.g. "host.dead"
+		field.String("type").Immutable(),
+		// deployment_id and site_id are what the event is about, where it
+		// is about one, for filtering
+		field.String("deployment_id").Optional().Immutable(),
+		field.String("site_id").Optional().Immutable(),
+		field.JSON("data", json.RawMessage{}).Optional().Immutable(),
+	}
+}
+
+// Mixin scopes events to the organization they are about.
+func (Event) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
+type pruneKey struct{}
+
+// Pruning marks ctx as pruning the log for its retention, which may delete
+// the oldest events.
+func Pruning(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pruneKey{}, true)
+}
+
+// Hooks keeps the log append-only, but for Pruning.
+func (Event) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				pruning := m.Op().Is(ent.OpDelete) && ctx.Value(pruneKey{}) != nil
+				if !m.Op().Is(ent.OpCreate) && !pruning {
+					return nil, fmt.Errorf("events cannot be changed or deleted")
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type"),
+		index.Fields("deployment_id"),
+		index.Fields("site_id"),
+	}
+}
@@ -31,12 +31,18 @@ func (Host) Fields() []ent.Field {
 		field.String("ip_address").Optional(),
 		field.String("edge_url").Optional(),
 		
-		field.JSON("metadata", struct{}{}).Optional(),
+		// metadata["inventory"] holds the hardware inventory its ERA reported
+		field.JSON("metadata", map[string]interface{}{}).Optional(),
 		field.Time("created_at").Optional(), field.Time("updated_at").Optional()}
 }
 func (Host) Edges() []ent.Edge {
 	return []ent.Edge{edge.From("site", Site.Type).Ref("hosts").Unique().Field("site_id")}
 }
+
+// Mixin scopes hosts like their site; org_id is the site's.
+func (Host) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
 func (Host) Annotations() []schema.Annotation {
 	return []schema.Annotation{entsql.Annotation{Table: "host"}}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization is a tenant of the CO. It owns apps, sites, deployments and
+// users, and its quotas cap how many of them it may have; 0 is no cap.
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		UUIDField(),
+		// name namespaces the organization's deployments in the git repo
+		field.String("name").
+			Unique().
+			Immutable().
+			Match(regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)),
+		field.String("display_name").Optional(),
+		field.Int("max_sites").Default(0).NonNegative(),
+		field.Int("max_deployments").Default(0).NonNegative(),
+		field.Int("max_apps").Default(0).NonNegative(),
+		field.Int("max_users").Default(0).NonNegative(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("apps", ApplicationDesc.Type),
+		edge.To("sites", Site.Type),
+		edge.To("deployments", DeploymentStatus.Type),
+		edge.To("users", User.Type),
+	}
+}
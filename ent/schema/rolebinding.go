@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleBinding grants a user a role on a scope: every site ("*"), one site
+// ("site:<site_id>") or a site group ("group:<name>").
+type RoleBinding struct {
+	ent.Schema
+}
+
+func (RoleBinding) Fields() []ent.Field {
+	return []ent.Field{
+		UUIDField(),
+		field.Enum("role").Values("viewer", "deployer", "admin"),
+		field.String("scope").Default("*"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (RoleBinding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("roles").
+			Unique().
+			Required(),
+	}
+}
+
+func (RoleBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role", "scope").Edges("user").Unique(),
+	}
+}
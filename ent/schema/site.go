@@ -23,12 +23,21 @@ func (Site) Fields() []ent.Field {
 		field.String("name").Optional(),
 		field.String("description").Optional(),
 		field.String("location").Optional(),
+		// group names a set of sites roles can be granted on
+		field.String("group").Optional(),
 		field.UUID("orchestrator_id", uuid.UUID{}).Optional(),
 		field.JSON("metadata", struct{}{}).Optional(),
+		// set by the LO's sync; status is "stale" once the LO stops syncing
+		field.String("status").Optional(),
+		field.Time("last_seen").Optional(),
 		field.Time("created_at").Optional(), field.Time("updated_at").Optional()}
 }
 func (Site) Edges() []ent.Edge {
-	return []ent.Edge{edge.To("hosts", Host.Type), edge.From("orchestrator", Orchestrator.Type).Ref("sites").Unique().Field("orchestrator_id")}
+	return []ent.Edge{edge.To("hosts", Host.Type), edge.From("orchestrator", Orchestrator.Type).Ref("sites").Unique().Field("orchestrator_id"),
+		edge.From("organization", Organization.Type).Ref("sites").Unique().Field("org_id")}
+}
+func (Site) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
 }
 func (Site) Annotations() []schema.Annotation {
 	return []schema.Annotation{entsql.Annotation{Table: "site"}}
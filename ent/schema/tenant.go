@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent/intercept"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+)
+
+// TenantMixin gives an entity the organization that owns it, and scopes it
+// to the tenant of the context: queries only see, updates and deletes only
+// touch, the tenant's rows, and creates make rows the tenant's. Without a
+// tenant in the context nothing is scoped.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("org_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if t, ok := tenant.FromContext(ctx); ok {
+				q.WhereP(sql.FieldEQ("org_id", t.ID))
+			}
+			return nil
+		}),
+	}
+}
+
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				t, ok := tenant.FromContext(ctx)
+				if !ok {
+					return next.Mutate(ctx, m)
+				}
+				if m.Op().Is(ent.OpCreate) {
+					if id, ok := m.Field("org_id"); ok && id.(uuid.UUID) != t.ID {
+						return nil, fmt.Errorf("%s belongs to another organization", m.Type())
+					}
+					if err := m.SetField("org_id", t.ID); err != nil {
+						return nil, err
+					}
+					return next.Mutate(ctx, m)
+				}
+				w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation %T", m)
+				}
+				w.WhereP(sql.FieldEQ("org_id", t.ID))
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -1,20 +1,45 @@
-// Code generated by entimport, DO NOT EDIT.
-
 package schema
 
-import "entgo.io/ent"
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
 
-// User holds the schema definition for the User entity.
+// User is a person or service allowed to call the CO API. What it may do is
+// given by its role bindings.
 type User struct {
 	ent.Schema
 }
 
 // Fields of the User.
 func (User) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.String("username").NotEmpty().Unique(),
+		// bcrypt; empty for service users, which only use API tokens
+		field.String("password_hash").Optional().Sensitive(),
+		field.Bool("disabled").Default(false),
+		field.Time("last_login_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("roles", RoleBinding.Type),
+		edge.To("tokens", APIToken.Type),
+		// users of no organization are the platform's: they act across
+		// organizations
+		edge.From("organization", Organization.Type).
+			Ref("users").
+			Unique().
+			Field("org_id"),
+	}
+}
+
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
 }
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook is a subscription to CO events: each event of its types is
+// POSTed to its URL, signed with its secret.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").NotEmpty(),
+		field.String("url").NotEmpty(),
+		// secret keys the HMAC-SHA256 signature of every delivery
+		field.String("secret").Sensitive(),
+		// event_types are the events delivered; none means all
+		field.JSON("event_types", []string{}).Optional(),
+		field.Bool("active").Default(true),
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Mixin scopes webhooks to an organization; platform webhooks, with none,
+// get every organization's events.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
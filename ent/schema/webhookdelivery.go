@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is one event sent, or to be sent, to one webhook, and how
+// its attempts went. Deliveries are kept as the webhook's delivery log.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.String("event_id"),
+		field.String("event_type"),
+		// payload is the body POSTed, the same on every attempt
+		field.Text("payload"),
+		// status is "pending" until delivered ("succeeded") or out of
+		// attempts ("failed")
+		field.String("status").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		// response_status and error are of the last attempt
+		field.Int("response_status").Optional(),
+		field.String("error").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").
+			Unique().Required().Field("webhook_id"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
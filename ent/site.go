@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/google/uuid"
 )
@@ -20,6 +21,8 @@ type Site struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
 	// SiteID holds the value of the "site_id" field.
 	SiteID string `json:"site_id,omitempty"`
 	// Name holds the value of the "name" field.
@@ -28,10 +31,16 @@ type Site struct {
 	Description string `json:"description,omitempty"`
 	// Location holds the value of the "location" field.
 	Location string `json:"location,omitempty"`
+	// Group holds the value of the "group" field.
+	Group string `json:"group,omitempty"`
 	// OrchestratorID holds the value of the "orchestrator_id" field.
 	OrchestratorID uuid.UUID `json:"orchestrator_id,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata struct{} `json:"metadata,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// LastSeen holds the value of the "last_seen" field.
+	LastSeen time.Time `json:"last_seen,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,9 +57,11 @@ type SiteEdges struct {
 	Hosts []*Host `json:"hosts,omitempty"`
 	// Orchestrator holds the value of the orchestrator edge.
 	Orchestrator *Orchestrator `json:"orchestrator,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // HostsOrErr returns the Hosts value or an error if the edge
@@ -73,6 +84,17 @@ func (e SiteEdges) OrchestratorOrErr() (*Orchestrator, error) {
 	return nil, &NotLoadedError{edge: "orchestrator"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SiteEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Site) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -80,11 +102,11 @@ func (*Site) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case site.FieldMetadata:
 			values[i] = new([]byte)
-		case site.FieldSiteID, site.FieldName, site.FieldDescription, site.FieldLocation:
+		case site.FieldSiteID, site.FieldName, site.FieldDescription, site.FieldLocation, site.FieldGroup, site.FieldStatus:
 			values[i] = new(sql.NullString)
-		case site.FieldCreatedAt, site.FieldUpdatedAt:
+		case site.FieldLastSeen, site.FieldCreatedAt, site.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case site.FieldID, site.FieldOrchestratorID:
+		case site.FieldID, site.FieldOrgID, site.FieldOrchestratorID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -107,6 +129,12 @@ func (_m *Site) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case site.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				_m.OrgID = *value
+			}
 		case site.FieldSiteID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field site_id", values[i])
@@ -131,6 +159,12 @@ func (_m *Site) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Location = value.String
 			}
+		case site.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				_m.Group = value.String
+			}
 		case site.FieldOrchestratorID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field orchestrator_id", values[i])
@@ -145,6 +179,18 @@ func (_m *Site) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case site.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case site.FieldLastSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen", values[i])
+			} else if value.Valid {
+				_m.LastSeen = value.Time
+			}
 		case site.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -180,6 +226,11 @@ func (_m *Site) QueryOrchestrator() *OrchestratorQuery {
 	return NewSiteClient(_m.config).QueryOrchestrator(_m)
 }
 
+// QueryOrganization queries the "organization" edge of the Site entity.
+func (_m *Site) QueryOrganization() *OrganizationQuery {
+	return NewSiteClient(_m.config).QueryOrganization(_m)
+}
+
 // Update returns a builder for updating this Site.
 // Note that you need to call Site.Unwrap() before calling this method if this Site
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,6 +254,9 @@ func (_m *Site) String() string {
 	var builder strings.Builder
 	builder.WriteString("Site(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgID))
+	builder.WriteString(", ")
 	builder.WriteString("site_id=")
 	builder.WriteString(_m.SiteID)
 	builder.WriteString(", ")
@@ -215,12 +269,21 @@ func (_m *Site) String() string {
 	builder.WriteString("location=")
 	builder.WriteString(_m.Location)
 	builder.WriteString(", ")
+	builder.WriteString("group=")
+	builder.WriteString(_m.Group)
+	builder.WriteString(", ")
 	builder.WriteString("orchestrator_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrchestratorID))
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("last_seen=")
+	builder.WriteString(_m.LastSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,20 @@ type SiteCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetOrgID sets the "org_id" field.
+func (_c *SiteCreate) SetOrgID(v uuid.UUID) *SiteCreate {
+	_c.mutation.SetOrgID(v)
+	return _c
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_c *SiteCreate) SetNillableOrgID(v *uuid.UUID) *SiteCreate {
+	if v != nil {
+		_c.SetOrgID(*v)
+	}
+	return _c
+}
+
 // SetSiteID sets the "site_id" field.
 func (_c *SiteCreate) SetSiteID(v string) *SiteCreate {
 	_c.mutation.SetSiteID(v)
@@ -74,6 +89,20 @@ func (_c *SiteCreate) SetNillableLocation(v *string) *SiteCreate {
 	return _c
 }
 
+// SetGroup sets the "group" field.
+func (_c *SiteCreate) SetGroup(v string) *SiteCreate {
+	_c.mutation.SetGroup(v)
+	return _c
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_c *SiteCreate) SetNillableGroup(v *string) *SiteCreate {
+	if v != nil {
+		_c.SetGroup(*v)
+	}
+	return _c
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (_c *SiteCreate) SetOrchestratorID(v uuid.UUID) *SiteCreate {
 	_c.mutation.SetOrchestratorID(v)
@@ -102,6 +131,34 @@ func (_c *SiteCreate) SetNillableMetadata(v *struct{}) *SiteCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *SiteCreate) SetStatus(v string) *SiteCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SiteCreate) SetNillableStatus(v *string) *SiteCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (_c *SiteCreate) SetLastSeen(v time.Time) *SiteCreate {
+	_c.mutation.SetLastSeen(v)
+	return _c
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (_c *SiteCreate) SetNillableLastSeen(v *time.Time) *SiteCreate {
+	if v != nil {
+		_c.SetLastSeen(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SiteCreate) SetCreatedAt(v time.Time) *SiteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -164,6 +221,25 @@ func (_c *SiteCreate) SetOrchestrator(v *Orchestrator) *SiteCreate {
 	return _c.SetOrchestratorID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *SiteCreate) SetOrganizationID(id uuid.UUID) *SiteCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_c *SiteCreate) SetNillableOrganizationID(id *uuid.UUID) *SiteCreate {
+	if id != nil {
+		_c = _c.SetOrganizationID(*id)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *SiteCreate) SetOrganization(v *Organization) *SiteCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the SiteMutation object of the builder.
 func (_c *SiteCreate) Mutation() *SiteMutation {
 	return _c.mutation
@@ -171,7 +247,9 @@ func (_c *SiteCreate) Mutation() *SiteMutation {
 
 // Save creates the Site in the database.
 func (_c *SiteCreate) Save(ctx context.Context) (*Site, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -198,11 +276,15 @@ func (_c *SiteCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SiteCreate) defaults() {
+func (_c *SiteCreate) defaults() error {
 	if _, ok := _c.mutation.ID(); !ok {
+		if site.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized site.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := site.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -262,10 +344,22 @@ func (_c *SiteCreate) createSpec() (*Site, *sqlgraph.CreateSpec) {
 		_spec.SetField(site.FieldLocation, field.TypeString, value)
 		_node.Location = value
 	}
+	if value, ok := _c.mutation.Group(); ok {
+		_spec.SetField(site.FieldGroup, field.TypeString, value)
+		_node.Group = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(site.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(site.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LastSeen(); ok {
+		_spec.SetField(site.FieldLastSeen, field.TypeTime, value)
+		_node.LastSeen = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(site.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -307,6 +401,23 @@ func (_c *SiteCreate) createSpec() (*Site, *sqlgraph.CreateSpec) {
 		_node.OrchestratorID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   site.OrganizationTable,
+			Columns: []string{site.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -314,7 +425,7 @@ func (_c *SiteCreate) createSpec() (*Site, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Site.Create().
-//		SetSiteID(v).
+//		SetOrgID(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -323,7 +434,7 @@ func (_c *SiteCreate) createSpec() (*Site, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SiteUpsert) {
-//			SetSiteID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *SiteCreate) OnConflict(opts ...sql.ConflictOption) *SiteUpsertOne {
@@ -359,6 +470,24 @@ type (
 	}
 )
 
+// SetOrgID sets the "org_id" field.
+func (u *SiteUpsert) SetOrgID(v uuid.UUID) *SiteUpsert {
+	u.Set(site.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SiteUpsert) UpdateOrgID() *SiteUpsert {
+	u.SetExcluded(site.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *SiteUpsert) ClearOrgID() *SiteUpsert {
+	u.SetNull(site.FieldOrgID)
+	return u
+}
+
 // SetSiteID sets the "site_id" field.
 func (u *SiteUpsert) SetSiteID(v string) *SiteUpsert {
 	u.Set(site.FieldSiteID, v)
@@ -425,6 +554,24 @@ func (u *SiteUpsert) ClearLocation() *SiteUpsert {
 	return u
 }
 
+// SetGroup sets the "group" field.
+func (u *SiteUpsert) SetGroup(v string) *SiteUpsert {
+	u.Set(site.FieldGroup, v)
+	return u
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *SiteUpsert) UpdateGroup() *SiteUpsert {
+	u.SetExcluded(site.FieldGroup)
+	return u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *SiteUpsert) ClearGroup() *SiteUpsert {
+	u.SetNull(site.FieldGroup)
+	return u
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (u *SiteUpsert) SetOrchestratorID(v uuid.UUID) *SiteUpsert {
 	u.Set(site.FieldOrchestratorID, v)
@@ -461,6 +608,42 @@ func (u *SiteUpsert) ClearMetadata() *SiteUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *SiteUpsert) SetStatus(v string) *SiteUpsert {
+	u.Set(site.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SiteUpsert) UpdateStatus() *SiteUpsert {
+	u.SetExcluded(site.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *SiteUpsert) ClearStatus() *SiteUpsert {
+	u.SetNull(site.FieldStatus)
+	return u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *SiteUpsert) SetLastSeen(v time.Time) *SiteUpsert {
+	u.Set(site.FieldLastSeen, v)
+	return u
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *SiteUpsert) UpdateLastSeen() *SiteUpsert {
+	u.SetExcluded(site.FieldLastSeen)
+	return u
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (u *SiteUpsert) ClearLastSeen() *SiteUpsert {
+	u.SetNull(site.FieldLastSeen)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SiteUpsert) SetCreatedAt(v time.Time) *SiteUpsert {
 	u.Set(site.FieldCreatedAt, v)
@@ -545,6 +728,27 @@ func (u *SiteUpsertOne) Update(set func(*SiteUpsert)) *SiteUpsertOne {
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *SiteUpsertOne) SetOrgID(v uuid.UUID) *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SiteUpsertOne) UpdateOrgID() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *SiteUpsertOne) ClearOrgID() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetSiteID sets the "site_id" field.
 func (u *SiteUpsertOne) SetSiteID(v string) *SiteUpsertOne {
 	return u.Update(func(s *SiteUpsert) {
@@ -622,6 +826,27 @@ func (u *SiteUpsertOne) ClearLocation() *SiteUpsertOne {
 	})
 }
 
+// SetGroup sets the "group" field.
+func (u *SiteUpsertOne) SetGroup(v string) *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *SiteUpsertOne) UpdateGroup() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *SiteUpsertOne) ClearGroup() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearGroup()
+	})
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (u *SiteUpsertOne) SetOrchestratorID(v uuid.UUID) *SiteUpsertOne {
 	return u.Update(func(s *SiteUpsert) {
@@ -664,6 +889,48 @@ func (u *SiteUpsertOne) ClearMetadata() *SiteUpsertOne {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *SiteUpsertOne) SetStatus(v string) *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SiteUpsertOne) UpdateStatus() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *SiteUpsertOne) ClearStatus() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *SiteUpsertOne) SetLastSeen(v time.Time) *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *SiteUpsertOne) UpdateLastSeen() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (u *SiteUpsertOne) ClearLastSeen() *SiteUpsertOne {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearLastSeen()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SiteUpsertOne) SetCreatedAt(v time.Time) *SiteUpsertOne {
 	return u.Update(func(s *SiteUpsert) {
@@ -842,7 +1109,7 @@ func (_c *SiteCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SiteUpsert) {
-//			SetSiteID(v+v).
+//			SetOrgID(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *SiteCreateBulk) OnConflict(opts ...sql.ConflictOption) *SiteUpsertBulk {
@@ -921,6 +1188,27 @@ func (u *SiteUpsertBulk) Update(set func(*SiteUpsert)) *SiteUpsertBulk {
 	return u
 }
 
+// SetOrgID sets the "org_id" field.
+func (u *SiteUpsertBulk) SetOrgID(v uuid.UUID) *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SiteUpsertBulk) UpdateOrgID() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *SiteUpsertBulk) ClearOrgID() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearOrgID()
+	})
+}
+
 // SetSiteID sets the "site_id" field.
 func (u *SiteUpsertBulk) SetSiteID(v string) *SiteUpsertBulk {
 	return u.Update(func(s *SiteUpsert) {
@@ -998,6 +1286,27 @@ func (u *SiteUpsertBulk) ClearLocation() *SiteUpsertBulk {
 	})
 }
 
+// SetGroup sets the "group" field.
+func (u *SiteUpsertBulk) SetGroup(v string) *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetGroup(v)
+	})
+}
+
+// UpdateGroup sets the "group" field to the value that was provided on create.
+func (u *SiteUpsertBulk) UpdateGroup() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateGroup()
+	})
+}
+
+// ClearGroup clears the value of the "group" field.
+func (u *SiteUpsertBulk) ClearGroup() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearGroup()
+	})
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (u *SiteUpsertBulk) SetOrchestratorID(v uuid.UUID) *SiteUpsertBulk {
 	return u.Update(func(s *SiteUpsert) {
@@ -1040,6 +1349,48 @@ func (u *SiteUpsertBulk) ClearMetadata() *SiteUpsertBulk {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *SiteUpsertBulk) SetStatus(v string) *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SiteUpsertBulk) UpdateStatus() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *SiteUpsertBulk) ClearStatus() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *SiteUpsertBulk) SetLastSeen(v time.Time) *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *SiteUpsertBulk) UpdateLastSeen() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (u *SiteUpsertBulk) ClearLastSeen() *SiteUpsertBulk {
+	return u.Update(func(s *SiteUpsert) {
+		s.ClearLastSeen()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SiteUpsertBulk) SetCreatedAt(v time.Time) *SiteUpsertBulk {
 	return u.Update(func(s *SiteUpsert) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ type SiteQuery struct {
 	predicates       []predicate.Site
 	withHosts        *HostQuery
 	withOrchestrator *OrchestratorQuery
+	withOrganization *OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (_q *SiteQuery) QueryOrchestrator() *OrchestratorQuery {
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *SiteQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(site.Table, site.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, site.OrganizationTable, site.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Site entity from the query.
 // Returns a *NotFoundError when no Site was found.
 func (_q *SiteQuery) First(ctx context.Context) (*Site, error) {
@@ -302,6 +326,7 @@ func (_q *SiteQuery) Clone() *SiteQuery {
 		predicates:       append([]predicate.Site{}, _q.predicates...),
 		withHosts:        _q.withHosts.Clone(),
 		withOrchestrator: _q.withOrchestrator.Clone(),
+		withOrganization: _q.withOrganization.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,18 +355,29 @@ func (_q *SiteQuery) WithOrchestrator(opts ...func(*OrchestratorQuery)) *SiteQue
 	return _q
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SiteQuery) WithOrganization(opts ...func(*OrganizationQuery)) *SiteQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		SiteID string `json:"site_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Site.Query().
-//		GroupBy(site.FieldSiteID).
+//		GroupBy(site.FieldOrgID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SiteQuery) GroupBy(field string, fields ...string) *SiteGroupBy {
@@ -359,11 +395,11 @@ func (_q *SiteQuery) GroupBy(field string, fields ...string) *SiteGroupBy {
 // Example:
 //
 //	var v []struct {
-//		SiteID string `json:"site_id,omitempty"`
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
 //	}
 //
 //	client.Site.Query().
-//		Select(site.FieldSiteID).
+//		Select(site.FieldOrgID).
 //		Scan(ctx, &v)
 func (_q *SiteQuery) Select(fields ...string) *SiteSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -408,9 +444,10 @@ func (_q *SiteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Site, e
 	var (
 		nodes       = []*Site{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withHosts != nil,
 			_q.withOrchestrator != nil,
+			_q.withOrganization != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,12 @@ func (_q *SiteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Site, e
 			return nil, err
 		}
 	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *Site, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -506,6 +549,35 @@ func (_q *SiteQuery) loadOrchestrator(ctx context.Context, query *OrchestratorQu
 	}
 	return nil
 }
+func (_q *SiteQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Site, init func(*Site), assign func(*Site, *Organization)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Site)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *SiteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -535,6 +607,9 @@ func (_q *SiteQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withOrchestrator != nil {
 			_spec.Node.AddColumnOnce(site.FieldOrchestratorID)
 		}
+		if _q.withOrganization != nil {
+			_spec.Node.AddColumnOnce(site.FieldOrgID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
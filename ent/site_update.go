@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/balaji-balu/margo-hello-world/ent/host"
 	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
 	"github.com/balaji-balu/margo-hello-world/ent/predicate"
 	"github.com/balaji-balu/margo-hello-world/ent/site"
 	"github.com/google/uuid"
@@ -31,6 +32,26 @@ func (_u *SiteUpdate) Where(ps ...predicate.Site) *SiteUpdate {
 	return _u
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *SiteUpdate) SetOrgID(v uuid.UUID) *SiteUpdate {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableOrgID(v *uuid.UUID) *SiteUpdate {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *SiteUpdate) ClearOrgID() *SiteUpdate {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetSiteID sets the "site_id" field.
 func (_u *SiteUpdate) SetSiteID(v string) *SiteUpdate {
 	_u.mutation.SetSiteID(v)
@@ -105,6 +126,26 @@ func (_u *SiteUpdate) ClearLocation() *SiteUpdate {
 	return _u
 }
 
+// SetGroup sets the "group" field.
+func (_u *SiteUpdate) SetGroup(v string) *SiteUpdate {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableGroup(v *string) *SiteUpdate {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *SiteUpdate) ClearGroup() *SiteUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (_u *SiteUpdate) SetOrchestratorID(v uuid.UUID) *SiteUpdate {
 	_u.mutation.SetOrchestratorID(v)
@@ -145,6 +186,46 @@ func (_u *SiteUpdate) ClearMetadata() *SiteUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *SiteUpdate) SetStatus(v string) *SiteUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableStatus(v *string) *SiteUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SiteUpdate) ClearStatus() *SiteUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (_u *SiteUpdate) SetLastSeen(v time.Time) *SiteUpdate {
+	_u.mutation.SetLastSeen(v)
+	return _u
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (_u *SiteUpdate) SetNillableLastSeen(v *time.Time) *SiteUpdate {
+	if v != nil {
+		_u.SetLastSeen(*v)
+	}
+	return _u
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (_u *SiteUpdate) ClearLastSeen() *SiteUpdate {
+	_u.mutation.ClearLastSeen()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *SiteUpdate) SetCreatedAt(v time.Time) *SiteUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -205,6 +286,25 @@ func (_u *SiteUpdate) SetOrchestrator(v *Orchestrator) *SiteUpdate {
 	return _u.SetOrchestratorID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SiteUpdate) SetOrganizationID(id uuid.UUID) *SiteUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *SiteUpdate) SetNillableOrganizationID(id *uuid.UUID) *SiteUpdate {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SiteUpdate) SetOrganization(v *Organization) *SiteUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the SiteMutation object of the builder.
 func (_u *SiteUpdate) Mutation() *SiteMutation {
 	return _u.mutation
@@ -237,6 +337,12 @@ func (_u *SiteUpdate) ClearOrchestrator() *SiteUpdate {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SiteUpdate) ClearOrganization() *SiteUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SiteUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -294,12 +400,30 @@ func (_u *SiteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LocationCleared() {
 		_spec.ClearField(site.FieldLocation, field.TypeString)
 	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(site.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(site.FieldGroup, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(site.FieldMetadata, field.TypeJSON, value)
 	}
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(site.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(site.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(site.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeen(); ok {
+		_spec.SetField(site.FieldLastSeen, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenCleared() {
+		_spec.ClearField(site.FieldLastSeen, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(site.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -386,6 +510,35 @@ func (_u *SiteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   site.OrganizationTable,
+			Columns: []string{site.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   site.OrganizationTable,
+			Columns: []string{site.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{site.Label}
@@ -406,6 +559,26 @@ type SiteUpdateOne struct {
 	mutation *SiteMutation
 }
 
+// SetOrgID sets the "org_id" field.
+func (_u *SiteUpdateOne) SetOrgID(v uuid.UUID) *SiteUpdateOne {
+	_u.mutation.SetOrgID(v)
+	return _u
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableOrgID(v *uuid.UUID) *SiteUpdateOne {
+	if v != nil {
+		_u.SetOrgID(*v)
+	}
+	return _u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (_u *SiteUpdateOne) ClearOrgID() *SiteUpdateOne {
+	_u.mutation.ClearOrgID()
+	return _u
+}
+
 // SetSiteID sets the "site_id" field.
 func (_u *SiteUpdateOne) SetSiteID(v string) *SiteUpdateOne {
 	_u.mutation.SetSiteID(v)
@@ -480,6 +653,26 @@ func (_u *SiteUpdateOne) ClearLocation() *SiteUpdateOne {
 	return _u
 }
 
+// SetGroup sets the "group" field.
+func (_u *SiteUpdateOne) SetGroup(v string) *SiteUpdateOne {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableGroup(v *string) *SiteUpdateOne {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *SiteUpdateOne) ClearGroup() *SiteUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
 // SetOrchestratorID sets the "orchestrator_id" field.
 func (_u *SiteUpdateOne) SetOrchestratorID(v uuid.UUID) *SiteUpdateOne {
 	_u.mutation.SetOrchestratorID(v)
@@ -520,6 +713,46 @@ func (_u *SiteUpdateOne) ClearMetadata() *SiteUpdateOne {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *SiteUpdateOne) SetStatus(v string) *SiteUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableStatus(v *string) *SiteUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SiteUpdateOne) ClearStatus() *SiteUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (_u *SiteUpdateOne) SetLastSeen(v time.Time) *SiteUpdateOne {
+	_u.mutation.SetLastSeen(v)
+	return _u
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableLastSeen(v *time.Time) *SiteUpdateOne {
+	if v != nil {
+		_u.SetLastSeen(*v)
+	}
+	return _u
+}
+
+// ClearLastSeen clears the value of the "last_seen" field.
+func (_u *SiteUpdateOne) ClearLastSeen() *SiteUpdateOne {
+	_u.mutation.ClearLastSeen()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *SiteUpdateOne) SetCreatedAt(v time.Time) *SiteUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -580,6 +813,25 @@ func (_u *SiteUpdateOne) SetOrchestrator(v *Orchestrator) *SiteUpdateOne {
 	return _u.SetOrchestratorID(v.ID)
 }
 
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SiteUpdateOne) SetOrganizationID(id uuid.UUID) *SiteUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization" edge to the Organization entity by ID if the given value is not nil.
+func (_u *SiteUpdateOne) SetNillableOrganizationID(id *uuid.UUID) *SiteUpdateOne {
+	if id != nil {
+		_u = _u.SetOrganizationID(*id)
+	}
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SiteUpdateOne) SetOrganization(v *Organization) *SiteUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
 // Mutation returns the SiteMutation object of the builder.
 func (_u *SiteUpdateOne) Mutation() *SiteMutation {
 	return _u.mutation
@@ -612,6 +864,12 @@ func (_u *SiteUpdateOne) ClearOrchestrator() *SiteUpdateOne {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SiteUpdateOne) ClearOrganization() *SiteUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
 // Where appends a list predicates to the SiteUpdate builder.
 func (_u *SiteUpdateOne) Where(ps ...predicate.Site) *SiteUpdateOne {
 	_u.mutation.Where(ps...)
@@ -699,12 +957,30 @@ func (_u *SiteUpdateOne) sqlSave(ctx context.Context) (_node *Site, err error) {
 	if _u.mutation.LocationCleared() {
 		_spec.ClearField(site.FieldLocation, field.TypeString)
 	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(site.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(site.FieldGroup, field.TypeString)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(site.FieldMetadata, field.TypeJSON, value)
 	}
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(site.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(site.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(site.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeen(); ok {
+		_spec.SetField(site.FieldLastSeen, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenCleared() {
+		_spec.ClearField(site.FieldLastSeen, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(site.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -791,6 +1067,35 @@ func (_u *SiteUpdateOne) sqlSave(ctx context.Context) (_node *Site, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   site.OrganizationTable,
+			Columns: []string{site.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   site.OrganizationTable,
+			Columns: []string{site.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Site{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
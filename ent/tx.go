@@ -12,24 +12,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
 	// ApplicationDesc is the client for interacting with the ApplicationDesc builders.
 	ApplicationDesc *ApplicationDescClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// Component is the client for interacting with the Component builders.
 	Component *ComponentClient
 	// DeploymentComponentStatus is the client for interacting with the DeploymentComponentStatus builders.
 	DeploymentComponentStatus *DeploymentComponentStatusClient
 	// DeploymentProfile is the client for interacting with the DeploymentProfile builders.
 	DeploymentProfile *DeploymentProfileClient
+	// DeploymentRevision is the client for interacting with the DeploymentRevision builders.
+	DeploymentRevision *DeploymentRevisionClient
 	// DeploymentStatus is the client for interacting with the DeploymentStatus builders.
 	DeploymentStatus *DeploymentStatusClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
 	// Host is the client for interacting with the Host builders.
 	Host *HostClient
 	// Orchestrator is the client for interacting with the Orchestrator builders.
 	Orchestrator *OrchestratorClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// RoleBinding is the client for interacting with the RoleBinding builders.
+	RoleBinding *RoleBindingClient
 	// Site is the client for interacting with the Site builders.
 	Site *SiteClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +177,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIToken = NewAPITokenClient(tx.config)
 	tx.ApplicationDesc = NewApplicationDescClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
 	tx.Component = NewComponentClient(tx.config)
 	tx.DeploymentComponentStatus = NewDeploymentComponentStatusClient(tx.config)
 	tx.DeploymentProfile = NewDeploymentProfileClient(tx.config)
+	tx.DeploymentRevision = NewDeploymentRevisionClient(tx.config)
 	tx.DeploymentStatus = NewDeploymentStatusClient(tx.config)
+	tx.Event = NewEventClient(tx.config)
 	tx.Host = NewHostClient(tx.config)
 	tx.Orchestrator = NewOrchestratorClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.RoleBinding = NewRoleBindingClient(tx.config)
 	tx.Site = NewSiteClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +203,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ApplicationDesc.QueryXXX(), the query will be executed
+// applies a query, for example: APIToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+)
+
+// SiteParam finds the site of the :id path parameter, for
+// middleware.RequireSite. A site the CO does not know yet is in no group.
+func SiteParam(client *ent.Client) func(*gin.Context) (auth.Site, error) {
+	return func(c *gin.Context) (auth.Site, error) {
+		return authSite(c, client, c.Param("id"))
+	}
+}
+
+// DeploymentSite finds the site of the deployment in the :id path
+// parameter, for middleware.RequireSite. An unknown deployment is at no
+// site, so only those with a role on every site get as far as its 404.
+func DeploymentSite(client *ent.Client) func(*gin.Context) (auth.Site, error) {
+	return func(c *gin.Context) (auth.Site, error) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			return auth.Site{}, nil
+		}
+		rev, err := client.DeploymentRevision.Query().
+			Where(deploymentrevision.DeploymentIDEQ(id)).
+			Select(deploymentrevision.FieldSiteID).
+			First(c)
+		if ent.IsNotFound(err) {
+			return auth.Site{}, nil
+		}
+		if err != nil {
+			return auth.Site{}, err
+		}
+		return authSite(c, client, rev.SiteID)
+	}
+}
+
+func authSite(ctx context.Context, client *ent.Client, siteID string) (auth.Site, error) {
+	s, err := client.Site.Query().Where(site.SiteID(siteID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return auth.Site{ID: siteID}, nil
+	}
+	if err != nil {
+		return auth.Site{}, err
+	}
+	return siteAccess(s), nil
+}
+
+// siteAccess is what scopes are matched against for s; nil is no site.
+func siteAccess(s *ent.Site) auth.Site {
+	if s == nil {
+		return auth.Site{}
+	}
+	return auth.Site{ID: s.SiteID, Group: s.Group}
+}
+
+// deploymentSites maps each deployment to the site it deploys to. A
+// deployment stays at one site across its revisions.
+func deploymentSites(ctx context.Context, client *ent.Client) (map[uuid.UUID]auth.Site, error) {
+	revs, err := client.DeploymentRevision.Query().
+		Select(deploymentrevision.FieldDeploymentID, deploymentrevision.FieldSiteID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sites, err := client.Site.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[string]string, len(sites))
+	for _, s := range sites {
+		groups[s.SiteID] = s.Group
+	}
+	out := make(map[uuid.UUID]auth.Site, len(revs))
+	for _, r := range revs {
+		out[r.DeploymentID] = auth.Site{ID: r.SiteID, Group: groups[r.SiteID]}
+	}
+	return out, nil
+}
@@ -1,27 +1,42 @@
 package handlers
 
 import (
-	"context"
+	"errors"
 	"net/http"
 	"log"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/goccy/go-yaml"
 	"github.com/google/uuid"
 
 	"github.com/balaji-balu/margo-hello-world/ent"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
 	"github.com/balaji-balu/margo-hello-world/ent/component"	
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
 	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	"github.com/balaji-balu/margo-hello-world/pkg/application/validation"
 	"github.com/balaji-balu/margo-hello-world/internal/gitfetcher"
+	"github.com/balaji-balu/margo-hello-world/internal/ocifetch"
 )
 type AppRequest struct {
 	Category string `json:"category" binding:"required"`
-	AppName string `json:"app_name" binding:"required"`
-	Version string `json:version" binding:"required"`
+	// AppName and Version default, for an OCI package, to the ones its
+	// margo.yaml gives.
+	AppName string `json:"app_name"`
+	Version string `json:"version"`
 	RepoURL string `json:"repo_url" `
+	// OCIRef is an app package in an OCI registry, e.g.
+	// registry.example.com/apps/hello:1.0.0, to import instead of RepoURL.
+	OCIRef string `json:"oci_ref"`
+}
+
+// Target names the app asked for as category/app_name/version, as the
+// audit log records it.
+func (r AppRequest) Target() string {
+	return path.Join(r.Category, r.AppName, r.Version)
 }
 
 func ListApps(c *gin.Context, client *ent.Client) {
@@ -72,7 +87,11 @@ func GetApp(c *gin.Context, client *ent.Client) {
 	c.JSON(http.StatusOK, app)
 }
 
-func CreateApp(c *gin.Context, client *ent.Client, fetcher *gitfetcher.GitFetcher) {
+// CreateApp adds an app from the registry: a git repo_url holding
+// category/app_name/version/margo.yaml, or an OCI package at oci_ref,
+// whose margo.yaml names the app and whose icon, license and release notes
+// are stored with it.
+func CreateApp(c *gin.Context, client *ent.Client, fetcher *gitfetcher.GitFetcher, puller *ocifetch.Puller) {
 
 	// verify already added, if yes, reject
 	//post content : app name and app repo url
@@ -84,38 +103,55 @@ func CreateApp(c *gin.Context, client *ent.Client, fetcher *gitfetcher.GitFetche
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	middleware.AuditTarget(c, req.Target())
+
+	var appDesc *application.ApplicationDescription
+	var res *AppResources
+	if req.OCIRef != "" {
+		// the package names the app, so it is pulled before the
+		// duplicate check
+		pkg, err := puller.Pull(c.Request.Context(), req.OCIRef)
+		if err != nil {
+			log.Printf("❌ failed to pull package: %v", err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+		content, _ := pkg.File(ocifetch.DescriptionFile)
+		if appDesc = parseDescription(c, content); appDesc == nil {
+			return
+		}
+		if req.AppName == "" {
+			req.AppName = appDesc.Metadata.Name
+		}
+		if req.Version == "" {
+			req.Version = appDesc.Metadata.Version
+		}
+		if res, err = packageResources(pkg, appDesc); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		middleware.AuditTarget(c, req.Target())
+	} else if req.RepoURL == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "repo_url or oci_ref is required"})
+		return
+	}
+	if req.AppName == "" || req.Version == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "app_name and version are required"})
+		return
+	}
 
 	appName := req.AppName // or from query, form, etc.
 	category := req.Category
 	version := req.Version
 
-	if req.RepoURL == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "repo url req"})
-		return
-	}
-
 	log.Println("appName", appName, "category", category, "version", version)
-	q := client.ApplicationDesc.Query()
-	if category != "" {
-		q = q.Where(applicationdesc.CategoryEQ(category))
-	}
-	if appName != "" {
-		q = q.Where(applicationdesc.NameEQ(appName))
-	}
-	if version != "" {
-		q = q.Where(applicationdesc.VersionEQ(version))
-	}
-	apps, err := q.Exist(c)
-
-	// apps, err := client.ApplicationDesc.
-	// 	Query().
-	// 	Where(
-	// 		applicationdesc.CategoryEQ(category),
-    //     	applicationdesc.NameEQ(appName),
-    //     	applicationdesc.VersionEQ(version),
-	// 		//applicationdesc.NameContainsFold(appName), // case-insensitive partial match
-	// 	).
-	// 	Exist(c)
+	apps, err := client.ApplicationDesc.Query().
+		Where(
+			applicationdesc.CategoryEQ(category),
+			applicationdesc.NameEQ(appName),
+			applicationdesc.VersionEQ(version),
+		).
+		Exist(c)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -127,39 +163,132 @@ func CreateApp(c *gin.Context, client *ent.Client, fetcher *gitfetcher.GitFetche
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "duplicate apps found"})
 		return
 	}
+	if err := checkQuota(c, client, quotaApps, 1); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
 
-	fetcher.RepoURL = req.RepoURL
-	path := fmt.Sprintf("%s/%s/%s", category, appName, version)
-	log.Println("path:", path)
-	content, err := fetcher.FetchAppResource(path, "margo.yaml")
+	if req.OCIRef == "" {
+		fetcher.RepoURL = req.RepoURL
+		path := fmt.Sprintf("%s/%s/%s", category, appName, version)
+		log.Println("path:", path)
+		content, err := fetcher.FetchAppResource(path, "margo.yaml")
+		if err != nil {
+			log.Printf("❌ failed to fetch resource: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		log.Println("📄 margo.yaml contents:\n", string(content))
+
+		if appDesc = parseDescription(c, []byte(content)); appDesc == nil {
+			return
+		}
+	}
+
+	err = Persist(c.Request.Context(), client, appName, category, appDesc, res)
 	if err != nil {
-		log.Printf("❌ failed to fetch resource: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		var qe *QuotaError
+		if errors.As(err, &qe) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	c.JSON(http.StatusCreated, appDesc)
+}
 
-	log.Println("📄 margo.yaml contents:\n", string(content))
-
-	//var app ent.ApplicationDesc
-	var appDesc application.ApplicationDescription
-	if err := yaml.Unmarshal([]byte(content), &appDesc); err != nil {
+// parseDescription parses and validates margo.yaml. If it is not valid it
+// responds with every error and where it is, and returns nil.
+func parseDescription(c *gin.Context, content []byte) *application.ApplicationDescription {
+	ad, err := validation.Parse(content)
+	if errs, ok := validation.AsErrors(err); ok {
+		log.Printf("❌ invalid application description: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid application description", "errors": errs})
+		return nil
+	}
+	if err != nil {
 		log.Printf("❌ failed to unmarshall resource: %v", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}	
+		return nil
+	}
+	return ad
+}
 
-	// appDesc, err := application.ParseFromFile("./tests/app3.yaml")
-	// if err != nil {
-	// 	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-	// 	return
-	// }
+// iconTypes are the media types an app icon may have. They are raster
+// images only: an SVG served from the API's origin could run script there.
+var iconTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
 
-	err = Persist(context.Background(), client, appName, category, &appDesc)
+// packageResources reads the files the catalog entry of ad points at out
+// of pkg. A file it names must be in the package, and the icon must be one
+// of iconTypes.
+func packageResources(pkg *ocifetch.Package, ad *application.ApplicationDescription) (*AppResources, error) {
+	res := &AppResources{ArtifactURL: pkg.Reference}
+	meta := ad.Metadata.Catalog.Application
+	if meta == nil {
+		return res, nil
+	}
+	file := func(name string) ([]byte, error) {
+		if name == "" {
+			return nil, nil
+		}
+		b, ok := pkg.File(name)
+		if !ok {
+			return nil, fmt.Errorf("package has no %s, which %s names", name, ocifetch.DescriptionFile)
+		}
+		return b, nil
+	}
+	var err error
+	if res.Icon, err = file(meta.Icon); err != nil {
+		return nil, err
+	}
+	if len(res.Icon) > 0 {
+		res.IconMediaType = http.DetectContentType(res.Icon)
+		if mt := pkg.MediaType(meta.Icon); strings.HasPrefix(mt, "image/") {
+			res.IconMediaType = mt
+		}
+		if !iconTypes[res.IconMediaType] {
+			return nil, fmt.Errorf("icon %s is %s; it must be a PNG, JPEG, GIF or WebP image", meta.Icon, res.IconMediaType)
+		}
+	}
+	license, err := file(meta.LicenseFile)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, err
+	}
+	notes, err := file(meta.ReleaseNotes)
+	if err != nil {
+		return nil, err
+	}
+	res.License, res.ReleaseNotes = string(license), string(notes)
+	return res, nil
+}
+
+// GetAppIcon serves the icon an app was imported with, as an image that
+// browsers neither sniff as something else nor let run anything.
+func GetAppIcon(c *gin.Context, client *ent.Client) {
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid uuid"})
 		return
 	}
-	c.JSON(http.StatusCreated, appDesc)
+	app, err := client.ApplicationDesc.Get(c, uid)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "app not found"})
+		return
+	}
+	if len(app.IconData) == 0 || !iconTypes[app.IconMediaType] {
+		c.JSON(http.StatusNotFound, gin.H{"error": "app has no icon"})
+		return
+	}
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "default-src 'none'; sandbox")
+	c.Data(http.StatusOK, app.IconMediaType, app.IconData)
 }
 
 func DeleteApp(c *gin.Context, client *ent.Client) {
@@ -168,6 +297,11 @@ func DeleteApp(c *gin.Context, client *ent.Client) {
         c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
         return
     }
+    middleware.AuditTarget(c, req.Target())
+    if req.AppName == "" || req.Version == "" {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "app_name and version are required"})
+        return
+    }
 
     category := req.Category
     appName := req.AppName
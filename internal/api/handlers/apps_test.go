@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/balaji-balu/margo-hello-world/internal/ocifetch"
+	"github.com/balaji-balu/margo-hello-world/pkg/application/validation"
+)
+
+const testMargoYAML = `apiVersion: margo.org/v1-alpha1
+kind: ApplicationDescription
+metadata:
+  id: com-example-hello
+  name: hello
+  version: 1.2.0
+  catalog:
+    application:
+      icon: ./resources/icon.png
+      licenseFile: LICENSE
+      releaseNotes: ./RELEASE.md
+    organization:
+      - name: Example
+deploymentProfiles:
+  - type: compose
+    id: hello-compose
+    components:
+      - name: hello
+        properties:
+          repository: registry.example.com/hello:1.2.0
+`
+
+// testRegistry serves one OCI package, tagged latest at apps/hello, from
+// just the endpoints a pull uses.
+func testRegistry(t *testing.T, files map[string]string) *httptest.Server {
+	t.Helper()
+	blobs := map[string][]byte{}
+	var layers []ocispec.Descriptor
+	for name, body := range files {
+		d := digest.FromString(body)
+		blobs[d.String()] = []byte(body)
+		layers = append(layers, ocispec.Descriptor{
+			MediaType:   "application/octet-stream",
+			Digest:      d,
+			Size:        int64(len(body)),
+			Annotations: map[string]string{ocispec.AnnotationTitle: name},
+		})
+	}
+	config := []byte("{}")
+	blobs[digest.FromBytes(config).String()] = config
+	manifest, _ := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: ocispec.MediaTypeEmptyJSON, Digest: digest.FromBytes(config), Size: 2},
+		Layers:    layers,
+	})
+	manifestDigest := digest.FromBytes(manifest).String()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []byte
+		switch ref := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]; {
+		case strings.HasPrefix(r.URL.Path, "/v2/apps/hello/manifests/") && (ref == "latest" || ref == manifestDigest):
+			data = manifest
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", manifestDigest)
+		case strings.HasPrefix(r.URL.Path, "/v2/apps/hello/blobs/") && blobs[ref] != nil:
+			data = blobs[ref]
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCreateAppFromOCI(t *testing.T) {
+	client := openTestDB(t)
+	srv := testRegistry(t, map[string]string{
+		"margo.yaml":         testMargoYAML,
+		"resources/icon.png": "\x89PNG\r\n\x1a\n",
+		"LICENSE":            "MIT",
+		"RELEASE.md":         "first release",
+	})
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	puller := &ocifetch.Puller{PlainHTTP: true}
+	r.POST("/apps", func(c *gin.Context) { CreateApp(c, client, nil, puller) })
+	r.GET("/apps/:id/icon", func(c *gin.Context) { GetAppIcon(c, client) })
+	create := func(req AppRequest) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/apps", bytes.NewReader(body)))
+		return w
+	}
+
+	if w := create(AppRequest{Category: "demo", OCIRef: host + "/apps/hello:latest"}); w.Code != http.StatusCreated {
+		t.Fatalf("create: %d %s", w.Code, w.Body)
+	}
+	app, err := client.ApplicationDesc.Query().Only(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.Name != "hello" || app.Version != "1.2.0" || app.License != "MIT" || app.ReleaseNotes != "first release" ||
+		!strings.HasPrefix(app.Artifacturl, host+"/apps/hello@sha256:") {
+		t.Errorf("app: %+v", app)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/apps/"+app.ID.String()+"/icon", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/png" || w.Body.String() != "\x89PNG\r\n\x1a\n" ||
+		w.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("icon: %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+
+	if w := create(AppRequest{Category: "demo", OCIRef: host + "/apps/hello:latest"}); w.Code == http.StatusCreated {
+		t.Error("imported the same app twice")
+	}
+	if w := create(AppRequest{Category: "demo", OCIRef: host + "/apps/missing:latest"}); w.Code != http.StatusBadGateway {
+		t.Errorf("missing package: %d", w.Code)
+	}
+	if w := create(AppRequest{Category: "demo"}); w.Code != http.StatusBadRequest {
+		t.Errorf("no source: %d", w.Code)
+	}
+
+	// an SVG icon would run its script from the API's origin
+	svg := testRegistry(t, map[string]string{
+		"margo.yaml":         testMargoYAML,
+		"resources/icon.png": `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`,
+		"LICENSE":            "MIT",
+		"RELEASE.md":         "first release",
+	})
+	if w := create(AppRequest{Category: "other", OCIRef: strings.TrimPrefix(svg.URL, "http://") + "/apps/hello:latest"}); w.Code != http.StatusBadRequest {
+		t.Errorf("svg icon: %d %s", w.Code, w.Body)
+	}
+
+	invalid := testRegistry(t, map[string]string{
+		"margo.yaml": strings.Replace(testMargoYAML, "type: compose", "type: kubernetes", 1),
+	})
+	w = create(AppRequest{Category: "demo", OCIRef: strings.TrimPrefix(invalid.URL, "http://") + "/apps/hello:latest"})
+	var resp struct {
+		Errors []validation.Error `json:"errors"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if w.Code != http.StatusBadRequest || len(resp.Errors) != 1 || resp.Errors[0].Path != "$.deploymentProfiles[0].type" || resp.Errors[0].Line == 0 {
+		t.Errorf("invalid description: %d %s", w.Code, w.Body)
+	}
+	if n := client.ApplicationDesc.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("%d apps", n)
+	}
+}
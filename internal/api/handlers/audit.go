@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/auditentry"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+	// auditExportBatch is how many entries an export reads at a time.
+	auditExportBatch = 500
+)
+
+// ListAudit lists audit entries, newest first, filtered by actor, action
+// (a substring, e.g. "deployments"), target, result and time, e.g.
+// ?actor=alice&since=2026-10-01T00:00:00Z. Pages are ?limit= long (100 by
+// default) and the next is asked for with ?before=<next> from the last.
+func ListAudit(c *gin.Context, client *ent.Client) {
+	q, err := auditQuery(c, client)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit := defaultAuditLimit
+	if s := c.Query("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = min(limit, maxAuditLimit)
+	}
+	if s := c.Query("before"); s != "" {
+		before, err := strconv.Atoi(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid before"})
+			return
+		}
+		q = q.Where(auditentry.IDLT(before))
+	}
+	entries, err := q.Order(ent.Desc(auditentry.FieldID)).Limit(limit).All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	resp := gin.H{"entries": entries}
+	if len(entries) == limit {
+		resp["next"] = entries[len(entries)-1].ID
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ExportAudit writes every audit entry matching ListAudit's filters, oldest
+// first, as ?format=csv or jsonl (the default), for download.
+func ExportAudit(c *gin.Context, client *ent.Client) {
+	q, err := auditQuery(c, client)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	format := c.DefaultQuery("format", "jsonl")
+	var write func(*ent.AuditEntry) error
+	var flush func()
+	switch format {
+	case "jsonl":
+		c.Header("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(c.Writer)
+		write = func(e *ent.AuditEntry) error { return enc.Encode(e) }
+		flush = func() {}
+	case "csv":
+		c.Header("Content-Type", "text/csv")
+		w := csv.NewWriter(c.Writer)
+		w.Write(auditCSVHeader)
+		write = func(e *ent.AuditEntry) error { return w.Write(auditCSVRecord(e)) }
+		flush = w.Flush
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or jsonl"})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), format))
+	c.Status(http.StatusOK)
+
+	// page by id, so that entries added meanwhile neither repeat nor shift
+	// the pages
+	after := 0
+	for {
+		entries, err := q.Clone().
+			Where(auditentry.IDGT(after)).
+			Order(ent.Asc(auditentry.FieldID)).
+			Limit(auditExportBatch).
+			All(c)
+		if err != nil {
+			// the status is out; all that is left is to stop short
+			c.Error(err)
+			break
+		}
+		for _, e := range entries {
+			if err := write(e); err != nil {
+				flush()
+				return
+			}
+		}
+		flush()
+		if len(entries) < auditExportBatch {
+			break
+		}
+		after = entries[len(entries)-1].ID
+	}
+}
+
+// auditQuery builds the query for the filters in the request.
+func auditQuery(c *gin.Context, client *ent.Client) (*ent.AuditEntryQuery, error) {
+	q := client.AuditEntry.Query()
+	if s := c.Query("actor"); s != "" {
+		q = q.Where(auditentry.Actor(s))
+	}
+	if s := c.Query("action"); s != "" {
+		q = q.Where(auditentry.ActionContains(s))
+	}
+	if s := c.Query("target"); s != "" {
+		q = q.Where(auditentry.TargetContains(s))
+	}
+	if s := c.Query("result"); s != "" {
+		q = q.Where(auditentry.Result(s))
+	}
+	if s := c.Query("since"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since: %w", err)
+		}
+		q = q.Where(auditentry.CreatedAtGTE(t))
+	}
+	if s := c.Query("until"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid until: %w", err)
+		}
+		q = q.Where(auditentry.CreatedAtLT(t))
+	}
+	return q, nil
+}
+
+var auditCSVHeader = []string{"id", "created_at", "actor", "action", "target", "result", "status", "error", "payload_hash", "source_ip"}
+
+func auditCSVRecord(e *ent.AuditEntry) []string {
+	return []string{
+		strconv.Itoa(e.ID),
+		e.CreatedAt.UTC().Format(time.RFC3339),
+		e.Actor,
+		e.Action,
+		e.Target,
+		e.Result,
+		strconv.Itoa(e.Status),
+		e.Error,
+		e.PayloadHash,
+		e.SourceIP,
+	}
+}
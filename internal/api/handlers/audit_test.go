@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+)
+
+func TestAudit(t *testing.T) {
+	client := openTestDB(t)
+	if _, _, err := BootstrapAdmin(context.Background(), client, "admin-pw"); err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.POST("/auth/login", func(c *gin.Context) { Login(c, client, 0) })
+	api := r.Group("", middleware.Authenticate(client, true), middleware.Audit(client))
+	api.POST("/users", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUser(c, client) })
+	api.POST("/users/:name/tokens", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUserToken(c, client) })
+	api.POST("/sites", middleware.Require(auth.Admin), func(c *gin.Context) { CreateSite(c, client) })
+	api.GET("/sites", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { ListSites(c, client) })
+	api.GET("/audit", middleware.Require(auth.Admin), func(c *gin.Context) { ListAudit(c, client) })
+	api.GET("/audit/export", middleware.Require(auth.Admin), func(c *gin.Context) { ExportAudit(c, client) })
+
+	do := func(method, path, token string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	mustJSON := func(v any) []byte {
+		b, _ := json.Marshal(v)
+		return b
+	}
+
+	var login TokenInfo
+	w := do("POST", "/auth/login", "", mustJSON(LoginRequest{Username: "admin", Password: "admin-pw"}))
+	json.Unmarshal(w.Body.Bytes(), &login)
+	admin := login.Token
+
+	site := mustJSON(SiteRequest{SiteID: "plant-1"})
+	if w := do("POST", "/sites", admin, site); w.Code != http.StatusCreated {
+		t.Fatalf("create site: %d", w.Code)
+	}
+	roles := []auth.Binding{{Role: auth.Viewer, Scope: auth.AllScopes}}
+	if w := do("POST", "/users", admin, mustJSON(UserRequest{Username: "bob", Roles: &roles})); w.Code != http.StatusCreated {
+		t.Fatalf("create user: %d", w.Code)
+	}
+	var issued TokenInfo
+	json.Unmarshal(do("POST", "/users/bob/tokens", admin, mustJSON(TokenRequest{Name: "t"})).Body.Bytes(), &issued)
+	if w := do("POST", "/sites", issued.Token, mustJSON(SiteRequest{SiteID: "plant-2"})); w.Code != http.StatusForbidden {
+		t.Fatalf("viewer created a site: %d", w.Code)
+	}
+	do("GET", "/sites", issued.Token, nil)
+
+	var list struct {
+		Entries []ent.AuditEntry `json:"entries"`
+		Next    int              `json:"next"`
+	}
+	if w := do("GET", "/audit", admin, nil); w.Code != http.StatusOK {
+		t.Fatalf("list: %d", w.Code)
+	} else {
+		json.Unmarshal(w.Body.Bytes(), &list)
+	}
+	// reads are not audited: the site creations, the user and its token
+	if len(list.Entries) != 4 {
+		t.Fatalf("entries: %+v", list.Entries)
+	}
+	denied, created := list.Entries[0], list.Entries[3]
+	if denied.Actor != "bob" || denied.Result != middleware.AuditFailure || denied.Status != http.StatusForbidden || denied.Error == "" {
+		t.Errorf("denied request: %+v", denied)
+	}
+	sum := sha256.Sum256(site)
+	if created.Actor != "admin" || created.Action != "POST /sites" || created.Target != "/sites" ||
+		created.Result != middleware.AuditSuccess || created.PayloadHash != hex.EncodeToString(sum[:]) || created.SourceIP == "" {
+		t.Errorf("created site: %+v", created)
+	}
+
+	json.Unmarshal(do("GET", "/audit?actor=admin&limit=2", admin, nil).Body.Bytes(), &list)
+	if len(list.Entries) != 2 || list.Next == 0 {
+		t.Fatalf("first page: %+v", list)
+	}
+	var page struct {
+		Entries []ent.AuditEntry `json:"entries"`
+	}
+	json.Unmarshal(do("GET", "/audit?actor=admin&limit=2&before="+strconv.Itoa(list.Next), admin, nil).Body.Bytes(), &page)
+	if len(page.Entries) != 1 || page.Entries[0].ID != created.ID {
+		t.Errorf("second page: %+v", page.Entries)
+	}
+
+	w = do("GET", "/audit/export?format=csv&result=failure", admin, nil)
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil || len(rows) != 2 || rows[0][0] != "id" || rows[1][2] != "bob" {
+		t.Errorf("csv export: %v %v", rows, err)
+	}
+
+	if err := client.AuditEntry.Update().ClearOrgID().Exec(context.Background()); err == nil {
+		t.Error("changed audit entries")
+	}
+	if _, err := client.AuditEntry.Delete().Exec(context.Background()); err == nil {
+		t.Error("deleted audit entries")
+	}
+}
+
+func TestAuditWithoutAuth(t *testing.T) {
+	client := openTestDB(t)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	api := r.Group("", middleware.Authenticate(client, false), middleware.Audit(client))
+	api.POST("/sites", func(c *gin.Context) { CreateSite(c, client) })
+	api.POST("/ping", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	post := func(path string, body []byte) int {
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.Header.Set("X-Author", "alice")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+	site := []byte(`{"site_id":"plant-1"}`)
+	if code := post("/sites", site); code != http.StatusCreated {
+		t.Fatalf("create site: %d", code)
+	}
+	// a body the handler never reads is hashed all the same
+	unread := []byte("not read")
+	post("/ping", unread)
+	if code := post("/sites", bytes.Repeat([]byte(" "), 9<<20)); code != http.StatusBadRequest {
+		t.Errorf("oversized body: %d", code)
+	}
+
+	entries := client.AuditEntry.Query().Order(ent.Asc("id")).AllX(context.Background())
+	if len(entries) != 3 {
+		t.Fatalf("entries: %+v", entries)
+	}
+	for i, want := range [][]byte{site, unread} {
+		sum := sha256.Sum256(want)
+		if e := entries[i]; e.Actor != "unauthenticated:alice" || e.PayloadHash != hex.EncodeToString(sum[:]) {
+			t.Errorf("entry %d: %+v", i, e)
+		}
+	}
+	if e := entries[2]; e.PayloadHash != "" || e.Result != middleware.AuditFailure {
+		t.Errorf("oversized entry: %+v", e)
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// statusRollup is the state of a deployment rolled up from its components:
+// a host is as bad as its worst component, a site as its worst host and the
+// deployment as its worst site. Components no host has reported yet count
+// towards their site but belong to no host.
+type statusRollup struct {
+	State string
+	Error model.StatusError
+	Sites map[string]model.SiteOutcome
+}
+
+func rollupStatus(rows []*ent.DeploymentComponentStatus) statusRollup {
+	r := statusRollup{Sites: map[string]model.SiteOutcome{}}
+	siteStates := map[string][]string{}
+
+	// worst first, so the first error seen is the one to report
+	rows = append([]*ent.DeploymentComponentStatus(nil), rows...)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return stateRank[rows[i].State] < stateRank[rows[j].State]
+	})
+	for _, row := range rows {
+		so := r.Sites[row.SiteID]
+		if so.Hosts == nil {
+			so.Hosts = map[string]model.HostOutcome{}
+		}
+		if so.Error.Code == "" && row.ErrorCode != "" {
+			so.Error = model.StatusError{Code: row.ErrorCode, Message: row.ErrorMessage}
+		}
+		if r.Error.Code == "" && row.ErrorCode != "" {
+			r.Error = so.Error
+		}
+		if row.UpdatedAt.After(so.UpdatedAt) {
+			so.UpdatedAt = row.UpdatedAt
+		}
+		if row.HostID != "" {
+			ho := so.Hosts[row.HostID]
+			if ho.Components == nil {
+				ho.Components = map[string]string{}
+			}
+			ho.Components[row.Name] = row.State
+			ho.State = worstState(mapValues(ho.Components))
+			so.Hosts[row.HostID] = ho
+		}
+		siteStates[row.SiteID] = append(siteStates[row.SiteID], row.State)
+		r.Sites[row.SiteID] = so
+	}
+
+	var states []string
+	for id, so := range r.Sites {
+		so.State = worstState(siteStates[id])
+		r.Sites[id] = so
+		states = append(states, so.State)
+	}
+	r.State = worstState(states)
+	return r
+}
+
+// componentRows loads the component statuses of a deployment.
+func componentRows(ctx context.Context, client *ent.Client, id uuid.UUID) ([]*ent.DeploymentComponentStatus, error) {
+	return client.DeploymentComponentStatus.Query().
+		Where(deploymentcomponentstatus.HasDeploymentWith(deploymentstatus.IDEQ(id))).
+		Order(ent.Asc(deploymentcomponentstatus.FieldSiteID, deploymentcomponentstatus.FieldHostID, deploymentcomponentstatus.FieldName)).
+		All(ctx)
+}
+
+// applyComponentStatus stores what site/host reported for one component,
+// unless the component has reported something newer. A report the host did
+// not stamp is taken as made at receivedAt (unix ns). The first report from
+// a host replaces the placeholder the component had while no host had
+// reported it.
+func applyComponentStatus(ctx context.Context, tx *ent.Tx, id uuid.UUID, site string, c model.DeploymentComponent, receivedAt int64) error {
+	reportedAt := c.ReportedAt
+	if reportedAt == 0 {
+		reportedAt = receivedAt
+	}
+	row, err := tx.DeploymentComponentStatus.Query().
+		Where(
+			deploymentcomponentstatus.HasDeploymentWith(deploymentstatus.IDEQ(id)),
+			deploymentcomponentstatus.SiteID(site),
+			deploymentcomponentstatus.HostID(c.HostID),
+			deploymentcomponentstatus.Name(c.Name),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		if err := tx.DeploymentComponentStatus.Create().
+			SetDeploymentID(id).
+			SetSiteID(site).
+			SetHostID(c.HostID).
+			SetName(c.Name).
+			SetState(c.State).
+			SetErrorCode(c.Error.Code).
+			SetErrorMessage(c.Error.Message).
+			SetReportedAt(reportedAt).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if c.HostID == "" {
+			return nil
+		}
+		_, err = tx.DeploymentComponentStatus.Delete().
+			Where(
+				deploymentcomponentstatus.HasDeploymentWith(deploymentstatus.IDEQ(id)),
+				deploymentcomponentstatus.SiteIDIn(site, ""),
+				deploymentcomponentstatus.HostID(""),
+				deploymentcomponentstatus.Name(c.Name),
+			).
+			Exec(ctx)
+		return err
+	case err != nil:
+		return err
+	case reportedAt < row.ReportedAt:
+		// late; the component has moved on
+		return nil
+	}
+	return row.Update().
+		SetState(c.State).
+		SetErrorCode(c.Error.Code).
+		SetErrorMessage(c.Error.Message).
+		SetReportedAt(reportedAt).
+		Exec(ctx)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestComponentStatus(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	newDeployment := func() uuid.UUID {
+		id := uuid.New()
+		if err := SaveDeploymentStatus(ctx, client, &model.DeploymentStatus{
+			DeploymentID: id.String(),
+			SiteID:       "site-a",
+			Status:       model.DeploymentState{State: string(model.StatePending)},
+			Components: []model.DeploymentComponent{
+				{Name: "api", State: string(model.StatePending)},
+				{Name: "db", State: string(model.StatePending)},
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	dep, other := newDeployment(), newDeployment()
+
+	report := func(host string, at int64, states ...string) {
+		t.Helper()
+		ds := &model.DeploymentStatus{DeploymentID: dep.String(), SiteID: "site-a"}
+		for i := 0; i < len(states); i += 2 {
+			c := model.DeploymentComponent{Name: states[i], State: states[i+1], HostID: host, ReportedAt: at}
+			if c.State == string(model.StateFailed) {
+				c.Error = model.StatusError{Code: "INSTALL_FAILED", Message: "boom"}
+			}
+			ds.Components = append(ds.Components, c)
+		}
+		if err := UpdateDeploymentStatus(ctx, client, ds); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state := func(id uuid.UUID) (statusRollup, string) {
+		t.Helper()
+		rows, err := componentRows(ctx, client, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := client.DeploymentStatus.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rollupStatus(rows), d.State
+	}
+
+	report("h1", 10, "api", "installed", "db", "installed")
+	r, got := state(dep)
+	if got != "installed" || len(r.Sites["site-a"].Hosts) != 1 {
+		t.Fatalf("after h1: %s %+v", got, r)
+	}
+
+	report("h2", 20, "api", "failed")
+	r, got = state(dep)
+	site := r.Sites["site-a"]
+	if got != "failed" || site.State != "failed" || site.Hosts["h1"].State != "installed" ||
+		site.Hosts["h2"].Components["api"] != "failed" || r.Error.Code != "INSTALL_FAILED" {
+		t.Fatalf("after h2 failed: %s %+v", got, r)
+	}
+
+	// observed before the failure, received after it
+	report("h2", 15, "api", "installing")
+	if r, got = state(dep); r.Sites["site-a"].Hosts["h2"].Components["api"] != "failed" || got != "failed" {
+		t.Errorf("late report applied: %s %+v", got, r)
+	}
+
+	report("h2", 30, "api", "installed")
+	if r, got = state(dep); got != "installed" || r.Error.Code != "" {
+		t.Errorf("after h2 recovered: %s %+v", got, r)
+	}
+
+	// an unstamped report is taken as made when it arrived
+	report("h2", 0, "api", "failed")
+	if _, got = state(dep); got != "failed" {
+		t.Errorf("unstamped report: %s", got)
+	}
+
+	// another deployment with the same component names is untouched
+	if r, got = state(other); got != "pending" || len(r.Sites["site-a"].Hosts) != 0 {
+		t.Errorf("other deployment: %s %+v", got, r)
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/google/go-github/v55/github"
 	"golang.org/x/oauth2"
 	"net/http"
-	//"strings"
+	"strings"
 
 	//"github.com/joho/godotenv"
 	//"path/filepath"
@@ -21,12 +21,19 @@ import (
 	//pb "github.com/balaji-balu/margo-hello-world/proto_generated"
 	"github.com/balaji-balu/margo-hello-world/ent"
 	"github.com/balaji-balu/margo-hello-world/ent/component"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentprofile"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
 	"github.com/balaji-balu/margo-hello-world/ent/applicationdesc"
 	//"github.com/balaji-balu/margo-hello-world/internal/config"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
 	"github.com/balaji-balu/margo-hello-world/internal/co"
 	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
 	"github.com/balaji-balu/margo-hello-world/internal/metrics"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
 	"github.com/balaji-balu/margo-hello-world/pkg/application"
 	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
 	"github.com/balaji-balu/margo-hello-world/pkg/model"
@@ -56,6 +63,13 @@ type App struct {
 	//ProfileID string        `json:"profile_id"`
 	Sites     []HostMapping `json:"sites"`
 	DeployType string 		`json:"deploy_type"`
+	// Parameters are passed through to the deployment; targets use JSON
+	// pointers such as /env/LOG_LEVEL or /files/~1etc~1app~1mode.
+	Parameters []deployment.Parameter `json:"parameters,omitempty"`
+	// Author is recorded in the deployment's revision history when auth
+	// is disabled; the X-Author header is used when it is empty. With auth
+	// on, the caller is.
+	Author string `json:"author,omitempty"`
 }
 
 func init() {
@@ -78,7 +92,7 @@ func init() {
 // }
 
 
-func buildDeployParameters(siteID string) []deployment.Parameter {
+func buildDeployParameters(siteID string, extra []deployment.Parameter) []deployment.Parameter {
 	var params []deployment.Parameter
 
 	//for _, site := range sites {
@@ -95,7 +109,7 @@ func buildDeployParameters(siteID string) []deployment.Parameter {
 		params = append(params, param)
 	//}
 
-	return params
+	return append(params, extra...)
 }
 
 func buildDeploymentComponents(ents []*ent.Component) []deployment.Component {
@@ -110,6 +124,11 @@ func buildDeploymentComponents(ents []*ent.Component) []deployment.Component {
 				Timeout:         c.Properties.Timeout,
 				PackageLocation: c.Properties.PackageLocation,
 				KeyLocation:     c.Properties.KeyLocation,
+				Env:             c.Properties.Env,
+				Mounts:          c.Properties.Mounts,
+				LivenessProbe:   c.Properties.LivenessProbe,
+				ReadinessProbe:  c.Properties.ReadinessProbe,
+				DependsOn:       c.Properties.DependsOn,
 			},
 		})
 	}
@@ -120,16 +139,56 @@ func buildDeploymentProfile(
 	profile *ent.DeploymentProfile,
 	components []*ent.Component) deployment.DeploymentProfile {
 	return deployment.DeploymentProfile{
-		Type:       profile.Type,
-		Components: buildDeploymentComponents(components),
+		Type:              profile.Type,
+		Components:        buildDeploymentComponents(components),
+		RequiredResources: buildRequiredResources(profile),
 	}
 }
 
+// buildRequiredResources restores the profile's resource requirements from the
+// flattened columns Persist writes, so the ERA can turn them into limits.
+func buildRequiredResources(profile *ent.DeploymentProfile) *application.Resources {
+	if profile.CPUCores == 0 && profile.Memory == "" && profile.Storage == "" &&
+		len(profile.Peripherals) == 0 && len(profile.Interfaces) == 0 {
+		return nil
+	}
+
+	res := &application.Resources{
+		CPU: application.CPUInfo{
+			Cores:         profile.CPUCores,
+			Architectures: profile.CPUArchitectures,
+		},
+		Memory:  profile.Memory,
+		Storage: profile.Storage,
+	}
+	for _, p := range profile.Peripherals {
+		res.Peripherals = append(res.Peripherals, application.Peripheral{
+			Type:         mapString(p, "type"),
+			Manufacturer: mapString(p, "manufacturer"),
+			Model:        mapString(p, "model"),
+		})
+	}
+	for _, i := range profile.Interfaces {
+		res.Interfaces = append(res.Interfaces, application.Interface{
+			Type: mapString(i, "type"),
+		})
+	}
+	return res
+}
+
+func mapString(m map[string]interface{}, key string) string {
+	if v, ok := m[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
 func buildApplicationDeployment(
 	appDesc *ent.ApplicationDesc,
 	profile *ent.DeploymentProfile,
 	components []*ent.Component,
 	siteID string,
+	params []deployment.Parameter,
 ) deployment.ApplicationDeployment {
 	// id := strings.ReplaceAll(appDesc.Name, " ", "-")
 	// id = strings.ReplaceAll(id, "'", "")
@@ -153,7 +212,7 @@ func buildApplicationDeployment(
 		},
 		Spec: deployment.Spec{
 			DeploymentProfile: buildDeploymentProfile(profile, components),
-			Parameters:        buildDeployParameters(siteID),
+			Parameters:        buildDeployParameters(siteID, params),
 		},
 	}
 }
@@ -199,27 +258,12 @@ func PushDeploymentYAML(ctx context.Context,
 	return nil
 }
 
-func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string) {
-	//log.Println("CreateDeployment called. Site:", cfg.Server.Site)
- 
-	//c.StartDeplotment
-
-	start := time.Now()
-
-	log.Println("Repo URL:", repo)
-
-	var app App
-	if err := c.ShouldBindJSON(&app); err != nil {
-		log.Printf("❌ Error binding JSON: %v", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	log.Printf("🌀 Incoming app: %+v\n", app)
-	log.Println("Targeted Sites:", app.Sites)
-	ctx := context.Background()
-
-	// --- 1️⃣ Check if the app exists by ID or name ---
+// findApp looks up the app a request names, by ID or by
+// category/name/version, with the profile of its deploy type and that
+// profile's components. On failure it also returns the HTTP status to
+// answer with.
+func findApp(ctx context.Context, client *ent.Client, app App) (
+	*ent.ApplicationDesc, *ent.DeploymentProfile, []*ent.Component, int, error) {
 	q := client.ApplicationDesc.Query()
 
 	// Build a conditional filter
@@ -227,9 +271,8 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 		log.Println("searching id....", app.AppID)
 		uid, err := uuid.Parse(app.AppID)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid uuid"})
-			return
-		}		
+			return nil, nil, nil, http.StatusBadRequest, fmt.Errorf("invalid uuid")
+		}
 		q = q.Where(applicationdesc.IDEQ(uid))
 	} else if app.AppName != "" {
 		log.Println("searching name....", app.AppName)
@@ -239,31 +282,26 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 			applicationdesc.VersionEQ(app.Version),
 		)
 	} else {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "app_id or app name must be provided"})
-		return
+		return nil, nil, nil, http.StatusBadRequest, fmt.Errorf("app_id or app name must be provided")
 	}
 
 	existingApps, err := q.All(ctx)
 	if err != nil {
 		log.Printf("❌ DB query failed: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+		return nil, nil, nil, http.StatusInternalServerError, err
 	}
 
-	// --- 2️⃣ Validate uniqueness ---
+	// --- Validate uniqueness ---
 	if len(existingApps) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "no matching app found in registry"})
-		return
+		return nil, nil, nil, http.StatusNotFound, fmt.Errorf("no matching app found in registry")
 	}
 	if len(existingApps) > 1 {
-		c.JSON(http.StatusConflict, gin.H{"error": "multiple apps matched — ambiguous identifier"})
-		return
+		return nil, nil, nil, http.StatusConflict, fmt.Errorf("multiple apps matched — ambiguous identifier")
 	}
 
 	appDesc := existingApps[0]
 	log.Printf("✅ Found app match: %s (id=%s)\n", appDesc.Name, appDesc.ID)
 
-
 	profile, err := client.DeploymentProfile.
 		Query().
 		Where(
@@ -272,8 +310,7 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 		).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "deployment profile not found"})
-		return
+		return nil, nil, nil, http.StatusNotFound, fmt.Errorf("deployment profile not found")
 	}
 	log.Println("🔹 Processing deployment profile ID:", profile.ID)
 
@@ -282,31 +319,71 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 		Where(component.DeploymentProfileIDEQ(profile.ID)).
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "components  not found"})
-		return
+		return nil, nil, nil, http.StatusNotFound, fmt.Errorf("components  not found")
 	}
 	log.Println("components:", components)
+	return appDesc, profile, components, 0, nil
+}
+
+func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string) {
+	//log.Println("CreateDeployment called. Site:", cfg.Server.Site)
+ 
+	//c.StartDeplotment
+
+	start := time.Now()
+
+	log.Println("Repo URL:", repo)
+
+	var app App
+	if err := c.ShouldBindJSON(&app); err != nil {
+		log.Printf("❌ Error binding JSON: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Printf("🌀 Incoming app: %+v\n", app)
+	log.Println("Targeted Sites:", app.Sites)
+	ctx := c.Request.Context()
+	t, _ := tenant.FromContext(ctx)
+
+	// the caller must be allowed to deploy to every site asked for
+	for _, site := range app.Sites {
+		where, err := authSite(ctx, client, site.SiteID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !middleware.Authorize(c, auth.Deployer, where) {
+			return
+		}
+	}
+
+	appDesc, profile, components, status, err := findApp(ctx, client, app)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	if err := checkQuota(ctx, client, quotaDeployments, len(app.Sites)); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
 
 	//TBD: create deployment for all the selected targets
 	// 
 	var deployments []string
 	for _, site := range app.Sites {
 
-		appdply := buildApplicationDeployment(appDesc, profile, components, site.SiteID)
+		appdply := buildApplicationDeployment(appDesc, profile, components, site.SiteID, app.Parameters)
 		deploymentID := appdply.Metadata.Annotations.ID
 		log.Println("deploymentID:", deploymentID)
 
 		// 1. Marshal to YAML
 		yamlBytes, err := yaml.Marshal(appdply)
 		if err != nil {
-			fmt.Errorf("failed to marshal YAML: %w", err)
+			log.Printf("failed to marshal YAML: %v", err)
 			continue
 			//return fmt.Errorf("failed to marshal YAML: %w", err)
 		}
-		err = co.CreateDeployment(site.SiteID, deploymentID, yamlBytes)
-		if err != nil {
-			fmt.Errorf("Failed to create deployments repo with err:", err)
-		}
 		// token := os.Getenv("GITHUB_TOKEN")
 
 		// owner := cfg.Git.Owner //"edge-orchestration-platform"
@@ -334,6 +411,7 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 			//APIVersion:   "deployment.margo/v1",
 			//Kind:         "DeploymentStatus",
 			DeploymentID: deploymentID,
+			SiteID:       site.SiteID,
 			Status: model.DeploymentState{
 				State: string(model.StatePending),
 				Error: model.StatusError{},
@@ -353,7 +431,24 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 		status.Components = cs
 		
 
-		SaveDeploymentStatus(ctx, client, status)
+		// the status and the revision are counted against the quota with
+		// the organization locked, and removed if the commit cannot be pushed
+		if err := createDeployment(ctx, co, client, t.Name, status, revisionInput{
+			DeploymentID: uuid.MustParse(deploymentID),
+			Deployment:   appdply,
+			Spec:         yamlBytes,
+			SiteID:       site.SiteID,
+			Author:       author(c, app.Author),
+		}); err != nil {
+			log.Printf("failed to create deployment %s: %v", deploymentID, err)
+			// the sites done so far stay deployed
+			middleware.AuditTarget(c, strings.Join(deployments, ","))
+			c.JSON(errorStatus(err), gin.H{
+				"error":          fmt.Sprintf("site %s: %v", site.SiteID, err),
+				"deployment_ids": deployments,
+			})
+			return
+		}
 
 		deployments = append(deployments, deploymentID)
 		log.Println("before calling metrics:", site.SiteID)
@@ -362,7 +457,8 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 		log.Printf("✅ Successfully pushed deployment YAML for profile %s", profile.ID)
 	}
 
-	log.Printf("deployments done:", deployments)
+	log.Println("deployments done:", deployments)
+	middleware.AuditTarget(c, strings.Join(deployments, ","))
 
 	c.JSON(http.StatusOK, gin.H{
 		"deployment_ids": deployments,
@@ -394,38 +490,77 @@ func CreateDeployment(c *gin.Context,co *co.CO,  client *ent.Client, repo string
 // 	// c.JSON(http.StatusOK, deployment)
 // }
 
-func HandleStreamDeployment(c *gin.Context, sm *streammanager.StreamManager) {
+// HandleStreamDeployment streams the state changes of the deployment :id
+// from the event log until it is installed or failed. Each has the id of
+// its event, to resume after with Last-Event-ID as on /events. A client
+// that does not resume is sent the deployment's current state first, with
+// no id, and nothing more if that is already installed or failed.
+func HandleStreamDeployment(c *gin.Context, client *ent.Client, sm *streammanager.StreamManager) {
 	id := c.Param("id")
-
 	log.Println("HandleStreamDeployment called with id", id)
-	c.Header("Content-Type", "text/event-stream")
-	c.Header("Cache-Control", "no-cache")
-	c.Header("Connection", "keep-alive")
 
-	flusher, ok := c.Writer.(http.Flusher)
-	if !ok {
-		http.Error(c.Writer, "Streaming unsupported!", http.StatusInternalServerError)
+	after, resume, err := lastEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-
-	ch := sm.Register(id)
-	defer sm.Unregister(id, ch)
-
-	c.Status(http.StatusOK)
-	flusher.Flush()
-
-	for ev := range ch {
-		data, _ := json.Marshal(ev)
-		fmt.Fprintf(c.Writer, "data:%s\n\n", data)
-		flusher.Flush()
-
-		if ev.Status == "completed" || ev.Status == "failed" {
-			break
+	var start func() bool
+	if !resume {
+		depID, err := uuid.Parse(id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deployment not found"})
+			return
+		}
+		if _, err := client.DeploymentStatus.Get(c, depID); err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "deployment not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		start = func() bool {
+			// read again now that changes are subscribed to, so that none
+			// falls between this state and the stream
+			d, err := client.DeploymentStatus.Get(c, depID)
+			if err != nil {
+				log.Printf("deployment stream %s: %v", id, err)
+				return true
+			}
+			var site string
+			if rev, err := latestRevision(c, client, depID); err == nil {
+				site = rev.SiteID
+			}
+			data, _ := json.Marshal(streammanager.DeployEvent{
+				DeploymentId: id,
+				Timestamp:    d.UpdatedAt.Format(time.RFC3339),
+				SiteID:       site,
+				Message:      d.ErrorMessage,
+				Status:       d.State,
+			})
+			fmt.Fprintf(c.Writer, "data:%s\n\n", data)
+			return deploymentDone(d.State)
 		}
 	}
+	f := streammanager.Filter{DeploymentID: id, Types: []string{webhook.EventDeploymentState}}
+	streamEvents(c, client, sm, f, after, resume, start, func(ev streammanager.Event) bool {
+		var st webhook.DeploymentState
+		json.Unmarshal(ev.Data, &st)
+		data, _ := json.Marshal(streammanager.DeployEvent{
+			DeploymentId: id,
+			Timestamp:    ev.Time.Format(time.RFC3339),
+			SiteID:       st.SiteID,
+			Message:      st.ErrorMessage,
+			Status:       st.To,
+		})
+		fmt.Fprintf(c.Writer, "id: %d\ndata:%s\n\n", ev.ID, data)
+		return deploymentDone(st.To)
+	})
+}
 
-	// When done, ensure connection closes cleanly
-	//c.Writer.Flush()
+// deploymentDone tells whether a deployment in state changes no more by itself.
+func deploymentDone(state string) bool {
+	return state == string(model.StateInstalled) || state == string(model.StateFailed)
 }
 
 func GenerateDeploymentID() string {
@@ -433,21 +568,29 @@ func GenerateDeploymentID() string {
 }
 
 
+// SaveDeploymentStatus records a new deployment as ds has it, within the
+// quota of the organization it is for.
 func SaveDeploymentStatus(ctx context.Context, client *ent.Client, ds *model.DeploymentStatus) error {
-	id, err := uuid.Parse(ds.DeploymentID)
+	tx, err := client.Tx(ctx)
 	if err != nil {
 		return err
 	}
+	if err := saveDeploymentStatus(ctx, tx, ds); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
 
-	tx, err := client.Tx(ctx)
+// saveDeploymentStatus is SaveDeploymentStatus within tx.
+func saveDeploymentStatus(ctx context.Context, tx *ent.Tx, ds *model.DeploymentStatus) error {
+	id, err := uuid.Parse(ds.DeploymentID)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		}
-	}()
+	if err := lockQuota(ctx, tx, quotaDeployments, 1); err != nil {
+		return err
+	}
 
 	deploy, err := tx.DeploymentStatus.
 		Create().
@@ -459,23 +602,89 @@ func SaveDeploymentStatus(ctx context.Context, client *ent.Client, ds *model.Dep
 		SetErrorMessage(ds.Status.Error.Message).
 		Save(ctx)
 	if err != nil {
-		return tx.Rollback()
+		return err
 	}
 
 	for _, c := range ds.Components {
 		_, err = tx.DeploymentComponentStatus.
 			Create().
 			SetName(c.Name).
+			SetSiteID(ds.SiteID).
+			SetHostID(c.HostID).
 			SetState(c.State).
 			SetErrorCode(c.Error.Code).
 			SetErrorMessage(c.Error.Message).
 			SetDeployment(deploy).
 			Save(ctx)
 		if err != nil {
-			return tx.Rollback()
+			return err
+		}
+	}
+	return nil
+}
+
+// createDeployment records a new deployment's status and first revision,
+// then commits in.Spec to org's deployments. The rows are committed first,
+// as a pushed commit cannot be taken back should the transaction then fail;
+// if the push fails they are removed again. The revision gets its commit
+// sha once pushed.
+func createDeployment(ctx context.Context, coo *co.CO, client *ent.Client, org string, ds *model.DeploymentStatus, in revisionInput) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	var rev *ent.DeploymentRevision
+	err = func() error {
+		if err := saveDeploymentStatus(ctx, tx, ds); err != nil {
+			return err
 		}
+		rev, err = createRevision(ctx, tx.Client(), in)
+		return err
+	}()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
 	}
 
+	sha, err := coo.CreateDeployment(org, in.SiteID, in.DeploymentID.String(), in.Spec, in.Author)
+	if err != nil {
+		if rerr := removeDeployment(ctx, client, in.DeploymentID); rerr != nil {
+			log.Printf("failed to remove deployment %s after its push failed: %v", in.DeploymentID, rerr)
+		}
+		return fmt.Errorf("deployments repo: %w", err)
+	}
+	if err := client.DeploymentRevision.UpdateOneID(rev.ID).SetCommitSha(sha).Exec(ctx); err != nil {
+		// the deployment stands; only its revision misses the sha
+		log.Printf("failed to record commit %s of deployment %s: %v", sha, in.DeploymentID, err)
+	}
+	return nil
+}
+
+// removeDeployment deletes a deployment's status, components and revisions.
+func removeDeployment(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.DeploymentComponentStatus.Delete().
+		Where(deploymentcomponentstatus.HasDeploymentWith(deploymentstatus.IDEQ(id))).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.DeploymentRevision.Delete().
+		Where(deploymentrevision.HasDeploymentWith(deploymentstatus.IDEQ(id))).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.DeploymentStatus.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
 	return tx.Commit()
 }
 
@@ -11,17 +11,17 @@ import (
 
 	"github.com/balaji-balu/margo-hello-world/ent"
 	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
-	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
-	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
 	"github.com/balaji-balu/margo-hello-world/internal/metrics"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
 	"github.com/balaji-balu/margo-hello-world/pkg/model"
 	
 )
 
-/*
-TBD: add to deployment history esp installed, failed 
-*/
-func DeploymentStatusHandler(c *gin.Context, client *ent.Client, sm *streammanager.StreamManager) {
+// DeploymentStatusHandler stores a status report from an LO and adds it to
+// the outcome of the deployment's latest revision.
+func DeploymentStatusHandler(c *gin.Context, client *ent.Client) {
 
 	ctx := c.Request.Context()
 	
@@ -35,70 +35,122 @@ func DeploymentStatusHandler(c *gin.Context, client *ent.Client, sm *streammanag
 
 	log.Println("Received deployment status:", ds)
 
-	log.Println("Deployment id:", ds.DeploymentID, sm)
+	log.Println("Deployment id:", ds.DeploymentID)
 	
-	UpdateDeploymentStatus(ctx, client, &ds)
+	if err := UpdateDeploymentStatus(ctx, client, &ds); err != nil {
+		log.Println("failed to update deployment status:", err)
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deployment not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
 	if ds.Status.State == "failed" || ds.Status.State == "installed" {
 		metrics.DeploymentsActive.WithLabelValues(ds.DeploymentID).Dec()
 	} 
-
-	// brodcast the status to streaming(SSR) clients
-	sm.Broadcast(ds.DeploymentID, streammanager.DeployEvent{
-		DeploymentId: ds.DeploymentID,
-		Timestamp:    time.Now().Format(time.RFC3339),
-		SiteID:       "",
-		Message:      "", //dr.Message,
-		Status:       string(ds.Status.State),
-	})
-
 }
 
-func UpdateDeploymentStatus(ctx context.Context, 
+// UpdateDeploymentStatus applies a status report to the deployment's
+// components, keyed by site, host and name, rolls them up into the state of
+// the deployment and records that on its latest revision. A component's
+// reports are ordered by the ReportedAt its host stamped them with, as the
+// host publishes them from several goroutines and the LO forwards them in
+// any order; ds.TimeStamp mixes the LO's and the ERA's clocks, so it cannot
+// order them. Components ignore reports older than the last one they took.
+func UpdateDeploymentStatus(ctx context.Context,
 		client *ent.Client, ds *model.DeploymentStatus) error {
 	id, err := uuid.Parse(ds.DeploymentID)
 	if err != nil {
 		return err
 	}
+	// for hosts that do not stamp their reports
+	receivedAt := time.Now().UnixNano()
+	site := ds.SiteID
+	if site == "" {
+		// one deployment is for one site
+		if rev, err := latestRevision(ctx, client, id); err == nil {
+			site = rev.SiteID
+		}
+	}
 
-	tx, err := client.Tx(ctx)
+	// two reports creating the same component row race on its unique
+	// index; the loser retries and updates the winner's row
+	var prev, next *ent.DeploymentStatus
+	for attempt := 0; ; attempt++ {
+		prev, next, err = applyDeploymentStatus(ctx, client, id, site, ds, receivedAt)
+		if !ent.IsConstraintError(err) || attempt == 1 {
+			break
+		}
+	}
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Update main deployment state
-	_, err = tx.DeploymentStatus.
-		Update().
-		Where(deploymentstatus.IDEQ(id)).
-		SetState(ds.Status.State).
-		SetErrorCode(ds.Status.Error.Code).
-		SetErrorMessage(ds.Status.Error.Message).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
-	if err != nil {
-		return tx.Rollback()
+	if prev.State != next.State {
+		publishDeploymentState(ctx, client, prev, next, site)
 	}
+	return recordOutcome(ctx, client, id)
+}
+
+// publishDeploymentState logs a deployment's state changing, for its
+// stream and webhooks.
+func publishDeploymentState(ctx context.Context, client *ent.Client, prev, next *ent.DeploymentStatus, site string) {
+	publish(ctx, client, webhook.Event{
+		Type:  webhook.EventDeploymentState,
+		OrgID: next.OrgID,
+		Data: webhook.DeploymentState{
+			DeploymentID: next.ID.String(),
+			SiteID:       site,
+			From:         prev.State,
+			To:           next.State,
+			ErrorCode:    next.ErrorCode,
+			ErrorMessage: next.ErrorMessage,
+		},
+	}, next.ID.String(), site)
+}
 
-	// Update each component state
+// applyDeploymentStatus returns the deployment as it was before the report
+// and after.
+func applyDeploymentStatus(ctx context.Context, client *ent.Client,
+		id uuid.UUID, site string, ds *model.DeploymentStatus, receivedAt int64) (prev, next *ent.DeploymentStatus, err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prev, err = tx.DeploymentStatus.Get(ctx, id); err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
 	for _, c := range ds.Components {
-		_, err = tx.DeploymentComponentStatus.
-			Update().
-			Where(deploymentcomponentstatus.NameEQ(c.Name)).
-			SetState(c.State).
-			SetErrorCode(c.Error.Code).
-			SetErrorMessage(c.Error.Message).
-			Save(ctx)
-		if err != nil {
-			return tx.Rollback()
+		if err := applyComponentStatus(ctx, tx, id, site, c, receivedAt); err != nil {
+			tx.Rollback()
+			return nil, nil, err
 		}
 	}
+	rows, err := componentRows(ctx, tx.Client(), id)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
 
-	return tx.Commit()
+	update := tx.DeploymentStatus.UpdateOneID(id).SetUpdatedAt(time.Now())
+	if len(rows) == 0 {
+		// nothing to roll up; take the report as it is
+		update.SetState(ds.Status.State).
+			SetErrorCode(ds.Status.Error.Code).
+			SetErrorMessage(ds.Status.Error.Message)
+	} else {
+		r := rollupStatus(rows)
+		update.SetState(r.State).
+			SetErrorCode(r.Error.Code).
+			SetErrorMessage(r.Error.Message)
+	}
+	if next, err = update.Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	return prev, next, tx.Commit()
 }
 
 // GetDeploymentsStatus returns all deployment statuses with components
@@ -169,28 +221,20 @@ func ListDeploymentsStatus(c *gin.Context, client *ent.Client) {
         })
         return
     }
+    sites, err := deploymentSites(ctx, client)
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+        return
+    }
 
     result := make([]gin.H, 0, len(deployments))
 
     for _, d := range deployments {
-        components := make([]gin.H, 0, len(d.Edges.Components))
-        for _, comp := range d.Edges.Components {
-            components = append(components, gin.H{
-                "name":         comp.Name,
-                "state":        comp.State,
-                "errorCode":    comp.ErrorCode,
-                "errorMessage": comp.ErrorMessage,
-            })
+        // only the deployments at sites the caller may view
+        if !middleware.Can(c, auth.Viewer, sites[d.ID]) {
+            continue
         }
-
-        result = append(result, gin.H{
-            "id":           d.ID,
-            "deploymentID": d.ID.String(),
-            "state":        d.State,
-            "errorCode":    d.ErrorCode,
-            "errorMessage": d.ErrorMessage,
-            "components":   components,
-        })
+        result = append(result, deploymentJSON(d))
     }
 
     c.JSON(http.StatusOK, gin.H{"deployments": result})
@@ -216,22 +260,31 @@ func GetDeploymentStatus(c *gin.Context, client *ent.Client) {
         return
     }
 
-    components := make([]gin.H, 0, len(deployment.Edges.Components))
-    for _, comp := range deployment.Edges.Components {
+    c.JSON(http.StatusOK, deploymentJSON(deployment))
+}
+
+// deploymentJSON is a deployment's status with its components, which must
+// be loaded, per site and host, and their rollup per site.
+func deploymentJSON(d *ent.DeploymentStatus) gin.H {
+    components := make([]gin.H, 0, len(d.Edges.Components))
+    for _, comp := range d.Edges.Components {
         components = append(components, gin.H{
             "name":         comp.Name,
+            "siteId":       comp.SiteID,
+            "hostId":       comp.HostID,
             "state":        comp.State,
             "errorCode":    comp.ErrorCode,
             "errorMessage": comp.ErrorMessage,
+            "updatedAt":    comp.UpdatedAt,
         })
     }
-
-    c.JSON(http.StatusOK, gin.H{
-        "id":           deployment.ID,
-        "deploymentID": deployment.ID.String(),
-        "state":        deployment.State,
-        "errorCode":    deployment.ErrorCode,
-        "errorMessage": deployment.ErrorMessage,
+    return gin.H{
+        "id":           d.ID,
+        "deploymentID": d.ID.String(),
+        "state":        d.State,
+        "errorCode":    d.ErrorCode,
+        "errorMessage": d.ErrorMessage,
         "components":   components,
-    })
+        "sites":        rollupStatus(d.Edges.Components).Sites,
+    }
 }
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+)
+
+const (
+	// eventKeepAlive is how often an idle event stream gets a comment, so
+	// that proxies do not close it.
+	eventKeepAlive = 15 * time.Second
+	replayBatch    = 500
+)
+
+// publish records ev in the event log, for the event streams, and has it
+// delivered to the webhooks subscribed to it. deploymentID and siteID are
+// what the event is about, for filtering.
+func publish(ctx context.Context, client *ent.Client, ev webhook.Event, deploymentID, siteID string) {
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		log.Printf("events: %s: %v", ev.Type, err)
+		return
+	}
+	logged, err := streammanager.Publish(ctx, client, streammanager.Event{
+		Type:         ev.Type,
+		DeploymentID: deploymentID,
+		SiteID:       siteID,
+		OrgID:        ev.OrgID,
+		Data:         data,
+	})
+	if err != nil {
+		log.Printf("events: %s: %v", ev.Type, err)
+	} else {
+		// webhooks get the event as the log has it
+		ev.ID, ev.Time = strconv.Itoa(logged.ID), logged.Time
+	}
+	if err := webhook.Publish(ctx, client, ev); err != nil {
+		log.Printf("webhooks: %s: %v", ev.Type, err)
+	}
+}
+
+// StreamEvents streams the event log of the whole fleet as server-sent
+// events, each with the event's type, its id and the event as JSON. Filter
+// with ?type= (repeated or comma-separated; "host.*" is every host event),
+// ?deployment= and ?site=; only events of sites the caller can view are
+// sent. A client resumes after the last event it got with the
+// Last-Event-ID header or ?last_event_id= (0 is the whole log); otherwise
+// it gets the events from now on. A client that falls behind is sent a
+// "dropped" event with how many it missed and the id to resume after, and
+// the stream ends; so is one resuming after events since pruned from the
+// log, with "pruned" in place of how many.
+func StreamEvents(c *gin.Context, client *ent.Client, sm *streammanager.StreamManager) {
+	after, resume, err := lastEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	f := streammanager.Filter{
+		DeploymentID: c.Query("deployment"),
+		SiteID:       c.Query("site"),
+	}
+	for _, t := range c.QueryArray("type") {
+		for _, t := range strings.Split(t, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				f.Types = append(f.Types, t)
+			}
+		}
+	}
+
+	all := middleware.Can(c, auth.Viewer, auth.Site{})
+	sites := map[string]auth.Site{}
+	visible := func(ev streammanager.Event) bool {
+		if all {
+			return true
+		}
+		if ev.SiteID == "" {
+			return false
+		}
+		s, ok := sites[ev.SiteID]
+		if !ok {
+			var err error
+			if s, err = authSite(c, client, ev.SiteID); err != nil {
+				return false
+			}
+			sites[ev.SiteID] = s
+		}
+		return middleware.Can(c, auth.Viewer, s)
+	}
+
+	streamEvents(c, client, sm, f, after, resume, nil, func(ev streammanager.Event) bool {
+		if visible(ev) {
+			data, _ := json.Marshal(ev)
+			fmt.Fprintf(c.Writer, "event: %s\nid: %d\ndata: %s\n\n", ev.Type, ev.ID, data)
+		}
+		return false
+	})
+}
+
+// lastEventID is the event a client resumes after, if it resumes.
+func lastEventID(c *gin.Context) (id int, resume bool, err error) {
+	s := c.GetHeader("Last-Event-ID")
+	if s == "" {
+		s = c.Query("last_event_id")
+	}
+	if s == "" {
+		return 0, false, nil
+	}
+	if id, err = strconv.Atoi(s); err != nil || id < 0 {
+		return 0, false, fmt.Errorf("invalid last event id %q", s)
+	}
+	return id, true, nil
+}
+
+// streamEvents streams the events that pass f, after the event after if
+// resuming, then as they are published, until the client goes or send says
+// it is done. send writes an event; the stream is flushed after it. start,
+// if not nil, writes what comes before the events, once no event published
+// after it can be missed; it too may say the stream is done.
+func streamEvents(c *gin.Context, client *ent.Client, sm *streammanager.StreamManager,
+	f streammanager.Filter, after int, resume bool, start func() bool, send func(streammanager.Event) bool) {
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "streaming unsupported"})
+		return
+	}
+	if t, ok := tenant.FromContext(c); ok {
+		f.OrgID = &t.ID
+	}
+
+	// subscribe before replaying, so that no event falls between the two;
+	// the ones in both are sent once
+	sub := sm.Subscribe(f)
+	defer func() { sm.Unsubscribe(sub) }()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	done := start != nil && start()
+	flusher.Flush()
+	if done {
+		return
+	}
+
+	if resume && after > 0 {
+		// the log no longer has all the events after it
+		oldest, err := streammanager.Oldest(c, client)
+		if err != nil {
+			log.Printf("event stream: %v", err)
+			return
+		}
+		if oldest > after+1 {
+			fmt.Fprintf(c.Writer, "event: dropped\ndata: {\"pruned\":true,\"last_event_id\":%d}\n\n", oldest-1)
+			flusher.Flush()
+			return
+		}
+	}
+
+	last := -1
+	if resume {
+		last = after
+	}
+	emit := func(ev streammanager.Event) bool {
+		if ev.ID <= last {
+			return false
+		}
+		last = ev.ID
+		done := send(ev)
+		flusher.Flush()
+		return done
+	}
+
+	for resume {
+		evs, err := streammanager.Replay(c, client, f, last, replayBatch)
+		if err != nil {
+			log.Printf("event stream: %v", err)
+			return
+		}
+		for _, ev := range evs {
+			if emit(ev) {
+				return
+			}
+		}
+		if len(evs) < replayBatch {
+			break
+		}
+		// what the subscription buffered meanwhile is in the next batch;
+		// a fresh one sheds it, so that a long replay does not overflow
+		// the buffer and end the stream for events it sends anyway
+		sm.Unsubscribe(sub)
+		sub = sm.Subscribe(f)
+	}
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case ev := <-sub.Events():
+			if emit(ev) {
+				return
+			}
+		case <-sub.Lagged():
+			// the events still buffered came before the first dropped
+			for len(sub.Events()) > 0 {
+				if emit(<-sub.Events()) {
+					return
+				}
+			}
+			fmt.Fprintf(c.Writer, "event: dropped\ndata: {\"dropped\":%d,\"last_event_id\":%d}\n\n", sub.Dropped(), last)
+			flusher.Flush()
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// sseFrame is one server-sent event.
+type sseFrame struct {
+	event, id, data string
+}
+
+// readFrame reads the next event of an SSE stream, skipping comments.
+func readFrame(r *bufio.Reader) (sseFrame, error) {
+	var f sseFrame
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return f, err
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "":
+			if f != (sseFrame{}) {
+				return f, nil
+			}
+		case strings.HasPrefix(line, "event: "):
+			f.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "id: "):
+			f.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data:"):
+			f.data = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	if _, _, err := BootstrapAdmin(ctx, client, "admin-pw"); err != nil {
+		t.Fatal(err)
+	}
+	sm := streammanager.NewStreamManager(client)
+	sm.PollInterval = 20 * time.Millisecond
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go sm.Run(runCtx)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.POST("/auth/login", func(c *gin.Context) { Login(c, client, 0) })
+	api := r.Group("", middleware.Authenticate(client, true))
+	api.POST("/auth/tokens", func(c *gin.Context) { CreateToken(c, client) })
+	api.GET("/events", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { StreamEvents(c, client, sm) })
+	api.GET("/deployments/:id/stream", func(c *gin.Context) { HandleStreamDeployment(c, client, sm) })
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	post := func(path, token string, body any) []byte {
+		t.Helper()
+		b, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", srv.URL+path, bytes.NewReader(b))
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		out, _ := io.ReadAll(resp.Body)
+		return out
+	}
+	var login, limited TokenInfo
+	json.Unmarshal(post("/auth/login", "", LoginRequest{Username: "admin", Password: "admin-pw"}), &login)
+	json.Unmarshal(post("/auth/tokens", login.Token, TokenRequest{Name: "plant-1", Role: "viewer", Scope: "site:plant-1"}), &limited)
+
+	stream := func(path, token, lastEventID string) (*bufio.Reader, func()) {
+		t.Helper()
+		sctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		req, _ := http.NewRequestWithContext(sctx, "GET", srv.URL+path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+			t.Fatalf("%s: %d %s", path, resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		return bufio.NewReader(resp.Body), func() { cancel(); resp.Body.Close() }
+	}
+	expect := func(r *bufio.Reader, want ...string) {
+		t.Helper()
+		for _, w := range want {
+			f, err := readFrame(r)
+			if err != nil {
+				t.Fatalf("want %s: %v", w, err)
+			}
+			var ev streammanager.Event
+			json.Unmarshal([]byte(f.data), &ev)
+			if got := f.event + "@" + ev.SiteID; got != w || f.id == "" {
+				t.Fatalf("got %s (id %q), want %s", got, f.id, w)
+			}
+		}
+	}
+
+	hostEvent := func(typ, site string) webhook.Event {
+		return webhook.Event{Type: typ, Data: webhook.HostState{HostID: "h1", SiteID: site}}
+	}
+	publish(ctx, client, hostEvent(webhook.EventHostDead, "plant-1"), "", "plant-1")
+	publish(ctx, client, hostEvent(webhook.EventHostDead, "plant-2"), "", "plant-2")
+	dep := "0b7f7a8e-7c1e-4a8c-9b5f-1b0f2c1d9e01"
+	publish(ctx, client, webhook.Event{Type: webhook.EventDeploymentState,
+		Data: webhook.DeploymentState{DeploymentID: dep, SiteID: "plant-1", From: "pending", To: "installing"}}, dep, "plant-1")
+
+	// an admin resumes from the start of the log, host events only
+	admin, closeAdmin := stream("/events?type=host.*", login.Token, "0")
+	defer closeAdmin()
+	expect(admin, "host.dead@plant-1", "host.dead@plant-2")
+
+	// a viewer of plant-1 only sees its events
+	viewer, closeViewer := stream("/events?last_event_id=1", limited.Token, "")
+	defer closeViewer()
+	expect(viewer, "deployment.state_changed@plant-1")
+
+	// both get new events as they are published
+	publish(ctx, client, hostEvent(webhook.EventHostRecovered, "plant-2"), "", "plant-2")
+	publish(ctx, client, hostEvent(webhook.EventHostRecovered, "plant-1"), "", "plant-1")
+	expect(admin, "host.recovered@plant-2", "host.recovered@plant-1")
+	expect(viewer, "host.recovered@plant-1")
+
+	// the deployment stream ends with the deployment installed
+	deployment, closeDeployment := stream("/deployments/"+dep+"/stream", login.Token, "0")
+	defer closeDeployment()
+	publish(ctx, client, webhook.Event{Type: webhook.EventDeploymentState,
+		Data: webhook.DeploymentState{DeploymentID: dep, SiteID: "plant-1", From: "installing", To: "installed"}}, dep, "plant-1")
+	var states []string
+	for {
+		f, err := readFrame(deployment)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ev streammanager.DeployEvent
+		json.Unmarshal([]byte(f.data), &ev)
+		states = append(states, ev.Status)
+	}
+	if strings.Join(states, ",") != "installing,installed" {
+		t.Errorf("deployment stream = %v", states)
+	}
+
+	// a client that does not resume gets the current state first, and
+	// nothing more once the deployment is installed
+	installed := uuid.New()
+	if err := SaveDeploymentStatus(ctx, client, &model.DeploymentStatus{
+		DeploymentID: installed.String(),
+		SiteID:       "plant-1",
+		Status:       model.DeploymentState{State: string(model.StateInstalled)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	current, closeCurrent := stream("/deployments/"+installed.String()+"/stream", login.Token, "")
+	defer closeCurrent()
+	f, err := readFrame(current)
+	var ev streammanager.DeployEvent
+	json.Unmarshal([]byte(f.data), &ev)
+	if err != nil || f.id != "" || ev.Status != string(model.StateInstalled) || ev.DeploymentId != installed.String() {
+		t.Errorf("current state: %+v %v", f, err)
+	}
+	if _, err := readFrame(current); err != io.EOF {
+		t.Errorf("stream of an installed deployment went on: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/deployments/"+uuid.NewString()+"/stream", nil)
+	req.Header.Set("Authorization", "Bearer "+login.Token)
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("stream of an unknown deployment: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/events", nil)
+	req.Header.Set("Authorization", "Bearer "+login.Token)
+	req.Header.Set("Last-Event-ID", "latest")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bad Last-Event-ID: %d", w.Code)
+	}
+}
+
+func TestStreamEventsLongReplay(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	sm := streammanager.NewStreamManager(client)
+	sm.Buffer, sm.PollInterval = 1, 10*time.Millisecond
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go sm.Run(runCtx)
+	time.Sleep(50 * time.Millisecond)
+
+	logEvent := func() int {
+		t.Helper()
+		ev, err := streammanager.Publish(ctx, client, streammanager.Event{Type: webhook.EventHostDead, SiteID: "plant-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ev.ID
+	}
+	for i := 0; i < 2*replayBatch; i++ {
+		logEvent()
+	}
+
+	// events published while the replay is under way overflow the
+	// subscription, but the replay sends them, so nothing is dropped
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	sctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	c.Request = httptest.NewRequest("GET", "/events", nil).WithContext(sctx)
+	var sent []int
+	lastID := 0
+	streamEvents(c, client, sm, streammanager.Filter{}, 0, true, nil, func(ev streammanager.Event) bool {
+		if len(sent) == 0 {
+			for i := 0; i < 3; i++ {
+				lastID = logEvent()
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		sent = append(sent, ev.ID)
+		return false
+	})
+	if strings.Contains(w.Body.String(), "dropped") {
+		t.Errorf("stream dropped events: %s", w.Body.String()[max(0, w.Body.Len()-200):])
+	}
+	if len(sent) != 2*replayBatch+3 || sent[len(sent)-1] != lastID {
+		t.Errorf("sent %d events, the last %d; want %d, the last %d", len(sent), sent[len(sent)-1], 2*replayBatch+3, lastID)
+	}
+}
+
+func TestStreamEventsPruned(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	sm := streammanager.NewStreamManager(client)
+
+	var ids []int
+	for range 5 {
+		ev, err := streammanager.Publish(ctx, client, streammanager.Event{Type: webhook.EventHostDead, SiteID: "plant-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, ev.ID)
+	}
+	if _, err := streammanager.Prune(ctx, client, streammanager.Retention{MaxCount: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	resume := func(after int) (string, []int) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		sctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		c.Request = httptest.NewRequest("GET", "/events", nil).WithContext(sctx)
+		var sent []int
+		streamEvents(c, client, sm, streammanager.Filter{}, after, true, nil, func(ev streammanager.Event) bool {
+			sent = append(sent, ev.ID)
+			return false
+		})
+		return w.Body.String(), sent
+	}
+
+	// the events after the first were pruned: resume after the last of them
+	body, sent := resume(ids[0])
+	want := fmt.Sprintf(`{"pruned":true,"last_event_id":%d}`, ids[2])
+	if !strings.Contains(body, "event: dropped\ndata: "+want) || len(sent) != 0 {
+		t.Errorf("resuming after %d: sent %v, body %q", ids[0], sent, body)
+	}
+	if body, sent = resume(ids[2]); strings.Contains(body, "dropped") || len(sent) != 2 || sent[0] != ids[3] {
+		t.Errorf("resuming after %d: sent %v, body %q", ids[2], sent, body)
+	}
+	// 0 asks for what the log has
+	if body, sent = resume(0); strings.Contains(body, "dropped") || len(sent) != 2 {
+		t.Errorf("resuming after 0: sent %v, body %q", sent, body)
+	}
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// metadataInventory is the Host.metadata key holding the hardware inventory.
+const metadataInventory = "inventory"
+
+// PutHostInventory stores the hardware inventory an LO forwards for one of its
+// hosts, creating the host (and its site) on first contact. A host stays at
+// the site it is registered at: the caller must be allowed there, and the
+// inventory cannot move it elsewhere.
+func PutHostInventory(c *gin.Context, client *ent.Client) {
+	hostID := c.Param("id")
+
+	var inv model.HardwareInventory
+	if err := c.BindJSON(&inv); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	inv.HostID = hostID
+
+	h, err := client.Host.Query().Where(host.HostID(hostID)).Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if h != nil && h.SiteID != uuid.Nil {
+		current, err := client.Site.Get(c, h.SiteID)
+		if ent.IsNotFound(err) {
+			// at a site of another organization
+			c.JSON(http.StatusConflict, gin.H{"error": "host belongs to another site"})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !middleware.Authorize(c, auth.Deployer, siteAccess(current)) {
+			return
+		}
+		if inv.SiteID == "" {
+			inv.SiteID = current.SiteID
+		}
+		if inv.SiteID != current.SiteID {
+			c.JSON(http.StatusConflict, gin.H{"error": "host belongs to site " + current.SiteID})
+			return
+		}
+	}
+
+	where, err := authSite(c, client, inv.SiteID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !middleware.Authorize(c, auth.Deployer, where) {
+		return
+	}
+
+	saved, err := saveHostInventory(c, client, inv)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, saved)
+}
+
+func saveHostInventory(ctx context.Context, client *ent.Client, inv model.HardwareInventory) (*ent.Host, error) {
+	now := time.Now()
+
+	h, err := client.Host.Query().Where(host.HostID(inv.HostID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	meta := map[string]interface{}{}
+	if h != nil {
+		for k, v := range h.Metadata {
+			meta[k] = v
+		}
+	}
+	meta[metadataInventory] = inv
+
+	var siteRef *ent.Site
+	if inv.SiteID != "" {
+		if siteRef, err = ensureSite(ctx, client, inv.SiteID); err != nil {
+			return nil, err
+		}
+		if h != nil && h.SiteID != uuid.Nil && h.SiteID != siteRef.ID {
+			return nil, fmt.Errorf("%w: host %s is registered at another site", errConflict, inv.HostID)
+		}
+	}
+
+	if h == nil {
+		create := client.Host.Create().
+			SetHostID(inv.HostID).
+			SetMetadata(meta).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if siteRef != nil {
+			create.SetSite(siteRef)
+		}
+		return create.Save(ctx)
+	}
+
+	update := h.Update().SetMetadata(meta).SetUpdatedAt(now)
+	if siteRef != nil {
+		update.SetSite(siteRef)
+	}
+	return update.Save(ctx)
+}
+
+// ensureSite returns the site with the LO's site id, creating a bare record
+// when the CO has not seen it yet.
+func ensureSite(ctx context.Context, client *ent.Client, siteID string) (*ent.Site, error) {
+	s, err := client.Site.Query().Where(site.SiteID(siteID)).Only(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return s, err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockQuota(ctx, tx, quotaSites, 1); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	now := time.Now()
+	s, err = tx.Site.Create().
+		SetSiteID(siteID).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return s, tx.Commit()
+}
+
+// hostInventory decodes the inventory stored in h's metadata.
+func hostInventory(h *ent.Host) (model.HardwareInventory, bool) {
+	var inv model.HardwareInventory
+	raw, ok := h.Metadata[metadataInventory]
+	if !ok {
+		return inv, false
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return inv, false
+	}
+	return inv, json.Unmarshal(b, &inv) == nil
+}
+
+func GetHostInventory(c *gin.Context, client *ent.Client) {
+	h, err := client.Host.Query().Where(host.HostID(c.Param("id"))).WithSite().Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "host not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !middleware.Authorize(c, auth.Viewer, siteAccess(h.Edges.Site)) {
+		return
+	}
+	inv, ok := hostInventory(h)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no inventory reported for host"})
+		return
+	}
+	c.JSON(http.StatusOK, inv)
+}
+
+// ListInventory returns the inventory of every host the caller may view,
+// optionally filtered by site, CPU architecture and device type (e.g. ?arch=arm64&device=camera).
+func ListInventory(c *gin.Context, client *ent.Client) {
+	siteID := c.Query("site")
+	arch := c.Query("arch")
+	device := c.Query("device")
+
+	q := client.Host.Query().WithSite()
+	if siteID != "" {
+		q = q.Where(host.HasSiteWith(site.SiteID(siteID)))
+	}
+	hosts, err := q.All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	out := []model.HardwareInventory{}
+	for _, h := range hosts {
+		if !middleware.Can(c, auth.Viewer, siteAccess(h.Edges.Site)) {
+			continue
+		}
+		inv, ok := hostInventory(h)
+		if !ok {
+			continue
+		}
+		if arch != "" && inv.CPU.Arch != arch {
+			continue
+		}
+		if device != "" && !inv.HasDevice(device) {
+			continue
+		}
+		out = append(out, inv)
+	}
+	c.JSON(http.StatusOK, gin.H{"hosts": out})
+}
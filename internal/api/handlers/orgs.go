@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+)
+
+// Quotas caps how many of each thing an organization may have; 0 is no cap.
+// As usage it is how many it has.
+type Quotas struct {
+	Sites       int `json:"sites"`
+	Deployments int `json:"deployments"`
+	Apps        int `json:"apps"`
+	Users       int `json:"users"`
+}
+
+// OrgRequest creates or updates an organization. Name cannot be changed;
+// on update, empty fields are left as they are and quotas, if set, replace
+// the old ones.
+type OrgRequest struct {
+	Name        string  `json:"name"`
+	DisplayName string  `json:"display_name"`
+	Quotas      *Quotas `json:"quotas,omitempty"`
+}
+
+// OrgInfo is an organization with its quotas and its usage of them.
+type OrgInfo struct {
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Quotas      Quotas    `json:"quotas"`
+	Usage       Quotas    `json:"usage"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func ListOrgs(c *gin.Context, client *ent.Client) {
+	orgs, err := client.Organization.Query().Order(ent.Asc(organization.FieldName)).All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	out := make([]OrgInfo, 0, len(orgs))
+	for _, o := range orgs {
+		info, err := orgInfo(c, client, o)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		out = append(out, info)
+	}
+	c.JSON(http.StatusOK, gin.H{"organizations": out})
+}
+
+func GetOrg(c *gin.Context, client *ent.Client) {
+	o, ok := findOrg(c, client, c.Param("org"))
+	if !ok {
+		return
+	}
+	respondOrg(c, client, http.StatusOK, o)
+}
+
+// GetOwnOrg returns the organization the caller acts within.
+func GetOwnOrg(c *gin.Context, client *ent.Client) {
+	t, ok := tenant.FromContext(c)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not acting within an organization"})
+		return
+	}
+	o, ok := findOrg(c, client, t.Name)
+	if !ok {
+		return
+	}
+	respondOrg(c, client, http.StatusOK, o)
+}
+
+func CreateOrg(c *gin.Context, client *ent.Client) {
+	var req OrgRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+	create := client.Organization.Create().
+		SetName(req.Name).
+		SetDisplayName(req.DisplayName)
+	if q := req.Quotas; q != nil {
+		create.SetMaxSites(q.Sites).
+			SetMaxDeployments(q.Deployments).
+			SetMaxApps(q.Apps).
+			SetMaxUsers(q.Users)
+	}
+	o, err := create.Save(c)
+	switch {
+	case ent.IsConstraintError(err):
+		c.JSON(http.StatusConflict, gin.H{"error": "organization already exists"})
+		return
+	case ent.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	respondOrg(c, client, http.StatusCreated, o)
+}
+
+// UpdateOrg changes an organization's display name or quotas. Lowering a
+// quota below what the organization has takes nothing away; it only stops
+// it from adding more.
+func UpdateOrg(c *gin.Context, client *ent.Client) {
+	o, ok := findOrg(c, client, c.Param("org"))
+	if !ok {
+		return
+	}
+	var req OrgRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Name != "" && req.Name != o.Name {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name cannot be changed"})
+		return
+	}
+	update := o.Update()
+	if req.DisplayName != "" {
+		update.SetDisplayName(req.DisplayName)
+	}
+	if q := req.Quotas; q != nil {
+		update.SetMaxSites(q.Sites).
+			SetMaxDeployments(q.Deployments).
+			SetMaxApps(q.Apps).
+			SetMaxUsers(q.Users)
+	}
+	o, err := update.Save(c)
+	if ent.IsValidationError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	respondOrg(c, client, http.StatusOK, o)
+}
+
+// DeleteOrg removes an organization that owns nothing any more.
+func DeleteOrg(c *gin.Context, client *ent.Client) {
+	o, ok := findOrg(c, client, c.Param("org"))
+	if !ok {
+		return
+	}
+	usage, err := orgUsage(c, client, o)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if usage != (Quotas{}) {
+		c.JSON(http.StatusConflict, gin.H{"error": "organization still owns sites, deployments, apps or users", "usage": usage})
+		return
+	}
+	if err := client.Organization.DeleteOne(o).Exec(c); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// orgContext returns ctx acting within the organization named, for a
+// platform user placing something in it; an empty name leaves ctx as it is.
+// It answers the request itself if it cannot.
+func orgContext(c *gin.Context, client *ent.Client, name string) (context.Context, bool) {
+	if name == "" {
+		return c, true
+	}
+	o, ok := findOrg(c, client, name)
+	if !ok {
+		return nil, false
+	}
+	return tenant.NewContext(c.Request.Context(), tenant.Tenant{ID: o.ID, Name: o.Name}), true
+}
+
+func findOrg(c *gin.Context, client *ent.Client, name string) (*ent.Organization, bool) {
+	o, err := client.Organization.Query().Where(organization.Name(name)).Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "organization not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return o, true
+}
+
+func respondOrg(c *gin.Context, client *ent.Client, status int, o *ent.Organization) {
+	info, err := orgInfo(c, client, o)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(status, info)
+}
+
+func orgInfo(ctx context.Context, client *ent.Client, o *ent.Organization) (OrgInfo, error) {
+	usage, err := orgUsage(ctx, client, o)
+	if err != nil {
+		return OrgInfo{}, err
+	}
+	return OrgInfo{
+		Name:        o.Name,
+		DisplayName: o.DisplayName,
+		Quotas: Quotas{
+			Sites:       o.MaxSites,
+			Deployments: o.MaxDeployments,
+			Apps:        o.MaxApps,
+			Users:       o.MaxUsers,
+		},
+		Usage:     usage,
+		CreatedAt: o.CreatedAt,
+	}, nil
+}
+
+// orgUsage counts what o owns, by querying within it.
+func orgUsage(ctx context.Context, client *ent.Client, o *ent.Organization) (Quotas, error) {
+	ctx = tenant.NewContext(ctx, tenant.Tenant{ID: o.ID, Name: o.Name})
+	var usage Quotas
+	for _, r := range []struct {
+		what string
+		n    *int
+	}{
+		{quotaSites, &usage.Sites},
+		{quotaDeployments, &usage.Deployments},
+		{quotaApps, &usage.Apps},
+		{quotaUsers, &usage.Users},
+	} {
+		n, err := countOwned(ctx, client, r.what)
+		if err != nil {
+			return Quotas{}, err
+		}
+		*r.n = n
+	}
+	return usage, nil
+}
+
+// What quotas cap.
+const (
+	quotaSites       = "sites"
+	quotaDeployments = "deployments"
+	quotaApps        = "apps"
+	quotaUsers       = "users"
+)
+
+// QuotaError is adding to an organization more than its quota allows.
+type QuotaError struct {
+	Org  string
+	What string
+	Max  int
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("quota exceeded: organization %s may have at most %d %s", e.Org, e.Max, e.What)
+}
+
+// checkQuota returns a *QuotaError if adding more of what would take the
+// tenant of ctx past its quota. Without a tenant nothing is capped. Outside
+// lockQuota it is only a first check, to refuse early.
+func checkQuota(ctx context.Context, client *ent.Client, what string, adding int) error {
+	t, ok := tenant.FromContext(ctx)
+	if !ok || adding <= 0 {
+		return nil
+	}
+	o, err := client.Organization.Get(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	max := map[string]int{
+		quotaSites:       o.MaxSites,
+		quotaDeployments: o.MaxDeployments,
+		quotaApps:        o.MaxApps,
+		quotaUsers:       o.MaxUsers,
+	}[what]
+	if max == 0 {
+		return nil
+	}
+	n, err := countOwned(ctx, client, what)
+	if err != nil {
+		return err
+	}
+	if n+adding > max {
+		return &QuotaError{Org: o.Name, What: what, Max: max}
+	}
+	return nil
+}
+
+// lockQuota is checkQuota within tx, which is to add what: it first locks
+// the tenant's organization until tx ends, so that concurrent creates are
+// counted one after the other and cannot together exceed the quota.
+func lockQuota(ctx context.Context, tx *ent.Tx, what string, adding int) error {
+	t, ok := tenant.FromContext(ctx)
+	if !ok || adding <= 0 {
+		return nil
+	}
+	// a write that changes nothing, for the row lock it takes
+	if err := tx.Organization.UpdateOneID(t.ID).AddMaxSites(0).Exec(ctx); err != nil {
+		return err
+	}
+	return checkQuota(ctx, tx.Client(), what, adding)
+}
+
+// countOwned counts what the tenant of ctx has.
+func countOwned(ctx context.Context, client *ent.Client, what string) (int, error) {
+	switch what {
+	case quotaSites:
+		return client.Site.Query().Count(ctx)
+	case quotaDeployments:
+		return client.DeploymentStatus.Query().Count(ctx)
+	case quotaApps:
+		return client.ApplicationDesc.Query().Count(ctx)
+	case quotaUsers:
+		return client.User.Query().Count(ctx)
+	}
+	return 0, fmt.Errorf("no quota on %s", what)
+}
+
+// errorStatus is the status to answer err with: 403 for a quota exceeded,
+// 409 for a conflict with another site, 500 for anything else.
+func errorStatus(err error) int {
+	var qe *QuotaError
+	if errors.As(err, &qe) {
+		return http.StatusForbidden
+	}
+	if errors.Is(err, errConflict) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestTenants(t *testing.T) {
+	client := openTestDB(t)
+	if _, _, err := BootstrapAdmin(context.Background(), client, "admin-pw"); err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.POST("/auth/login", func(c *gin.Context) { Login(c, client, 0) })
+	api := r.Group("", middleware.Authenticate(client, true))
+	api.GET("/org", func(c *gin.Context) { GetOwnOrg(c, client) })
+	api.POST("/orgs", middleware.RequirePlatform(), func(c *gin.Context) { CreateOrg(c, client) })
+	api.DELETE("/orgs/:org", middleware.RequirePlatform(), func(c *gin.Context) { DeleteOrg(c, client) })
+	api.GET("/users", middleware.Require(auth.Admin), func(c *gin.Context) { ListUsers(c, client) })
+	api.POST("/users", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUser(c, client) })
+	api.POST("/users/:name/tokens", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUserToken(c, client) })
+	api.GET("/sites", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { ListSites(c, client) })
+	api.POST("/sites", middleware.Require(auth.Admin), func(c *gin.Context) { CreateSite(c, client) })
+	api.GET("/sites/:id", middleware.RequireSite(auth.Viewer, SiteParam(client)), func(c *gin.Context) { GetSite(c, client) })
+	api.POST("/sites/:id/sync", middleware.RequireSite(auth.Deployer, SiteParam(client)), func(c *gin.Context) { SyncSite(c, client) })
+	api.GET("/orchestrators", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { ListOrchestrators(c, client) })
+	api.GET("/orchestrators/:id", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { GetOrchestrator(c, client) })
+
+	do := func(method, path, token, org string, in, out any) int {
+		var body bytes.Buffer
+		if in != nil {
+			json.NewEncoder(&body).Encode(in)
+		}
+		req := httptest.NewRequest(method, path, &body)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if org != "" {
+			req.Header.Set(middleware.OrganizationHeader, org)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if out != nil {
+			json.Unmarshal(w.Body.Bytes(), out)
+		}
+		return w.Code
+	}
+
+	var login TokenInfo
+	if code := do("POST", "/auth/login", "", "", LoginRequest{Username: "admin", Password: "admin-pw"}, &login); code != http.StatusOK {
+		t.Fatalf("login: %d", code)
+	}
+	platform := login.Token
+
+	for _, o := range []OrgRequest{{Name: "acme", Quotas: &Quotas{Sites: 1}}, {Name: "globex"}} {
+		if code := do("POST", "/orgs", platform, "", o, nil); code != http.StatusCreated {
+			t.Fatalf("create org %s: %d", o.Name, code)
+		}
+	}
+	if code := do("POST", "/orgs", platform, "", OrgRequest{Name: "Not Valid"}, nil); code != http.StatusBadRequest {
+		t.Errorf("invalid org name: %d", code)
+	}
+
+	// each organization gets an admin of its own
+	tokens := map[string]string{}
+	for _, org := range []string{"acme", "globex"} {
+		roles := []auth.Binding{{Role: auth.Admin, Scope: auth.AllScopes}}
+		name := org + "-admin"
+		if code := do("POST", "/users", platform, "", UserRequest{Username: name, Organization: org, Roles: &roles}, nil); code != http.StatusCreated {
+			t.Fatalf("create %s: %d", name, code)
+		}
+		var issued TokenInfo
+		if code := do("POST", "/users/"+name+"/tokens", platform, "", TokenRequest{Name: "test"}, &issued); code != http.StatusCreated {
+			t.Fatalf("token for %s: %d", name, code)
+		}
+		tokens[org] = issued.Token
+	}
+	acme, globex := tokens["acme"], tokens["globex"]
+
+	if code := do("POST", "/sites", acme, "", SiteRequest{SiteID: "a-1"}, nil); code != http.StatusCreated {
+		t.Fatalf("acme site: %d", code)
+	}
+	if code := do("POST", "/sites", acme, "", SiteRequest{SiteID: "a-2"}, nil); code != http.StatusForbidden {
+		t.Errorf("site past acme's quota: %d, want 403", code)
+	}
+	if code := do("POST", "/sites", globex, "", SiteRequest{SiteID: "g-1"}, nil); code != http.StatusCreated {
+		t.Fatalf("globex site: %d", code)
+	}
+	if code := do("POST", "/sites", globex, "", SiteRequest{SiteID: "a-1"}, nil); code != http.StatusConflict {
+		t.Errorf("globex took acme's site id: %d, want 409", code)
+	}
+	// nor can an LO of globex sync acme's site, or report acme's hosts
+	if code := do("POST", "/sites/a-1/sync", acme, "", model.SiteSync{Hosts: []model.HostSync{{HostID: "a-host"}}}, nil); code != http.StatusOK {
+		t.Fatalf("acme sync: %d", code)
+	}
+	if code := do("POST", "/sites/a-1/sync", globex, "", model.SiteSync{}, nil); code != http.StatusConflict {
+		t.Errorf("globex synced acme's site: %d, want 409", code)
+	}
+	if code := do("POST", "/sites/g-1/sync", globex, "", model.SiteSync{Hosts: []model.HostSync{{HostID: "a-host"}}}, nil); code != http.StatusConflict {
+		t.Errorf("globex reported acme's host: %d, want 409", code)
+	}
+
+	var list struct {
+		Sites []SiteInfo `json:"sites"`
+	}
+	if do("GET", "/sites", globex, "", nil, &list); len(list.Sites) != 1 || list.Sites[0].SiteID != "g-1" {
+		t.Errorf("sites visible to globex: %+v", list.Sites)
+	}
+	if code := do("GET", "/sites/a-1", globex, "", nil, nil); code != http.StatusNotFound {
+		t.Errorf("globex read acme's site: %d, want 404", code)
+	}
+	if do("GET", "/sites", platform, "", nil, &list); len(list.Sites) != 2 {
+		t.Errorf("sites visible to the platform: %+v", list.Sites)
+	}
+	if do("GET", "/sites", platform, "acme", nil, &list); len(list.Sites) != 1 || list.Sites[0].SiteID != "a-1" {
+		t.Errorf("sites of acme to the platform: %+v", list.Sites)
+	}
+	if code := do("GET", "/sites", platform, "initech", nil, nil); code != http.StatusNotFound {
+		t.Errorf("unknown organization: %d", code)
+	}
+	// the header does not take a tenant's user out of its own organization
+	if do("GET", "/sites", globex, "acme", nil, &list); len(list.Sites) != 1 || list.Sites[0].SiteID != "g-1" {
+		t.Errorf("globex with acme's header: %+v", list.Sites)
+	}
+
+	var users struct {
+		Users []UserInfo `json:"users"`
+	}
+	if do("GET", "/users", acme, "", nil, &users); len(users.Users) != 1 || users.Users[0].Organization != "acme" {
+		t.Errorf("users visible to acme: %+v", users.Users)
+	}
+	if code := do("POST", "/users", acme, "", UserRequest{Username: "mole", Organization: "globex"}, nil); code != http.StatusForbidden {
+		t.Errorf("acme placed a user in globex: %d", code)
+	}
+
+	var own OrgInfo
+	if code := do("GET", "/org", acme, "", nil, &own); code != http.StatusOK || own.Usage.Sites != 1 || own.Usage.Users != 1 || own.Quotas.Sites != 1 {
+		t.Errorf("acme's own org: %d %+v", code, own)
+	}
+	if code := do("POST", "/orgs", acme, "", OrgRequest{Name: "mine"}, nil); code != http.StatusForbidden {
+		t.Errorf("tenant admin created an org: %d", code)
+	}
+	if code := do("DELETE", "/orgs/acme", platform, "", nil, nil); code != http.StatusConflict {
+		t.Errorf("deleted an org that owns sites: %d", code)
+	}
+
+	// orchestrators belong to no organization: a tenant sees those of its
+	// own sites
+	ctx := context.Background()
+	now := time.Now()
+	for siteID, name := range map[string]string{"a-1": "lo-acme", "g-1": "lo-globex"} {
+		o := client.Orchestrator.Create().SetID(uuid.New()).SetName(name).SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+		client.Site.Update().Where(site.SiteID(siteID)).SetOrchestratorID(o.ID).ExecX(ctx)
+	}
+	var orchs struct {
+		Orchestrators []ent.Orchestrator `json:"orchestrators"`
+	}
+	if do("GET", "/orchestrators", acme, "", nil, &orchs); len(orchs.Orchestrators) != 1 || orchs.Orchestrators[0].Name != "lo-acme" {
+		t.Errorf("orchestrators visible to acme: %+v", orchs.Orchestrators)
+	}
+	if do("GET", "/orchestrators", platform, "", nil, &orchs); len(orchs.Orchestrators) != 2 {
+		t.Errorf("orchestrators visible to the platform: %+v", orchs.Orchestrators)
+	}
+	globexLO := client.Orchestrator.Query().Where(orchestrator.Name("lo-globex")).OnlyX(ctx)
+	if code := do("GET", "/orchestrators/"+globexLO.ID.String(), acme, "", nil, nil); code != http.StatusNotFound {
+		t.Errorf("acme read globex's orchestrator: %d, want 404", code)
+	}
+	if code := do("GET", "/orchestrators/"+globexLO.ID.String(), globex, "", nil, nil); code != http.StatusOK {
+		t.Errorf("globex read its orchestrator: %d", code)
+	}
+
+	// within a tenant, rows cannot be made for another
+	orgs := client.Organization.Query().AllX(ctx)
+	byName := map[string]tenant.Tenant{}
+	for _, o := range orgs {
+		byName[o.Name] = tenant.Tenant{ID: o.ID, Name: o.Name}
+	}
+	if _, err := client.Site.Create().
+		SetSiteID("sneaky").
+		SetOrgID(byName["globex"].ID).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(tenant.NewContext(ctx, byName["acme"])); err == nil {
+		t.Error("created a site for another organization")
+	}
+	if n, err := client.Site.Delete().Exec(tenant.NewContext(ctx, byName["globex"])); err != nil || n != 1 {
+		t.Errorf("globex deleted %d sites: %v", n, err)
+	}
+	if n := client.Site.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d sites left, want acme's", n)
+	}
+}
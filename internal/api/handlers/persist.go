@@ -9,11 +9,39 @@ import (
 	"github.com/balaji-balu/margo-hello-world/pkg/application"
 )
 
-func Persist(ctx context.Context, client *ent.Client, localAppName, category string, ad *application.ApplicationDescription) error {
+// AppResources are what an app package holds besides its description:
+// where it came from and the files margo.yaml points at.
+type AppResources struct {
+	// ArtifactURL is the package's reference, pinned to its digest.
+	ArtifactURL   string
+	Icon          []byte
+	IconMediaType string
+	License       string
+	ReleaseNotes  string
+}
+
+// Persist stores an app's description; res, if not nil, adds the package
+// resources it was imported with. It is one transaction, within the quota of
+// the organization the app is for.
+func Persist(ctx context.Context, client *ent.Client, localAppName, category string, ad *application.ApplicationDescription, res *AppResources) (err error) {
 	//ad := ads[0]
 	log.Println("[CO] persisting app desc 1:", ad)
 	//return nil
 
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	if err := lockQuota(ctx, tx, quotaApps, 1); err != nil {
+		return err
+	}
+	client = tx.Client()
+
 	// save catlog info
 	appcreate := client.ApplicationDesc.
 		Create().
@@ -50,6 +78,15 @@ func Persist(ctx context.Context, client *ent.Client, localAppName, category str
 						 SetSite(ad.Metadata.Catalog.Application.Site)
 			}	
 		}
+		if res != nil {
+			appcreate.
+				SetArtifacturl(res.ArtifactURL).
+				SetLicense(res.License).
+				SetReleaseNotes(res.ReleaseNotes)
+			if len(res.Icon) > 0 {
+				appcreate.SetIconData(res.Icon).SetIconMediaType(res.IconMediaType)
+			}
+		}
 	appObj, err := appcreate.Save(ctx)	
 	if err != nil {	
 		return err
@@ -107,6 +144,11 @@ func Persist(ctx context.Context, client *ent.Client, localAppName, category str
 					Timeout:         component.Properties.Timeout,
 					PackageLocation: component.Properties.PackageLocation,
 					KeyLocation:     component.Properties.KeyLocation,
+					Env:             component.Properties.Env,
+					Mounts:          component.Properties.Mounts,
+					LivenessProbe:   component.Properties.LivenessProbe,
+					ReadinessProbe:  component.Properties.ReadinessProbe,
+					DependsOn:       component.Properties.DependsOn,
 				}).
 				Save(ctx)
 			if err != nil {
@@ -115,7 +157,7 @@ func Persist(ctx context.Context, client *ent.Client, localAppName, category str
 		}
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func peripheralsToMap(peripherals []application.Peripheral) []map[string]interface{} {
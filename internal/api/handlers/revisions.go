@@ -0,0 +1,468 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"gopkg.in/yaml.v3"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentcomponentstatus"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentrevision"
+	"github.com/balaji-balu/margo-hello-world/ent/deploymentstatus"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/internal/co"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// revisionInput is what a new deployment revision records.
+type revisionInput struct {
+	DeploymentID   uuid.UUID
+	Deployment     deployment.ApplicationDeployment
+	Spec           []byte
+	SiteID         string
+	CommitSHA      string
+	Author         string
+	RedeployedFrom int
+}
+
+// createRevision stores in as the next revision of its deployment.
+func createRevision(ctx context.Context, client *ent.Client, in revisionInput) (*ent.DeploymentRevision, error) {
+	latest, err := latestRevision(ctx, client, in.DeploymentID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	next := 1
+	if latest != nil {
+		next = latest.Revision + 1
+	}
+
+	ann := in.Deployment.Metadata.Annotations
+	create := client.DeploymentRevision.Create().
+		SetDeploymentID(in.DeploymentID).
+		SetRevision(next).
+		SetAppID(ann.ApplicationID).
+		SetAppName(in.Deployment.Metadata.Labels["app"]).
+		SetAppVersion(ann.Version).
+		SetProfile(in.Deployment.Spec.DeploymentProfile.Type).
+		SetParameters(in.Deployment.Spec.Parameters).
+		SetSiteID(in.SiteID).
+		SetSpec(string(in.Spec)).
+		SetCommitSha(in.CommitSHA).
+		SetAuthor(in.Author)
+	if in.RedeployedFrom > 0 {
+		create.SetRedeployedFrom(in.RedeployedFrom)
+	}
+	return create.Save(ctx)
+}
+
+func latestRevision(ctx context.Context, client *ent.Client, depID uuid.UUID) (*ent.DeploymentRevision, error) {
+	return client.DeploymentRevision.Query().
+		Where(deploymentrevision.DeploymentIDEQ(depID)).
+		Order(ent.Desc(deploymentrevision.FieldRevision)).
+		First(ctx)
+}
+
+// recordOutcome sets the outcome of the deployment's latest revision from
+// the rolled-up state of its components.
+func recordOutcome(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	rev, err := latestRevision(ctx, client, id)
+	if ent.IsNotFound(err) {
+		// deployed before revisions were kept
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := componentRows(ctx, client, id)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	r := rollupStatus(rows)
+	return rev.Update().
+		SetOutcomes(r.Sites).
+		SetState(r.State).
+		Exec(ctx)
+}
+
+// stateRank orders states from worst to best; unknown states rank with
+// installing.
+var stateRank = map[string]int{
+	string(model.StateFailed):     0,
+	string(model.StateUnhealthy):  1,
+	string(model.StateRestarting): 2,
+	string(model.StatePending):    3,
+	string(model.StateInstalling): 4,
+	string(model.StateRecovered):  5,
+	string(model.StateInstalled):  6,
+}
+
+func worstState(states []string) string {
+	worst, rank := "", len(stateRank)
+	for _, s := range states {
+		r, ok := stateRank[s]
+		if !ok {
+			r = stateRank[string(model.StateInstalling)]
+		}
+		if r < rank {
+			worst, rank = s, r
+		}
+	}
+	return worst
+}
+
+func mapValues(m map[string]string) []string {
+	vs := make([]string, 0, len(m))
+	for _, v := range m {
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+// ListRevisions returns the revisions of a deployment, newest first,
+// without their specs.
+func ListRevisions(c *gin.Context, client *ent.Client) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid deployment id"})
+		return
+	}
+	revs, err := client.DeploymentRevision.Query().
+		Where(deploymentrevision.DeploymentIDEQ(id)).
+		Order(ent.Desc(deploymentrevision.FieldRevision)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if len(revs) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "deployment has no revisions"})
+		return
+	}
+	out := make([]gin.H, 0, len(revs))
+	for _, r := range revs {
+		out = append(out, revisionJSON(r, false))
+	}
+	c.JSON(http.StatusOK, gin.H{"revisions": out})
+}
+
+// GetRevision returns one revision of a deployment with its spec.
+func GetRevision(c *gin.Context, client *ent.Client) {
+	rev, ok := findRevision(c, client, c.Param("rev"))
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, revisionJSON(rev, true))
+}
+
+// DiffRevisions compares revisions from and to of a deployment: the
+// recorded fields that differ and a line diff of their specs.
+func DiffRevisions(c *gin.Context, client *ent.Client) {
+	from, ok := findRevision(c, client, c.Query("from"))
+	if !ok {
+		return
+	}
+	to, ok := findRevision(c, client, c.Query("to"))
+	if !ok {
+		return
+	}
+
+	changes := gin.H{}
+	field := func(name, a, b string) {
+		if a != b {
+			changes[name] = []string{a, b}
+		}
+	}
+	field("app_version", from.AppVersion, to.AppVersion)
+	field("profile", from.Profile, to.Profile)
+	field("commit_sha", from.CommitSha, to.CommitSha)
+	field("parameters", parametersString(from.Parameters), parametersString(to.Parameters))
+
+	c.JSON(http.StatusOK, gin.H{
+		"deployment_id": from.DeploymentID,
+		"from":          from.Revision,
+		"to":            to.Revision,
+		"changes":       changes,
+		"spec_diff":     lineDiff(from.Spec, to.Spec),
+	})
+}
+
+// UpdateDeployment deploys another version, profile or parameters of an
+// app under an existing deployment, at its site, as a new revision.
+func UpdateDeployment(c *gin.Context, coo *co.CO, client *ent.Client) {
+	ctx := c.Request.Context()
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid deployment id"})
+		return
+	}
+	var app App
+	if err := c.ShouldBindJSON(&app); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	latest, err := latestRevision(ctx, client, id)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "deployment has no revisions"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	appDesc, profile, components, status, err := findApp(ctx, client, app)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	dep := buildApplicationDeployment(appDesc, profile, components, latest.SiteID, app.Parameters)
+	dep.Metadata.Annotations.ID = id.String()
+	spec, err := yaml.Marshal(dep)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if string(spec) == latest.Spec {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("revision %d already deploys this", latest.Revision)})
+		return
+	}
+
+	rev, err := deployRevision(ctx, coo, client, revisionInput{
+		DeploymentID: id,
+		Deployment:   dep,
+		Spec:         spec,
+		SiteID:       latest.SiteID,
+		Author:       author(c, app.Author),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, revisionJSON(rev, false))
+}
+
+// RedeployRevision writes the spec of an older revision back to the
+// deployments repo as a new revision.
+func RedeployRevision(c *gin.Context, coo *co.CO, client *ent.Client) {
+	ctx := c.Request.Context()
+	old, ok := findRevision(c, client, c.Param("rev"))
+	if !ok {
+		return
+	}
+	latest, err := latestRevision(ctx, client, old.DeploymentID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if latest.Spec == old.Spec {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("revision %d is what is deployed", old.Revision)})
+		return
+	}
+
+	var dep deployment.ApplicationDeployment
+	if err := yaml.Unmarshal([]byte(old.Spec), &dep); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	rev, err := deployRevision(ctx, coo, client, revisionInput{
+		DeploymentID:   old.DeploymentID,
+		Deployment:     dep,
+		Spec:           []byte(old.Spec),
+		SiteID:         old.SiteID,
+		Author:         author(c, ""),
+		RedeployedFrom: old.Revision,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, revisionJSON(rev, false))
+}
+
+// deployRevision records the revision, resets the deployment's status to
+// pending and commits in.Spec to the deployments repo, in one transaction:
+// nothing is recorded unless the spec is committed, and nothing is
+// committed unless the revision could be recorded.
+func deployRevision(ctx context.Context, coo *co.CO, client *ent.Client, in revisionInput) (*ent.DeploymentRevision, error) {
+	org, err := deploymentOrg(ctx, client, in.DeploymentID)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := func() (*ent.DeploymentRevision, error) {
+		if err := resetDeploymentStatus(ctx, tx.Client(), in.DeploymentID, in.SiteID, in.Deployment); err != nil {
+			return nil, err
+		}
+		rev, err := createRevision(ctx, tx.Client(), in)
+		if err != nil {
+			return nil, err
+		}
+		sha, err := coo.CreateDeployment(org, in.SiteID, in.DeploymentID.String(), in.Spec, in.Author)
+		if err != nil {
+			return nil, fmt.Errorf("deployments repo: %w", err)
+		}
+		return rev.Update().SetCommitSha(sha).Save(ctx)
+	}()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return rev, tx.Commit()
+}
+
+// deploymentOrg is the name of the organization owning a deployment, which
+// namespaces it in the deployments repo; empty if it has none.
+func deploymentOrg(ctx context.Context, client *ent.Client, id uuid.UUID) (string, error) {
+	org, err := client.DeploymentStatus.Query().
+		Where(deploymentstatus.IDEQ(id)).
+		QueryOrganization().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return org.Name, nil
+}
+
+// resetDeploymentStatus marks a redeployed deployment, and the components
+// of its new spec on siteID, pending. client is a transaction's.
+func resetDeploymentStatus(ctx context.Context, client *ent.Client, id uuid.UUID, siteID string, dep deployment.ApplicationDeployment) error {
+	if err := client.DeploymentStatus.UpdateOneID(id).
+		SetState(string(model.StatePending)).
+		ClearErrorCode().
+		ClearErrorMessage().
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.DeploymentComponentStatus.Delete().
+		Where(deploymentcomponentstatus.HasDeploymentWith(deploymentstatus.IDEQ(id))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	for _, comp := range dep.Spec.DeploymentProfile.Components {
+		if err := client.DeploymentComponentStatus.Create().
+			SetName(comp.Name).
+			SetSiteID(siteID).
+			SetState(string(model.StatePending)).
+			SetDeploymentID(id).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findRevision looks up revision number s of the deployment in the path,
+// answering the request itself if it cannot.
+func findRevision(c *gin.Context, client *ent.Client, s string) (*ent.DeploymentRevision, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid deployment id"})
+		return nil, false
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid revision %q", s)})
+		return nil, false
+	}
+	rev, err := client.DeploymentRevision.Query().
+		Where(
+			deploymentrevision.DeploymentIDEQ(id),
+			deploymentrevision.RevisionEQ(n),
+		).
+		Only(c.Request.Context())
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("revision %d not found", n)})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return rev, true
+}
+
+func revisionJSON(r *ent.DeploymentRevision, withSpec bool) gin.H {
+	h := gin.H{
+		"deployment_id":   r.DeploymentID,
+		"revision":        r.Revision,
+		"app_id":          r.AppID,
+		"app_name":        r.AppName,
+		"app_version":     r.AppVersion,
+		"profile":         r.Profile,
+		"parameters":      r.Parameters,
+		"site_id":         r.SiteID,
+		"commit_sha":      r.CommitSha,
+		"author":          r.Author,
+		"redeployed_from": r.RedeployedFrom,
+		"state":           r.State,
+		"outcomes":        r.Outcomes,
+		"created_at":      r.CreatedAt,
+	}
+	if withSpec {
+		h["spec"] = r.Spec
+	}
+	return h
+}
+
+// author is who asked for a change: the caller, or with auth disabled the
+// request's author if it names one, marked unauthenticated.
+func author(c *gin.Context, fromBody string) string {
+	if p := middleware.PrincipalOf(c); (p == nil || p == auth.Anonymous) && fromBody != "" {
+		return middleware.Unauthenticated(fromBody)
+	}
+	return middleware.Actor(c)
+}
+
+func parametersString(params []deployment.Parameter) string {
+	var parts []string
+	for _, p := range params {
+		parts = append(parts, p.Name+"="+p.Value)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, " ")
+}
+
+// lineDiff is a line diff of a and b: each line prefixed with "+", "-"
+// or " ".
+func lineDiff(a, b string) string {
+	dmp := diffmatchpatch.New()
+	ca, cb, lines := dmp.DiffLinesToChars(a, b)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(ca, cb, false), lines)
+
+	var sb strings.Builder
+	for _, d := range diffs {
+		prefix := " "
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			prefix = "+"
+		case diffmatchpatch.DiffDelete:
+			prefix = "-"
+		}
+		for _, line := range strings.SplitAfter(d.Text, "\n") {
+			if line == "" {
+				continue
+			}
+			sb.WriteString(prefix + line)
+			if !strings.HasSuffix(line, "\n") {
+				sb.WriteString("\n")
+			}
+		}
+	}
+	return sb.String()
+}
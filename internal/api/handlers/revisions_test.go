@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+	_ "modernc.org/sqlite"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	_ "github.com/balaji-balu/margo-hello-world/ent/runtime"
+	"github.com/balaji-balu/margo-hello-world/internal/co"
+	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := stdsql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+		filepath.Join(t.TempDir(), "co.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testCO is a CO whose deployments repo pushes to a local bare repo.
+func testCO(t *testing.T) *co.CO {
+	t.Helper()
+	dir := t.TempDir()
+	bare := filepath.Join(dir, "deployments.git")
+	if _, err := git.PlainInit(bare, true); err != nil {
+		t.Fatal(err)
+	}
+	work := filepath.Join(dir, "work")
+	repo, err := git.PlainInit(work, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bare}}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _ := repo.Config()
+	cfg.User.Name, cfg.User.Email = "test", "test@localhost"
+	if err := repo.SetConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	m := gitmanager.NewManager()
+	m.Register(gitmanager.RepoConfig{Name: "deployments", Mode: gitmanager.GitRemote, WorkingPath: work})
+	return co.NewCO(m, "", "deployments")
+}
+
+func testDeployment(id uuid.UUID, version string) (deployment.ApplicationDeployment, []byte) {
+	dep := deployment.ApplicationDeployment{
+		Metadata: deployment.Metadata{
+			Labels:      map[string]string{"app": "demo"},
+			Annotations: deployment.Annotations{ID: id.String(), ApplicationID: "demo", Version: version},
+		},
+		Spec: deployment.Spec{
+			DeploymentProfile: deployment.DeploymentProfile{
+				Type:       "compose",
+				Components: []deployment.Component{{Name: "api"}},
+			},
+			Parameters: []deployment.Parameter{{Name: "SiteId", Value: "site-a"}},
+		},
+	}
+	spec, _ := yaml.Marshal(dep)
+	return dep, spec
+}
+
+func TestRevisions(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	coo := testCO(t)
+
+	id := uuid.New()
+	if err := SaveDeploymentStatus(ctx, client, &model.DeploymentStatus{
+		DeploymentID: id.String(),
+		Status:       model.DeploymentState{State: string(model.StatePending)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"1.0", "2.0"} {
+		dep, spec := testDeployment(id, v)
+		if _, err := deployRevision(ctx, coo, client, revisionInput{
+			DeploymentID: id, Deployment: dep, Spec: spec, SiteID: "site-a", Author: "alice",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := UpdateDeploymentStatus(ctx, client, &model.DeploymentStatus{
+		DeploymentID: id.String(),
+		Status:       model.DeploymentState{State: string(model.StateFailed)},
+		Components:   []model.DeploymentComponent{{Name: "api", State: "failed", HostID: "host-1"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/deployments/:id/revisions", func(c *gin.Context) { ListRevisions(c, client) })
+	r.GET("/deployments/:id/diff", func(c *gin.Context) { DiffRevisions(c, client) })
+	r.POST("/deployments/:id/revisions/:rev/redeploy", func(c *gin.Context) { RedeployRevision(c, coo, client) })
+	do := func(method, path string, out any) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		if out != nil {
+			json.Unmarshal(w.Body.Bytes(), out)
+		}
+		return w.Code
+	}
+	base := "/deployments/" + id.String()
+
+	var list struct {
+		Revisions []struct {
+			Revision       int                          `json:"revision"`
+			AppVersion     string                       `json:"app_version"`
+			CommitSHA      string                       `json:"commit_sha"`
+			Author         string                       `json:"author"`
+			State          string                       `json:"state"`
+			RedeployedFrom int                          `json:"redeployed_from"`
+			Outcomes       map[string]model.SiteOutcome `json:"outcomes"`
+		} `json:"revisions"`
+	}
+	if code := do("GET", base+"/revisions", &list); code != http.StatusOK || len(list.Revisions) != 2 {
+		t.Fatalf("list: %d %+v", code, list)
+	}
+	latest := list.Revisions[0]
+	if latest.Revision != 2 || latest.AppVersion != "2.0" || latest.Author != "alice" || latest.CommitSHA == "" {
+		t.Errorf("latest revision = %+v", latest)
+	}
+	if latest.State != "failed" || latest.Outcomes["site-a"].Hosts["host-1"].Components["api"] != "failed" {
+		t.Errorf("outcome not recorded: %+v", latest)
+	}
+	if list.Revisions[1].State != "pending" {
+		t.Errorf("outcome recorded on an older revision")
+	}
+	cfg, _ := coo.Mgr.GetConfig("deployments")
+	repo, err := git.PlainOpen(cfg.WorkingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit, err := repo.CommitObject(plumbing.NewHash(latest.CommitSHA)); err != nil || commit.Author.Name != "alice" {
+		t.Errorf("commit not authored by alice: %v %v", commit, err)
+	}
+
+	var diff struct {
+		Changes  map[string][]string `json:"changes"`
+		SpecDiff string              `json:"spec_diff"`
+	}
+	if code := do("GET", base+"/diff?from=1&to=2", &diff); code != http.StatusOK {
+		t.Fatalf("diff: %d", code)
+	}
+	if got := diff.Changes["app_version"]; len(got) != 2 || got[0] != "1.0" || got[1] != "2.0" {
+		t.Errorf("app_version change = %v", got)
+	}
+	if !strings.Contains(diff.SpecDiff, "-        version: \"1.0\"") || !strings.Contains(diff.SpecDiff, "+        version: \"2.0\"") {
+		t.Errorf("spec diff:\n%s", diff.SpecDiff)
+	}
+
+	if code := do("POST", base+"/revisions/1/redeploy", nil); code != http.StatusOK {
+		t.Fatalf("redeploy: %d", code)
+	}
+	if code := do("POST", base+"/revisions/1/redeploy", nil); code != http.StatusConflict {
+		t.Errorf("redeploying what is deployed: %d, want 409", code)
+	}
+	do("GET", base+"/revisions", &list)
+	if len(list.Revisions) != 3 || list.Revisions[0].RedeployedFrom != 1 || list.Revisions[0].AppVersion != "1.0" {
+		t.Errorf("after redeploy: %+v", list.Revisions)
+	}
+	ds, err := fetchDeployment(ctx, client, id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.State != string(model.StatePending) || len(ds.Edges.Components) != 1 {
+		t.Errorf("status not reset: %s %d components", ds.State, len(ds.Edges.Components))
+	}
+
+	// nothing is recorded for a spec that could not be committed
+	if err := os.RemoveAll(filepath.Join(filepath.Dir(cfg.WorkingPath), "deployments.git")); err != nil {
+		t.Fatal(err)
+	}
+	ds.Update().SetState(string(model.StateFailed)).ExecX(ctx)
+	if code := do("POST", base+"/revisions/2/redeploy", nil); code != http.StatusInternalServerError {
+		t.Errorf("redeploy without a repo: %d, want 500", code)
+	}
+	if n := client.DeploymentRevision.Query().CountX(ctx); n != 3 {
+		t.Errorf("%d revisions after a failed commit", n)
+	}
+	if ds, _ := fetchDeployment(ctx, client, id.String()); ds.State != string(model.StateFailed) {
+		t.Errorf("status reset to %s after a failed commit", ds.State)
+	}
+}
+
+func TestCreateDeployment(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	coo := testCO(t)
+
+	create := func() (uuid.UUID, error) {
+		id := uuid.New()
+		dep, spec := testDeployment(id, "1.0")
+		return id, createDeployment(ctx, coo, client, "", &model.DeploymentStatus{
+			DeploymentID: id.String(),
+			SiteID:       "site-a",
+			Status:       model.DeploymentState{State: string(model.StatePending)},
+			Components:   []model.DeploymentComponent{{Name: "api", State: string(model.StatePending)}},
+		}, revisionInput{DeploymentID: id, Deployment: dep, Spec: spec, SiteID: "site-a", Author: "alice"})
+	}
+
+	id, err := create()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev, err := latestRevision(ctx, client, id); err != nil || rev.CommitSha == "" {
+		t.Fatalf("revision after push: %+v %v", rev, err)
+	}
+
+	// nothing stays recorded of a deployment that could not be pushed
+	cfg, _ := coo.Mgr.GetConfig("deployments")
+	if err := os.RemoveAll(filepath.Join(filepath.Dir(cfg.WorkingPath), "deployments.git")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := create(); err == nil {
+		t.Fatal("push to a missing remote succeeded")
+	}
+	if n := client.DeploymentStatus.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d deployments, want 1", n)
+	}
+	if n := client.DeploymentRevision.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d revisions, want 1", n)
+	}
+	if n := client.DeploymentComponentStatus.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d components, want 1", n)
+	}
+}
+
+func TestWorstState(t *testing.T) {
+	if got := worstState([]string{"installed", "installing", "installed"}); got != "installing" {
+		t.Errorf("got %q", got)
+	}
+	if got := worstState([]string{"installed", "failed", "pending"}); got != "failed" {
+		t.Errorf("got %q", got)
+	}
+}
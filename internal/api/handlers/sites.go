@@ -0,0 +1,582 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/ent/orchestrator"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// SiteRequest creates or updates a site. On update, empty fields are left
+// as they are.
+type SiteRequest struct {
+	SiteID         string `json:"site_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Location       string `json:"location"`
+	Group          string `json:"group"`
+	OrchestratorID string `json:"orchestrator_id"`
+}
+
+// SiteInfo is a site with the liveness of its hosts rolled up. LastSeen is
+// the last sync from its LO.
+type SiteInfo struct {
+	SiteID       string     `json:"site_id"`
+	Name         string     `json:"name,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Group        string     `json:"group,omitempty"`
+	Orchestrator string     `json:"orchestrator,omitempty"`
+	Status       string     `json:"status"`
+	Hosts        int        `json:"hosts"`
+	HostsOnline  int        `json:"hosts_online"`
+	LastSeen     time.Time  `json:"last_seen,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	HostList     []HostInfo `json:"host_list,omitempty"`
+}
+
+// HostInfo is a host as the CO knows it from its LO.
+type HostInfo struct {
+	HostID    string                   `json:"host_id"`
+	SiteID    string                   `json:"site_id,omitempty"`
+	Hostname  string                   `json:"hostname,omitempty"`
+	IPAddress string                   `json:"ip_address,omitempty"`
+	EdgeURL   string                   `json:"edge_url,omitempty"`
+	Runtime   string                   `json:"runtime,omitempty"`
+	Status    string                   `json:"status"`
+	LastSeen  time.Time                `json:"last_seen,omitempty"`
+	CPUFree   float64                  `json:"cpu_free,omitempty"`
+	Inventory *model.HardwareInventory `json:"inventory,omitempty"`
+}
+
+// ListSites lists the sites the caller may view, optionally filtered by
+// location (a case-insensitive substring), group and rolled-up status, e.g.
+// ?location=plant&status=degraded.
+func ListSites(c *gin.Context, client *ent.Client) {
+	q := client.Site.Query().WithHosts().WithOrchestrator().Order(ent.Asc(site.FieldSiteID))
+	if loc := c.Query("location"); loc != "" {
+		q = q.Where(site.LocationContainsFold(loc))
+	}
+	if group := c.Query("group"); group != "" {
+		q = q.Where(site.Group(group))
+	}
+	sites, err := q.All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	status := c.Query("status")
+	out := []SiteInfo{}
+	for _, s := range sites {
+		if !middleware.Can(c, auth.Viewer, siteAccess(s)) {
+			continue
+		}
+		info := siteInfo(s)
+		if status != "" && info.Status != status {
+			continue
+		}
+		out = append(out, info)
+	}
+	c.JSON(http.StatusOK, gin.H{"sites": out})
+}
+
+// GetSite returns a site with its hosts.
+func GetSite(c *gin.Context, client *ent.Client) {
+	s, ok := findSite(c, client)
+	if !ok {
+		return
+	}
+	info := siteInfo(s)
+	for _, h := range s.Edges.Hosts {
+		info.HostList = append(info.HostList, hostInfo(h, s.SiteID))
+	}
+	c.JSON(http.StatusOK, info)
+}
+
+func CreateSite(c *gin.Context, client *ent.Client) {
+	var req SiteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.SiteID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "site_id is required"})
+		return
+	}
+	exists, err := client.Site.Query().Where(site.SiteID(req.SiteID)).Exist(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if exists {
+		c.JSON(http.StatusConflict, gin.H{"error": "site already exists"})
+		return
+	}
+	if err := checkQuota(c, client, quotaSites, 1); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	orchID, err := orchestratorRef(c, client, req.OrchestratorID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tx, err := client.Tx(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := lockQuota(c, tx, quotaSites, 1); err != nil {
+		tx.Rollback()
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	now := time.Now()
+	create := tx.Site.Create().
+		SetSiteID(req.SiteID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetLocation(req.Location).
+		SetGroup(req.Group).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if orchID != nil {
+		create.SetOrchestratorID(*orchID)
+	}
+	if _, err := create.Save(c); err != nil {
+		tx.Rollback()
+		// site ids are unique across organizations
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": "site already exists"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	s, ok := findSiteID(c, client, req.SiteID)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusCreated, siteInfo(s))
+}
+
+func UpdateSite(c *gin.Context, client *ent.Client) {
+	s, ok := findSite(c, client)
+	if !ok {
+		return
+	}
+	var req SiteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.SiteID != "" && req.SiteID != s.SiteID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "site_id cannot be changed"})
+		return
+	}
+	orchID, err := orchestratorRef(c, client, req.OrchestratorID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	update := s.Update().SetUpdatedAt(time.Now())
+	if req.Name != "" {
+		update.SetName(req.Name)
+	}
+	if req.Description != "" {
+		update.SetDescription(req.Description)
+	}
+	if req.Location != "" {
+		update.SetLocation(req.Location)
+	}
+	if req.Group != "" {
+		update.SetGroup(req.Group)
+	}
+	if orchID != nil {
+		update.SetOrchestratorID(*orchID)
+	}
+	if _, err := update.Save(c); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if s, ok = findSiteID(c, client, s.SiteID); ok {
+		c.JSON(http.StatusOK, siteInfo(s))
+	}
+}
+
+// DeleteSite removes a site and its hosts. An LO that still runs the site
+// registers them again when it next reports.
+func DeleteSite(c *gin.Context, client *ent.Client) {
+	s, ok := findSite(c, client)
+	if !ok {
+		return
+	}
+	if err := deleteSite(c, client, s); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func deleteSite(ctx context.Context, client *ent.Client, s *ent.Site) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Host.Delete().Where(host.SiteID(s.ID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Site.DeleteOneID(s.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListHosts lists the hosts of the sites the caller may view, optionally
+// filtered by site (from the path or ?site=), site location and liveness
+// status.
+func ListHosts(c *gin.Context, client *ent.Client) {
+	q := client.Host.Query().WithSite().Order(ent.Asc(host.FieldHostID))
+	siteID := c.Param("id")
+	if siteID == "" {
+		siteID = c.Query("site")
+	} else if _, ok := findSite(c, client); !ok {
+		return
+	}
+	if siteID != "" {
+		q = q.Where(host.HasSiteWith(site.SiteID(siteID)))
+	}
+	if loc := c.Query("location"); loc != "" {
+		q = q.Where(host.HasSiteWith(site.LocationContainsFold(loc)))
+	}
+	hosts, err := q.All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	status := c.Query("status")
+	out := []HostInfo{}
+	for _, h := range hosts {
+		if !middleware.Can(c, auth.Viewer, siteAccess(h.Edges.Site)) {
+			continue
+		}
+		info := hostInfo(h, "")
+		if status != "" && info.Status != status {
+			continue
+		}
+		out = append(out, info)
+	}
+	c.JSON(http.StatusOK, gin.H{"hosts": out})
+}
+
+// GetSiteHost returns one host of a site, with its inventory.
+func GetSiteHost(c *gin.Context, client *ent.Client) {
+	h, err := client.Host.Query().
+		Where(host.HostID(c.Param("host")), host.HasSiteWith(site.SiteID(c.Param("id")))).
+		WithSite().
+		Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "host not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, hostInfo(h, ""))
+}
+
+// findSite loads the site named by the :id path parameter, with its hosts
+// and orchestrator, answering the request itself when it cannot.
+func findSite(c *gin.Context, client *ent.Client) (*ent.Site, bool) {
+	return findSiteID(c, client, c.Param("id"))
+}
+
+func findSiteID(c *gin.Context, client *ent.Client, siteID string) (*ent.Site, bool) {
+	s, err := client.Site.Query().
+		Where(site.SiteID(siteID)).
+		WithHosts().
+		WithOrchestrator().
+		Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "site not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return s, true
+}
+
+// orchestratorRef resolves the orchestrator id of a site request; empty
+// means none.
+func orchestratorRef(ctx context.Context, client *ent.Client, id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Orchestrator.Get(ctx, uid); err != nil {
+		return nil, err
+	}
+	return &uid, nil
+}
+
+// siteInfo rolls up the site's hosts, which must be loaded.
+func siteInfo(s *ent.Site) SiteInfo {
+	info := SiteInfo{
+		SiteID:      s.SiteID,
+		Name:        s.Name,
+		Description: s.Description,
+		Location:    s.Location,
+		Group:       s.Group,
+		Hosts:       len(s.Edges.Hosts),
+		LastSeen:    s.LastSeen,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+	if o := s.Edges.Orchestrator; o != nil {
+		info.Orchestrator = o.Name
+	}
+	for _, h := range s.Edges.Hosts {
+		if hostStatus(h) == model.HostOnline {
+			info.HostsOnline++
+		}
+	}
+	switch {
+	case s.Status == model.SiteStale:
+		info.Status = model.SiteStale
+	case info.Hosts == 0:
+		info.Status = model.SiteUnknown
+	case info.HostsOnline == info.Hosts:
+		info.Status = model.SiteOnline
+	case info.HostsOnline == 0:
+		info.Status = model.SiteOffline
+	default:
+		info.Status = model.SiteDegraded
+	}
+	return info
+}
+
+// hostInfo describes h; siteID is used when h's site is not loaded.
+func hostInfo(h *ent.Host, siteID string) HostInfo {
+	info := HostInfo{
+		HostID:    h.HostID,
+		SiteID:    siteID,
+		Hostname:  h.Hostname,
+		IPAddress: h.IPAddress,
+		EdgeURL:   h.EdgeURL,
+		Runtime:   h.Runtime,
+		Status:    hostStatus(h),
+		LastSeen:  h.LastSeen,
+		CPUFree:   h.CPUFree,
+	}
+	if s := h.Edges.Site; s != nil {
+		info.SiteID = s.SiteID
+	}
+	if inv, ok := hostInventory(h); ok {
+		info.Inventory = &inv
+	}
+	return info
+}
+
+func hostStatus(h *ent.Host) string {
+	if h.Status == "" {
+		return model.HostUnknown
+	}
+	return h.Status
+}
+
+// ListOrchestrators lists the orchestrators running the sites the caller may
+// view; a platform user sees them all.
+func ListOrchestrators(c *gin.Context, client *ent.Client) {
+	q := client.Orchestrator.Query().Order(ent.Asc(orchestrator.FieldName))
+	ids, all, err := viewableOrchestrators(c, client)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !all {
+		q = q.Where(orchestrator.IDIn(ids...))
+	}
+	orchs, err := q.All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"orchestrators": orchs})
+}
+
+// viewableOrchestrators returns the orchestrators of the sites the caller
+// may view. Orchestrators are no organization's, so only a platform user
+// sees them all, which all is true for.
+func viewableOrchestrators(c *gin.Context, client *ent.Client) (ids []uuid.UUID, all bool, err error) {
+	if p := middleware.PrincipalOf(c); p.Platform() && p.Can(auth.Viewer, auth.Site{}) {
+		return nil, true, nil
+	}
+	sites, err := client.Site.Query().Where(site.OrchestratorIDNotNil()).All(c)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, s := range sites {
+		if middleware.Can(c, auth.Viewer, siteAccess(s)) {
+			ids = append(ids, s.OrchestratorID)
+		}
+	}
+	return ids, false, nil
+}
+
+func GetOrchestrator(c *gin.Context, client *ent.Client) {
+	o, ok := findOrchestrator(c, client)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, o)
+}
+
+// OrchestratorRequest creates or updates an orchestrator record. On update,
+// empty fields are left as they are.
+type OrchestratorRequest struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Region      string `json:"region"`
+	APIEndpoint string `json:"api_endpoint"`
+}
+
+func CreateOrchestrator(c *gin.Context, client *ent.Client) {
+	var req OrchestratorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+	now := time.Now()
+	o, err := client.Orchestrator.Create().
+		SetID(uuid.New()).
+		SetName(req.Name).
+		SetType(req.Type).
+		SetRegion(req.Region).
+		SetAPIEndpoint(req.APIEndpoint).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, o)
+}
+
+func UpdateOrchestrator(c *gin.Context, client *ent.Client) {
+	o, ok := findOrchestrator(c, client)
+	if !ok {
+		return
+	}
+	var req OrchestratorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	update := o.Update().SetUpdatedAt(time.Now())
+	if req.Name != "" {
+		update.SetName(req.Name)
+	}
+	if req.Type != "" {
+		update.SetType(req.Type)
+	}
+	if req.Region != "" {
+		update.SetRegion(req.Region)
+	}
+	if req.APIEndpoint != "" {
+		update.SetAPIEndpoint(req.APIEndpoint)
+	}
+	o, err := update.Save(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, o)
+}
+
+// DeleteOrchestrator removes an orchestrator record; its sites stay, without
+// an orchestrator.
+func DeleteOrchestrator(c *gin.Context, client *ent.Client) {
+	o, ok := findOrchestrator(c, client)
+	if !ok {
+		return
+	}
+	if err := deleteOrchestrator(c, client, o); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func deleteOrchestrator(ctx context.Context, client *ent.Client, o *ent.Orchestrator) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Site.Update().Where(site.OrchestratorID(o.ID)).ClearOrchestrator().Save(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Orchestrator.DeleteOneID(o.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func findOrchestrator(c *gin.Context, client *ent.Client) (*ent.Orchestrator, bool) {
+	uid, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid uuid"})
+		return nil, false
+	}
+	ids, all, err := viewableOrchestrators(c, client)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if !all && !slices.Contains(ids, uid) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "orchestrator not found"})
+		return nil, false
+	}
+	o, err := client.Orchestrator.Get(c, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "orchestrator not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return o, true
+}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestSites(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.Authenticate(client, false))
+	r.GET("/sites", func(c *gin.Context) { ListSites(c, client) })
+	r.POST("/sites", func(c *gin.Context) { CreateSite(c, client) })
+	r.GET("/sites/:id", func(c *gin.Context) { GetSite(c, client) })
+	r.DELETE("/sites/:id", func(c *gin.Context) { DeleteSite(c, client) })
+	r.GET("/sites/:id/hosts", func(c *gin.Context) { ListHosts(c, client) })
+	r.GET("/hosts", func(c *gin.Context) { ListHosts(c, client) })
+	r.POST("/orchestrators", func(c *gin.Context) { CreateOrchestrator(c, client) })
+	do := func(method, path string, in, out any) int {
+		var body bytes.Buffer
+		if in != nil {
+			json.NewEncoder(&body).Encode(in)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, &body))
+		if out != nil {
+			json.Unmarshal(w.Body.Bytes(), out)
+		}
+		return w.Code
+	}
+
+	var orch ent.Orchestrator
+	if code := do("POST", "/orchestrators", OrchestratorRequest{Name: "lo-east"}, &orch); code != http.StatusCreated {
+		t.Fatalf("create orchestrator: %d", code)
+	}
+	if code := do("POST", "/sites", SiteRequest{SiteID: "plant-1", Location: "Pune", OrchestratorID: orch.ID.String()}, nil); code != http.StatusCreated {
+		t.Fatalf("create site: %d", code)
+	}
+	if code := do("POST", "/sites", SiteRequest{SiteID: "plant-1"}, nil); code != http.StatusConflict {
+		t.Errorf("duplicate site: %d, want 409", code)
+	}
+
+	// hosts come in from the LO; one of plant-2's is down
+	for _, h := range []struct{ id, site, status string }{
+		{"h1", "plant-1", model.HostOnline},
+		{"h2", "plant-2", model.HostOnline},
+		{"h3", "plant-2", model.HostOffline},
+	} {
+		saved, err := saveHostInventory(ctx, client, model.HardwareInventory{HostID: h.id, SiteID: h.site})
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved.Update().SetStatus(h.status).SetLastSeen(time.Now()).ExecX(ctx)
+	}
+	client.Site.Update().Where(site.SiteID("plant-2")).SetLocation("Chennai").ExecX(ctx)
+
+	var sites struct{ Sites []SiteInfo }
+	do("GET", "/sites", nil, &sites)
+	if len(sites.Sites) != 2 {
+		t.Fatalf("sites = %+v", sites.Sites)
+	}
+	if s := sites.Sites[0]; s.SiteID != "plant-1" || s.Status != model.SiteOnline || s.Orchestrator != "lo-east" {
+		t.Errorf("plant-1 = %+v", s)
+	}
+	if s := sites.Sites[1]; s.Status != model.SiteDegraded || s.Hosts != 2 || s.HostsOnline != 1 {
+		t.Errorf("plant-2 = %+v", s)
+	}
+	do("GET", "/sites?location=pun", nil, &sites)
+	if len(sites.Sites) != 1 || sites.Sites[0].SiteID != "plant-1" {
+		t.Errorf("sites in Pune = %+v", sites.Sites)
+	}
+	do("GET", "/sites?status=degraded", nil, &sites)
+	if len(sites.Sites) != 1 || sites.Sites[0].SiteID != "plant-2" {
+		t.Errorf("degraded sites = %+v", sites.Sites)
+	}
+
+	var hosts struct{ Hosts []HostInfo }
+	do("GET", "/sites/plant-2/hosts?status=offline", nil, &hosts)
+	if len(hosts.Hosts) != 1 || hosts.Hosts[0].HostID != "h3" || hosts.Hosts[0].Inventory == nil {
+		t.Errorf("offline hosts of plant-2 = %+v", hosts.Hosts)
+	}
+	if code := do("GET", "/sites/plant-9/hosts", nil, nil); code != http.StatusNotFound {
+		t.Errorf("hosts of unknown site: %d, want 404", code)
+	}
+
+	if code := do("DELETE", "/sites/plant-2", nil, nil); code != http.StatusNoContent {
+		t.Fatalf("delete: %d", code)
+	}
+	do("GET", "/hosts", nil, &hosts)
+	if len(hosts.Hosts) != 1 || hosts.Hosts[0].SiteID != "plant-1" {
+		t.Errorf("hosts after delete = %+v", hosts.Hosts)
+	}
+}
+
+func TestSyncSite(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/sites/:id/sync", func(c *gin.Context) { SyncSite(c, client) })
+	post := func(s model.SiteSync) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(s)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/sites/"+s.SiteID+"/sync", bytes.NewReader(body)))
+		return w
+	}
+	sync := func(s model.SiteSync) SiteInfo {
+		t.Helper()
+		w := post(s)
+		if w.Code != http.StatusOK {
+			t.Fatalf("sync: %d %s", w.Code, w.Body)
+		}
+		var info SiteInfo
+		json.Unmarshal(w.Body.Bytes(), &info)
+		return info
+	}
+
+	report := model.SiteSync{
+		SiteID:       "plant-1",
+		Location:     "Pune",
+		Orchestrator: model.OrchestratorSync{ID: "7f1c1a52-5d1f-4bd8-a0b7-6a3a2f7e9c11", Name: "lo-1"},
+		Hosts: []model.HostSync{
+			{HostID: "h1", Status: model.HostOnline, Runtime: "containerd",
+				Inventory: &model.HardwareInventory{CPU: model.CPUInventory{Arch: "arm64"}}},
+			{HostID: "h2", Status: model.HostOffline},
+		},
+	}
+	info := sync(report)
+	if info.Status != model.SiteDegraded || info.Orchestrator != "lo-1" || info.LastSeen.IsZero() {
+		t.Errorf("registered site = %+v", info)
+	}
+	h1 := client.Host.Query().Where(host.HostID("h1")).OnlyX(ctx)
+	if inv, ok := hostInventory(h1); !ok || inv.CPU.Arch != "arm64" || inv.SiteID != "plant-1" {
+		t.Errorf("h1 inventory = %+v", inv)
+	}
+
+	// h2 left the site; the inventory h1 reported before is kept
+	report.Hosts = report.Hosts[:1]
+	report.Hosts[0].Inventory = nil
+	if info := sync(report); info.Status != model.SiteOnline || info.Hosts != 1 {
+		t.Errorf("after h2 left = %+v", info)
+	}
+	if _, ok := hostInventory(client.Host.Query().Where(host.HostID("h1")).OnlyX(ctx)); !ok {
+		t.Error("h1 inventory dropped")
+	}
+	if n := client.Orchestrator.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d orchestrators", n)
+	}
+
+	// another site's LO can neither claim h1 nor plant-1's orchestrator,
+	// and plant-1 cannot be taken over by another orchestrator
+	other := model.SiteSync{
+		SiteID:       "plant-2",
+		Orchestrator: model.OrchestratorSync{ID: "0b6f3a2e-9f43-4d8e-8c55-2f1d0f4a7e21", Name: "lo-2"},
+		Hosts:        []model.HostSync{{HostID: "h1", Status: model.HostOffline}},
+	}
+	if w := post(other); w.Code != http.StatusConflict {
+		t.Errorf("claiming h1 = %d %s", w.Code, w.Body)
+	}
+	other.Hosts = nil
+	other.Orchestrator = model.OrchestratorSync{ID: report.Orchestrator.ID, Name: "stolen"}
+	if w := post(other); w.Code != http.StatusConflict {
+		t.Errorf("claiming lo-1 = %d %s", w.Code, w.Body)
+	}
+	hijack := report
+	hijack.Orchestrator = model.OrchestratorSync{ID: "0b6f3a2e-9f43-4d8e-8c55-2f1d0f4a7e21", Name: "lo-2"}
+	if w := post(hijack); w.Code != http.StatusConflict {
+		t.Errorf("rebinding plant-1 = %d %s", w.Code, w.Body)
+	}
+	h1 = client.Host.Query().Where(host.HostID("h1")).WithSite().OnlyX(ctx)
+	if h1.Edges.Site.SiteID != "plant-1" || h1.Status != model.HostOnline {
+		t.Errorf("h1 after claims = %s %s", h1.Edges.Site.SiteID, h1.Status)
+	}
+	if o := client.Orchestrator.Query().OnlyX(ctx); o.Name != "lo-1" {
+		t.Errorf("orchestrator renamed to %q", o.Name)
+	}
+	if client.Site.Query().Where(site.SiteID("plant-2")).ExistX(ctx) {
+		t.Error("plant-2 registered")
+	}
+
+	// the LO goes quiet
+	if n, err := MarkStaleSites(ctx, client, time.Hour); err != nil || n != 0 {
+		t.Fatalf("fresh site marked stale: %d %v", n, err)
+	}
+	client.Site.Update().SetLastSeen(time.Now().Add(-2 * time.Hour)).ExecX(ctx)
+	if n, err := MarkStaleSites(ctx, client, time.Hour); err != nil || n != 1 {
+		t.Fatalf("marked %d, %v", n, err)
+	}
+	if n, _ := MarkStaleSites(ctx, client, time.Hour); n != 0 {
+		t.Errorf("stale site marked again")
+	}
+	s := client.Site.Query().Where(site.SiteID("plant-1")).WithHosts().OnlyX(ctx)
+	if got := siteInfo(s); got.Status != model.SiteStale || got.HostsOnline != 0 {
+		t.Errorf("stale site = %+v", got)
+	}
+	dead := client.Event.Query().Where(event.Type(webhook.EventHostDead)).AllX(ctx)
+	if len(dead) != 1 || dead[0].SiteID != "plant-1" {
+		t.Errorf("host.dead events = %+v", dead)
+	}
+
+	// and comes back
+	if info := sync(report); info.Status != model.SiteOnline {
+		t.Errorf("after resync = %+v", info)
+	}
+	if n := client.Event.Query().Where(event.Type(webhook.EventHostRecovered)).CountX(ctx); n != 1 {
+		t.Errorf("%d host.recovered events", n)
+	}
+}
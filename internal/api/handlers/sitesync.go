@@ -0,0 +1,346 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/ent/site"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// defaultStaleAfter is how long a site may go without syncing before it is
+// marked stale.
+const defaultStaleAfter = 5 * time.Minute
+
+// SyncSite takes an LO's report of its site: it registers the site and its
+// orchestrator on first contact and replaces the site's hosts with those
+// reported.
+func SyncSite(c *gin.Context, client *ent.Client) {
+	var req model.SiteSync
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.SiteID == "" {
+		req.SiteID = c.Param("id")
+	}
+	if req.SiteID != c.Param("id") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "site_id does not match the path"})
+		return
+	}
+	if err := syncSite(c, client, req); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if s, ok := findSiteID(c, client, req.SiteID); ok {
+		c.JSON(http.StatusOK, siteInfo(s))
+	}
+}
+
+func syncSite(ctx context.Context, client *ent.Client, req model.SiteSync) error {
+	now := time.Now()
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	rollback := func(err error) error {
+		tx.Rollback()
+		return err
+	}
+
+	// site ids are unique across organizations: one taken by another
+	// is a conflict, not a site to register
+	s, err := tx.Site.Query().Where(site.SiteID(req.SiteID)).Only(tenant.Unscoped(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		return rollback(err)
+	}
+	if s != nil && foreign(ctx, s.OrgID) {
+		return rollback(fmt.Errorf("%w: site %s belongs to another organization", errConflict, req.SiteID))
+	}
+	orchID, err := syncOrchestrator(ctx, tx, s, req.Orchestrator, now)
+	if err != nil {
+		return rollback(fmt.Errorf("orchestrator: %w", err))
+	}
+
+	switch {
+	case s == nil:
+		if err := lockQuota(ctx, tx, quotaSites, 1); err != nil {
+			return rollback(err)
+		}
+		create := tx.Site.Create().
+			SetSiteID(req.SiteID).
+			SetName(req.Name).
+			SetLocation(req.Location).
+			SetLastSeen(now).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if orchID != nil {
+			create.SetOrchestratorID(*orchID)
+		}
+		if s, err = create.Save(ctx); err != nil {
+			return rollback(err)
+		}
+		log.Printf("site %s registered", req.SiteID)
+	default:
+		update := s.Update().ClearStatus().SetLastSeen(now).SetUpdatedAt(now)
+		if req.Name != "" {
+			update.SetName(req.Name)
+		}
+		if req.Location != "" {
+			update.SetLocation(req.Location)
+		}
+		if orchID != nil {
+			update.SetOrchestratorID(*orchID)
+		}
+		if s, err = update.Save(ctx); err != nil {
+			return rollback(err)
+		}
+	}
+
+	reported := make([]string, 0, len(req.Hosts))
+	var events []webhook.Event
+	for _, hs := range req.Hosts {
+		if hs.HostID == "" {
+			continue
+		}
+		ev, err := syncHost(ctx, tx, s, hs, now)
+		if err != nil {
+			return rollback(fmt.Errorf("host %s: %w", hs.HostID, err))
+		}
+		if ev != nil {
+			events = append(events, *ev)
+		}
+		reported = append(reported, hs.HostID)
+	}
+	if _, err := tx.Host.Delete().
+		Where(host.SiteID(s.ID), host.HostIDNotIn(reported...)).
+		Exec(ctx); err != nil {
+		return rollback(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for _, ev := range events {
+		publish(ctx, client, ev, "", req.SiteID)
+	}
+	return nil
+}
+
+// errConflict is returned when an LO reports an orchestrator or host that
+// belongs to another site, or a site or host of another organization.
+var errConflict = errors.New("conflict")
+
+// foreign tells whether a row of organization orgID, found unscoped, is not
+// the tenant's ctx acts within.
+func foreign(ctx context.Context, orgID uuid.UUID) bool {
+	t, ok := tenant.FromContext(ctx)
+	return ok && orgID != t.ID
+}
+
+// syncOrchestrator creates or updates the record of the LO running s, nil
+// for a site yet to register, and returns its id, or nil when the LO did not
+// identify itself. An LO may only update the orchestrator s is bound to, and
+// may not take over one bound to another site.
+func syncOrchestrator(ctx context.Context, tx *ent.Tx, s *ent.Site, o model.OrchestratorSync, now time.Time) (*uuid.UUID, error) {
+	if o.ID == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(o.ID)
+	if err != nil {
+		return nil, err
+	}
+	if s != nil && s.OrchestratorID != uuid.Nil && s.OrchestratorID != id {
+		return nil, fmt.Errorf("%w: site %s is run by orchestrator %s", errConflict, s.SiteID, s.OrchestratorID)
+	}
+	others := tx.Site.Query().Where(site.OrchestratorID(id))
+	if s != nil {
+		others.Where(site.IDNEQ(s.ID))
+	}
+	taken, err := others.Exist(tenant.Unscoped(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, fmt.Errorf("%w: %s runs another site", errConflict, id)
+	}
+	err = tx.Orchestrator.Create().
+		SetID(id).
+		SetName(o.Name).
+		SetType("lo").
+		SetRegion(o.Region).
+		SetAPIEndpoint(o.APIEndpoint).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		OnConflictColumns("id").
+		Update(func(u *ent.OrchestratorUpsert) {
+			u.UpdateName().UpdateRegion().UpdateAPIEndpoint().UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	return &id, err
+}
+
+// syncHost creates or updates one reported host of s; a host registered at
+// another site is refused rather than moved. It returns the event for
+// webhooks if the host went offline or came back.
+func syncHost(ctx context.Context, tx *ent.Tx, s *ent.Site, hs model.HostSync, now time.Time) (*webhook.Event, error) {
+	h, err := tx.Host.Query().Where(host.HostID(hs.HostID)).Only(tenant.Unscoped(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if h != nil && foreign(ctx, h.OrgID) {
+		return nil, fmt.Errorf("%w: registered in another organization", errConflict)
+	}
+	if h != nil && h.SiteID != uuid.Nil && h.SiteID != s.ID {
+		return nil, fmt.Errorf("%w: registered at another site", errConflict)
+	}
+	status := hs.Status
+	if status == "" {
+		status = model.HostUnknown
+	}
+
+	meta := map[string]interface{}{}
+	if h != nil {
+		for k, v := range h.Metadata {
+			meta[k] = v
+		}
+	}
+	if hs.Inventory != nil {
+		inv := *hs.Inventory
+		inv.HostID, inv.SiteID = hs.HostID, s.SiteID
+		meta[metadataInventory] = inv
+	}
+
+	if h == nil {
+		create := tx.Host.Create().
+			SetHostID(hs.HostID).
+			SetSite(s).
+			SetStatus(status).
+			SetRuntime(hs.Runtime).
+			SetIPAddress(hs.IPAddress).
+			SetCPUFree(hs.CPUFree).
+			SetMetadata(meta).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if !hs.LastSeen.IsZero() {
+			create.SetLastSeen(hs.LastSeen)
+		}
+		return nil, create.Exec(ctx)
+	}
+
+	update := h.Update().
+		SetSite(s).
+		SetStatus(status).
+		SetCPUFree(hs.CPUFree).
+		SetMetadata(meta).
+		SetUpdatedAt(now)
+	if hs.Runtime != "" {
+		update.SetRuntime(hs.Runtime)
+	}
+	if hs.IPAddress != "" {
+		update.SetIPAddress(hs.IPAddress)
+	}
+	if !hs.LastSeen.IsZero() {
+		update.SetLastSeen(hs.LastSeen)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return hostEvent(s, h, status), nil
+}
+
+// hostEvent is the event of h going from its status to status: dead when
+// it goes offline, recovered when it comes back online; nil otherwise.
+func hostEvent(s *ent.Site, h *ent.Host, status string) *webhook.Event {
+	var t string
+	switch {
+	case status == model.HostOffline && h.Status != model.HostOffline:
+		t = webhook.EventHostDead
+	case status == model.HostOnline && h.Status == model.HostOffline:
+		t = webhook.EventHostRecovered
+	default:
+		return nil
+	}
+	return &webhook.Event{
+		Type:  t,
+		OrgID: s.OrgID,
+		Data:  webhook.HostState{HostID: h.HostID, SiteID: s.SiteID, From: h.Status, To: status},
+	}
+}
+
+// MarkStaleSites marks sites that have not synced for after as stale, and
+// their hosts offline, publishing host.dead for each host that was not. It
+// returns how many sites it marked.
+func MarkStaleSites(ctx context.Context, client *ent.Client, after time.Duration) (int, error) {
+	stale := site.And(
+		site.LastSeenLT(time.Now().Add(-after)),
+		site.Or(site.StatusIsNil(), site.StatusNEQ(model.SiteStale)),
+	)
+	sites, err := client.Site.Query().Where(stale).WithHosts().All(ctx)
+	if err != nil || len(sites) == 0 {
+		return 0, err
+	}
+
+	ids := make([]uuid.UUID, len(sites))
+	for i, s := range sites {
+		ids[i] = s.ID
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Site.Update().Where(site.IDIn(ids...)).SetStatus(model.SiteStale).Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Host.Update().Where(host.SiteIDIn(ids...)).SetStatus(model.HostOffline).Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	// the hosts as loaded are as they were before
+	for _, s := range sites {
+		for _, h := range s.Edges.Hosts {
+			if ev := hostEvent(s, h, model.HostOffline); ev != nil {
+				publish(ctx, client, *ev, "", s.SiteID)
+			}
+		}
+	}
+	return len(ids), nil
+}
+
+// WatchStaleSites runs MarkStaleSites until ctx ends; after is 0 for the
+// default of 5m.
+func WatchStaleSites(ctx context.Context, client *ent.Client, after time.Duration) {
+	if after <= 0 {
+		after = defaultStaleAfter
+	}
+	ticker := time.NewTicker(after / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := MarkStaleSites(ctx, client, after)
+			if err != nil {
+				log.Printf("stale sites: %v", err)
+			} else if n > 0 {
+				log.Printf("%d sites marked stale: no sync for %s", n, after)
+			}
+		}
+	}
+}
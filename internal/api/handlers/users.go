@@ -0,0 +1,600 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
+	"github.com/balaji-balu/margo-hello-world/ent/rolebinding"
+	"github.com/balaji-balu/margo-hello-world/ent/user"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+)
+
+// DefaultLoginTTL is how long a token from Login lasts unless configured.
+const DefaultLoginTTL = 30 * 24 * time.Hour
+
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// TokenName names the token issued, e.g. after the machine it is for.
+	TokenName string `json:"token_name"`
+}
+
+// Login checks a username and password and issues a token for the user,
+// which lasts ttl (0 means DefaultLoginTTL).
+func Login(c *gin.Context, client *ent.Client, ttl time.Duration) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	u, err := client.User.Query().Where(user.Username(req.Username)).Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if u == nil || u.Disabled || !auth.CheckPassword(u.PasswordHash, req.Password) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid username or password"})
+		return
+	}
+	if ttl <= 0 {
+		ttl = DefaultLoginTTL
+	}
+	if req.TokenName == "" {
+		req.TokenName = "login"
+	}
+	now := time.Now()
+	expires := now.Add(ttl)
+	info, err := issueToken(c, client, u.ID, req.TokenName, "", auth.AllScopes, &expires)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	client.User.UpdateOne(u).SetLastLoginAt(now).Exec(c)
+	info.User = u.Username
+	c.JSON(http.StatusOK, info)
+}
+
+// WhoAmI returns who the request acts for and what it may do.
+func WhoAmI(c *gin.Context) {
+	c.JSON(http.StatusOK, middleware.PrincipalOf(c))
+}
+
+// UserRequest creates or updates a user. On update, only what is set
+// changes; roles, if set, replace the user's bindings. Organization places
+// a new user in an organization; only a platform admin may name one, and
+// others' users are made in their own.
+type UserRequest struct {
+	Username     string          `json:"username"`
+	Password     string          `json:"password,omitempty"`
+	Organization string          `json:"organization,omitempty"`
+	Disabled     *bool           `json:"disabled,omitempty"`
+	Roles        *[]auth.Binding `json:"roles,omitempty"`
+}
+
+// UserInfo is a user as the API shows it, without its password.
+type UserInfo struct {
+	Username     string         `json:"username"`
+	Organization string         `json:"organization,omitempty"`
+	Disabled     bool           `json:"disabled"`
+	HasPassword  bool           `json:"has_password"`
+	Roles        []auth.Binding `json:"roles"`
+	LastLoginAt  *time.Time     `json:"last_login_at,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+func ListUsers(c *gin.Context, client *ent.Client) {
+	users, err := client.User.Query().WithRoles().WithOrganization().Order(ent.Asc(user.FieldUsername)).All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	out := make([]UserInfo, 0, len(users))
+	for _, u := range users {
+		out = append(out, userInfo(u))
+	}
+	c.JSON(http.StatusOK, gin.H{"users": out})
+}
+
+func GetUser(c *gin.Context, client *ent.Client) {
+	if u, ok := findUser(c, client); ok {
+		c.JSON(http.StatusOK, userInfo(u))
+	}
+}
+
+// CreateUser adds a user. A user without a password cannot log in; it is
+// for machines, such as an LO, that are given tokens instead.
+func CreateUser(c *gin.Context, client *ent.Client) {
+	var req UserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Username == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "username is required"})
+		return
+	}
+	var roles []auth.Binding
+	if req.Roles != nil {
+		roles = *req.Roles
+	}
+	if err := checkBindings(roles); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Organization != "" && !middleware.PrincipalOf(c).Platform() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "forbidden: only a platform admin may name an organization"})
+		return
+	}
+	ctx, ok := orgContext(c, client, req.Organization)
+	if !ok {
+		return
+	}
+	exists, err := client.User.Query().Where(user.Username(req.Username)).Exist(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if exists {
+		c.JSON(http.StatusConflict, gin.H{"error": "user already exists"})
+		return
+	}
+	if err := checkQuota(ctx, client, quotaUsers, 1); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if err := createUser(ctx, client, req.Username, req.Password, roles); err != nil {
+		// usernames are unique across organizations
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": "user already exists"})
+			return
+		}
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if u, ok := findUserName(c, client, req.Username); ok {
+		c.JSON(http.StatusCreated, userInfo(u))
+	}
+}
+
+func createUser(ctx context.Context, client *ent.Client, username, password string, roles []auth.Binding) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := lockQuota(ctx, tx, quotaUsers, 1); err != nil {
+		tx.Rollback()
+		return err
+	}
+	create := tx.User.Create().SetUsername(username)
+	if password != "" {
+		hash, err := auth.HashPassword(password)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		create.SetPasswordHash(hash)
+	}
+	u, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := setBindings(ctx, tx, u.ID, roles); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// UpdateUser changes a user's password, disables or enables it, or
+// replaces its roles. Disabling a user stops its tokens from working.
+func UpdateUser(c *gin.Context, client *ent.Client) {
+	u, ok := findUser(c, client)
+	if !ok {
+		return
+	}
+	var req UserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Username != "" && req.Username != u.Username {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "username cannot be changed"})
+		return
+	}
+	if req.Organization != "" && (u.Edges.Organization == nil || req.Organization != u.Edges.Organization.Name) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "organization cannot be changed"})
+		return
+	}
+	if req.Roles != nil {
+		if err := checkBindings(*req.Roles); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	tx, err := client.Tx(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	update := tx.User.UpdateOneID(u.ID)
+	if req.Password != "" {
+		hash, err := auth.HashPassword(req.Password)
+		if err != nil {
+			tx.Rollback()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		update.SetPasswordHash(hash)
+	}
+	if req.Disabled != nil {
+		update.SetDisabled(*req.Disabled)
+	}
+	if _, err := update.Save(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Roles != nil {
+		if _, err := tx.RoleBinding.Delete().Where(rolebinding.HasUserWith(user.ID(u.ID))).Exec(c); err != nil {
+			tx.Rollback()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := setBindings(c, tx, u.ID, *req.Roles); err != nil {
+			tx.Rollback()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if u, ok = findUserName(c, client, u.Username); ok {
+		c.JSON(http.StatusOK, userInfo(u))
+	}
+}
+
+// DeleteUser removes a user with its roles and tokens.
+func DeleteUser(c *gin.Context, client *ent.Client) {
+	u, ok := findUser(c, client)
+	if !ok {
+		return
+	}
+	if p := middleware.PrincipalOf(c); p != nil && p.UserID == u.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cannot delete yourself"})
+		return
+	}
+	tx, err := client.Tx(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if _, err := tx.RoleBinding.Delete().Where(rolebinding.HasUserWith(user.ID(u.ID))).Exec(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if _, err := tx.APIToken.Delete().Where(apitoken.HasUserWith(user.ID(u.ID))).Exec(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := tx.User.DeleteOneID(u.ID).Exec(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// TokenRequest asks for a token. Role and scope narrow what it may do
+// below what its user may; ExpiresIn is a duration such as "720h", empty
+// for a token that does not expire.
+type TokenRequest struct {
+	Name      string `json:"name"`
+	Role      string `json:"role,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	ExpiresIn string `json:"expires_in,omitempty"`
+}
+
+// TokenInfo describes a token. Token itself is only there when it is
+// issued; the CO does not keep it.
+type TokenInfo struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	User       string     `json:"user,omitempty"`
+	Prefix     string     `json:"prefix"`
+	Role       string     `json:"role,omitempty"`
+	Scope      string     `json:"scope"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Token      string     `json:"token,omitempty"`
+}
+
+// ListTokens lists the caller's tokens.
+func ListTokens(c *gin.Context, client *ent.Client) {
+	listTokens(c, client, middleware.PrincipalOf(c).UserID)
+}
+
+// ListUserTokens lists the tokens of the user in the path.
+func ListUserTokens(c *gin.Context, client *ent.Client) {
+	if u, ok := findUser(c, client); ok {
+		listTokens(c, client, u.ID)
+	}
+}
+
+func listTokens(c *gin.Context, client *ent.Client, userID int) {
+	tokens, err := client.APIToken.Query().
+		Where(apitoken.HasUserWith(user.ID(userID))).
+		WithUser().
+		Order(ent.Asc(apitoken.FieldCreatedAt)).
+		All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	out := make([]TokenInfo, 0, len(tokens))
+	for _, t := range tokens {
+		out = append(out, tokenInfo(t))
+	}
+	c.JSON(http.StatusOK, gin.H{"tokens": out})
+}
+
+// CreateToken issues the caller a token, e.g. for CI. A token made with a
+// narrowed token is no wider than it.
+func CreateToken(c *gin.Context, client *ent.Client) {
+	p := middleware.PrincipalOf(c)
+	if p.UserID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "auth is disabled; there is no user to issue a token for"})
+		return
+	}
+	createToken(c, client, p.UserID, p.Username, p.Limit)
+}
+
+// CreateUserToken issues a token for the user in the path, such as the
+// user an LO acts as.
+func CreateUserToken(c *gin.Context, client *ent.Client) {
+	if u, ok := findUser(c, client); ok {
+		createToken(c, client, u.ID, u.Username, nil)
+	}
+}
+
+func createToken(c *gin.Context, client *ent.Client, userID int, username string, limit *auth.Binding) {
+	var req TokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+	role, scope, err := narrow(req.Role, req.Scope, limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var expires *time.Time
+	if req.ExpiresIn != "" {
+		d, err := time.ParseDuration(req.ExpiresIn)
+		if err != nil || d <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid expires_in " + req.ExpiresIn})
+			return
+		}
+		t := time.Now().Add(d)
+		expires = &t
+	}
+	info, err := issueToken(c, client, userID, req.Name, role, scope, expires)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	info.User = username
+	c.JSON(http.StatusCreated, info)
+}
+
+// narrow checks the role and scope asked of a token, keeping them within
+// limit, the narrowing of the token it is made with.
+func narrow(role, scope string, limit *auth.Binding) (string, string, error) {
+	if role != "" {
+		if _, err := auth.ParseRole(role); err != nil {
+			return "", "", err
+		}
+	}
+	scope, err := auth.ParseScope(scope)
+	if err != nil {
+		return "", "", err
+	}
+	if limit == nil {
+		return role, scope, nil
+	}
+	switch {
+	case role == "" && limit.Role != auth.Admin:
+		role = string(limit.Role)
+	case role != "" && !limit.Role.Allows(auth.Role(role)):
+		return "", "", errors.New("a token cannot have a higher role than the token it is made with")
+	}
+	switch {
+	case scope == auth.AllScopes:
+		scope = limit.Scope
+	case limit.Scope != auth.AllScopes && scope != limit.Scope:
+		return "", "", errors.New("a token cannot have a wider scope than the token it is made with")
+	}
+	return role, scope, nil
+}
+
+func issueToken(ctx context.Context, client *ent.Client, userID int, name, role, scope string, expires *time.Time) (TokenInfo, error) {
+	token, prefix, hash, err := auth.NewToken()
+	if err != nil {
+		return TokenInfo{}, err
+	}
+	t, err := client.APIToken.Create().
+		SetName(name).
+		SetTokenHash(hash).
+		SetPrefix(prefix).
+		SetRole(role).
+		SetScope(scope).
+		SetNillableExpiresAt(expires).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return TokenInfo{}, err
+	}
+	info := tokenInfo(t)
+	info.Token = token
+	return info, nil
+}
+
+// RevokeToken deletes one of the caller's tokens; an admin may delete
+// anyone's.
+func RevokeToken(c *gin.Context, client *ent.Client) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid token id"})
+		return
+	}
+	t, err := client.APIToken.Query().Where(apitoken.ID(id)).WithUser().Only(c)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	// the token's user is not loaded if it is another organization's
+	if t.Edges.User == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "token not found"})
+		return
+	}
+	if t.Edges.User.ID != middleware.PrincipalOf(c).UserID && !middleware.Authorize(c, auth.Admin, auth.Site{}) {
+		return
+	}
+	if err := client.APIToken.DeleteOne(t).Exec(c); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// BootstrapAdmin creates the user admin, an admin of every site, when there
+// are no users at all, so that a new CO can be logged in to. An empty
+// password is made up and returned.
+func BootstrapAdmin(ctx context.Context, client *ent.Client, password string) (created bool, _ string, _ error) {
+	n, err := client.User.Query().Count(ctx)
+	if err != nil || n > 0 {
+		return false, "", err
+	}
+	if password == "" {
+		b := make([]byte, 18)
+		if _, err := rand.Read(b); err != nil {
+			return false, "", err
+		}
+		password = base64.RawURLEncoding.EncodeToString(b)
+	}
+	err = createUser(ctx, client, "admin", password, []auth.Binding{{Role: auth.Admin, Scope: auth.AllScopes}})
+	return err == nil, password, err
+}
+
+func checkBindings(bs []auth.Binding) error {
+	for i, b := range bs {
+		if _, err := auth.ParseRole(string(b.Role)); err != nil {
+			return err
+		}
+		scope, err := auth.ParseScope(b.Scope)
+		if err != nil {
+			return err
+		}
+		bs[i].Scope = scope
+	}
+	return nil
+}
+
+func setBindings(ctx context.Context, tx *ent.Tx, userID int, bs []auth.Binding) error {
+	seen := map[auth.Binding]bool{}
+	for _, b := range bs {
+		if seen[b] {
+			continue
+		}
+		seen[b] = true
+		if err := tx.RoleBinding.Create().
+			SetRole(rolebinding.Role(b.Role)).
+			SetScope(b.Scope).
+			SetUserID(userID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findUser(c *gin.Context, client *ent.Client) (*ent.User, bool) {
+	return findUserName(c, client, c.Param("name"))
+}
+
+func findUserName(c *gin.Context, client *ent.Client, name string) (*ent.User, bool) {
+	u, err := client.User.Query().Where(user.Username(name)).WithRoles().WithOrganization().Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return u, true
+}
+
+// userInfo describes u, whose roles and organization must be loaded.
+func userInfo(u *ent.User) UserInfo {
+	info := UserInfo{
+		Username:    u.Username,
+		Disabled:    u.Disabled,
+		HasPassword: u.PasswordHash != "",
+		Roles:       []auth.Binding{},
+		LastLoginAt: u.LastLoginAt,
+		CreatedAt:   u.CreatedAt,
+	}
+	if o := u.Edges.Organization; o != nil {
+		info.Organization = o.Name
+	}
+	for _, b := range u.Edges.Roles {
+		info.Roles = append(info.Roles, auth.Binding{Role: auth.Role(b.Role), Scope: b.Scope})
+	}
+	return info
+}
+
+func tokenInfo(t *ent.APIToken) TokenInfo {
+	info := TokenInfo{
+		ID:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Role:       t.Role,
+		Scope:      t.Scope,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+	if u := t.Edges.User; u != nil {
+		info.User = u.Username
+	}
+	return info
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent/host"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestAuth(t *testing.T) {
+	client := openTestDB(t)
+	if created, _, err := BootstrapAdmin(context.Background(), client, "admin-pw"); err != nil || !created {
+		t.Fatalf("bootstrap: %v %v", created, err)
+	}
+	if created, _, _ := BootstrapAdmin(context.Background(), client, ""); created {
+		t.Fatal("bootstrapped a second admin")
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/auth/login", func(c *gin.Context) { Login(c, client, 0) })
+	api := r.Group("", middleware.Authenticate(client, true))
+	api.POST("/auth/tokens", func(c *gin.Context) { CreateToken(c, client) })
+	api.POST("/users", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUser(c, client) })
+	api.PUT("/users/:name", middleware.Require(auth.Admin), func(c *gin.Context) { UpdateUser(c, client) })
+	api.POST("/users/:name/tokens", middleware.Require(auth.Admin), func(c *gin.Context) { CreateUserToken(c, client) })
+	api.GET("/sites", middleware.RequireSome(auth.Viewer), func(c *gin.Context) { ListSites(c, client) })
+	api.POST("/sites", middleware.Require(auth.Admin), func(c *gin.Context) { CreateSite(c, client) })
+	api.POST("/sites/:id/sync", middleware.RequireSite(auth.Deployer, SiteParam(client)), func(c *gin.Context) { SyncSite(c, client) })
+	api.PUT("/hosts/:id/inventory", middleware.RequireSome(auth.Deployer), func(c *gin.Context) { PutHostInventory(c, client) })
+
+	do := func(method, path, token string, in, out any) int {
+		var body bytes.Buffer
+		if in != nil {
+			json.NewEncoder(&body).Encode(in)
+		}
+		req := httptest.NewRequest(method, path, &body)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if out != nil {
+			json.Unmarshal(w.Body.Bytes(), out)
+		}
+		return w.Code
+	}
+
+	if code := do("POST", "/auth/login", "", LoginRequest{Username: "admin", Password: "guess"}, nil); code != http.StatusUnauthorized {
+		t.Errorf("bad password: %d", code)
+	}
+	var login TokenInfo
+	if code := do("POST", "/auth/login", "", LoginRequest{Username: "admin", Password: "admin-pw"}, &login); code != http.StatusOK || login.Token == "" {
+		t.Fatalf("login: %d %+v", code, login)
+	}
+	admin := login.Token
+	if code := do("GET", "/sites", "", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("no token: %d", code)
+	}
+	if code := do("GET", "/sites", "mco_forged", nil, nil); code != http.StatusUnauthorized {
+		t.Errorf("unknown token: %d", code)
+	}
+
+	for _, s := range []SiteRequest{{SiteID: "plant-1", Group: "emea"}, {SiteID: "plant-2"}} {
+		if code := do("POST", "/sites", admin, s, nil); code != http.StatusCreated {
+			t.Fatalf("create site: %d", code)
+		}
+	}
+
+	// an LO's user may deploy to its site and nothing else
+	roles := []auth.Binding{{Role: auth.Deployer, Scope: "group:emea"}}
+	if code := do("POST", "/users", admin, UserRequest{Username: "lo-1", Roles: &roles}, nil); code != http.StatusCreated {
+		t.Fatalf("create user: %d", code)
+	}
+	var issued TokenInfo
+	if code := do("POST", "/users/lo-1/tokens", admin, TokenRequest{Name: "lo"}, &issued); code != http.StatusCreated {
+		t.Fatalf("user token: %d", code)
+	}
+	lo := issued.Token
+	if code := do("POST", "/sites/plant-1/sync", lo, map[string]any{}, nil); code != http.StatusOK {
+		t.Errorf("sync own site: %d", code)
+	}
+	if code := do("POST", "/sites/plant-2/sync", lo, map[string]any{}, nil); code != http.StatusForbidden {
+		t.Errorf("sync other site: %d, want 403", code)
+	}
+	if code := do("POST", "/sites", lo, SiteRequest{SiteID: "plant-3"}, nil); code != http.StatusForbidden {
+		t.Errorf("deployer created a site: %d", code)
+	}
+
+	// nor take over another site's hosts through their inventory
+	if code := do("PUT", "/hosts/h2/inventory", admin, model.HardwareInventory{SiteID: "plant-2"}, nil); code != http.StatusOK {
+		t.Fatalf("inventory of h2: %d", code)
+	}
+	if code := do("PUT", "/hosts/h2/inventory", lo, model.HardwareInventory{SiteID: "plant-1"}, nil); code != http.StatusForbidden {
+		t.Errorf("lo-1 claimed h2: %d, want 403", code)
+	}
+	if code := do("PUT", "/hosts/h2/inventory", admin, model.HardwareInventory{SiteID: "plant-1"}, nil); code != http.StatusConflict {
+		t.Errorf("moved h2: %d, want 409", code)
+	}
+	if code := do("PUT", "/hosts/h1/inventory", lo, model.HardwareInventory{SiteID: "plant-1"}, nil); code != http.StatusOK {
+		t.Errorf("inventory of own host: %d", code)
+	}
+	if code := do("PUT", "/hosts/h1/inventory", lo, model.HardwareInventory{}, nil); code != http.StatusOK {
+		t.Errorf("inventory without a site: %d", code)
+	}
+	if h := client.Host.Query().Where(host.HostID("h1")).WithSite().OnlyX(context.Background()); h.Edges.Site.SiteID != "plant-1" {
+		t.Errorf("h1 at %s", h.Edges.Site.SiteID)
+	}
+	var list struct {
+		Sites []SiteInfo `json:"sites"`
+	}
+	if do("GET", "/sites", lo, nil, &list); len(list.Sites) != 1 || list.Sites[0].SiteID != "plant-1" {
+		t.Errorf("sites visible to lo-1: %+v", list.Sites)
+	}
+	if do("GET", "/sites", admin, nil, &list); len(list.Sites) != 2 {
+		t.Errorf("sites visible to admin: %+v", list.Sites)
+	}
+
+	// a token narrowed to viewer cannot do what its admin user can, nor
+	// make itself a wider one
+	var narrowed TokenInfo
+	if code := do("POST", "/auth/tokens", admin, TokenRequest{Name: "ci", Role: "viewer"}, &narrowed); code != http.StatusCreated {
+		t.Fatalf("narrowed token: %d", code)
+	}
+	if code := do("POST", "/sites", narrowed.Token, SiteRequest{SiteID: "plant-3"}, nil); code != http.StatusForbidden {
+		t.Errorf("viewer token created a site: %d", code)
+	}
+	if code := do("POST", "/auth/tokens", narrowed.Token, TokenRequest{Name: "wider", Role: "admin"}, nil); code != http.StatusBadRequest {
+		t.Errorf("widened a token: %d", code)
+	}
+
+	disabled := true
+	if code := do("PUT", "/users/lo-1", admin, UserRequest{Disabled: &disabled}, nil); code != http.StatusOK {
+		t.Fatalf("disable: %d", code)
+	}
+	if code := do("POST", "/sites/plant-1/sync", lo, map[string]any{}, nil); code != http.StatusUnauthorized {
+		t.Errorf("disabled user's token: %d", code)
+	}
+}
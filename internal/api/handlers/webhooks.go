@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	entwebhook "github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
+	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+// WebhookRequest creates or, with only the fields given, updates a webhook.
+type WebhookRequest struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Secret keys the deliveries' signatures; one is made up if empty.
+	Secret string `json:"secret"`
+	// Events are the event types delivered; none is all of them.
+	Events *[]string `json:"events"`
+	Active *bool     `json:"active"`
+}
+
+// WebhookInfo is a webhook as the API shows it. Secret is only shown when
+// the webhook is created.
+type WebhookInfo struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func webhookInfo(h *ent.Webhook) WebhookInfo {
+	events := h.EventTypes
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookInfo{
+		ID:        h.ID,
+		Name:      h.Name,
+		URL:       h.URL,
+		Events:    events,
+		Active:    h.Active,
+		CreatedBy: h.CreatedBy,
+		CreatedAt: h.CreatedAt,
+		UpdatedAt: h.UpdatedAt,
+	}
+}
+
+// ListWebhooks lists the webhooks, with the event types they can have.
+func ListWebhooks(c *gin.Context, client *ent.Client) {
+	hooks, err := client.Webhook.Query().Order(ent.Asc(entwebhook.FieldName)).All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	infos := make([]WebhookInfo, len(hooks))
+	for i, h := range hooks {
+		infos[i] = webhookInfo(h)
+	}
+	c.JSON(http.StatusOK, gin.H{"webhooks": infos, "event_types": webhook.EventTypes})
+}
+
+// GetWebhook shows the webhook :id.
+func GetWebhook(c *gin.Context, client *ent.Client) {
+	if h, ok := findWebhook(c, client); ok {
+		c.JSON(http.StatusOK, webhookInfo(h))
+	}
+}
+
+// CreateWebhook subscribes a URL to events. The response has the secret,
+// which is not shown again.
+func CreateWebhook(c *gin.Context, client *ent.Client) {
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	middleware.AuditTarget(c, req.Name)
+	if req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+	if err := checkWebhook(req, true); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		req.Secret = hex.EncodeToString(b)
+	}
+
+	create := client.Webhook.Create().
+		SetName(req.Name).
+		SetURL(req.URL).
+		SetSecret(req.Secret).
+		SetCreatedBy(middleware.Actor(c))
+	if req.Events != nil {
+		create.SetEventTypes(*req.Events)
+	}
+	if req.Active != nil {
+		create.SetActive(*req.Active)
+	}
+	h, err := create.Save(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	info := webhookInfo(h)
+	info.Secret = h.Secret
+	c.JSON(http.StatusCreated, info)
+}
+
+// UpdateWebhook changes the fields given of the webhook :id; a secret
+// given replaces the old one.
+func UpdateWebhook(c *gin.Context, client *ent.Client) {
+	h, ok := findWebhook(c, client)
+	if !ok {
+		return
+	}
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := checkWebhook(req, false); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	update := h.Update()
+	if req.Name != "" {
+		update.SetName(req.Name)
+	}
+	if req.URL != "" {
+		update.SetURL(req.URL)
+	}
+	if req.Secret != "" {
+		update.SetSecret(req.Secret)
+	}
+	if req.Events != nil {
+		update.SetEventTypes(*req.Events)
+	}
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+	h, err := update.Save(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, webhookInfo(h))
+}
+
+// DeleteWebhook removes the webhook :id and its delivery log.
+func DeleteWebhook(c *gin.Context, client *ent.Client) {
+	h, ok := findWebhook(c, client)
+	if !ok {
+		return
+	}
+	tx, err := client.Tx(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if _, err := tx.WebhookDelivery.Delete().Where(webhookdelivery.WebhookID(h.ID)).Exec(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := tx.Webhook.DeleteOneID(h.ID).Exec(c); err != nil {
+		tx.Rollback()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "deleted", "id": h.ID})
+}
+
+// ListWebhookDeliveries is the delivery log of the webhook :id, newest
+// first, optionally only of one ?status=; pages are ?limit= long (50 by
+// default) and the next is asked for with ?before=<next>.
+func ListWebhookDeliveries(c *gin.Context, client *ent.Client) {
+	h, ok := findWebhook(c, client)
+	if !ok {
+		return
+	}
+	q := client.WebhookDelivery.Query().Where(webhookdelivery.WebhookID(h.ID))
+	if s := c.Query("status"); s != "" {
+		q = q.Where(webhookdelivery.Status(s))
+	}
+	limit := defaultDeliveryLimit
+	if s := c.Query("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = min(n, maxDeliveryLimit)
+	}
+	if s := c.Query("before"); s != "" {
+		before, err := strconv.Atoi(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid before"})
+			return
+		}
+		q = q.Where(webhookdelivery.IDLT(before))
+	}
+	deliveries, err := q.Order(ent.Desc(webhookdelivery.FieldID)).Limit(limit).All(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	resp := gin.H{"deliveries": deliveries}
+	if len(deliveries) == limit {
+		resp["next"] = deliveries[len(deliveries)-1].ID
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RedeliverWebhook queues the delivery :delivery of the webhook :id to be
+// sent again, with its attempts starting over.
+func RedeliverWebhook(c *gin.Context, client *ent.Client) {
+	h, ok := findWebhook(c, client)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("delivery"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid delivery id"})
+		return
+	}
+	n, err := client.WebhookDelivery.Update().
+		Where(webhookdelivery.ID(id), webhookdelivery.WebhookID(h.ID)).
+		SetStatus(webhook.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Save(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "delivery not found"})
+		return
+	}
+	webhook.Wake()
+	c.JSON(http.StatusAccepted, gin.H{"status": webhook.StatusPending, "id": id})
+}
+
+// findWebhook looks up the webhook :id, responding if it is not there.
+func findWebhook(c *gin.Context, client *ent.Client) (*ent.Webhook, bool) {
+	middleware.AuditTarget(c, c.Param("id"))
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return nil, false
+	}
+	h, err := client.Webhook.Get(c, id)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook not found"})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return h, true
+}
+
+// checkWebhook checks the URL and event types of req, where given; a new
+// webhook needs a URL.
+func checkWebhook(req WebhookRequest, creating bool) error {
+	if creating || req.URL != "" {
+		u, err := url.Parse(req.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("url must be an http or https URL")
+		}
+		// the dispatcher refuses these too, whatever a name resolves to;
+		// this only turns away the ones that are plain from the URL
+		ip, err := netip.ParseAddr(u.Hostname())
+		if strings.EqualFold(u.Hostname(), "localhost") || (err == nil && webhook.Internal(ip)) {
+			return fmt.Errorf("url must not point at an internal address")
+		}
+	}
+	if req.Events != nil {
+		for _, e := range *req.Events {
+			if !slices.Contains(webhook.EventTypes, e) {
+				return fmt.Errorf("unknown event type %q", e)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/internal/webhook"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.GET("/webhooks", func(c *gin.Context) { ListWebhooks(c, client) })
+	r.POST("/webhooks", func(c *gin.Context) { CreateWebhook(c, client) })
+	r.PATCH("/webhooks/:id", func(c *gin.Context) { UpdateWebhook(c, client) })
+	r.DELETE("/webhooks/:id", func(c *gin.Context) { DeleteWebhook(c, client) })
+	r.GET("/webhooks/:id/deliveries", func(c *gin.Context) { ListWebhookDeliveries(c, client) })
+	r.POST("/webhooks/:id/deliveries/:delivery/redeliver", func(c *gin.Context) { RedeliverWebhook(c, client) })
+
+	do := func(method, path string, body any, out any) int {
+		t.Helper()
+		b, _ := json.Marshal(body)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, bytes.NewReader(b)))
+		if out != nil {
+			json.Unmarshal(w.Body.Bytes(), out)
+		}
+		return w.Code
+	}
+
+	events := []string{"host.exploded"}
+	if code := do("POST", "/webhooks", WebhookRequest{Name: "x", URL: "http://example.com", Events: &events}, nil); code != http.StatusBadRequest {
+		t.Errorf("unknown event type: %d", code)
+	}
+	if code := do("POST", "/webhooks", WebhookRequest{Name: "x", URL: "ftp://example.com"}, nil); code != http.StatusBadRequest {
+		t.Errorf("ftp url: %d", code)
+	}
+	for _, u := range []string{"http://127.0.0.1:8080/hook", "http://[::1]/hook", "https://169.254.169.254/", "http://10.0.0.5/", "http://localhost/hook"} {
+		if code := do("POST", "/webhooks", WebhookRequest{Name: "x", URL: u}, nil); code != http.StatusBadRequest {
+			t.Errorf("internal url %s: %d", u, code)
+		}
+	}
+	events = []string{webhook.EventHostDead, webhook.EventHostRecovered}
+	var created WebhookInfo
+	if code := do("POST", "/webhooks", WebhookRequest{Name: "pager", URL: "https://example.com/hook", Events: &events}, &created); code != http.StatusCreated {
+		t.Fatalf("create: %d", code)
+	}
+	if len(created.Secret) != 64 {
+		t.Errorf("secret %q", created.Secret)
+	}
+	var list struct {
+		Webhooks   []WebhookInfo `json:"webhooks"`
+		EventTypes []string      `json:"event_types"`
+	}
+	do("GET", "/webhooks", nil, &list)
+	if len(list.Webhooks) != 1 || list.Webhooks[0].Secret != "" || len(list.EventTypes) != len(webhook.EventTypes) {
+		t.Errorf("list = %+v", list)
+	}
+
+	// a host dies and comes back
+	report := model.SiteSync{SiteID: "plant-1", Hosts: []model.HostSync{{HostID: "h1", Status: model.HostOnline}}}
+	for _, status := range []string{model.HostOnline, model.HostOffline, model.HostOffline, model.HostOnline} {
+		report.Hosts[0].Status = status
+		if err := syncSite(ctx, client, report); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var log struct {
+		Deliveries []ent.WebhookDelivery `json:"deliveries"`
+		Next       int                   `json:"next"`
+	}
+	do("GET", "/webhooks/"+created.ID.String()+"/deliveries", nil, &log)
+	if len(log.Deliveries) != 2 || log.Deliveries[0].EventType != webhook.EventHostRecovered ||
+		log.Deliveries[1].EventType != webhook.EventHostDead {
+		t.Fatalf("deliveries = %+v", log.Deliveries)
+	}
+	do("GET", "/webhooks/"+created.ID.String()+"/deliveries?limit=1", nil, &log)
+	if len(log.Deliveries) != 1 || log.Next != log.Deliveries[0].ID {
+		t.Errorf("first page = %+v, next %d", log.Deliveries, log.Next)
+	}
+
+	// only recoveries from now on
+	events = []string{webhook.EventHostRecovered}
+	var updated WebhookInfo
+	if code := do("PATCH", "/webhooks/"+created.ID.String(), WebhookRequest{Events: &events}, &updated); code != http.StatusOK ||
+		updated.Name != "pager" || len(updated.Events) != 1 {
+		t.Errorf("update: %d %+v", code, updated)
+	}
+	report.Hosts[0].Status = model.HostOffline
+	syncSite(ctx, client, report)
+	if n := client.WebhookDelivery.Query().CountX(ctx); n != 2 {
+		t.Errorf("%d deliveries after unsubscribing", n)
+	}
+
+	dead := client.WebhookDelivery.GetX(ctx, log.Deliveries[0].ID-1)
+	dead.Update().SetStatus(webhook.StatusFailed).SetAttempts(10).ExecX(ctx)
+	path := "/webhooks/" + created.ID.String() + "/deliveries/"
+	if code := do("POST", path+"9999/redeliver", nil, nil); code != http.StatusNotFound {
+		t.Errorf("redeliver missing: %d", code)
+	}
+	if code := do("POST", path+strconv.Itoa(dead.ID)+"/redeliver", nil, nil); code != http.StatusAccepted {
+		t.Errorf("redeliver: %d", code)
+	}
+	if d := client.WebhookDelivery.GetX(ctx, dead.ID); d.Status != webhook.StatusPending || d.Attempts != 0 {
+		t.Errorf("redelivered = %+v", d)
+	}
+
+	if code := do("DELETE", "/webhooks/"+created.ID.String(), nil, nil); code != http.StatusOK {
+		t.Fatalf("delete: %d", code)
+	}
+	if n := client.WebhookDelivery.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d deliveries left", n)
+	}
+}
@@ -0,0 +1,173 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+)
+
+const auditTargetKey = "audit_target"
+
+// Results of an audited request.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// maxAuditError is how much of a failed response is kept as its error.
+const maxAuditError = 1024
+
+// maxRequestBody bounds the body of an audited request.
+const maxRequestBody = 8 << 20
+
+// AuditTarget names what the request acted on, for its audit entry, when
+// the path does not say: a deployment or app just created, say.
+func AuditTarget(c *gin.Context, target string) {
+	c.Set(auditTargetKey, target)
+}
+
+// Actor is who the request acts for, as the audit log and the deployments
+// repo record it. With auth disabled it is the X-Author header, if any,
+// marked as Unauthenticated: anyone could have sent it.
+func Actor(c *gin.Context) string {
+	p := PrincipalOf(c)
+	if p == nil || p == auth.Anonymous {
+		if a := c.GetHeader("X-Author"); a != "" {
+			return Unauthenticated(a)
+		}
+		return auth.Anonymous.Username
+	}
+	return p.Username
+}
+
+// Unauthenticated marks a name the caller gave for itself, which nothing
+// vouches for, so that it is not taken for a user's.
+func Unauthenticated(name string) string {
+	return "unauthenticated:" + name
+}
+
+// Audit records every mutating request, that is anything but GET, HEAD and
+// OPTIONS, in the audit log once it has been answered: who made it, the
+// route, its target, the hash of its body, its status and where it came
+// from. Use it after Authenticate, so that requests turned away for their
+// role are recorded too.
+func Audit(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		// the body is hashed as the handler reads it, not held for it
+		var body *hashedBody
+		if c.Request.Body != nil {
+			limited := http.MaxBytesReader(c.Writer, c.Request.Body, maxRequestBody)
+			body = &hashedBody{Closer: limited, hash: sha256.New()}
+			body.r = io.TeeReader(limited, body.hash)
+			c.Request.Body = body
+		}
+		w := &auditWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		var hash string
+		if body != nil {
+			// what the handler left unread is part of the request too
+			if _, err := io.Copy(io.Discard, body); err == nil && body.n > 0 {
+				hash = hex.EncodeToString(body.hash.Sum(nil))
+			}
+		}
+
+		action := c.FullPath()
+		if action == "" {
+			action = c.Request.URL.Path
+		}
+		target := c.GetString(auditTargetKey)
+		if target == "" {
+			target = c.Request.URL.Path
+		}
+		status := w.Status()
+		create := client.AuditEntry.Create().
+			SetActor(Actor(c)).
+			SetAction(c.Request.Method + " " + action).
+			SetTarget(target).
+			SetPayloadHash(hash).
+			SetStatus(status).
+			SetResult(AuditSuccess).
+			SetSourceIP(c.ClientIP())
+		if status >= http.StatusBadRequest {
+			create.SetResult(AuditFailure).SetError(w.errorMessage())
+		}
+		// the request context carries the tenant; the entry is kept even
+		// if the caller has gone
+		if err := create.Exec(context.WithoutCancel(c.Request.Context())); err != nil {
+			log.Printf("audit: %s %s by %s not recorded: %v", c.Request.Method, c.Request.URL.Path, Actor(c), err)
+		}
+	}
+}
+
+// hashedBody is a request body that hashes what is read from it.
+type hashedBody struct {
+	io.Closer
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+}
+
+func (b *hashedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// auditWriter keeps the start of an error response for the audit entry.
+type auditWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	w.keep(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *auditWriter) WriteString(s string) (int, error) {
+	w.keep([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *auditWriter) keep(b []byte) {
+	if w.Status() < http.StatusBadRequest {
+		return
+	}
+	if n := maxAuditError - w.body.Len(); n > 0 {
+		w.body.Write(b[:min(n, len(b))])
+	}
+}
+
+// errorMessage is the "error" of a JSON error response, else its body.
+func (w *auditWriter) errorMessage() string {
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(w.body.Bytes(), &resp) == nil && resp.Error != "" {
+		return resp.Error
+	}
+	if w.body.Len() == 0 {
+		return http.StatusText(w.Status())
+	}
+	return w.body.String()
+}
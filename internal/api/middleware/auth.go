@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/apitoken"
+	"github.com/balaji-balu/margo-hello-world/ent/organization"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+)
+
+const principalKey = "principal"
+
+// OrganizationHeader names the organization a platform user acts within.
+const OrganizationHeader = "X-Organization"
+
+// Authenticate finds who the request acts for from its bearer token and
+// turns it away if it has none. With auth disabled every request acts for
+// auth.Anonymous, an admin of every site.
+//
+// The request then acts within the principal's organization: handlers and
+// ent queries made with the request's context see only its apps, sites,
+// deployments and users. A platform user acts across organizations, or
+// within the one named by the X-Organization header.
+func Authenticate(client *ent.Client, enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled {
+			c.Set(principalKey, auth.Anonymous)
+			if scope(c, client, auth.Anonymous) {
+				c.Next()
+			}
+			return
+		}
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="co"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+		p, err := principalOf(c, client, token)
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="co", error="invalid_token"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(principalKey, p)
+		if scope(c, client, p) {
+			c.Next()
+		}
+	}
+}
+
+// scope puts the tenant p acts within in the request's context, answering
+// the request itself if it names an organization that does not exist.
+func scope(c *gin.Context, client *ent.Client, p *auth.Principal) bool {
+	t := tenant.Tenant{ID: p.OrgID, Name: p.Organization}
+	if p.Platform() {
+		name := c.GetHeader(OrganizationHeader)
+		if name == "" {
+			return true
+		}
+		org, err := client.Organization.Query().Where(organization.Name(name)).Only(c)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "organization " + name + " not found"})
+			return false
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return false
+		}
+		t = tenant.Tenant{ID: org.ID, Name: org.Name}
+	}
+	c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), t))
+	return true
+}
+
+var (
+	errInvalidToken = errors.New("invalid or expired token")
+	errDisabled     = errors.New("user is disabled")
+)
+
+// principalOf looks up the user and bindings of token.
+func principalOf(c *gin.Context, client *ent.Client, token string) (*auth.Principal, error) {
+	t, err := client.APIToken.Query().
+		Where(apitoken.TokenHash(auth.HashToken(token))).
+		WithUser(func(q *ent.UserQuery) { q.WithRoles().WithOrganization() }).
+		Only(c)
+	if ent.IsNotFound(err) {
+		return nil, errInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if t.ExpiresAt != nil && now.After(*t.ExpiresAt) {
+		return nil, errInvalidToken
+	}
+	u := t.Edges.User
+	if u.Disabled {
+		return nil, errDisabled
+	}
+	// last use is a hint, not worth failing the request over
+	client.APIToken.UpdateOne(t).SetLastUsedAt(now).Exec(c)
+
+	p := &auth.Principal{UserID: u.ID, Username: u.Username, OrgID: u.OrgID}
+	if org := u.Edges.Organization; org != nil {
+		p.Organization = org.Name
+	}
+	for _, b := range u.Edges.Roles {
+		p.Bindings = append(p.Bindings, auth.Binding{Role: auth.Role(b.Role), Scope: b.Scope})
+	}
+	if t.Role != "" || t.Scope != auth.AllScopes {
+		p.Limit = &auth.Binding{Role: auth.Role(t.Role), Scope: t.Scope}
+		if t.Role == "" {
+			p.Limit.Role = auth.Admin
+		}
+	}
+	return p, nil
+}
+
+// PrincipalOf is who the request acts for, as set by Authenticate.
+func PrincipalOf(c *gin.Context) *auth.Principal {
+	p, _ := c.Get(principalKey)
+	principal, _ := p.(*auth.Principal)
+	return principal
+}
+
+// RequirePlatform lets through requests whose principal is an admin of the
+// platform rather than of one organization: for managing organizations.
+func RequirePlatform() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := PrincipalOf(c)
+		if !p.Platform() || !p.Can(auth.Admin, auth.Site{}) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden: requires a platform admin"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// Require lets through requests whose principal has role on every site:
+// for what belongs to no one site, such as apps and users.
+func Require(role auth.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if Authorize(c, role, auth.Site{}) {
+			c.Next()
+		}
+	}
+}
+
+// RequireSome lets through requests whose principal has role on some site.
+// The handler then checks, or filters by, the sites it touches.
+func RequireSome(role auth.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !PrincipalOf(c).CanSome(role) {
+			forbid(c, role)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireSite lets through requests whose principal has role on the site
+// the request is about, as site finds it.
+func RequireSite(role auth.Role, site func(*gin.Context) (auth.Site, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s, err := site(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if Authorize(c, role, s) {
+			c.Next()
+		}
+	}
+}
+
+// Authorize is whether the request's principal has role on site. If not,
+// it answers the request with 403.
+func Authorize(c *gin.Context, role auth.Role, site auth.Site) bool {
+	if !PrincipalOf(c).Can(role, site) {
+		forbid(c, role)
+		return false
+	}
+	return true
+}
+
+// Can is whether the request's principal has role on site, for handlers
+// that leave out what the caller may not see.
+func Can(c *gin.Context, role auth.Role, site auth.Site) bool {
+	return PrincipalOf(c).Can(role, site)
+}
+
+func forbid(c *gin.Context, role auth.Role) {
+	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden: requires the " + string(role) + " role"})
+}
@@ -1,10 +1,26 @@
 package middleware
 
-import "github.com/gin-gonic/gin"
+import (
+	"slices"
 
-func CORSMiddleware() gin.HandlerFunc {
+	"github.com/gin-gonic/gin"
+)
+
+// CORSMiddleware lets browsers on origins call the API. No origins means
+// any origin, which only suits development.
+func CORSMiddleware(origins []string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+		origin := c.GetHeader("Origin")
+		switch {
+		case len(origins) == 0:
+			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+		case slices.Contains(origins, origin):
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Add("Vary", "Origin")
+		case origin != "" && c.Request.Method == "OPTIONS":
+			c.AbortWithStatus(403)
+			return
+		}
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		if c.Request.Method == "OPTIONS" {
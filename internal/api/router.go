@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"log"
 	"os"
 	"github.com/gin-gonic/gin"
@@ -8,20 +9,30 @@ import (
 	"github.com/balaji-balu/margo-hello-world/ent"
 	"github.com/balaji-balu/margo-hello-world/internal/api/handlers"
 	"github.com/balaji-balu/margo-hello-world/internal/api/middleware"
+	"github.com/balaji-balu/margo-hello-world/internal/auth"
 	//"github.com/balaji-balu/margo-hello-world/internal/config"
 	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
 	"github.com/balaji-balu/margo-hello-world/internal/gitfetcher"
+	"github.com/balaji-balu/margo-hello-world/internal/ocifetch"
 	"github.com/balaji-balu/margo-hello-world/internal/co"
 	"github.com/balaji-balu/margo-hello-world/pkg/co/model"
 )
 
 func NewRouter(client *ent.Client, co *co.CO, cfg model.CoConfig) *gin.Engine {
 	r := gin.New()
+	// handlers query with the gin context; let it see the tenant the
+	// request context carries
+	r.ContextWithFallback = true
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
-	r.Use(middleware.CORSMiddleware())
+	r.Use(middleware.CORSMiddleware(cfg.Server.CORSOrigins))
 
-	sm := streammanager.NewStreamManager()
+	sm := streammanager.NewStreamManager(client)
+	sm.Retention = streammanager.Retention{
+		MaxAge:   cfg.Events.MaxAge,
+		MaxCount: cfg.Events.MaxCount,
+	}.Or(sm.Retention)
+	go sm.Run(context.Background())
 
 	log.Println("App registry details:",
 		cfg.Appregistry.Repo,
@@ -33,28 +44,160 @@ func NewRouter(client *ent.Client, co *co.CO, cfg model.CoConfig) *gin.Engine {
 		LocalDir: "./cache/app-registry",
 		Token: os.Getenv("GITHUB_TOKEN"),
 	}
+	puller := ocifetch.Puller{
+		Registry:  cfg.Appregistry.OCI.Registry,
+		Username:  cfg.Appregistry.OCI.Username,
+		Password:  os.Getenv("OCI_REGISTRY_PASSWORD"),
+		PlainHTTP: cfg.Appregistry.OCI.PlainHTTP,
+	}
+
+	if !cfg.Auth.Enabled {
+		log.Println("WARNING: auth is disabled; anyone who can reach the API is an admin")
+	}
+	authn := middleware.Authenticate(client, cfg.Auth.Enabled)
+	viewer := middleware.RequireSome(auth.Viewer)
+	deployer := middleware.RequireSome(auth.Deployer)
+	admin := middleware.Require(auth.Admin)
+	platform := middleware.RequirePlatform()
+	siteViewer := middleware.RequireSite(auth.Viewer, handlers.SiteParam(client))
+	siteDeployer := middleware.RequireSite(auth.Deployer, handlers.SiteParam(client))
+	siteAdmin := middleware.RequireSite(auth.Admin, handlers.SiteParam(client))
+	depViewer := middleware.RequireSite(auth.Viewer, handlers.DeploymentSite(client))
+	depDeployer := middleware.RequireSite(auth.Deployer, handlers.DeploymentSite(client))
 
-	api := r.Group("/api/v1")
+	open := r.Group("/api/v1")
 	{
-		api.GET("/apps", func(c *gin.Context) { handlers.ListApps(c, client) })
-		api.POST("/apps", func(c *gin.Context) { 
-			handlers.CreateApp(c, client, &fetcher) })
-		api.GET("/apps/:id", func(c *gin.Context) { handlers.GetApp(c, client) })
-		api.DELETE("/apps", func(c *gin.Context) { handlers.DeleteApp(c, client)})	
-
-		api.POST("/deployments/:id/status", func(c *gin.Context) { 
-			handlers.DeploymentStatusHandler(c, client, sm) })
-		api.GET("/deployments", func(c *gin.Context){
+		open.GET("/healthz", handlers.HealthzHandler)
+		open.POST("/auth/login", func(c *gin.Context) {
+			handlers.Login(c, client, cfg.Auth.TokenTTL) })
+	}
+
+	// every mutating request past authentication is audited
+	api := r.Group("/api/v1", authn, middleware.Audit(client))
+	{
+		api.GET("/auth/whoami", handlers.WhoAmI)
+		api.GET("/auth/tokens", func(c *gin.Context) {
+			handlers.ListTokens(c, client) })
+		api.POST("/auth/tokens", func(c *gin.Context) {
+			handlers.CreateToken(c, client) })
+		api.DELETE("/auth/tokens/:id", func(c *gin.Context) {
+			handlers.RevokeToken(c, client) })
+
+		api.GET("/audit", admin, func(c *gin.Context) {
+			handlers.ListAudit(c, client) })
+		api.GET("/audit/export", admin, func(c *gin.Context) {
+			handlers.ExportAudit(c, client) })
+
+		api.GET("/webhooks", admin, func(c *gin.Context) {
+			handlers.ListWebhooks(c, client) })
+		api.POST("/webhooks", admin, func(c *gin.Context) {
+			handlers.CreateWebhook(c, client) })
+		api.GET("/webhooks/:id", admin, func(c *gin.Context) {
+			handlers.GetWebhook(c, client) })
+		api.PATCH("/webhooks/:id", admin, func(c *gin.Context) {
+			handlers.UpdateWebhook(c, client) })
+		api.DELETE("/webhooks/:id", admin, func(c *gin.Context) {
+			handlers.DeleteWebhook(c, client) })
+		api.GET("/webhooks/:id/deliveries", admin, func(c *gin.Context) {
+			handlers.ListWebhookDeliveries(c, client) })
+		api.POST("/webhooks/:id/deliveries/:delivery/redeliver", admin, func(c *gin.Context) {
+			handlers.RedeliverWebhook(c, client) })
+
+		api.GET("/events", viewer, func(c *gin.Context) {
+			handlers.StreamEvents(c, client, sm) })
+
+		api.GET("/org", func(c *gin.Context) {
+			handlers.GetOwnOrg(c, client) })
+		api.GET("/orgs", platform, func(c *gin.Context) {
+			handlers.ListOrgs(c, client) })
+		api.POST("/orgs", platform, func(c *gin.Context) {
+			handlers.CreateOrg(c, client) })
+		api.GET("/orgs/:org", platform, func(c *gin.Context) {
+			handlers.GetOrg(c, client) })
+		api.PUT("/orgs/:org", platform, func(c *gin.Context) {
+			handlers.UpdateOrg(c, client) })
+		api.DELETE("/orgs/:org", platform, func(c *gin.Context) {
+			handlers.DeleteOrg(c, client) })
+
+		api.GET("/users", admin, func(c *gin.Context) {
+			handlers.ListUsers(c, client) })
+		api.POST("/users", admin, func(c *gin.Context) {
+			handlers.CreateUser(c, client) })
+		api.GET("/users/:name", admin, func(c *gin.Context) {
+			handlers.GetUser(c, client) })
+		api.PUT("/users/:name", admin, func(c *gin.Context) {
+			handlers.UpdateUser(c, client) })
+		api.DELETE("/users/:name", admin, func(c *gin.Context) {
+			handlers.DeleteUser(c, client) })
+		api.GET("/users/:name/tokens", admin, func(c *gin.Context) {
+			handlers.ListUserTokens(c, client) })
+		api.POST("/users/:name/tokens", admin, func(c *gin.Context) {
+			handlers.CreateUserToken(c, client) })
+
+		api.GET("/apps", viewer, func(c *gin.Context) { handlers.ListApps(c, client) })
+		api.POST("/apps", admin, func(c *gin.Context) { 
+			handlers.CreateApp(c, client, &fetcher, &puller) })
+		api.GET("/apps/:id", viewer, func(c *gin.Context) { handlers.GetApp(c, client) })
+		api.GET("/apps/:id/icon", viewer, func(c *gin.Context) { handlers.GetAppIcon(c, client) })
+		api.DELETE("/apps", admin, func(c *gin.Context) { handlers.DeleteApp(c, client)})	
+
+		api.POST("/deployments/:id/status", depDeployer, func(c *gin.Context) { 
+			handlers.DeploymentStatusHandler(c, client) })
+		api.GET("/deployments", viewer, func(c *gin.Context){
 			handlers.ListDeploymentsStatus(c, client) })
-		api.GET("/deployments/:id/status", func(c *gin.Context) { 
+		api.GET("/deployments/:id/status", depViewer, func(c *gin.Context) { 
 			handlers.GetDeploymentStatus(c, client) })
-		api.GET("/deployments/:id/stream", func(c *gin.Context) { 
-			handlers.HandleStreamDeployment(c, sm) })			
-		api.POST("/deployments", func(c *gin.Context) { 
+		api.GET("/deployments/:id/stream", depViewer, func(c *gin.Context) { 
+			handlers.HandleStreamDeployment(c, client, sm) })			
+		api.POST("/deployments", deployer, func(c *gin.Context) { 
 			handlers.CreateDeployment(c,co, client, cfg.Git.Repo) })
+		api.PUT("/deployments/:id", depDeployer, func(c *gin.Context) {
+			handlers.UpdateDeployment(c, co, client) })
+		api.GET("/deployments/:id/revisions", depViewer, func(c *gin.Context) {
+			handlers.ListRevisions(c, client) })
+		api.GET("/deployments/:id/revisions/:rev", depViewer, func(c *gin.Context) {
+			handlers.GetRevision(c, client) })
+		api.GET("/deployments/:id/diff", depViewer, func(c *gin.Context) {
+			handlers.DiffRevisions(c, client) })
+		api.POST("/deployments/:id/revisions/:rev/redeploy", depDeployer, func(c *gin.Context) {
+			handlers.RedeployRevision(c, co, client) })
+
+		api.GET("/sites", viewer, func(c *gin.Context) {
+			handlers.ListSites(c, client) })
+		api.POST("/sites", admin, func(c *gin.Context) {
+			handlers.CreateSite(c, client) })
+		api.GET("/sites/:id", siteViewer, func(c *gin.Context) {
+			handlers.GetSite(c, client) })
+		api.PUT("/sites/:id", siteAdmin, func(c *gin.Context) {
+			handlers.UpdateSite(c, client) })
+		api.DELETE("/sites/:id", siteAdmin, func(c *gin.Context) {
+			handlers.DeleteSite(c, client) })
+		api.POST("/sites/:id/sync", siteDeployer, func(c *gin.Context) {
+			handlers.SyncSite(c, client) })
+		api.GET("/sites/:id/hosts", siteViewer, func(c *gin.Context) {
+			handlers.ListHosts(c, client) })
+		api.GET("/sites/:id/hosts/:host", siteViewer, func(c *gin.Context) {
+			handlers.GetSiteHost(c, client) })
+		api.GET("/hosts", viewer, func(c *gin.Context) {
+			handlers.ListHosts(c, client) })
 
-		api.GET("/healthz", handlers.HealthzHandler)
+		api.GET("/orchestrators", viewer, func(c *gin.Context) {
+			handlers.ListOrchestrators(c, client) })
+		api.POST("/orchestrators", platform, func(c *gin.Context) {
+			handlers.CreateOrchestrator(c, client) })
+		api.GET("/orchestrators/:id", viewer, func(c *gin.Context) {
+			handlers.GetOrchestrator(c, client) })
+		api.PUT("/orchestrators/:id", platform, func(c *gin.Context) {
+			handlers.UpdateOrchestrator(c, client) })
+		api.DELETE("/orchestrators/:id", platform, func(c *gin.Context) {
+			handlers.DeleteOrchestrator(c, client) })
 
+		api.PUT("/hosts/:id/inventory", deployer, func(c *gin.Context) {
+			handlers.PutHostInventory(c, client) })
+		api.GET("/hosts/:id/inventory", viewer, func(c *gin.Context) {
+			handlers.GetHostInventory(c, client) })
+		api.GET("/inventory", viewer, func(c *gin.Context) {
+			handlers.ListInventory(c, client) })
 	}
 
 	return r
@@ -0,0 +1,120 @@
+package artifacts
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Normalize spells out an image reference in full, as Docker does:
+// nginx:1.25 is docker.io/library/nginx:1.25.
+func Normalize(ref string) string {
+	first, rest, ok := strings.Cut(ref, "/")
+	if ok && isRegistry(first) {
+		return ref
+	}
+	if !ok {
+		return "docker.io/library/" + ref
+	}
+	return "docker.io/" + first + "/" + rest
+}
+
+func isRegistry(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
+
+// Repository is the full repository name for a mirror request for name,
+// made on behalf of registry ns. Without ns, name must start with the
+// registry, as in lo:8081/ghcr.io/org/app.
+func Repository(ns, name string) string {
+	if ns == "" {
+		ns = "docker.io"
+		if first, rest, ok := strings.Cut(name, "/"); ok && isRegistry(first) {
+			ns, name = first, rest
+		}
+	}
+	if ns == "registry-1.docker.io" {
+		ns = "docker.io"
+	}
+	if ns == "docker.io" && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	return ns + "/" + name
+}
+
+// PackageURL is where mirror serves the package published at u. Anything
+// but an http(s) URL, such as a local key file, is returned as is.
+func PackageURL(mirror, u string) string {
+	if mirror == "" {
+		return u
+	}
+	p, err := url.Parse(u)
+	if err != nil || (p.Scheme != "http" && p.Scheme != "https") {
+		return u
+	}
+	return strings.TrimSuffix(mirror, "/") + "/packages/" + p.Scheme + "/" + strings.TrimPrefix(u, p.Scheme+"://")
+}
+
+// Transport sends registry requests meant for any host to mirror instead,
+// naming the original registry in the ns query parameter as containerd
+// does for its mirrors.
+func Transport(mirror string, base http.RoundTripper) (http.RoundTripper, error) {
+	m, err := url.Parse(mirror)
+	if err != nil {
+		return nil, err
+	}
+	if m.Host == "" || (m.Scheme != "http" && m.Scheme != "https") {
+		return nil, fmt.Errorf("mirror %q: want http(s)://host[:port]", mirror)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &mirrorTransport{mirror: m, base: base}, nil
+}
+
+type mirrorTransport struct {
+	mirror *url.URL
+	base   http.RoundTripper
+}
+
+func (t *mirrorTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Host == t.mirror.Host {
+		return t.base.RoundTrip(r)
+	}
+	r = r.Clone(r.Context())
+	q := r.URL.Query()
+	q.Set("ns", r.URL.Host)
+	r.URL.RawQuery = q.Encode()
+	r.URL.Scheme, r.URL.Host, r.Host = t.mirror.Scheme, t.mirror.Host, t.mirror.Host
+	return t.base.RoundTrip(r)
+}
+
+// Authorize has base send token, the one the site's LO gives its ERAs, with
+// requests to mirror, and with no others. A nil base is
+// http.DefaultTransport.
+func Authorize(mirror, token string, base http.RoundTripper) (http.RoundTripper, error) {
+	m, err := url.Parse(mirror)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tokenTransport{host: m.Host, token: token, base: base}, nil
+}
+
+type tokenTransport struct {
+	host  string
+	token string
+	base  http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Host != t.host || t.token == "" {
+		return t.base.RoundTrip(r)
+	}
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(r)
+}
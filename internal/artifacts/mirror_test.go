@@ -0,0 +1,114 @@
+package artifacts
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	for in, want := range map[string]string{
+		"nginx:1.25":                  "docker.io/library/nginx:1.25",
+		"acme/api:1.0":                "docker.io/acme/api:1.0",
+		"ghcr.io/acme/api:1.0":        "ghcr.io/acme/api:1.0",
+		"localhost/api:1":             "localhost/api:1",
+		"registry:5000/api@sha256:ab": "registry:5000/api@sha256:ab",
+	} {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRepository(t *testing.T) {
+	for _, c := range []struct{ ns, name, want string }{
+		{"ghcr.io", "acme/api", "ghcr.io/acme/api"},
+		{"docker.io", "nginx", "docker.io/library/nginx"},
+		{"registry-1.docker.io", "acme/api", "docker.io/acme/api"},
+		{"", "ghcr.io/acme/api", "ghcr.io/acme/api"},
+		{"", "nginx", "docker.io/library/nginx"},
+	} {
+		if got := Repository(c.ns, c.name); got != c.want {
+			t.Errorf("Repository(%q, %q) = %q, want %q", c.ns, c.name, got, c.want)
+		}
+	}
+}
+
+func TestPackageURL(t *testing.T) {
+	const mirror = "http://lo:8081/"
+	for in, want := range map[string]string{
+		"https://pkgs.acme.io/api.tgz":     "http://lo:8081/packages/https/pkgs.acme.io/api.tgz",
+		"https://pkgs.acme.io/api.tgz.sig": "http://lo:8081/packages/https/pkgs.acme.io/api.tgz.sig",
+		"/etc/era/keys/acme.pub":           "/etc/era/keys/acme.pub",
+	} {
+		if got := PackageURL(mirror, in); got != want {
+			t.Errorf("PackageURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if got := PackageURL("", "https://x/y"); got != "https://x/y" {
+		t.Errorf("no mirror: %q", got)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var got *http.Request
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	}))
+	defer mirror.Close()
+
+	rt, err := Transport(mirror.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: rt}
+	resp, err := client.Get("https://ghcr.io/v2/acme/api/manifests/1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got == nil || got.URL.Path != "/v2/acme/api/manifests/1.0" || got.URL.Query().Get("ns") != "ghcr.io" {
+		t.Fatalf("mirror got %v", got)
+	}
+
+	if _, err := Transport("lo:8081", nil); err == nil {
+		t.Error("accepted a mirror without a scheme")
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	auth := map[string]string{}
+	record := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth[name] = r.Header.Get("Authorization")
+		}))
+	}
+	mirror, other := record("mirror"), record("other")
+	defer mirror.Close()
+	defer other.Close()
+
+	base, err := Authorize(mirror.URL, "s3cret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt, err := Transport(mirror.URL, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Transport: rt}).Get("https://ghcr.io/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if auth["mirror"] != "Bearer s3cret" {
+		t.Errorf("mirror got %q", auth["mirror"])
+	}
+	resp, err = (&http.Client{Transport: base}).Get(other.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if auth["other"] != "" {
+		t.Errorf("token sent to another host: %q", auth["other"])
+	}
+}
@@ -0,0 +1,337 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+)
+
+// fetchTimeout bounds one pull from upstream. Pulls are shared by every
+// request waiting for the artifact, so they do not end with the request
+// that started them.
+const fetchTimeout = 30 * time.Minute
+
+// MaxPackageSize bounds the package Download stores, so that a package
+// server cannot fill the disk.
+var MaxPackageSize int64 = 4 << 30
+
+// maxRedirects is how many redirects a package download follows, as
+// net/http does by default.
+const maxRedirects = 10
+
+// Upstream is where a pull-through store gets what it does not have.
+type Upstream struct {
+	// Registry opens the repository of an image reference; nil means
+	// RemoteRepository.
+	Registry func(ref registry.Reference) (oras.ReadOnlyTarget, error)
+	// HTTPClient downloads packages; nil means http.DefaultClient.
+	HTTPClient *http.Client
+	// Registries are the registries images are pulled from, e.g.
+	// docker.io, and PackageHosts the hosts (host or host:port) packages
+	// are downloaded from. Anything else is refused, so the store cannot
+	// be used to reach other hosts.
+	Registries   []string
+	PackageHosts []string
+}
+
+// ErrNotAllowed is returned for artifacts of an upstream the store does
+// not pull from.
+var ErrNotAllowed = errors.New("upstream not allowed")
+
+// allows is whether ref, an image reference or package URL, may be pulled
+// from upstream.
+func (up *Upstream) allows(ref string) bool {
+	if IsPackage(ref) {
+		u, err := url.Parse(ref)
+		return err == nil && (slices.Contains(up.PackageHosts, u.Host) || slices.Contains(up.PackageHosts, u.Hostname()))
+	}
+	r, err := registry.ParseReference(ref)
+	return err == nil && slices.Contains(up.Registries, r.Registry)
+}
+
+// PullThrough makes s fetch artifacts it is asked for but does not have
+// from up, and keep them. Package downloads follow redirects only to
+// PackageHosts.
+func (s *Store) PullThrough(up Upstream) {
+	if up.Registry == nil {
+		up.Registry = RemoteRepository
+	}
+	if up.HTTPClient == nil {
+		up.HTTPClient = http.DefaultClient
+	}
+	client := *up.HTTPClient
+	check := client.CheckRedirect
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !up.allows(req.URL.String()) {
+			return fmt.Errorf("redirect to %s: %w", req.URL.Host, ErrNotAllowed)
+		}
+		if check != nil {
+			return check(req, via)
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return nil
+	}
+	up.HTTPClient = &client
+	s.upstream = &up
+}
+
+// Fetch makes sure ref, an image reference or package URL, is in the store,
+// pulling it from upstream if it is not, and marks it used. Concurrent
+// fetches of one ref pull it once.
+func (s *Store) Fetch(ctx context.Context, ref string) error {
+	if desc, err := s.oci.Resolve(ctx, ref); err == nil {
+		s.touch(desc)
+		return nil
+	}
+	if s.upstream == nil {
+		return fmt.Errorf("%s: %w", ref, errdef.ErrNotFound)
+	}
+	if !s.upstream.allows(ref) {
+		return fmt.Errorf("%s: %w", ref, ErrNotAllowed)
+	}
+	return s.once(ctx, ref, func(ctx context.Context) error {
+		if s.Has(ctx, ref) {
+			// a pull that ended since the check above
+			return nil
+		}
+		var err error
+		if IsPackage(ref) {
+			err = Download(ctx, s.upstream.HTTPClient, s.oci, ref)
+		} else {
+			err = CopyImage(ctx, s.oci, ref, s.upstream.Registry)
+		}
+		if err != nil {
+			return err
+		}
+		// its manifest may have been stored already, under another reference
+		if desc, err := s.oci.Resolve(ctx, ref); err == nil {
+			s.touch(desc)
+		}
+		return nil
+	})
+}
+
+// Prefetch fetches every ref and returns the errors of those that failed.
+func (s *Store) Prefetch(ctx context.Context, refs []string) error {
+	var errs []error
+	for _, ref := range refs {
+		if err := s.Fetch(ctx, ref); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ref, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fetchDigest pulls the manifest or blob dgst of repo from upstream.
+func (s *Store) fetchDigest(ctx context.Context, repo string, dgst digest.Digest) error {
+	if s.upstream == nil {
+		return errdef.ErrNotFound
+	}
+	if reg, _, _ := strings.Cut(repo, "/"); !slices.Contains(s.upstream.Registries, reg) {
+		return fmt.Errorf("%s: %w", repo, ErrNotAllowed)
+	}
+	return s.once(ctx, repo+"@"+dgst.String(), func(ctx context.Context) error {
+		r, err := registry.ParseReference(repo + "@" + dgst.String())
+		if err != nil {
+			return err
+		}
+		src, err := s.upstream.Registry(r)
+		if err != nil {
+			return err
+		}
+		desc, err := src.Resolve(ctx, r.Reference)
+		if err != nil {
+			// not a manifest; a remote repository resolves blobs apart
+			repo, ok := src.(interface{ Blobs() registry.BlobStore })
+			if !ok {
+				return err
+			}
+			if desc, err = repo.Blobs().Resolve(ctx, r.Reference); err != nil {
+				return err
+			}
+		}
+		return oras.CopyGraph(ctx, src, s.oci, desc, oras.DefaultCopyGraphOptions)
+	})
+}
+
+type call struct {
+	done chan struct{}
+	err  error
+}
+
+// once runs fn for key unless a run for key is under way, in which case it
+// waits for that run instead.
+func (s *Store) once(ctx context.Context, key string, fn func(context.Context) error) error {
+	s.mu.Lock()
+	c, ok := s.calls[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		if s.calls == nil {
+			s.calls = map[string]*call{}
+		}
+		s.calls[key] = c
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+			defer cancel()
+			c.err = fn(ctx)
+			s.mu.Lock()
+			delete(s.calls, key)
+			s.mu.Unlock()
+			close(c.done)
+		}()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsPackage is whether ref names a package (an http(s) URL) rather than
+// an image.
+func IsPackage(ref string) bool {
+	return strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://")
+}
+
+// CopyImage copies ref, with all its platforms and its cosign signature if
+// it has one, from the repository open returns into dst, tagged as the
+// store expects.
+func CopyImage(ctx context.Context, dst oras.Target, ref string, open func(registry.Reference) (oras.ReadOnlyTarget, error)) error {
+	r, err := registry.ParseReference(ref)
+	if err != nil {
+		return err
+	}
+	src, err := open(r)
+	if err != nil {
+		return err
+	}
+	desc, err := oras.Copy(ctx, src, r.Reference, dst, ref, oras.DefaultCopyOptions)
+	if err != nil {
+		return err
+	}
+	sig := strings.Replace(desc.Digest.String(), ":", "-", 1) + ".sig"
+	if _, err := src.Resolve(ctx, sig); err == nil {
+		r.Reference = sig
+		if _, err := oras.Copy(ctx, src, sig, dst, r.String(), oras.DefaultCopyOptions); err != nil {
+			return fmt.Errorf("signature: %w", err)
+		}
+	}
+	return nil
+}
+
+// RemoteRepository opens the registry repository of r, with credentials
+// from the Docker config. Only the credentials of r's registry are used.
+func RemoteRepository(r registry.Reference) (oras.ReadOnlyTarget, error) {
+	host := r.Registry
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	repo, err := remote.NewRepository(host + "/" + r.Repository)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+	if err != nil {
+		return nil, err
+	}
+	credential := credentials.Credential(creds)
+	repo.Client = &auth.Client{
+		Credential: func(ctx context.Context, hostport string) (auth.Credential, error) {
+			if hostport != host {
+				return auth.EmptyCredential, nil
+			}
+			return credential(ctx, hostport)
+		},
+		Cache: auth.NewCache(),
+	}
+	return repo, nil
+}
+
+// ErrNoPackage is returned by Download when nothing is published at the URL.
+var ErrNoPackage = fmt.Errorf("package %w", errdef.ErrNotFound)
+
+// Download stores the package published at url in target. It is streamed
+// through a temporary file, never held in memory whole, and refused if it
+// is larger than MaxPackageSize.
+func Download(ctx context.Context, client *http.Client, target oras.Target, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNoPackage
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("GET returned %s", resp.Status)
+	case resp.ContentLength > MaxPackageSize:
+		return fmt.Errorf("package of %d bytes is larger than %d", resp.ContentLength, MaxPackageSize)
+	}
+
+	f, err := os.CreateTemp("", "package-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(io.MultiWriter(f, digester.Hash()), io.LimitReader(resp.Body, MaxPackageSize+1))
+	if err != nil {
+		return err
+	}
+	if size > MaxPackageSize {
+		return fmt.Errorf("package is larger than %d bytes", MaxPackageSize)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	layer := ocispec.Descriptor{
+		MediaType: "application/octet-stream",
+		Digest:    digester.Digest(),
+		Size:      size,
+	}
+	return pushPackage(ctx, target, url, layer, f)
+}
+
+// pushPackage stores layer, read from r, as the package published at url
+// in target.
+func pushPackage(ctx context.Context, target oras.Target, url string, layer ocispec.Descriptor, r io.Reader) error {
+	if err := target.Push(ctx, layer, r); err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		return err
+	}
+	layer.Annotations = map[string]string{ocispec.AnnotationTitle: path.Base(url)}
+	desc, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, PackageArtifactType, oras.PackManifestOptions{
+		Layers:              []ocispec.Descriptor{layer},
+		ManifestAnnotations: map[string]string{AnnotationPackageURL: url},
+	})
+	if err != nil {
+		return err
+	}
+	return target.Tag(ctx, desc, url)
+}
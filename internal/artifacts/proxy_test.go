@@ -0,0 +1,298 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/registry"
+)
+
+// upstream is a registry holding one image, ghcr.io/acme/api:1.0, and a
+// package server holding /api.tgz, which /again redirects to, and /moved,
+// which redirects to another host. It counts what is asked of it.
+type upstream struct {
+	images   *memory.Store
+	layer    ocispec.Descriptor
+	files    *httptest.Server
+	other    *httptest.Server
+	opens    atomic.Int32
+	requests atomic.Int32
+	// redirected counts the requests that reached the other host
+	redirected atomic.Int32
+}
+
+func newUpstream(t *testing.T) *upstream {
+	t.Helper()
+	ctx := context.Background()
+	u := &upstream{images: memory.New()}
+	var err error
+	if u.layer, err = oras.PushBytes(ctx, u.images, ocispec.MediaTypeImageLayer, []byte("api layer")); err != nil {
+		t.Fatal(err)
+	}
+	config, err := oras.PushBytes(ctx, u.images, ocispec.MediaTypeImageConfig, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, err := oras.PackManifest(ctx, u.images, oras.PackManifestVersion1_1, "", oras.PackManifestOptions{
+		Layers:           []ocispec.Descriptor{u.layer},
+		ConfigDescriptor: &config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u.images.Tag(ctx, desc, "1.0"); err != nil {
+		t.Fatal(err)
+	}
+	u.other = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u.redirected.Add(1)
+		w.Write([]byte("internal"))
+	}))
+	t.Cleanup(u.other.Close)
+	u.files = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u.requests.Add(1)
+		switch r.URL.Path {
+		case "/api.tgz":
+			w.Write([]byte("api package"))
+		case "/again":
+			http.Redirect(w, r, "/api.tgz", http.StatusFound)
+		case "/moved":
+			http.Redirect(w, r, u.other.URL+"/latest/meta-data", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(u.files.Close)
+	return u
+}
+
+func (u *upstream) Upstream(t *testing.T) Upstream {
+	return Upstream{
+		Registry: func(r registry.Reference) (oras.ReadOnlyTarget, error) {
+			u.opens.Add(1)
+			if r.Registry+"/"+r.Repository != "ghcr.io/acme/api" {
+				t.Errorf("unexpected repository %s", r)
+			}
+			return u.images, nil
+		},
+		HTTPClient:   u.files.Client(),
+		Registries:   []string{"ghcr.io"},
+		PackageHosts: []string{u.files.Listener.Addr().String()},
+	}
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(b)
+}
+
+func TestPullThrough(t *testing.T) {
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+
+	if code, _ := get(t, srv.URL+"/v2/acme/api/manifests/1.0?ns=ghcr.io"); code != http.StatusOK {
+		t.Fatalf("manifest: %d", code)
+	}
+	if code, body := get(t, srv.URL+"/v2/acme/api/blobs/"+up.layer.Digest.String()+"?ns=ghcr.io"); code != http.StatusOK || body != "api layer" {
+		t.Fatalf("blob: %d %q", code, body)
+	}
+	if code, body := get(t, PackageURL(srv.URL, up.files.URL+"/api.tgz")); code != http.StatusOK || body != "api package" {
+		t.Fatalf("package: %d %q", code, body)
+	}
+	if code, _ := get(t, PackageURL(srv.URL, up.files.URL+"/api.tgz.sig")); code != http.StatusNotFound {
+		t.Errorf("missing package: %d, want 404", code)
+	}
+
+	// from the store now
+	opens, requests := up.opens.Load(), up.requests.Load()
+	get(t, srv.URL+"/v2/acme/api/manifests/1.0?ns=ghcr.io")
+	get(t, PackageURL(srv.URL, up.files.URL+"/api.tgz"))
+	if up.opens.Load() != opens || up.requests.Load() != requests {
+		t.Errorf("cached artifacts pulled again")
+	}
+}
+
+func TestFetchOnce(t *testing.T) {
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := store.Prefetch(context.Background(), []string{"ghcr.io/acme/api:1.0", up.files.URL + "/api.tgz"}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := up.requests.Load(); n != 1 {
+		t.Errorf("package downloaded %d times", n)
+	}
+	if !store.Has(context.Background(), "ghcr.io/acme/api:1.0") {
+		t.Error("image not stored")
+	}
+}
+
+func TestOffline(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+
+	if code, _ := get(t, srv.URL+"/v2/acme/api/manifests/1.0?ns=ghcr.io"); code != http.StatusNotFound {
+		t.Errorf("manifest: %d, want 404", code)
+	}
+	if code, _ := get(t, srv.URL+"/packages/https/example.com/api.tgz"); code != http.StatusNotFound {
+		t.Errorf("package: %d, want 404", code)
+	}
+	if err := store.Prefetch(context.Background(), []string{"ghcr.io/acme/api:1.0"}); err == nil {
+		t.Error("offline store prefetched")
+	}
+}
+
+func TestUpstreamNotAllowed(t *testing.T) {
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+
+	if code, _ := get(t, srv.URL+"/v2/acme/api/manifests/1.0?ns=evil.example"); code != http.StatusForbidden {
+		t.Errorf("manifest of another registry: %d, want 403", code)
+	}
+	if code, _ := get(t, srv.URL+"/v2/acme/api/blobs/"+up.layer.Digest.String()+"?ns=evil.example"); code != http.StatusForbidden {
+		t.Errorf("blob of another registry: %d, want 403", code)
+	}
+	if code, _ := get(t, srv.URL+"/packages/http/169.254.169.254/latest/meta-data"); code != http.StatusForbidden {
+		t.Errorf("package of another host: %d, want 403", code)
+	}
+	if err := store.Fetch(context.Background(), "docker.io/library/nginx:1.25"); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("fetch from another registry: %v", err)
+	}
+	if up.opens.Load() != 0 || up.requests.Load() != 0 {
+		t.Error("upstream reached")
+	}
+}
+
+func TestPackageRedirects(t *testing.T) {
+	ctx := context.Background()
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+
+	// within the package host
+	if err := store.Fetch(ctx, up.files.URL+"/again"); err != nil {
+		t.Errorf("redirect within the host: %v", err)
+	}
+	// the redirect is checked against PackageHosts like the URL
+	if err := store.Fetch(ctx, up.files.URL+"/moved"); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("redirect to another host: %v", err)
+	}
+	if up.redirected.Load() != 0 || store.Has(ctx, up.files.URL+"/moved") {
+		t.Error("redirect to another host followed")
+	}
+}
+
+func TestDownloadTooLarge(t *testing.T) {
+	defer func(max int64) { MaxPackageSize = max }(MaxPackageSize)
+	MaxPackageSize = 4
+
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+	pkg := up.files.URL + "/api.tgz"
+	if err := store.Fetch(context.Background(), pkg); err == nil || !strings.Contains(err.Error(), "larger") {
+		t.Errorf("fetch err = %v", err)
+	}
+	if store.Has(context.Background(), pkg) {
+		t.Error("oversized package stored")
+	}
+}
+
+func TestEvict(t *testing.T) {
+	ctx := context.Background()
+	up := newUpstream(t)
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.PullThrough(up.Upstream(t))
+	pkg := up.files.URL + "/api.tgz"
+	if err := store.Prefetch(ctx, []string{"ghcr.io/acme/api:1.0", pkg}); err != nil {
+		t.Fatal(err)
+	}
+	full, err := store.size()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if evicted, err := store.Evict(ctx, full, nil); err != nil || len(evicted) != 0 {
+		t.Fatalf("evicted %v, %v within the limit", evicted, err)
+	}
+	// the image was used last, so the package goes
+	time.Sleep(10 * time.Millisecond)
+	if err := store.Fetch(ctx, "ghcr.io/acme/api:1.0"); err != nil {
+		t.Fatal(err)
+	}
+	// pinned artifacts stay, however long unused
+	if evicted, err := store.Evict(ctx, 0, []string{pkg, "ghcr.io/acme/api:1.0"}); err != nil || len(evicted) != 0 {
+		t.Fatalf("evicted pinned %v, %v", evicted, err)
+	}
+	evicted, err := store.Evict(ctx, full-1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evicted) != 1 || evicted[0] != pkg {
+		t.Fatalf("evicted %v", evicted)
+	}
+	if store.Has(ctx, pkg) || !store.Has(ctx, "ghcr.io/acme/api:1.0") {
+		t.Error("wrong artifact evicted")
+	}
+	if size, _ := store.size(); size >= full {
+		t.Errorf("size %d after eviction, was %d", size, full)
+	}
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+	if code, _ := get(t, srv.URL+"/v2/acme/api/blobs/"+up.layer.Digest.String()+"?ns=ghcr.io"); code != http.StatusOK {
+		t.Errorf("image layer after eviction: %d", code)
+	}
+}
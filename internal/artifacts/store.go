@@ -0,0 +1,374 @@
+// Package artifacts keeps OCI images and packages on the LO so the ERAs of a
+// site can pull them without reaching the internet.
+//
+// Artifacts live in one OCI image layout. Images are tagged with their full
+// reference (docker.io/library/nginx:1.25) and packages with their URL. The
+// store is served to ERAs as a read-only OCI distribution endpoint under
+// /v2/, laid out as a containerd registry mirror (the original registry is
+// named in the ns query parameter), and packages under /packages/.
+//
+// Artifacts get in from bundles or, when the store pulls through (see
+// PullThrough), from the registries and package servers themselves the
+// first time an ERA asks for them or the LO prefetches them. Evict keeps
+// the store within a size by dropping the least recently used.
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+)
+
+const (
+	// PackageArtifactType marks the manifest a package is wrapped in.
+	PackageArtifactType = "application/vnd.margo.package.v1"
+	// AnnotationPackageURL records where a package was downloaded from.
+	AnnotationPackageURL = "org.margo.package.url"
+)
+
+// Store is the artifact store of one LO.
+type Store struct {
+	dir      string
+	oci      *oci.Store
+	upstream *Upstream
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// NewStore opens, or creates, the store in dir.
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s, err := oci.New(dir)
+	if err != nil {
+		return nil, fmt.Errorf("artifact store %s: %w", dir, err)
+	}
+	return &Store{dir: dir, oci: s}, nil
+}
+
+// Target is what artifacts are copied into; see the package doc for how
+// they must be tagged.
+func (s *Store) Target() oras.Target { return s.oci }
+
+// Has is whether ref, an image reference or package URL, is stored.
+func (s *Store) Has(ctx context.Context, ref string) bool {
+	_, err := s.oci.Resolve(ctx, ref)
+	return err == nil
+}
+
+// touch marks the manifest desc used now. The manifest's modification time
+// is when it was last used; Evict drops the oldest first.
+func (s *Store) touch(desc ocispec.Descriptor) {
+	now := time.Now()
+	os.Chtimes(s.blobPath(desc), now, now)
+}
+
+func (s *Store) blobPath(desc ocispec.Descriptor) string {
+	return filepath.Join(s.dir, ocispec.ImageBlobsDir, desc.Digest.Algorithm().String(), desc.Digest.Encoded())
+}
+
+// size is how many bytes the store's blobs take.
+func (s *Store) size() (int64, error) {
+	var n int64
+	err := filepath.WalkDir(filepath.Join(s.dir, ocispec.ImageBlobsDir), func(_ string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		n += info.Size()
+		return nil
+	})
+	return n, err
+}
+
+// Evict drops the least recently used images and packages, with what only
+// they reference, until the store holds at most maxBytes, and returns the
+// references dropped. An artifact is used when it is stored, prefetched or
+// served. The pinned references, and the signatures of pinned images, are
+// never dropped, even if that leaves the store above maxBytes.
+func (s *Store) Evict(ctx context.Context, maxBytes int64, pinned []string) ([]string, error) {
+	size, err := s.size()
+	if err != nil || size <= maxBytes {
+		return nil, err
+	}
+	keep := map[string]bool{}
+	for _, ref := range pinned {
+		keep[ref] = true
+		if IsPackage(ref) {
+			continue
+		}
+		if desc, err := s.oci.Resolve(ctx, ref); err == nil {
+			keep[signatureTag(ref, desc.Digest)] = true
+		}
+	}
+
+	var tags []string
+	if err := s.oci.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	type entry struct {
+		ref  string
+		used time.Time
+	}
+	var entries []entry
+	for _, ref := range tags {
+		if keep[ref] {
+			continue
+		}
+		desc, err := s.oci.Resolve(ctx, ref)
+		if err != nil {
+			continue
+		}
+		info, err := os.Stat(s.blobPath(desc))
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{ref, info.ModTime()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].used.Before(entries[j].used) })
+
+	var evicted []string
+	for _, e := range entries {
+		if size <= maxBytes {
+			break
+		}
+		desc, err := s.oci.Resolve(ctx, e.ref)
+		if err != nil {
+			// dropped along with another reference to it
+			continue
+		}
+		if err := s.oci.Delete(ctx, desc); err != nil {
+			return evicted, fmt.Errorf("evict %s: %w", e.ref, err)
+		}
+		evicted = append(evicted, e.ref)
+		if size, err = s.size(); err != nil {
+			return evicted, err
+		}
+	}
+	return evicted, nil
+}
+
+// signatureTag is the tag of the cosign signature of the image ref whose
+// manifest is dgst, as CopyImage stores it.
+func signatureTag(ref string, dgst digest.Digest) string {
+	r, err := registry.ParseReference(ref)
+	if err != nil {
+		return ""
+	}
+	r.Reference = strings.Replace(dgst.String(), ":", "-", 1) + ".sig"
+	return r.String()
+}
+
+// FetchPackage opens the package published at url from target.
+func FetchPackage(ctx context.Context, target oras.ReadOnlyTarget, url string) (io.ReadCloser, int64, error) {
+	_, b, err := oras.FetchBytes(ctx, target, url, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	var m ocispec.Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, 0, err
+	}
+	if m.ArtifactType != PackageArtifactType || len(m.Layers) != 1 {
+		return nil, 0, fmt.Errorf("%s: not a package", url)
+	}
+	rc, err := target.Fetch(ctx, m.Layers[0])
+	if err != nil {
+		return nil, 0, err
+	}
+	return rc, m.Layers[0].Size, nil
+}
+
+// ServeHTTP serves the store: the read side of the OCI distribution API
+// under /v2/ and packages under /packages/<scheme>/<host>/<path>.
+func (s *Store) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		registryError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "the artifact store is read-only")
+		return
+	}
+	p := r.URL.Path
+	switch {
+	case p == "/v2" || p == "/v2/":
+		w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	case strings.HasPrefix(p, "/v2/"):
+		s.serveRegistry(w, r, strings.TrimPrefix(p, "/v2/"))
+	case strings.HasPrefix(p, "/packages/"):
+		s.servePackage(w, r, strings.TrimPrefix(p, "/packages/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Store) serveRegistry(w http.ResponseWriter, r *http.Request, p string) {
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+	if i := strings.LastIndex(p, "/manifests/"); i > 0 {
+		repo := Repository(r.URL.Query().Get("ns"), p[:i])
+		s.serveManifest(w, r, repo, p[i+len("/manifests/"):])
+		return
+	}
+	if i := strings.LastIndex(p, "/blobs/"); i > 0 {
+		repo := Repository(r.URL.Query().Get("ns"), p[:i])
+		s.serveBlob(w, r, repo, p[i+len("/blobs/"):])
+		return
+	}
+	registryError(w, http.StatusNotFound, "NAME_UNKNOWN", "unknown endpoint")
+}
+
+func (s *Store) serveManifest(w http.ResponseWriter, r *http.Request, repo, ref string) {
+	ctx := r.Context()
+	key := ref
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		key = repo + ":" + ref
+	}
+	desc, err := s.oci.Resolve(ctx, key)
+	if err != nil && s.upstream != nil {
+		if key == ref {
+			err = s.fetchDigest(ctx, repo, dgst)
+		} else {
+			err = s.Fetch(ctx, key)
+		}
+		if err == nil {
+			desc, err = s.oci.Resolve(ctx, key)
+		}
+	}
+	if errors.Is(err, ErrNotAllowed) {
+		registryError(w, http.StatusForbidden, "DENIED", fmt.Sprintf("%s is not pulled through the artifact store", repo))
+		return
+	}
+	if err != nil {
+		registryError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", fmt.Sprintf("%s@%s not in the artifact store", repo, ref))
+		return
+	}
+	s.touch(desc)
+	b, err := content.FetchAll(ctx, s.oci, desc)
+	if err != nil {
+		registryError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+		return
+	}
+	mediaType := desc.MediaType
+	if key == ref {
+		// resolved by digest: the layout does not know the media type
+		var m struct {
+			MediaType string `json:"mediaType"`
+		}
+		if json.Unmarshal(b, &m) == nil && m.MediaType != "" {
+			mediaType = m.MediaType
+		}
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(b))
+}
+
+func (s *Store) serveBlob(w http.ResponseWriter, r *http.Request, repo, ref string) {
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		registryError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+		return
+	}
+	desc, err := s.oci.Resolve(r.Context(), ref)
+	if err != nil && s.upstream != nil {
+		if err = s.fetchDigest(r.Context(), repo, dgst); err == nil {
+			desc, err = s.oci.Resolve(r.Context(), ref)
+		}
+	}
+	if errors.Is(err, ErrNotAllowed) {
+		registryError(w, http.StatusForbidden, "DENIED", fmt.Sprintf("%s is not pulled through the artifact store", repo))
+		return
+	}
+	if err != nil {
+		registryError(w, http.StatusNotFound, "BLOB_UNKNOWN", ref+" not in the artifact store")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+	w.Header().Set("Content-Length", strconv.FormatInt(desc.Size, 10))
+	if r.Method == http.MethodHead {
+		return
+	}
+	rc, err := s.oci.Fetch(r.Context(), desc)
+	if err != nil {
+		return
+	}
+	defer rc.Close()
+	io.Copy(w, rc)
+}
+
+func (s *Store) servePackage(w http.ResponseWriter, r *http.Request, p string) {
+	scheme, rest, ok := strings.Cut(p, "/")
+	if !ok || (scheme != "http" && scheme != "https") {
+		http.NotFound(w, r)
+		return
+	}
+	url := scheme + "://" + rest
+	if r.URL.RawQuery != "" {
+		url += "?" + r.URL.RawQuery
+	}
+	if err := s.Fetch(r.Context(), url); err != nil {
+		if errors.Is(err, ErrNotAllowed) {
+			http.Error(w, url+" is not pulled through the artifact store", http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, errdef.ErrNotFound) {
+			http.Error(w, url+" not in the artifact store", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	rc, size, err := FetchPackage(r.Context(), s.oci, url)
+	if err != nil {
+		if errors.Is(err, errdef.ErrNotFound) {
+			http.Error(w, url+" not in the artifact store", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	if r.Method == http.MethodHead {
+		return
+	}
+	io.Copy(w, rc)
+}
+
+func registryError(w http.ResponseWriter, status int, code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{"code": code, "message": msg}},
+	})
+}
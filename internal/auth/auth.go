@@ -0,0 +1,176 @@
+// Package auth holds the roles, scopes and credentials the CO checks
+// requests against.
+//
+// A user is granted roles by bindings, each on a scope: every site ("*"),
+// one site ("site:<site_id>") or a group of sites ("group:<name>"). Roles
+// are ordered, each allowing what the one below it does: a viewer reads, a
+// deployer also deploys, an admin also manages apps, sites and users.
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type Role string
+
+const (
+	Viewer   Role = "viewer"
+	Deployer Role = "deployer"
+	Admin    Role = "admin"
+)
+
+var rank = map[Role]int{Viewer: 1, Deployer: 2, Admin: 3}
+
+// ParseRole checks that s names a role.
+func ParseRole(s string) (Role, error) {
+	if _, ok := rank[Role(s)]; !ok {
+		return "", fmt.Errorf("unknown role %q (want viewer, deployer or admin)", s)
+	}
+	return Role(s), nil
+}
+
+// Allows is whether r allows what want does.
+func (r Role) Allows(want Role) bool {
+	return rank[r] >= rank[want]
+}
+
+// AllScopes is the scope of every site.
+const AllScopes = "*"
+
+// ParseScope checks that s is a scope: "*", "site:<site_id>" or
+// "group:<name>". Empty means "*".
+func ParseScope(s string) (string, error) {
+	if s == "" || s == AllScopes {
+		return AllScopes, nil
+	}
+	kind, name, _ := strings.Cut(s, ":")
+	if (kind != "site" && kind != "group") || name == "" {
+		return "", fmt.Errorf("bad scope %q (want *, site:<id> or group:<name>)", s)
+	}
+	return s, nil
+}
+
+// Site is what a scope is matched against. The zero Site is no site in
+// particular, which only "*" covers: it is what managing apps, users and
+// the site list itself is checked against.
+type Site struct {
+	ID    string
+	Group string
+}
+
+// Covers is whether scope takes in site.
+func Covers(scope string, site Site) bool {
+	switch {
+	case scope == AllScopes:
+		return true
+	case site.ID != "" && scope == "site:"+site.ID:
+		return true
+	case site.Group != "" && scope == "group:"+site.Group:
+		return true
+	}
+	return false
+}
+
+type Binding struct {
+	Role  Role   `json:"role"`
+	Scope string `json:"scope"`
+}
+
+// Principal is who a request acts for: a user with its bindings, limited
+// by the token it came with. Its bindings apply within its organization;
+// a user of none is the platform's.
+type Principal struct {
+	UserID       int       `json:"-"`
+	Username     string    `json:"username"`
+	Organization string    `json:"organization,omitempty"`
+	OrgID        uuid.UUID `json:"-"`
+	Bindings     []Binding `json:"roles"`
+	// Limit narrows the bindings to a role and scope, for a token that
+	// was given less than its user has.
+	Limit *Binding `json:"limit,omitempty"`
+}
+
+// Anonymous is who requests act for when auth is disabled.
+var Anonymous = &Principal{
+	Username: "anonymous",
+	Bindings: []Binding{{Role: Admin, Scope: AllScopes}},
+}
+
+// Platform is whether p belongs to no organization, and so may act across
+// them.
+func (p *Principal) Platform() bool {
+	return p != nil && p.OrgID == uuid.Nil
+}
+
+// Can is whether p has role on site.
+func (p *Principal) Can(role Role, site Site) bool {
+	if p == nil {
+		return false
+	}
+	if l := p.Limit; l != nil && !(l.Role.Allows(role) && Covers(l.Scope, site)) {
+		return false
+	}
+	for _, b := range p.Bindings {
+		if b.Role.Allows(role) && Covers(b.Scope, site) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanSome is whether p has role on any site at all, so that routes whose
+// site is checked by the handler can turn away those who have none.
+func (p *Principal) CanSome(role Role) bool {
+	if p == nil {
+		return false
+	}
+	if p.Limit != nil && !p.Limit.Role.Allows(role) {
+		return false
+	}
+	for _, b := range p.Bindings {
+		if b.Role.Allows(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// HashPassword hashes a password for storing.
+func HashPassword(password string) (string, error) {
+	h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(h), err
+}
+
+// CheckPassword is whether password is the one hash was made from.
+func CheckPassword(hash, password string) bool {
+	return hash != "" && bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// TokenPrefix starts every token, so that leaked ones are easy to find.
+const TokenPrefix = "mco_"
+
+// NewToken makes a token. Only its hash is stored; the token itself is shown
+// once, to whoever asked for it. prefix is enough of it to tell tokens apart.
+func NewToken() (token, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	token = TokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, token[:len(TokenPrefix)+6], HashToken(token), nil
+}
+
+// HashToken is the hash a token is stored and looked up by. Tokens are
+// random and long, so a fast hash does.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
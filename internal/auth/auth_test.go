@@ -0,0 +1,75 @@
+package auth
+
+import "testing"
+
+func TestCan(t *testing.T) {
+	plant1 := Site{ID: "plant-1", Group: "emea"}
+	plant2 := Site{ID: "plant-2", Group: "apac"}
+	p := &Principal{Bindings: []Binding{
+		{Role: Viewer, Scope: AllScopes},
+		{Role: Deployer, Scope: "group:emea"},
+		{Role: Admin, Scope: "site:plant-2"},
+	}}
+	for _, c := range []struct {
+		role Role
+		site Site
+		want bool
+	}{
+		{Viewer, plant1, true},
+		{Viewer, Site{}, true},
+		{Deployer, plant1, true},
+		{Admin, plant1, false},
+		{Deployer, plant2, true},
+		{Admin, plant2, true},
+		{Deployer, Site{ID: "plant-3"}, false},
+		{Admin, Site{}, false},
+	} {
+		if got := p.Can(c.role, c.site); got != c.want {
+			t.Errorf("Can(%s, %+v) = %v", c.role, c.site, got)
+		}
+	}
+
+	// a token narrowed to viewing plant-2
+	p.Limit = &Binding{Role: Viewer, Scope: "site:plant-2"}
+	if p.Can(Viewer, plant1) || p.Can(Admin, plant2) || !p.Can(Viewer, plant2) {
+		t.Error("limit not applied")
+	}
+	if !p.CanSome(Viewer) || p.CanSome(Deployer) {
+		t.Error("CanSome ignores the limit")
+	}
+
+	var nobody *Principal
+	if nobody.Can(Viewer, plant1) || nobody.CanSome(Viewer) {
+		t.Error("nil principal allowed")
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	for _, s := range []string{"", "*", "site:a", "group:b"} {
+		if _, err := ParseScope(s); err != nil {
+			t.Errorf("%q: %v", s, err)
+		}
+	}
+	for _, s := range []string{"site:", "host:a", "a"} {
+		if _, err := ParseScope(s); err == nil {
+			t.Errorf("%q accepted", s)
+		}
+	}
+}
+
+func TestTokens(t *testing.T) {
+	token, prefix, hash, err := NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HashToken(token) != hash || len(prefix) >= len(token) || token[:len(prefix)] != prefix {
+		t.Errorf("token %q prefix %q hash %q", token, prefix, hash)
+	}
+	h, err := HashPassword("s3cret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CheckPassword(h, "s3cret") || CheckPassword(h, "guess") || CheckPassword("", "") {
+		t.Error("CheckPassword")
+	}
+}
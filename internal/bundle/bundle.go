@@ -0,0 +1,328 @@
+// Package bundle carries deployments to sites without registry or git
+// access. A bundle is a tarball of an OCI image layout holding a
+// deployment, its app description and every image and package the
+// deployment references, tagged as the LO artifact store expects (see
+// internal/artifacts). The bundle manifest itself is tagged Tag.
+package bundle
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"gopkg.in/yaml.v3"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/registry"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	ArtifactType        = "application/vnd.margo.bundle.v1"
+	MediaTypeDeployment = "application/vnd.margo.deployment.v1+yaml"
+	MediaTypeApp        = "application/vnd.margo.app.v1+yaml"
+
+	// Tag names the bundle manifest in the layout.
+	Tag = "margo-bundle"
+
+	AnnotationDeploymentID = "org.margo.deployment.id"
+	AnnotationSite         = "org.margo.site"
+)
+
+// Contents describes a bundle.
+type Contents struct {
+	DeploymentID string   `json:"deployment_id"`
+	Site         string   `json:"site,omitempty"`
+	Images       []string `json:"images"`
+	Packages     []string `json:"packages"`
+
+	Deployment []byte `json:"-"`
+	App        []byte `json:"-"`
+}
+
+// Options tune Export.
+type Options struct {
+	// Site the deployment is meant for; Import at another site's LO is
+	// refused. Empty means any site.
+	Site string
+	// Registry opens the repository of an image reference; nil means
+	// artifacts.RemoteRepository.
+	Registry func(ref registry.Reference) (oras.ReadOnlyTarget, error)
+	// HTTPClient downloads packages; nil means http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Export writes a bundle of deployment (a desiredstate.yaml) and app (its
+// application description, optional) to w.
+func Export(ctx context.Context, w io.Writer, deployment, app []byte, opts Options) (*Contents, error) {
+	if opts.Registry == nil {
+		opts.Registry = artifacts.RemoteRepository
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+
+	var dep model.ApplicationDeployment
+	if err := yaml.Unmarshal(deployment, &dep); err != nil {
+		return nil, fmt.Errorf("deployment: %w", err)
+	}
+	c := &Contents{
+		DeploymentID: dep.Metadata.Annotations.ID,
+		Site:         opts.Site,
+		Deployment:   deployment,
+		App:          app,
+	}
+	if c.DeploymentID == "" {
+		return nil, fmt.Errorf("deployment: metadata.annotations.id is empty")
+	}
+	c.Images, c.Packages = references(&dep)
+
+	dir, err := os.MkdirTemp("", "bundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	store, err := oci.New(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range c.Images {
+		if err := artifacts.CopyImage(ctx, store, ref, opts.Registry); err != nil {
+			return nil, fmt.Errorf("image %s: %w", ref, err)
+		}
+	}
+	var pkgs []string
+	for _, url := range c.Packages {
+		if err := artifacts.Download(ctx, opts.HTTPClient, store, url); err != nil {
+			// signatures are only verified when the ERA is told to
+			if errors.Is(err, artifacts.ErrNoPackage) && strings.HasSuffix(url, ".sig") {
+				continue
+			}
+			return nil, fmt.Errorf("package %s: %w", url, err)
+		}
+		pkgs = append(pkgs, url)
+	}
+	c.Packages = pkgs
+
+	if err := pushManifest(ctx, store, c); err != nil {
+		return nil, err
+	}
+	if err := writeTar(w, dir); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// references lists the images and packages dep needs, in order and without
+// duplicates. Package signatures are included.
+func references(dep *model.ApplicationDeployment) (images, packages []string) {
+	seen := map[string]bool{}
+	add := func(list *[]string, s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			*list = append(*list, s)
+		}
+	}
+	for _, comp := range dep.Spec.DeploymentProfile.Components {
+		p := comp.Properties
+		if p.Repository != "" {
+			add(&images, artifacts.Normalize(p.Repository))
+		}
+		if artifacts.IsPackage(p.PackageURL) {
+			add(&packages, p.PackageURL)
+			add(&packages, p.PackageURL+".sig")
+		}
+		if artifacts.IsPackage(p.KeyURL) {
+			add(&packages, p.KeyURL)
+		}
+	}
+	sort.Strings(images)
+	sort.Strings(packages)
+	return images, packages
+}
+
+func pushManifest(ctx context.Context, store oras.Target, c *Contents) error {
+	var layers []ocispec.Descriptor
+	add := func(mediaType, title string, data []byte) error {
+		desc, err := oras.PushBytes(ctx, store, mediaType, data)
+		if err != nil {
+			return err
+		}
+		desc.Annotations = map[string]string{ocispec.AnnotationTitle: title}
+		layers = append(layers, desc)
+		return nil
+	}
+	if err := add(MediaTypeDeployment, "desiredstate.yaml", c.Deployment); err != nil {
+		return err
+	}
+	if len(c.App) > 0 {
+		if err := add(MediaTypeApp, "margo.yaml", c.App); err != nil {
+			return err
+		}
+	}
+	annotations := map[string]string{AnnotationDeploymentID: c.DeploymentID}
+	if c.Site != "" {
+		annotations[AnnotationSite] = c.Site
+	}
+	desc, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, ArtifactType, oras.PackManifestOptions{
+		Layers:              layers,
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return err
+	}
+	return store.Tag(ctx, desc, Tag)
+}
+
+// Import loads the bundle in the tarball at path into dst and returns what
+// it held.
+func Import(ctx context.Context, path string, dst oras.Target) (*Contents, error) {
+	src, err := oci.NewFromTar(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("not a bundle: %w", err)
+	}
+	c, err := read(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	if err := src.Tags(ctx, "", func(t []string) error {
+		tags = append(tags, t...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		if t == Tag {
+			continue
+		}
+		if _, err := oras.Copy(ctx, src, t, dst, t, oras.DefaultCopyOptions); err != nil {
+			return nil, fmt.Errorf("%s: %w", t, err)
+		}
+	}
+	return c, nil
+}
+
+// Read describes the bundle in the tarball at path.
+func Read(ctx context.Context, path string) (*Contents, error) {
+	src, err := oci.NewFromTar(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("not a bundle: %w", err)
+	}
+	return read(ctx, src)
+}
+
+func read(ctx context.Context, src *oci.ReadOnlyStore) (*Contents, error) {
+	_, b, err := oras.FetchBytes(ctx, src, Tag, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("not a bundle: %w", err)
+	}
+	var m ocispec.Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m.ArtifactType != ArtifactType {
+		return nil, fmt.Errorf("not a bundle: artifact type %q", m.ArtifactType)
+	}
+	c := &Contents{
+		DeploymentID: m.Annotations[AnnotationDeploymentID],
+		Site:         m.Annotations[AnnotationSite],
+	}
+	for _, l := range m.Layers {
+		data, err := content.FetchAll(ctx, src, l)
+		if err != nil {
+			return nil, err
+		}
+		switch l.MediaType {
+		case MediaTypeDeployment:
+			c.Deployment = data
+		case MediaTypeApp:
+			c.App = data
+		}
+	}
+	if c.Deployment == nil {
+		return nil, fmt.Errorf("bundle has no deployment")
+	}
+	// the manifest names the deployment the LO files it under; it must be
+	// the one the bundle carries
+	var dep model.ApplicationDeployment
+	if err := yaml.Unmarshal(c.Deployment, &dep); err != nil {
+		return nil, fmt.Errorf("deployment: %w", err)
+	}
+	if id := dep.Metadata.Annotations.ID; id != c.DeploymentID {
+		return nil, fmt.Errorf("bundle is for deployment %q but carries deployment %q", c.DeploymentID, id)
+	}
+
+	if err := src.Tags(ctx, "", func(tags []string) error {
+		for _, t := range tags {
+			switch {
+			case t == Tag, strings.HasSuffix(t, ".sig") && !artifacts.IsPackage(t):
+			case artifacts.IsPackage(t):
+				c.Packages = append(c.Packages, t)
+			default:
+				c.Images = append(c.Images, t)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// writeTar writes the layout in dir as a tarball.
+func writeTar(w io.Writer, dir string) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
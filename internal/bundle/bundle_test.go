@@ -0,0 +1,221 @@
+package bundle
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/registry"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+)
+
+const deployment = `
+metadata:
+  annotations:
+    id: dep-1
+    applicationId: demo
+spec:
+  deploymentProfile:
+    type: compose
+    components:
+      - name: api
+        properties:
+          repository: ghcr.io/acme/api:1.0
+          packageLocation: %s/pkgs/api.tgz
+          keyLocation: %s/keys/acme.pub
+      - name: web
+        properties:
+          repository: nginx:1.25
+`
+
+// fakeRegistry holds one image per repository, tagged by its tag.
+func fakeRegistry(t *testing.T, refs ...string) func(registry.Reference) (oras.ReadOnlyTarget, error) {
+	t.Helper()
+	ctx := context.Background()
+	repos := map[string]*memory.Store{}
+	for _, ref := range refs {
+		r, err := registry.ParseReference(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := memory.New()
+		layer, err := oras.PushBytes(ctx, s, ocispec.MediaTypeImageLayer, []byte("layer of "+ref))
+		if err != nil {
+			t.Fatal(err)
+		}
+		config, err := oras.PushBytes(ctx, s, ocispec.MediaTypeImageConfig, []byte("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc, err := oras.PackManifest(ctx, s, oras.PackManifestVersion1_1, "", oras.PackManifestOptions{
+			Layers:           []ocispec.Descriptor{layer},
+			ConfigDescriptor: &config,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Tag(ctx, desc, r.Reference); err != nil {
+			t.Fatal(err)
+		}
+		repos[r.Registry+"/"+r.Repository] = s
+	}
+	return func(r registry.Reference) (oras.ReadOnlyTarget, error) {
+		s, ok := repos[r.Registry+"/"+r.Repository]
+		if !ok {
+			t.Fatalf("unexpected repository %s", r)
+		}
+		return s, nil
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pkgs/api.tgz":
+			w.Write([]byte("api package"))
+		case "/keys/acme.pub":
+			w.Write([]byte("public key"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer files.Close()
+
+	dep := []byte(strings.ReplaceAll(deployment, "%s", files.URL))
+	file := filepath.Join(t.TempDir(), "bundle.tar")
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Export(ctx, f, dep, []byte("app: demo"), Options{
+		Site:     "site-a",
+		Registry: fakeRegistry(t, "ghcr.io/acme/api:1.0", "docker.io/library/nginx:1.25"),
+	})
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.DeploymentID != "dep-1" {
+		t.Errorf("deployment id = %q", c.DeploymentID)
+	}
+	wantImages := []string{"docker.io/library/nginx:1.25", "ghcr.io/acme/api:1.0"}
+	if strings.Join(c.Images, " ") != strings.Join(wantImages, " ") {
+		t.Errorf("images = %v, want %v", c.Images, wantImages)
+	}
+	// the package has no signature to carry
+	if len(c.Packages) != 2 {
+		t.Errorf("packages = %v", c.Packages)
+	}
+
+	store, err := artifacts.NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Import(ctx, file, store.Target())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DeploymentID != "dep-1" || got.Site != "site-a" {
+		t.Errorf("imported %+v", got)
+	}
+	if string(got.Deployment) != string(dep) || string(got.App) != "app: demo" {
+		t.Errorf("deployment or app changed in transit")
+	}
+	for _, ref := range append(wantImages, files.URL+"/pkgs/api.tgz") {
+		if !store.Has(ctx, ref) {
+			t.Errorf("%s not in the artifact store", ref)
+		}
+	}
+
+	// ERAs get both through the LO
+	srv := httptest.NewServer(store)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/v2/acme/api/manifests/1.0?ns=ghcr.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != ocispec.MediaTypeImageManifest {
+		t.Errorf("manifest: %s %s", resp.Status, resp.Header.Get("Content-Type"))
+	}
+	resp, err = http.Get(artifacts.PackageURL(srv.URL, files.URL+"/pkgs/api.tgz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "api package" {
+		t.Errorf("package = %q (%s)", body, resp.Status)
+	}
+}
+
+func TestExportMissingPackage(t *testing.T) {
+	files := httptest.NewServer(http.NotFoundHandler())
+	defer files.Close()
+
+	dep := []byte(strings.ReplaceAll(deployment, "%s", files.URL))
+	_, err := Export(context.Background(), io.Discard, dep, nil, Options{
+		Registry: fakeRegistry(t, "ghcr.io/acme/api:1.0", "docker.io/library/nginx:1.25"),
+	})
+	if err == nil || !strings.Contains(err.Error(), files.URL) {
+		t.Fatalf("err = %v, want a missing package named", err)
+	}
+}
+
+func TestImportRejectsOtherTarballs(t *testing.T) {
+	store, err := artifacts.NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "bundle.tar")
+	os.WriteFile(file, []byte("not a tarball"), 0o644)
+	if _, err := Import(context.Background(), file, store.Target()); err == nil {
+		t.Fatal("imported garbage")
+	}
+}
+
+func TestReadRejectsMismatchedID(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := oci.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the manifest says dep-2, the deployment it carries is dep-1
+	dep := strings.ReplaceAll(deployment, "%s", "https://files.example.com")
+	c := &Contents{DeploymentID: "dep-2", Deployment: []byte(dep)}
+	if err := pushManifest(ctx, store, c); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "bundle.tar")
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeTar(f, dir); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	if _, err := Read(ctx, file); err == nil || !strings.Contains(err.Error(), "dep-1") {
+		t.Fatalf("read err = %v", err)
+	}
+	dst, err := artifacts.NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(ctx, file, dst.Target()); err == nil {
+		t.Fatal("imported a bundle with a mismatched id")
+	}
+}
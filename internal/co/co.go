@@ -8,6 +8,7 @@ import (
 	//"github.com/balaji-balu/margo-hello-world/internal/gitobserver"
 	//"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
 	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 // CO uses gitmanager to read app-registry and write deployments
@@ -21,23 +22,35 @@ func NewCO(m *gitmanager.Manager, appRepo, depRepo string) *CO {
 	return &CO{Mgr: m, AppRepo: appRepo, DepRepo: depRepo}
 }
 
-// CreateDeployment writes a desiredstate.yaml under deployments/<site>/<deploymentID>/desiredstate.yaml
-func (c *CO) CreateDeployment(siteID, deploymentID string, yaml []byte) error {
+// CreateDeployment writes a desiredstate.yaml under deployments/<org>/<site>/<deploymentID>/desiredstate.yaml
+// and returns the hash of the commit that holds it. An empty org is a
+// deployment of no organization, kept at deployments/<site>/. The commit is
+// authored by actor, the user who asked for the deployment.
+func (c *CO) CreateDeployment(org, siteID, deploymentID string, yaml []byte, actor string) (string, error) {
 	fmt.Println("CreateDeployment: enter")
-	rel := filepath.Join(siteID, deploymentID, "desiredstate.yaml")
+	rel := filepath.Join(org, siteID, deploymentID, "desiredstate.yaml")
 	// write file into working path
 	cfg, _ := c.Mgr.GetConfig(c.DepRepo)
 	full := filepath.Join(cfg.WorkingPath, rel)
 	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
-		return err
+		return "", err
 	}
 	if err := os.WriteFile(full, yaml, 0644); err != nil {
-		return err
+		return "", err
 	}
 	// commit and push
 	msg := fmt.Sprintf("CO: create deployment %s for site %s", deploymentID, siteID)
 	fmt.Println("CreateDeployment: exit", msg)
-	return c.Mgr.CommitAndPush(c.DepRepo, rel, msg)
+	return c.Mgr.CommitAndPushAs(c.DepRepo, rel, msg, actorSignature(actor))
+}
+
+// actorSignature is the git author for a CO user; CO users have no email,
+// so one is made up under the CO's own domain.
+func actorSignature(actor string) *object.Signature {
+	if actor == "" {
+		return nil
+	}
+	return &object.Signature{Name: actor, Email: actor + "@co.margo"}
 }
 
 // ReadApp reads a file from app-registry
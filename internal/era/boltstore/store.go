@@ -0,0 +1,250 @@
+// Package boltstore is the ERA's local record of what it has applied: the
+// apps (and so component specs) it runs, the ops it received and opaque
+// blobs such as GC revision history. It lets an ERA rebuild its view after a
+// restart and reconcile it with the runtime.
+package boltstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+var (
+	bucketApps   = []byte("apps")
+	bucketOps    = []byte("operations")
+	bucketBlobs  = []byte("blobs")
+	bucketOrphan = []byte("adopted")
+)
+
+// AppRecord is an app as last successfully applied on this host.
+type AppRecord struct {
+	App          model.App `json:"app"`
+	DeploymentID string    `json:"deployment_id"`
+	UpdatedAt    int64     `json:"updated_at"`
+}
+
+// OpRecord is a received op and its outcome.
+type OpRecord struct {
+	Op        model.DiffOp `json:"op"`
+	Error     string       `json:"error,omitempty"`
+	AppliedAt int64        `json:"applied_at"`
+}
+
+// Adopted is a runtime component found at startup that no app record owns.
+type Adopted struct {
+	Name     string `json:"name"`
+	Artifact string `json:"artifact"`
+	Since    int64  `json:"since"`
+}
+
+// DefaultMaxOperations is how many ops a StateStore keeps by default.
+const DefaultMaxOperations = 1000
+
+type StateStore struct {
+	db *bolt.DB
+	// MaxOperations caps the op log; the oldest ops are pruned as new ones
+	// are added. Zero or less keeps them all.
+	MaxOperations int
+}
+
+func NewStateStore(path string) (*StateStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{bucketApps, bucketOps, bucketBlobs, bucketOrphan} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &StateStore{db: db, MaxOperations: DefaultMaxOperations}, nil
+}
+
+func (s *StateStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *StateStore) put(bucket []byte, key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(key), data)
+	})
+}
+
+func (s *StateStore) get(bucket []byte, key string, v any) (bool, error) {
+	var raw []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(bucket).Get([]byte(key)); b != nil {
+			raw = append([]byte(nil), b...)
+		}
+		return nil
+	})
+	if err != nil || raw == nil {
+		return false, err
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+func (s *StateStore) delete(bucket []byte, key string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Delete([]byte(key))
+	})
+}
+
+func (s *StateStore) SetApp(rec AppRecord) error {
+	rec.UpdatedAt = time.Now().Unix()
+	return s.put(bucketApps, rec.App.ID, rec)
+}
+
+func (s *StateStore) GetApp(appID string) (AppRecord, bool, error) {
+	var rec AppRecord
+	ok, err := s.get(bucketApps, appID, &rec)
+	return rec, ok, err
+}
+
+func (s *StateStore) DeleteApp(appID string) error {
+	return s.delete(bucketApps, appID)
+}
+
+func (s *StateStore) Apps() (map[string]AppRecord, error) {
+	apps := map[string]AppRecord{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketApps).ForEach(func(k, v []byte) error {
+			var rec AppRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return fmt.Errorf("app %s: %w", k, err)
+			}
+			apps[string(k)] = rec
+			return nil
+		})
+	})
+	return apps, err
+}
+
+// AddOperation appends op to the log, keyed like the LO's operations bucket,
+// and prunes the oldest ops beyond MaxOperations.
+func (s *StateStore) AddOperation(op model.DiffOp, opErr error) error {
+	rec := OpRecord{Op: op, AppliedAt: time.Now().Unix()}
+	if opErr != nil {
+		rec.Error = opErr.Error()
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketOps)
+		if err := b.Put([]byte(fmt.Sprintf("%s-%d", op.DeploymentID, op.TimeStamp)), data); err != nil {
+			return err
+		}
+		return pruneOperations(b, s.MaxOperations)
+	})
+}
+
+// pruneOperations deletes the oldest ops in b until max are left. Keys are
+// not in time order, so ops are ordered by when they were applied.
+func pruneOperations(b *bolt.Bucket, max int) error {
+	n := b.Stats().KeyN
+	if max <= 0 || n <= max {
+		return nil
+	}
+	type op struct {
+		key       []byte
+		appliedAt int64
+	}
+	ops := make([]op, 0, n)
+	err := b.ForEach(func(k, v []byte) error {
+		var rec OpRecord
+		// an unreadable op goes first
+		json.Unmarshal(v, &rec)
+		ops = append(ops, op{key: append([]byte(nil), k...), appliedAt: rec.AppliedAt})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].appliedAt < ops[j].appliedAt })
+	for _, o := range ops[:len(ops)-max] {
+		if err := b.Delete(o.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StateStore) Operations() ([]OpRecord, error) {
+	var ops []OpRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketOps).ForEach(func(k, v []byte) error {
+			var rec OpRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return err
+			}
+			ops = append(ops, rec)
+			return nil
+		})
+	})
+	return ops, err
+}
+
+func (s *StateStore) SetAdopted(a Adopted) error {
+	return s.put(bucketOrphan, a.Name, a)
+}
+
+func (s *StateStore) DeleteAdopted(name string) error {
+	return s.delete(bucketOrphan, name)
+}
+
+func (s *StateStore) Adopted() ([]Adopted, error) {
+	var out []Adopted
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketOrphan).ForEach(func(k, v []byte) error {
+			var a Adopted
+			if err := json.Unmarshal(v, &a); err != nil {
+				return err
+			}
+			out = append(out, a)
+			return nil
+		})
+	})
+	return out, err
+}
+
+// SaveBlob and LoadBlob store JSON documents owned by other subsystems.
+func (s *StateStore) SaveBlob(key string, v any) error {
+	return s.put(bucketBlobs, key, v)
+}
+
+// LoadBlob reports false when key was never saved.
+func (s *StateStore) LoadBlob(key string, v any) (bool, error) {
+	return s.get(bucketBlobs, key, v)
+}
+
+// Blob binds a blob key, e.g. to back gc.Tracker.
+type Blob struct {
+	s   *StateStore
+	key string
+}
+
+func (s *StateStore) Blob(key string) Blob {
+	return Blob{s: s, key: key}
+}
+
+func (b Blob) Load(v any) (bool, error) { return b.s.LoadBlob(b.key, v) }
+func (b Blob) Save(v any) error         { return b.s.SaveBlob(b.key, v) }
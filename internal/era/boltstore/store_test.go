@@ -0,0 +1,88 @@
+package boltstore
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestStateStoreSurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "era.db")
+	s, err := NewStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := model.App{ID: "app1", Version: "1.0", Components: map[string]model.Component{
+		"web": {Name: "web", Version: "1.0", Repository: "registry/web:1"},
+	}}
+	op := model.DiffOp{Action: model.ActionAddApp, App: app, DeploymentID: "d1", TimeStamp: 42}
+	if err := s.AddOperation(op, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddOperation(model.DiffOp{DeploymentID: "d1", TimeStamp: 43}, errors.New("boom")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetApp(AppRecord{App: app, DeploymentID: "d1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Blob("gc").Save(map[string]int{"a": 1}); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s, err = NewStateStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	apps, err := s.Apps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec, ok := apps["app1"]; !ok || rec.DeploymentID != "d1" || rec.App.Components["web"].Repository != "registry/web:1" {
+		t.Fatalf("unexpected apps: %+v", apps)
+	}
+
+	ops, err := s.Operations()
+	if err != nil || len(ops) != 2 || ops[1].Error != "boom" {
+		t.Fatalf("unexpected ops: %+v, %v", ops, err)
+	}
+
+	var blob map[string]int
+	if ok, err := s.Blob("gc").Load(&blob); !ok || err != nil || blob["a"] != 1 {
+		t.Fatalf("blob: %v %v %v", blob, ok, err)
+	}
+	if ok, _ := s.Blob("missing").Load(&blob); ok {
+		t.Fatal("missing blob reported present")
+	}
+}
+
+func TestOperationsPruned(t *testing.T) {
+	s, err := NewStateStore(filepath.Join(t.TempDir(), "era.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	s.MaxOperations = 3
+	for ts := int64(1); ts <= 5; ts++ {
+		if err := s.AddOperation(model.DiffOp{DeploymentID: "d", TimeStamp: ts}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ops, err := s.Operations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int64
+	for _, op := range ops {
+		got = append(got, op.Op.TimeStamp)
+	}
+	if !reflect.DeepEqual(got, []int64{3, 4, 5}) {
+		t.Fatalf("ops left: %v", got)
+	}
+}
@@ -0,0 +1,232 @@
+// Package gc prunes images and artifacts on the edge node that no retained
+// component revision references any more.
+//
+// Every install is recorded in a Tracker. The newest KeepRevisions revisions
+// of each component are kept for rollback; anything referenced only by older
+// revisions is removed on a schedule, or immediately when the disk holding
+// BaseDir crosses the high watermark. Images are removed from the runtime
+// plugin's store, packages from PackageDir.
+package gc
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	ReasonSchedule     = "schedule"
+	ReasonDiskPressure = "disk-pressure"
+
+	DefaultKeepRevisions = 2
+	DefaultInterval      = time.Hour
+	diskCheckInterval    = time.Minute
+)
+
+type Options struct {
+	KeepRevisions int
+	Interval      time.Duration
+	// DiskPath is the filesystem watched for pressure; HighWatermark is the
+	// used percentage (0-100) that triggers an early run. 0 disables it.
+	DiskPath      string
+	HighWatermark float64
+	// PackageDir holds the packages kept on the node, which components
+	// reference by file path. Expired packages in it are deleted; packages
+	// elsewhere, e.g. fetched over HTTP, are only forgotten.
+	PackageDir string
+}
+
+type Collector struct {
+	tracker *Tracker
+	store   edgeruntime.ImageStore
+	opts    Options
+	log     *zap.SugaredLogger
+
+	mu       sync.Mutex // serialises runs
+	OnReport func(model.GCReport)
+}
+
+func NewCollector(tracker *Tracker, store edgeruntime.ImageStore, opts Options, log *zap.SugaredLogger) *Collector {
+	if opts.KeepRevisions <= 0 {
+		opts.KeepRevisions = DefaultKeepRevisions
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	return &Collector{tracker: tracker, store: store, opts: opts, log: log}
+}
+
+// Track records an installed component revision.
+func (c *Collector) Track(spec edgeruntime.ComponentSpec) {
+	if spec.Artifact == "" && spec.PackageURL == "" {
+		return
+	}
+	if err := c.tracker.Record(spec.Name, spec.Version, spec.Artifact, spec.PackageURL); err != nil {
+		c.log.Warnw("gc: unable to record revision", "component", spec.Name, "err", err)
+	}
+}
+
+// Run collects on the schedule and on disk pressure until ctx is done.
+func (c *Collector) Run(ctx context.Context) {
+	sched := time.NewTicker(c.opts.Interval)
+	defer sched.Stop()
+	disk := time.NewTicker(diskCheckInterval)
+	defer disk.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sched.C:
+			c.Collect(ReasonSchedule)
+		case <-disk.C:
+			if c.underPressure() {
+				c.Collect(ReasonDiskPressure)
+			}
+		}
+	}
+}
+
+func (c *Collector) underPressure() bool {
+	if c.opts.HighWatermark <= 0 || c.opts.DiskPath == "" {
+		return false
+	}
+	used, err := diskUsedPercent(c.opts.DiskPath)
+	if err != nil {
+		c.log.Warnw("gc: disk usage", "path", c.opts.DiskPath, "err", err)
+		return false
+	}
+	return used >= c.opts.HighWatermark
+}
+
+// Collect removes every expired artifact still present on the node. The
+// report is only published when something was removed.
+func (c *Collector) Collect(reason string) model.GCReport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	report := model.GCReport{Reason: reason, Timestamp: time.Now().Unix()}
+
+	var forget []string
+	if expired := c.tracker.Expired(c.opts.KeepRevisions); len(expired) > 0 {
+		present := map[string]bool{}
+		images, err := c.store.Images()
+		if err != nil {
+			c.log.Errorw("gc: list images", "err", err)
+			return report
+		}
+		for _, img := range images {
+			present[img.Ref] = true
+		}
+
+		for _, ref := range expired {
+			if !present[ref] {
+				// already gone, just drop the stale revisions
+				forget = append(forget, ref)
+				continue
+			}
+			n, err := c.store.RemoveImage(ref)
+			if err != nil {
+				c.log.Warnw("gc: remove image", "ref", ref, "err", err)
+				report.Failed = append(report.Failed, ref)
+				continue
+			}
+			report.Removed = append(report.Removed, ref)
+			report.ReclaimedBytes += n
+			forget = append(forget, ref)
+		}
+	}
+
+	for _, loc := range c.tracker.ExpiredPackages(c.opts.KeepRevisions) {
+		path, ok := c.packagePath(loc)
+		if !ok {
+			// not kept on the node
+			forget = append(forget, loc)
+			continue
+		}
+		n, err := removeAll(path)
+		if err != nil {
+			c.log.Warnw("gc: remove package", "path", path, "err", err)
+			report.Failed = append(report.Failed, loc)
+			continue
+		}
+		if n > 0 {
+			report.Removed = append(report.Removed, loc)
+			report.ReclaimedBytes += n
+		}
+		forget = append(forget, loc)
+	}
+
+	if len(forget) > 0 {
+		if err := c.tracker.Forget(c.opts.KeepRevisions, forget); err != nil {
+			c.log.Warnw("gc: save revisions", "err", err)
+		}
+	}
+
+	if len(report.Removed) == 0 {
+		c.log.Debugw("gc run freed nothing", "reason", reason, "failed", len(report.Failed))
+		return report
+	}
+	c.log.Infow("gc run complete", "reason", reason,
+		"removed", len(report.Removed), "failed", len(report.Failed),
+		"reclaimed_bytes", report.ReclaimedBytes)
+	if c.OnReport != nil {
+		c.OnReport(report)
+	}
+	return report
+}
+
+// packagePath is where the package at loc is kept, if it is a file in
+// PackageDir.
+func (c *Collector) packagePath(loc string) (string, bool) {
+	if c.opts.PackageDir == "" {
+		return "", false
+	}
+	path := loc
+	if u, err := url.Parse(loc); err == nil && u.Scheme != "" {
+		if u.Scheme != "file" {
+			return "", false
+		}
+		path = u.Path
+	}
+	dir, err := filepath.Abs(c.opts.PackageDir)
+	if err != nil {
+		return "", false
+	}
+	path, err = filepath.Abs(path)
+	if err != nil || !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return "", false
+	}
+	return path, true
+}
+
+// removeAll deletes path and returns the bytes it held; a path already gone
+// held none.
+func removeAll(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return size, os.RemoveAll(path)
+}
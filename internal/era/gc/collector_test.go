@@ -0,0 +1,135 @@
+package gc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+type fakeStore struct {
+	images map[string]int64
+	inUse  map[string]bool
+}
+
+func (f *fakeStore) Images() ([]edgeruntime.Image, error) {
+	var out []edgeruntime.Image
+	for ref, size := range f.images {
+		out = append(out, edgeruntime.Image{Ref: ref, Size: size})
+	}
+	return out, nil
+}
+
+func (f *fakeStore) RemoveImage(ref string) (int64, error) {
+	if f.inUse[ref] {
+		return 0, fmt.Errorf("in use")
+	}
+	size := f.images[ref]
+	delete(f.images, ref)
+	return size, nil
+}
+
+func TestCollectKeepsNewestRevisions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revisions.json")
+	tracker, err := NewTracker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &fakeStore{images: map[string]int64{}, inUse: map[string]bool{}}
+	c := NewCollector(tracker, store, Options{KeepRevisions: 2}, zap.NewNop().Sugar())
+
+	for _, v := range []string{"1", "2", "3", "4"} {
+		ref := "registry/web:" + v
+		store.images[ref] = 100
+		c.Track(edgeruntime.ComponentSpec{Name: "web", Version: v, Artifact: ref})
+	}
+	// shared by an old web revision and the current api revision
+	store.images["registry/base:1"] = 50
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "0", Artifact: "registry/base:1"})
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "4", Artifact: "registry/web:4"})
+	c.Track(edgeruntime.ComponentSpec{Name: "api", Version: "1", Artifact: "registry/base:1"})
+	store.inUse["registry/web:1"] = true
+
+	r := c.Collect(ReasonSchedule)
+	// web history is now 4, base:1, 3, 2, 1
+	if r.ReclaimedBytes != 200 || len(r.Removed) != 2 || r.Removed[0] != "registry/web:2" || r.Removed[1] != "registry/web:3" {
+		t.Fatalf("unexpected report: %+v", r)
+	}
+	if len(r.Failed) != 1 || r.Failed[0] != "registry/web:1" {
+		t.Fatalf("expected in-use image to fail: %+v", r)
+	}
+	for _, ref := range []string{"registry/web:4", "registry/base:1"} {
+		if _, ok := store.images[ref]; !ok {
+			t.Fatalf("retained image %s was removed", ref)
+		}
+	}
+
+	// history survives a restart and no longer lists the pruned revision
+	reloaded, err := NewTracker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rev := range reloaded.Revisions("web") {
+		if rev.Artifact == "registry/web:2" || rev.Artifact == "registry/web:3" {
+			t.Fatalf("pruned revision still tracked: %+v", rev)
+		}
+	}
+}
+
+func TestCollectPackages(t *testing.T) {
+	dir := t.TempDir()
+	pkgDir := filepath.Join(dir, "packages")
+	tracker, err := NewTracker(filepath.Join(dir, "revisions.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &fakeStore{images: map[string]int64{}, inUse: map[string]bool{}}
+	c := NewCollector(tracker, store, Options{KeepRevisions: 1, PackageDir: pkgDir}, zap.NewNop().Sugar())
+	var reports []model.GCReport
+	c.OnReport = func(r model.GCReport) { reports = append(reports, r) }
+
+	// nothing expired yet: no report
+	if r := c.Collect(ReasonDiskPressure); len(r.Removed) != 0 || len(reports) != 0 {
+		t.Fatalf("empty run reported: %+v", reports)
+	}
+
+	if err := os.MkdirAll(pkgDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(dir, "outside.wasm")
+	for _, p := range []string{filepath.Join(pkgDir, "web-1.wasm"), filepath.Join(pkgDir, "web-2.wasm"), outside} {
+		if err := os.WriteFile(p, make([]byte, 10), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "1", PackageURL: "file://" + filepath.Join(pkgDir, "web-1.wasm")})
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "2", PackageURL: "http://lo:8081/web-2.wasm"})
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "3", PackageURL: outside})
+	c.Track(edgeruntime.ComponentSpec{Name: "web", Version: "4", PackageURL: filepath.Join(pkgDir, "web-2.wasm")})
+
+	r := c.Collect(ReasonSchedule)
+	if r.ReclaimedBytes != 10 || len(r.Removed) != 1 || len(reports) != 1 {
+		t.Fatalf("unexpected report: %+v", r)
+	}
+	if _, err := os.Stat(filepath.Join(pkgDir, "web-1.wasm")); !os.IsNotExist(err) {
+		t.Errorf("expired package kept: %v", err)
+	}
+	for _, p := range []string{filepath.Join(pkgDir, "web-2.wasm"), outside} {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("removed %s: %v", p, err)
+		}
+	}
+	if revs := tracker.Revisions("web"); len(revs) != 1 || revs[0].Version != "4" {
+		t.Errorf("revisions left: %+v", revs)
+	}
+
+	c.Collect(ReasonDiskPressure)
+	if len(reports) != 1 {
+		t.Errorf("reported a run that freed nothing: %+v", reports)
+	}
+}
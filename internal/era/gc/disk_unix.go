@@ -0,0 +1,18 @@
+//go:build !windows
+
+package gc
+
+import "syscall"
+
+func diskUsedPercent(path string) (float64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	total := float64(st.Blocks) * float64(st.Bsize)
+	if total == 0 {
+		return 0, nil
+	}
+	free := float64(st.Bavail) * float64(st.Bsize)
+	return (total - free) / total * 100, nil
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package gc
+
+// diskUsedPercent is not implemented on Windows; pressure-triggered runs are
+// disabled and only the schedule applies.
+func diskUsedPercent(path string) (float64, error) {
+	return 0, nil
+}
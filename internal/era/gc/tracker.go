@@ -0,0 +1,167 @@
+package gc
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Revision is one installed version of a component and the artifacts it
+// pulled: an image, a package, or both.
+type Revision struct {
+	Version     string    `json:"version"`
+	Artifact    string    `json:"artifact"`
+	Package     string    `json:"package,omitempty"`
+	InstalledAt time.Time `json:"installed_at"`
+}
+
+// Persister loads and saves the tracker's history as one JSON document.
+type Persister interface {
+	Load(v any) (bool, error)
+	Save(v any) error
+}
+
+// Tracker remembers, per component, which artifacts each installed revision
+// referenced. It is persisted so retention survives ERA restarts.
+type Tracker struct {
+	mu      sync.Mutex
+	persist Persister
+	revs    map[string][]Revision // component -> newest first
+}
+
+// NewTracker keeps history in a JSON file at path.
+func NewTracker(path string) (*Tracker, error) {
+	return NewTrackerWithStore(fileStore(path))
+}
+
+func NewTrackerWithStore(p Persister) (*Tracker, error) {
+	t := &Tracker{persist: p, revs: map[string][]Revision{}}
+	if _, err := p.Load(&t.revs); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Record notes that component was installed at version from the image
+// artifact and the package pkg, either of which may be empty. A reinstall of
+// a known revision moves it back to the front.
+func (t *Tracker) Record(component, version, artifact, pkg string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	revs := []Revision{{Version: version, Artifact: artifact, Package: pkg, InstalledAt: time.Now().UTC()}}
+	for _, r := range t.revs[component] {
+		if r.Version == version && r.Artifact == artifact && r.Package == pkg {
+			continue
+		}
+		revs = append(revs, r)
+	}
+	t.revs[component] = revs
+	return t.save()
+}
+
+// Expired returns the images only referenced by revisions older than the
+// newest keep of each component, sorted for stable output.
+func (t *Tracker) Expired(keep int) []string {
+	return t.expired(keep, func(r Revision) string { return r.Artifact })
+}
+
+// ExpiredPackages is Expired for packages.
+func (t *Tracker) ExpiredPackages(keep int) []string {
+	return t.expired(keep, func(r Revision) string { return r.Package })
+}
+
+func (t *Tracker) expired(keep int, artifact func(Revision) string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	retained := map[string]bool{}
+	expired := map[string]bool{}
+	for _, revs := range t.revs {
+		for i, r := range revs {
+			a := artifact(r)
+			if a == "" {
+				continue
+			}
+			if i < keep {
+				retained[a] = true
+			} else {
+				expired[a] = true
+			}
+		}
+	}
+
+	var out []string
+	for a := range expired {
+		if !retained[a] {
+			out = append(out, a)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Forget drops revisions beyond keep whose image and package are both in
+// removed, or not set, so they are not collected twice.
+func (t *Tracker) Forget(keep int, removed []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	gone := map[string]bool{}
+	for _, a := range removed {
+		gone[a] = true
+	}
+	for comp, revs := range t.revs {
+		kept := revs[:0]
+		for i, r := range revs {
+			if i >= keep && (r.Artifact == "" || gone[r.Artifact]) && (r.Package == "" || gone[r.Package]) {
+				continue
+			}
+			kept = append(kept, r)
+		}
+		t.revs[comp] = kept
+	}
+	return t.save()
+}
+
+// Revisions returns a copy of a component's history, newest first.
+func (t *Tracker) Revisions(component string) []Revision {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Revision(nil), t.revs[component]...)
+}
+
+func (t *Tracker) save() error {
+	return t.persist.Save(t.revs)
+}
+
+type fileStore string
+
+func (f fileStore) Load(v any) (bool, error) {
+	data, err := os.ReadFile(string(f))
+	switch {
+	case os.IsNotExist(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, json.Unmarshal(data, v)
+}
+
+func (f fileStore) Save(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(string(f)), 0755); err != nil {
+		return err
+	}
+	tmp := string(f) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, string(f))
+}
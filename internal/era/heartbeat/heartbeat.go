@@ -1,38 +1,122 @@
 package heartbeat
 
 import (
+	"context"
 	"fmt"
-	"time"
 	"math/rand"
+	"time"
+
 	"go.uber.org/zap"
-	"github.com/balaji-balu/margo-hello-world/pkg/model"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/telemetry"
 	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
 )
 
-func StartHeartbeat(nb *natsbroker.Broker, 
+type Options struct {
+	// Interval between heartbeats; Jitter adds up to that much random delay
+	// to each one so a site's hosts do not report in lockstep.
+	Interval time.Duration
+	Jitter   time.Duration
+	// MaxBackoff caps the interval while heartbeats cannot be delivered; it
+	// doubles from Interval on each failure and resets on the next success.
+	MaxBackoff time.Duration
+
+	Runtime      string
+	Region       string
+	Version      string
+	Capabilities []string
+	// DiskPath is the filesystem whose usage is reported.
+	DiskPath string
+}
+
+const (
+	DefaultInterval   = 10 * time.Second
+	DefaultMaxBackoff = 2 * time.Minute
+
+	flushTimeout = 5 * time.Second
+)
+
+func (o Options) withDefaults() Options {
+	if o.Interval <= 0 {
+		o.Interval = DefaultInterval
+	}
+	if o.MaxBackoff < o.Interval {
+		o.MaxBackoff = DefaultMaxBackoff
+		if o.MaxBackoff < o.Interval {
+			o.MaxBackoff = o.Interval
+		}
+	}
+	if o.Jitter < 0 {
+		o.Jitter = 0
+	}
+	return o
+}
+
+func StartHeartbeat(nb *natsbroker.Broker,
 	log *zap.SugaredLogger,
-	siteID, hostID string) {
- 
-	ticker := time.NewTicker(10 * time.Second)
-	go func() {
-		for range ticker.C {
-			msg := model.HealthMsg{
-				NodeID:     hostID,
-				SiteID:     siteID,
-				CPUPercent: rand.Float64() * 20,
-				MemMB:      50 + rand.Float64()*20,
-				Timestamp:  time.Now().Unix(),
-				// Runtime:    en.Runtime,
-				// //Region:     en.Region,
-			}
-			subj := fmt.Sprintf("health.%s.%s", siteID, hostID)
-			if err := nb.Publish(subj, msg); err != nil {
-				log.Errorw("Heart publish failed:", "err", err)
-			} else {
-				//log.Debugw("Heart msg published", "Subject:", subj,"msg:", msg)
-				nb.Flush()
+	siteID, hostID string, opts Options) {
+	go Run(context.Background(), nb, log, siteID, hostID, opts)
+}
+
+// Run sends heartbeats until ctx is done.
+func Run(ctx context.Context, nb *natsbroker.Broker,
+	log *zap.SugaredLogger,
+	siteID, hostID string, opts Options) {
+
+	opts = opts.withDefaults()
+	sampler := telemetry.NewSampler(opts.DiskPath)
+	subj := fmt.Sprintf("health.%s.%s", siteID, hostID)
+
+	failures := 0
+	for {
+		msg := model.HealthMsg{
+			NodeID:          hostID,
+			SiteID:          siteID,
+			Timestamp:       time.Now().Unix(),
+			Runtime:         opts.Runtime,
+			Region:          opts.Region,
+			Version:         opts.Version,
+			Capabilities:    opts.Capabilities,
+			IntervalSeconds: int((opts.Interval + opts.Jitter + time.Second - 1) / time.Second),
+		}
+		sampler.Sample(&msg)
+
+		err := nb.Publish(subj, msg)
+		if err == nil {
+			err = nb.FlushTimeout(flushTimeout)
+		}
+		if err != nil {
+			failures++
+			if failures == 1 {
+				log.Warnw("heartbeat not delivered; backing off", "subject", subj, "err", err)
 			}
-			//log.Println("Heart msg published", id)
+		} else if failures > 0 {
+			log.Infow("heartbeat delivered again", "subject", subj, "missed", failures)
+			failures = 0
 		}
-	}()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(nextDelay(opts, failures, rand.Int63n)):
+		}
+	}
+}
+
+// nextDelay is Interval (plus jitter) while heartbeats get through and
+// doubles per consecutive failure up to MaxBackoff. randn returns a value in
+// [0, n).
+func nextDelay(opts Options, failures int, randn func(n int64) int64) time.Duration {
+	d := opts.Interval
+	for i := 0; i < failures && d < opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > opts.MaxBackoff {
+		d = opts.MaxBackoff
+	}
+	if opts.Jitter > 0 {
+		d += time.Duration(randn(int64(opts.Jitter)))
+	}
+	return d
 }
\ No newline at end of file
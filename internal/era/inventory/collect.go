@@ -0,0 +1,345 @@
+// Package inventory discovers a host's static hardware from /proc, /sys and
+// /dev for placement decisions.
+package inventory
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+type Collector struct {
+	// ProcRoot, SysRoot and DevRoot default to /proc, /sys and /dev; tests
+	// point them at fixtures.
+	ProcRoot string
+	SysRoot  string
+	DevRoot  string
+}
+
+func NewCollector() *Collector {
+	return &Collector{ProcRoot: "/proc", SysRoot: "/sys", DevRoot: "/dev"}
+}
+
+// Collect reads the current inventory. Sources that are missing are skipped.
+func (c *Collector) Collect() model.HardwareInventory {
+	inv := model.HardwareInventory{
+		CPU:          c.cpu(),
+		MemTotalMB:   c.memTotalMB(),
+		BlockDevices: c.blockDevices(),
+		NICs:         c.nics(),
+		Devices:      c.devices(),
+	}
+	inv.Hash = Hash(inv)
+	inv.CollectedAt = time.Now().Unix()
+	return inv
+}
+
+// Hash digests the hardware fields of inv, ignoring identity and timestamps.
+func Hash(inv model.HardwareInventory) string {
+	inv.HostID, inv.SiteID, inv.Hash, inv.CollectedAt = "", "", "", 0
+	b, _ := json.Marshal(inv)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *Collector) cpu() model.CPUInventory {
+	cpu := model.CPUInventory{Arch: runtime.GOARCH, Cores: runtime.NumCPU()}
+
+	f, err := os.Open(filepath.Join(c.ProcRoot, "cpuinfo"))
+	if err != nil {
+		return cpu
+	}
+	defer f.Close()
+
+	processors := 0
+	var hardware string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		key, val, ok := strings.Cut(sc.Text(), ":")
+		if !ok {
+			continue
+		}
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		switch key {
+		case "processor":
+			processors++
+		case "model name":
+			// x86 and some arm kernels
+			if cpu.Model == "" {
+				cpu.Model = val
+			}
+		case "Hardware", "Model":
+			// arm boards report the SoC or board here instead
+			if hardware == "" {
+				hardware = val
+			}
+		}
+	}
+	if cpu.Model == "" {
+		cpu.Model = hardware
+	}
+	if processors > 0 {
+		cpu.Cores = processors
+	}
+	return cpu
+}
+
+func (c *Collector) memTotalMB() uint64 {
+	f, err := os.Open(filepath.Join(c.ProcRoot, "meminfo"))
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, _ := strconv.ParseUint(fields[1], 10, 64)
+			return kb / 1024
+		}
+	}
+	return 0
+}
+
+// blockDevices lists whole disks from /sys/block, skipping loop, ram and
+// device-mapper nodes.
+func (c *Collector) blockDevices() []model.BlockDevice {
+	dir := filepath.Join(c.SysRoot, "block")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.BlockDevice
+	for _, e := range entries {
+		name := e.Name()
+		if hasAnyPrefix(name, "loop", "ram", "zram", "dm-", "sr", "fd") {
+			continue
+		}
+		base := filepath.Join(dir, name)
+		sectors, _ := strconv.ParseUint(readTrim(filepath.Join(base, "size")), 10, 64)
+		out = append(out, model.BlockDevice{
+			Name:       name,
+			SizeBytes:  sectors * 512, // /sys reports 512-byte sectors regardless of device
+			Model:      readTrim(filepath.Join(base, "device", "model")),
+			Rotational: readTrim(filepath.Join(base, "queue", "rotational")) == "1",
+			Removable:  readTrim(filepath.Join(base, "removable")) == "1",
+		})
+	}
+	return out
+}
+
+func (c *Collector) nics() []model.NIC {
+	dir := filepath.Join(c.SysRoot, "class", "net")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.NIC
+	for _, e := range entries {
+		name := e.Name()
+		if name == "lo" {
+			continue
+		}
+		base := filepath.Join(dir, name)
+		nic := model.NIC{Name: name, Type: "ethernet", MAC: readTrim(filepath.Join(base, "address"))}
+		switch {
+		case exists(filepath.Join(base, "wireless")) || exists(filepath.Join(base, "phy80211")):
+			nic.Type = "wifi"
+		case !exists(filepath.Join(base, "device")):
+			// bridges, veths, tunnels
+			nic.Type = "virtual"
+		}
+		// speed is -1 or unreadable while the link is down
+		if speed, err := strconv.Atoi(readTrim(filepath.Join(base, "speed"))); err == nil && speed > 0 {
+			nic.SpeedMbps = speed
+		}
+		if target, err := os.Readlink(filepath.Join(base, "device", "driver")); err == nil {
+			nic.Driver = filepath.Base(target)
+		}
+		out = append(out, nic)
+	}
+	return out
+}
+
+func (c *Collector) devices() []model.Device {
+	var out []model.Device
+	out = append(out, c.cameras()...)
+	out = append(out, c.serialPorts()...)
+	out = append(out, c.usbDevices()...)
+	out = append(out, c.gpus()...)
+	for _, g := range []struct{ typ, pattern string }{
+		{"i2c", "i2c-*"},
+		{"spi", "spidev*"},
+		{"gpio", "gpiochip*"},
+	} {
+		paths, _ := filepath.Glob(filepath.Join(c.DevRoot, g.pattern))
+		for _, p := range paths {
+			out = append(out, model.Device{Type: g.typ, Path: devPath(p, c.DevRoot), Name: filepath.Base(p)})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Type != out[j].Type {
+			return out[i].Type < out[j].Type
+		}
+		return out[i].Path+out[i].Name < out[j].Path+out[j].Name
+	})
+	return out
+}
+
+func (c *Collector) cameras() []model.Device {
+	dir := filepath.Join(c.SysRoot, "class", "video4linux")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.Device
+	for _, e := range entries {
+		out = append(out, model.Device{
+			Type:  "camera",
+			Path:  "/dev/" + e.Name(),
+			Name:  e.Name(),
+			Model: readTrim(filepath.Join(dir, e.Name(), "name")),
+		})
+	}
+	return out
+}
+
+// serialPorts lists ttys backed by real hardware. The kernel creates
+// ttyS0..31 whether or not a UART exists, so those count only when their
+// port type is set.
+func (c *Collector) serialPorts() []model.Device {
+	dir := filepath.Join(c.SysRoot, "class", "tty")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.Device
+	for _, e := range entries {
+		name := e.Name()
+		base := filepath.Join(dir, name)
+		switch {
+		case hasAnyPrefix(name, "ttyUSB", "ttyACM", "ttyAMA"):
+		case strings.HasPrefix(name, "ttyS"):
+			if t := readTrim(filepath.Join(base, "type")); t == "" || t == "0" {
+				continue
+			}
+		default:
+			continue
+		}
+		if !exists(filepath.Join(base, "device")) {
+			continue
+		}
+		out = append(out, model.Device{Type: "serial", Path: "/dev/" + name, Name: name})
+	}
+	return out
+}
+
+// usbDevices lists attached USB devices, skipping root hubs and interface
+// entries (names containing ':').
+func (c *Collector) usbDevices() []model.Device {
+	dir := filepath.Join(c.SysRoot, "bus", "usb", "devices")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.Device
+	for _, e := range entries {
+		name := e.Name()
+		if strings.Contains(name, ":") || strings.HasPrefix(name, "usb") {
+			continue
+		}
+		base := filepath.Join(dir, name)
+		vendor := readTrim(filepath.Join(base, "idVendor"))
+		if vendor == "" {
+			continue
+		}
+		d := model.Device{
+			Type:         "usb",
+			Name:         name,
+			VendorID:     vendor,
+			ProductID:    readTrim(filepath.Join(base, "idProduct")),
+			Manufacturer: readTrim(filepath.Join(base, "manufacturer")),
+			Model:        readTrim(filepath.Join(base, "product")),
+		}
+		bus, _ := strconv.Atoi(readTrim(filepath.Join(base, "busnum")))
+		dev, _ := strconv.Atoi(readTrim(filepath.Join(base, "devnum")))
+		if bus > 0 && dev > 0 {
+			d.Path = filepath.Join("/dev/bus/usb", leftPad3(bus), leftPad3(dev))
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func (c *Collector) gpus() []model.Device {
+	dir := filepath.Join(c.SysRoot, "class", "drm")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []model.Device
+	for _, e := range entries {
+		name := e.Name()
+		// card0, not connectors like card0-HDMI-A-1
+		if !strings.HasPrefix(name, "card") || strings.Contains(name, "-") {
+			continue
+		}
+		d := model.Device{Type: "gpu", Path: "/dev/dri/" + name, Name: name}
+		if target, err := os.Readlink(filepath.Join(dir, name, "device", "driver")); err == nil {
+			d.Model = filepath.Base(target)
+		}
+		d.VendorID = strings.TrimPrefix(readTrim(filepath.Join(dir, name, "device", "vendor")), "0x")
+		out = append(out, d)
+	}
+	return out
+}
+
+func readTrim(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func exists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
+
+func hasAnyPrefix(s string, prefixes ...string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func leftPad3(n int) string {
+	s := strconv.Itoa(n)
+	for len(s) < 3 {
+		s = "0" + s
+	}
+	return s
+}
+
+// devPath reports p as a /dev path even when DevRoot points at a fixture.
+func devPath(p, devRoot string) string {
+	rel, err := filepath.Rel(devRoot, p)
+	if err != nil {
+		return p
+	}
+	return "/dev/" + filepath.ToSlash(rel)
+}
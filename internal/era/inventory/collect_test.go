@@ -0,0 +1,119 @@
+package inventory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, body := range files {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func fixture(t *testing.T) *Collector {
+	root := t.TempDir()
+	c := &Collector{
+		ProcRoot: filepath.Join(root, "proc"),
+		SysRoot:  filepath.Join(root, "sys"),
+		DevRoot:  filepath.Join(root, "dev"),
+	}
+	writeTree(t, c.ProcRoot, map[string]string{
+		"cpuinfo": "processor\t: 0\nmodel name\t: ARMv8 Processor\nprocessor\t: 1\nHardware\t: BCM2835\n",
+		"meminfo": "MemTotal:        4096000 kB\n",
+	})
+	writeTree(t, c.SysRoot, map[string]string{
+		"block/mmcblk0/size":               "62333952\n",
+		"block/mmcblk0/removable":          "0\n",
+		"block/mmcblk0/queue/rotational":   "0\n",
+		"block/loop0/size":                 "8\n",
+		"class/net/eth0/address":           "dc:a6:32:00:00:01\n",
+		"class/net/eth0/speed":             "1000\n",
+		"class/net/eth0/device/uevent":     "",
+		"class/net/wlan0/address":          "dc:a6:32:00:00:02\n",
+		"class/net/wlan0/wireless/x":       "",
+		"class/net/wlan0/device/uevent":    "",
+		"class/net/docker0/address":        "02:42:00:00:00:01\n",
+		"class/video4linux/video0/name":    "USB Camera\n",
+		"class/tty/ttyUSB0/device/uevent":  "",
+		"class/tty/ttyS0/device/uevent":    "",
+		"class/tty/ttyS0/type":             "0\n",
+		"class/tty/tty1/uevent":            "",
+		"bus/usb/devices/1-1/idVendor":     "046d\n",
+		"bus/usb/devices/1-1/idProduct":    "0825\n",
+		"bus/usb/devices/1-1/manufacturer": "Logitech\n",
+		"bus/usb/devices/1-1/busnum":       "1\n",
+		"bus/usb/devices/1-1/devnum":       "4\n",
+		"bus/usb/devices/1-1:1.0/idVendor": "046d\n",
+		"bus/usb/devices/usb1/idVendor":    "1d6b\n",
+	})
+	writeTree(t, c.DevRoot, map[string]string{"i2c-1": ""})
+	return c
+}
+
+func TestCollect(t *testing.T) {
+	inv := fixture(t).Collect()
+
+	if inv.CPU.Model != "ARMv8 Processor" || inv.CPU.Cores != 2 {
+		t.Fatalf("CPU = %+v", inv.CPU)
+	}
+	if inv.MemTotalMB != 4000 {
+		t.Fatalf("MemTotalMB = %d", inv.MemTotalMB)
+	}
+	if len(inv.BlockDevices) != 1 || inv.BlockDevices[0].SizeBytes != 62333952*512 {
+		t.Fatalf("BlockDevices = %+v", inv.BlockDevices)
+	}
+
+	nicTypes := map[string]string{}
+	for _, n := range inv.NICs {
+		nicTypes[n.Name] = n.Type
+	}
+	if nicTypes["eth0"] != "ethernet" || nicTypes["wlan0"] != "wifi" || nicTypes["docker0"] != "virtual" {
+		t.Fatalf("NIC types = %v", nicTypes)
+	}
+
+	got := map[string]string{}
+	for _, d := range inv.Devices {
+		got[d.Type+" "+d.Path] = d.Model
+	}
+	want := map[string]string{
+		"camera /dev/video0":       "USB Camera",
+		"serial /dev/ttyUSB0":      "",
+		"usb /dev/bus/usb/001/004": "",
+		"i2c /dev/i2c-1":           "",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("devices = %v", got)
+	}
+	for k, model := range want {
+		if m, ok := got[k]; !ok || m != model {
+			t.Fatalf("device %q missing or wrong model in %v", k, got)
+		}
+	}
+	if !inv.HasDevice("camera") || inv.HasDevice("gpu") {
+		t.Fatal("HasDevice mismatch")
+	}
+}
+
+func TestHashTracksHardwareOnly(t *testing.T) {
+	c := fixture(t)
+	a := c.Collect()
+	b := c.Collect()
+	b.HostID, b.CollectedAt = "other", a.CollectedAt+60
+	if Hash(a) != Hash(b) {
+		t.Fatal("hash should ignore identity and timestamps")
+	}
+
+	writeTree(t, c.SysRoot, map[string]string{"class/video4linux/video1/name": "Second Camera\n"})
+	if c.Collect().Hash == a.Hash {
+		t.Fatal("hash should change when a device is added")
+	}
+}
@@ -0,0 +1,38 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// DefaultInterval is how often Watch re-reads the hardware.
+const DefaultInterval = 5 * time.Minute
+
+// Watch re-collects every interval and calls onChange whenever the inventory
+// differs from last (usually the one sent at registration). It returns when
+// ctx ends.
+func (c *Collector) Watch(ctx context.Context, interval time.Duration, last model.HardwareInventory,
+	onChange func(model.HardwareInventory)) {
+
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		inv := c.Collect()
+		if inv.Hash == last.Hash {
+			continue
+		}
+		onChange(inv)
+		last = inv
+	}
+}
@@ -2,6 +2,9 @@ package lifecycle
 
 import (
     //"log"
+    "fmt"
+    "sort"
+    "sync"
 
     "go.uber.org/zap"
 
@@ -22,31 +25,126 @@ import (
 type LifecycleController struct {
     plugin edgeruntime.RuntimePlugin
     log *zap.SugaredLogger
+
+    // ParamDir holds config files written for /files/ parameter targets.
+    ParamDir string
+    // Mirror, when set, is where images and packages are pulled from
+    // instead of their registries and URLs (the site's LO), with
+    // MirrorToken.
+    Mirror      string
+    MirrorToken string
+
+    // BeforeInstall, when set, can veto an install (e.g. signature checks)
+    // or pin what it pulls, by changing the spec.
+    BeforeInstall func(*edgeruntime.ComponentSpec) error
+    // OnInstalled, when set, is called after every successful Install.
+    OnInstalled func(edgeruntime.ComponentSpec)
+    // OnStarted and OnStopping bracket a component's running life; the
+    // runtime manager uses them to start and stop health probes.
+    OnStarted  func(edgeruntime.ComponentSpec)
+    OnStopping func(name string)
+    // WaitReady, when set, blocks until a started component is ready; it
+    // gates starting the components that depend on it.
+    WaitReady func(name string) error
+
+    mu        sync.Mutex
+    installed map[string]edgeruntime.ComponentSpec // the spec each component was last installed from
 }
 
 func NewLifecycleController(runtime string, log *zap.SugaredLogger) *LifecycleController {
     log.Infow("Runtime", "", runtime)
+    return NewLifecycleControllerWithPlugin(plugins.Get(runtime), log)
+}
+
+// NewLifecycleControllerWithPlugin drives the given plugin instance instead
+// of the registered one, e.g. one mock per simulated host.
+func NewLifecycleControllerWithPlugin(p edgeruntime.RuntimePlugin, log *zap.SugaredLogger) *LifecycleController {
     return &LifecycleController{
-        plugin: plugins.Get(runtime),
+        plugin: p,
         log: log,
     }
 }
 
+func (lc *LifecycleController) Plugin() edgeruntime.RuntimePlugin {
+    return lc.plugin
+}
+
 func (lc *LifecycleController) Apply(c edgeruntime.ComponentSpec) error {
     lc.log.Infow("LifecycleController: Apply Enter")   
-    if err := lc.plugin.Install(c); err != nil {
-        lc.log.Errorw("Install failed", err)
+    if err := lc.install(&c); err != nil {
+        lc.log.Errorw("Install failed", "err", err)
+        return err
+    }
+    return lc.start(c)
+}
+
+func (lc *LifecycleController) start(c edgeruntime.ComponentSpec) error {
+    if err := lc.plugin.Start(c); err != nil {
+        return err
+    }
+    if lc.OnStarted != nil {
+        lc.OnStarted(c)
+    }
+    return nil
+}
+
+func (lc *LifecycleController) stopping(name string) {
+    if lc.OnStopping != nil {
+        lc.OnStopping(name)
+    }
+}
+
+// install installs c, as BeforeInstall leaves it.
+func (lc *LifecycleController) install(c *edgeruntime.ComponentSpec) error {
+    if lc.BeforeInstall != nil {
+        if err := lc.BeforeInstall(c); err != nil {
+            return err
+        }
+    }
+    if err := lc.plugin.Install(*c); err != nil {
         return err
     }
-    return lc.plugin.Start(c)
+    lc.remember(*c)
+    if lc.OnInstalled != nil {
+        lc.OnInstalled(*c)
+    }
+    return nil
+}
+
+func (lc *LifecycleController) remember(c edgeruntime.ComponentSpec) {
+    lc.mu.Lock()
+    defer lc.mu.Unlock()
+    if lc.installed == nil {
+        lc.installed = map[string]edgeruntime.ComponentSpec{}
+    }
+    lc.installed[c.Name] = c
+}
+
+func (lc *LifecycleController) lastInstalled(name string) (edgeruntime.ComponentSpec, bool) {
+    lc.mu.Lock()
+    defer lc.mu.Unlock()
+    c, ok := lc.installed[name]
+    return c, ok
+}
+
+func (lc *LifecycleController) forget(name string) {
+    lc.mu.Lock()
+    defer lc.mu.Unlock()
+    delete(lc.installed, name)
 }
 
 func (lc *LifecycleController) Stop(name string) error {
+    lc.stopping(name)
     return lc.plugin.Stop(name)
 }
 
 func (lc *LifecycleController) Delete(name string) error {
-    return lc.plugin.Delete(name)
+    lc.stopping(name)
+    if err := lc.plugin.Delete(name); err != nil {
+        return err
+    }
+    lc.forget(name)
+    return nil
 }
 
 func (lc *LifecycleController) HandleAction(op model.DiffOp) (error) {
@@ -72,7 +170,7 @@ func (lc *LifecycleController) HandleAction(op model.DiffOp) (error) {
     case model.ActionUpdateComp:
         lc.log.Debugw("ActionUpdateComp")
         //comp := app.Component(act.CompID)
-        return nil //lc.handleUpdateComp(ctx, rt, comp)
+        return lc.handleUpdateComp(&app, op.CompName)
 
     case model.ActionRemoveComp:
         lc.log.Debugw("ActionRemoveComp")
@@ -81,45 +179,166 @@ func (lc *LifecycleController) HandleAction(op model.DiffOp) (error) {
 
     case model.ActionRemoveApp:
         lc.log.Debugw("ActionRemoveApp")
-        return nil //lc.handleRemoveApp(ctx, rt, app)
+        return lc.handleRemoveApp(&app)
     }
 
     return nil
 }
 
+// handleAddApp installs the app's components in dependency order, then starts
+// them in the same order, waiting for a component to be ready before starting
+// the ones that depend on it. A failure is cascaded to every dependant, while
+// unrelated components still go ahead.
 func (lc *LifecycleController) handleAddApp(app *model.App) error {
     lc.log.Debugw("handleAddApp: enter")
-    for _, comp := range app.Components {
-        lc.log.Debugw("","", comp)
+    order, err := DependencyOrder(app)
+    if err != nil {
+        lc.log.Errorw("dependency order", "app", app.ID, "err", err)
+        return err
+    }
+    lc.log.Debugw("component order", "app", app.ID, "order", order)
+
+    appErr := newAppError(app.ID)
+    specs := map[string]edgeruntime.ComponentSpec{}
+    for _, name := range order {
+        comp := app.Components[name]
+        if dep, failed := appErr.failedDependency(comp); failed {
+            appErr.Failed[name] = &DependencyError{Dependency: dep}
+            continue
+        }
         // comp.Name
         // comp.Version
         // comp.Repository
         // comp.PackageURL
         // comp.KeyURL
-        c := edgeruntime.ComponentSpec{
-            Name:     comp.Name,
-            Runtime:  "containerd",
-            Artifact: comp.Repository,
+        c, err := lc.componentSpec(app, comp)
+        if err != nil {
+            lc.log.Errorw("component spec","err", err)
+            appErr.Failed[name] = err
+            continue
         }
 
-        if err := lc.plugin.Install(c); err != nil {
+        if err := lc.install(&c); err != nil {
             lc.log.Errorw("plugin install","err", err)
-            return err
+            appErr.Failed[name] = err
+            continue
         }
+        specs[name] = c
     }
-    for _, comp := range app.Components {
-        lc.log.Debugw("","", comp)
-        c := edgeruntime.ComponentSpec{
-            Name:     comp.Name,
-            Runtime:  "containerd",
-            Artifact: comp.Repository,
+    for _, name := range order {
+        if _, failed := appErr.Failed[name]; failed {
+            continue
+        }
+        if dep, failed := appErr.failedDependency(app.Components[name]); failed {
+            appErr.Failed[name] = &DependencyError{Dependency: dep}
+            continue
         }
-        if err := lc.plugin.Start(c); err != nil {
+        if err := lc.start(specs[name]); err != nil {
             lc.log.Errorw("plugin Start","err", err)
-            return err
+            appErr.Failed[name] = err
+            continue
+        }
+        if lc.WaitReady != nil && hasDependants(app, name) {
+            if err := lc.WaitReady(name); err != nil {
+                lc.log.Errorw("dependency not ready", "component", name, "err", err)
+                appErr.Failed[name] = err
+            }
         }
     }
     lc.log.Debugw("handleAddApp: exit")
+    return appErr.orNil()
+}
+
+// handleRemoveApp stops and deletes the app's components in reverse
+// dependency order, so nothing loses a dependency while still running.
+func (lc *LifecycleController) handleRemoveApp(app *model.App) error {
+    order, err := DependencyOrder(app)
+    if err != nil {
+        // a recorded app should never have a cycle; remove in name order
+        lc.log.Warnw("dependency order", "app", app.ID, "err", err)
+        order = order[:0]
+        for name := range app.Components {
+            order = append(order, name)
+        }
+        sort.Strings(order)
+    }
+
+    appErr := newAppError(app.ID)
+    for i := len(order) - 1; i >= 0; i-- {
+        name := order[i]
+        if err := lc.Stop(name); err != nil {
+            lc.log.Warnw("plugin Stop", "component", name, "err", err)
+        }
+        if err := lc.Delete(name); err != nil {
+            appErr.Failed[name] = err
+        }
+    }
+    return appErr.orNil()
+}
+
+// handleUpdateComp replaces a running component with its new spec, e.g. after
+// a parameter value changed. If the new spec does not install and start, the
+// component is put back as it was.
+func (lc *LifecycleController) handleUpdateComp(app *model.App, name string) error {
+    prev, ok := lc.lastInstalled(name)
+    err := lc.Redeploy(app, name)
+    if err == nil || !ok {
+        return err
+    }
+    lc.log.Warnw("update failed; restoring previous spec", "component", name, "err", err)
+    if rerr := lc.restore(prev); rerr != nil {
+        lc.log.Errorw("restore previous spec", "component", name, "err", rerr)
+        return fmt.Errorf("%w (restoring previous spec: %v)", err, rerr)
+    }
+    return err
+}
+
+// restore reinstalls and starts c in place of whatever is left of a failed
+// update. c was verified when first installed, so it is not checked again.
+func (lc *LifecycleController) restore(c edgeruntime.ComponentSpec) error {
+    lc.stopping(c.Name)
+    if err := lc.plugin.Stop(c.Name); err != nil {
+        lc.log.Debugw("plugin Stop", "component", c.Name, "err", err)
+    }
+    if err := lc.plugin.Delete(c.Name); err != nil {
+        lc.log.Debugw("plugin Delete", "component", c.Name, "err", err)
+    }
+    if err := lc.plugin.Install(c); err != nil {
+        return err
+    }
+    lc.remember(c)
+    return lc.start(c)
+}
+
+// Redeploy removes whatever the runtime holds for the component and installs
+// and starts it again from app.
+func (lc *LifecycleController) Redeploy(app *model.App, name string) error {
+    lc.log.Debugw("Redeploy: enter", "component", name)
+    comp, ok := app.Components[name]
+    if !ok {
+        return fmt.Errorf("component %s not in app %s", name, app.ID)
+    }
+    c, err := lc.componentSpec(app, comp)
+    if err != nil {
+        return err
+    }
+
+    lc.stopping(name)
+    if err := lc.plugin.Stop(name); err != nil {
+        lc.log.Warnw("plugin Stop", "component", name, "err", err)
+    }
+    if err := lc.plugin.Delete(name); err != nil {
+        return err
+    }
+    if err := lc.install(&c); err != nil {
+        lc.log.Errorw("plugin install", "err", err)
+        return err
+    }
+    if err := lc.start(c); err != nil {
+        lc.log.Errorw("plugin Start", "err", err)
+        return err
+    }
+    lc.log.Debugw("Redeploy: exit", "component", name)
     return nil
 }
 
@@ -0,0 +1,198 @@
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// DependencyOrder returns app's components ordered so that each comes after
+// everything it depends on. Ties are broken by name, so the order is the same
+// on every run. Unknown dependencies and cycles are errors.
+func DependencyOrder(app *model.App) ([]string, error) {
+	names := make([]string, 0, len(app.Components))
+	for name := range app.Components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, dep := range app.Components[name].DependsOn {
+			if _, ok := app.Components[dep]; !ok {
+				return nil, fmt.Errorf("component %s depends on unknown component %s", name, dep)
+			}
+		}
+	}
+
+	// Kahn's algorithm, always taking the smallest ready name
+	pending := map[string]int{}
+	dependants := map[string][]string{}
+	for _, name := range names {
+		deps := uniq(app.Components[name].DependsOn)
+		pending[name] = len(deps)
+		for _, dep := range deps {
+			dependants[dep] = append(dependants[dep], name)
+		}
+	}
+	var ready []string
+	for _, name := range names {
+		if pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	order := make([]string, 0, len(names))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		name := ready[0]
+		ready = ready[1:]
+		order = append(order, name)
+		for _, d := range dependants[name] {
+			if pending[d]--; pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	if len(order) < len(names) {
+		return nil, findCycle(app, names)
+	}
+	return order, nil
+}
+
+// findCycle describes one dependency cycle in app, e.g. "a -> b -> a".
+func findCycle(app *model.App, names []string) error {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			i := len(path) - 1
+			for path[i] != name {
+				i--
+			}
+			return append(append([]string{}, path[i:]...), name)
+		}
+		state[name] = visiting
+		path = append(path, name)
+		deps := append([]string(nil), app.Components[name].DependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+
+	for _, name := range names {
+		if cycle := visit(name); cycle != nil {
+			return fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	return fmt.Errorf("dependency cycle")
+}
+
+func uniq(in []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, s := range in {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// hasDependants reports whether any component of app depends on name.
+func hasDependants(app *model.App, name string) bool {
+	for _, c := range app.Components {
+		for _, dep := range c.DependsOn {
+			if dep == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DependencyError marks a component that was not installed or started
+// because a component it depends on failed.
+type DependencyError struct {
+	Dependency string
+}
+
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("dependency %s failed", e.Dependency)
+}
+
+// AppError collects per-component failures of an app-level action.
+type AppError struct {
+	App    string
+	Failed map[string]error
+}
+
+func newAppError(app string) *AppError {
+	return &AppError{App: app, Failed: map[string]error{}}
+}
+
+func (e *AppError) Error() string {
+	names := make([]string, 0, len(e.Failed))
+	for name := range e.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s: %v", name, e.Failed[name]))
+	}
+	return fmt.Sprintf("app %s: %s", e.App, strings.Join(parts, "; "))
+}
+
+// Cause returns the first failure that is not a cascaded dependency failure.
+func (e *AppError) Cause() error {
+	names := make([]string, 0, len(e.Failed))
+	for name := range e.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var derr *DependencyError
+		if !errors.As(e.Failed[name], &derr) {
+			return e.Failed[name]
+		}
+	}
+	return nil
+}
+
+func (e *AppError) orNil() error {
+	if len(e.Failed) == 0 {
+		return nil
+	}
+	return e
+}
+
+// failedDependency returns the first dependency of comp that has failed.
+func (e *AppError) failedDependency(comp model.Component) (string, bool) {
+	for _, dep := range comp.DependsOn {
+		if _, failed := e.Failed[dep]; failed {
+			return dep, true
+		}
+	}
+	return "", false
+}
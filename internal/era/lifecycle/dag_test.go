@@ -0,0 +1,206 @@
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func appWith(deps map[string][]string) *model.App {
+	app := &model.App{ID: "app", Components: map[string]model.Component{}}
+	for name, d := range deps {
+		app.Components[name] = model.Component{Name: name, Repository: "repo/" + name, DependsOn: d}
+	}
+	return app
+}
+
+func TestDependencyOrder(t *testing.T) {
+	app := appWith(map[string][]string{
+		"api":    {"db", "cache"},
+		"web":    {"api"},
+		"db":     nil,
+		"cache":  nil,
+		"worker": {"db"},
+	})
+	order, err := DependencyOrder(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"cache", "db", "api", "web", "worker"}
+	if !reflect.DeepEqual(order, want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+
+	_, err = DependencyOrder(appWith(map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}}))
+	if err == nil || !strings.Contains(err.Error(), "a -> b -> c -> a") {
+		t.Fatalf("cycle error = %v", err)
+	}
+
+	_, err = DependencyOrder(appWith(map[string][]string{"a": {"a"}}))
+	if err == nil {
+		t.Fatal("self dependency should be a cycle")
+	}
+
+	_, err = DependencyOrder(appWith(map[string][]string{"a": {"missing"}}))
+	if err == nil || !strings.Contains(err.Error(), "unknown component missing") {
+		t.Fatalf("unknown dependency error = %v", err)
+	}
+}
+
+// recordingPlugin logs calls and fails Install for names or artifacts in
+// failInstall.
+type recordingPlugin struct {
+	calls       []string
+	failInstall map[string]bool
+}
+
+func (p *recordingPlugin) Name() string           { return "recording" }
+func (p *recordingPlugin) Capabilities() []string { return nil }
+func (p *recordingPlugin) Install(c edgeruntime.ComponentSpec) error {
+	p.calls = append(p.calls, "install "+c.Name)
+	if p.failInstall[c.Name] || p.failInstall[c.Artifact] {
+		return fmt.Errorf("pull %s failed", c.Name)
+	}
+	return nil
+}
+func (p *recordingPlugin) Start(c edgeruntime.ComponentSpec) error {
+	p.calls = append(p.calls, "start "+c.Name)
+	return nil
+}
+func (p *recordingPlugin) Stop(name string) error {
+	p.calls = append(p.calls, "stop "+name)
+	return nil
+}
+func (p *recordingPlugin) Delete(name string) error {
+	p.calls = append(p.calls, "delete "+name)
+	return nil
+}
+func (p *recordingPlugin) Status(string) (edgeruntime.ComponentStatus, error) {
+	return edgeruntime.ComponentStatus{}, nil
+}
+
+func TestHandleAddAppOrderAndCascade(t *testing.T) {
+	app := appWith(map[string][]string{
+		"db":    nil,
+		"api":   {"db"},
+		"web":   {"api"},
+		"cache": nil,
+	})
+
+	p := &recordingPlugin{failInstall: map[string]bool{}}
+	lc := &LifecycleController{plugin: p, log: zap.NewNop().Sugar()}
+	var waited []string
+	lc.WaitReady = func(name string) error {
+		waited = append(waited, name)
+		return nil
+	}
+
+	if err := lc.handleAddApp(app); err != nil {
+		t.Fatal(err)
+	}
+	// dependencies first, ties by name
+	want := []string{
+		"install cache", "install db", "install api", "install web",
+		"start cache", "start db", "start api", "start web",
+	}
+	if !reflect.DeepEqual(p.calls, want) {
+		t.Fatalf("calls = %v\nwant    %v", p.calls, want)
+	}
+	if !reflect.DeepEqual(waited, []string{"db", "api"}) {
+		t.Fatalf("waited for %v, want components with dependants only", waited)
+	}
+
+	// db fails: api and web are skipped, cache still comes up
+	p = &recordingPlugin{failInstall: map[string]bool{"db": true}}
+	lc.plugin = p
+	err := lc.handleAddApp(app)
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("err = %v, want *AppError", err)
+	}
+	if len(appErr.Failed) != 3 {
+		t.Fatalf("failed = %v", appErr.Failed)
+	}
+	var derr *DependencyError
+	if !errors.As(appErr.Failed["web"], &derr) || derr.Dependency != "api" {
+		t.Fatalf("web error = %v", appErr.Failed["web"])
+	}
+	if cause := appErr.Cause(); cause == nil || !strings.Contains(cause.Error(), "pull db failed") {
+		t.Fatalf("cause = %v", cause)
+	}
+	want = []string{"install cache", "install db", "start cache"}
+	if !reflect.DeepEqual(p.calls, want) {
+		t.Fatalf("calls = %v, want %v", p.calls, want)
+	}
+}
+
+func TestHandleRemoveAppReverseOrder(t *testing.T) {
+	app := appWith(map[string][]string{"db": nil, "api": {"db"}})
+	p := &recordingPlugin{}
+	lc := &LifecycleController{plugin: p, log: zap.NewNop().Sugar()}
+
+	if err := lc.handleRemoveApp(app); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"stop api", "delete api", "stop db", "delete db"}
+	if !reflect.DeepEqual(p.calls, want) {
+		t.Fatalf("calls = %v, want %v", p.calls, want)
+	}
+}
+
+func TestHandleUpdateCompRestores(t *testing.T) {
+	app := appWith(map[string][]string{"web": nil})
+	mode := func(v string) []model.ParameterValue {
+		return []model.ParameterValue{{Name: "mode", Pointer: "/files/~1etc~1web~1mode", Value: v}}
+	}
+	web := app.Components["web"]
+	web.Parameters = mode("edge")
+	app.Components["web"] = web
+	p := &recordingPlugin{failInstall: map[string]bool{}}
+	lc := &LifecycleController{plugin: p, log: zap.NewNop().Sugar(), ParamDir: t.TempDir()}
+	if err := lc.handleAddApp(app); err != nil {
+		t.Fatal(err)
+	}
+
+	web.Repository = "repo/web:broken"
+	web.Parameters = mode("cloud")
+	app.Components["web"] = web
+	p.failInstall["repo/web:broken"] = true
+	p.calls = nil
+	if err := lc.handleUpdateComp(app, "web"); err == nil || !strings.Contains(err.Error(), "pull web failed") {
+		t.Fatalf("update err = %v", err)
+	}
+	want := []string{"stop web", "delete web", "install web", "stop web", "delete web", "install web", "start web"}
+	if !reflect.DeepEqual(p.calls, want) {
+		t.Fatalf("calls = %v\nwant    %v", p.calls, want)
+	}
+	c, _ := lc.lastInstalled("web")
+	if c.Artifact != "repo/web" {
+		t.Fatalf("running %q after restore", c.Artifact)
+	}
+	// the restored spec mounts the file with the old value
+	if len(c.Mounts) != 1 {
+		t.Fatalf("mounts after restore: %+v", c.Mounts)
+	}
+	if data, err := os.ReadFile(c.Mounts[0].Source); err != nil || string(data) != "edge" {
+		t.Fatalf("config file after restore: %q, %v", data, err)
+	}
+
+	// removed components are not restored
+	if err := lc.Delete("web"); err != nil {
+		t.Fatal(err)
+	}
+	p.calls = nil
+	lc.handleUpdateComp(app, "web")
+	if !reflect.DeepEqual(p.calls, []string{"stop web", "delete web", "install web"}) {
+		t.Fatalf("calls after removal = %v", p.calls)
+	}
+}
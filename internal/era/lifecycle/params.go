@@ -0,0 +1,86 @@
+package lifecycle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// applyParameters layers resolved deployment parameters over the component
+// spec. /env/ targets become environment variables; /files/ targets are
+// written under dir and bind-mounted read-only at the requested path.
+func applyParameters(c *edgeruntime.ComponentSpec, params []model.ParameterValue, dir string) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	env := make(map[string]string, len(c.Env)+len(params))
+	for k, v := range c.Env {
+		env[k] = v
+	}
+
+	for _, p := range params {
+		kind, key, ok := model.ParseParameterPointer(p.Pointer)
+		if !ok {
+			return fmt.Errorf("parameter %s: unsupported pointer %q", p.Name, p.Pointer)
+		}
+
+		switch kind {
+		case model.ParamTargetEnv:
+			env[key] = p.Value
+
+		case model.ParamTargetFile:
+			if dir == "" {
+				return fmt.Errorf("parameter %s: no directory for config files", p.Name)
+			}
+			if !safeName(c.Name) {
+				return fmt.Errorf("parameter %s: invalid component name %q", p.Name, c.Name)
+			}
+			src, err := writeParamFile(filepath.Join(dir, c.Name), key, p.Value)
+			if err != nil {
+				return fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			c.Mounts = append(c.Mounts, edgeruntime.Mount{Source: src, Target: key, ReadOnly: true})
+		}
+	}
+
+	c.Env = env
+	return nil
+}
+
+// writeParamFile stores value under dir, named after the in-container target
+// and the value. A new value gets a new file, so the one a running spec
+// mounts is never rewritten and a failed update can be rolled back to it.
+func writeParamFile(dir, target, value string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	t := sha256.Sum256([]byte(target))
+	v := sha256.Sum256([]byte(value))
+	path := filepath.Join(dir, hex.EncodeToString(t[:8])+"-"+hex.EncodeToString(v[:8])+"-"+filepath.Base(target))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	// written aside and renamed, so a mount never sees half a file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(value), 0644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return path, nil
+}
+
+// safeName tells whether a component name can name a directory under the
+// parameter directory without leaving it.
+func safeName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,63 @@
+package lifecycle
+
+import (
+	"os"
+	"testing"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func TestApplyParameters(t *testing.T) {
+	dir := t.TempDir()
+	base := map[string]string{"LOG_LEVEL": "info", "KEEP": "1"}
+	c := edgeruntime.ComponentSpec{Name: "web", Env: base}
+
+	params := []model.ParameterValue{
+		{Name: "logLevel", Pointer: "/env/LOG_LEVEL", Value: "debug"},
+		{Name: "mode", Pointer: "/files/~1etc~1web~1mode", Value: "edge"},
+	}
+	if err := applyParameters(&c, params, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Env["LOG_LEVEL"] != "debug" || c.Env["KEEP"] != "1" {
+		t.Fatalf("unexpected env: %v", c.Env)
+	}
+	if base["LOG_LEVEL"] != "info" {
+		t.Fatal("component env from the desired state was modified")
+	}
+
+	if len(c.Mounts) != 1 || c.Mounts[0].Target != "/etc/web/mode" || !c.Mounts[0].ReadOnly {
+		t.Fatalf("unexpected mounts: %+v", c.Mounts)
+	}
+	data, err := os.ReadFile(c.Mounts[0].Source)
+	if err != nil || string(data) != "edge" {
+		t.Fatalf("config file: %q, %v", data, err)
+	}
+
+	// a new value gets a file of its own
+	first := c.Mounts[0].Source
+	c = edgeruntime.ComponentSpec{Name: "web"}
+	params[1].Value = "cloud"
+	if err := applyParameters(&c, params, dir); err != nil {
+		t.Fatal(err)
+	}
+	if c.Mounts[0].Source == first {
+		t.Fatal("new value written over the old file")
+	}
+	if data, _ := os.ReadFile(first); string(data) != "edge" {
+		t.Fatalf("old config file: %q", data)
+	}
+
+	bad := []model.ParameterValue{{Name: "site", Pointer: "/sites/s1", Value: "s1"}}
+	if err := applyParameters(&c, bad, dir); err == nil {
+		t.Fatal("unsupported pointer accepted")
+	}
+	for _, name := range []string{"..", "../../etc", `a\b`, ""} {
+		c := edgeruntime.ComponentSpec{Name: name}
+		if err := applyParameters(&c, params[1:], dir); err == nil {
+			t.Errorf("component name %q accepted", name)
+		}
+	}
+}
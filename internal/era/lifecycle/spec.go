@@ -0,0 +1,97 @@
+package lifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// componentSpec builds the plugin spec for comp with its parameters applied.
+func (lc *LifecycleController) componentSpec(app *model.App, comp model.Component) (edgeruntime.ComponentSpec, error) {
+	c, err := componentSpec(app, comp)
+	if err != nil {
+		return c, err
+	}
+	if err := applyParameters(&c, comp.Parameters, lc.ParamDir); err != nil {
+		return c, fmt.Errorf("component %s: %w", comp.Name, err)
+	}
+	if lc.Mirror != "" {
+		c.Mirror = lc.Mirror
+		c.MirrorToken = lc.MirrorToken
+		c.PackageURL = artifacts.PackageURL(lc.Mirror, c.PackageURL)
+		c.KeyLocation = artifacts.PackageURL(lc.Mirror, c.KeyLocation)
+	}
+	return c, nil
+}
+
+// componentSpec translates one component of the desired app into the spec
+// handed to the runtime plugin. App-level resources apply to each component.
+func componentSpec(app *model.App, comp model.Component) (edgeruntime.ComponentSpec, error) {
+	c := edgeruntime.ComponentSpec{
+		Name:     comp.Name,
+		Version:  comp.Version,
+		Runtime:  "containerd",
+		Artifact: comp.Repository,
+		Env:      comp.Env,
+
+		PackageURL:  comp.PackageURL,
+		KeyLocation: comp.KeyURL,
+
+		Liveness:  runtimeProbe(comp.Liveness),
+		Readiness: runtimeProbe(comp.Readiness),
+	}
+
+	for _, m := range comp.Mounts {
+		c.Mounts = append(c.Mounts, edgeruntime.Mount{
+			Source:   m.Source,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly,
+		})
+	}
+
+	if app.Resources != nil {
+		mem, err := edgeruntime.ParseMemory(app.Resources.Memory)
+		if err != nil {
+			return c, fmt.Errorf("component %s: %w", comp.Name, err)
+		}
+		c.Resources = edgeruntime.Resources{
+			CPUCores:    app.Resources.CPUCores,
+			MemoryBytes: mem,
+		}
+		for _, p := range app.Resources.Peripherals {
+			c.Peripherals = append(c.Peripherals, edgeruntime.Peripheral{
+				Type:         p.Type,
+				Manufacturer: p.Manufacturer,
+				Model:        p.Model,
+			})
+		}
+	}
+
+	return c, nil
+}
+
+func runtimeProbe(p *model.Probe) *edgeruntime.Probe {
+	if p == nil {
+		return nil
+	}
+	rp := &edgeruntime.Probe{
+		TCPPort:          p.TCPPort,
+		Exec:             p.Exec,
+		InitialDelay:     time.Duration(p.InitialDelay) * time.Second,
+		Period:           time.Duration(p.Period) * time.Second,
+		Timeout:          time.Duration(p.Timeout) * time.Second,
+		FailureThreshold: p.FailureThreshold,
+		SuccessThreshold: p.SuccessThreshold,
+	}
+	if p.HTTPGet != nil {
+		rp.HTTPGet = &edgeruntime.HTTPGetProbe{
+			Path:   p.HTTPGet.Path,
+			Port:   p.HTTPGet.Port,
+			Scheme: p.HTTPGet.Scheme,
+		}
+	}
+	return rp
+}
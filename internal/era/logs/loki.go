@@ -0,0 +1,125 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	lokiBatchSize     = 500
+	lokiFlushInterval = 2 * time.Second
+	lokiQueueSize     = 4096
+)
+
+// LokiSink pushes captured lines to a Loki push endpoint. It is best effort:
+// when Loki is slow or unreachable lines are dropped rather than blocking the
+// component's stdio.
+type LokiSink struct {
+	url    string
+	labels map[string]string
+	client *http.Client
+	queue  chan lokiEntry
+	log    *zap.SugaredLogger
+}
+
+type lokiEntry struct {
+	component string
+	line      model.LogLine
+}
+
+// NewLokiSink starts a sink pushing to baseURL + /loki/api/v1/push. labels are
+// attached to every stream (e.g. site and host); component and stream are
+// added per line.
+func NewLokiSink(baseURL string, labels map[string]string, log *zap.SugaredLogger) *LokiSink {
+	s := &LokiSink{
+		url:    baseURL + "/loki/api/v1/push",
+		labels: labels,
+		client: &http.Client{Timeout: 5 * time.Second},
+		queue:  make(chan lokiEntry, lokiQueueSize),
+		log:    log,
+	}
+	go s.run()
+	return s
+}
+
+func (s *LokiSink) Write(component string, line model.LogLine) {
+	select {
+	case s.queue <- lokiEntry{component: component, line: line}:
+	default:
+	}
+}
+
+func (s *LokiSink) run() {
+	ticker := time.NewTicker(lokiFlushInterval)
+	defer ticker.Stop()
+
+	var batch []lokiEntry
+	for {
+		select {
+		case e := <-s.queue:
+			batch = append(batch, e)
+			if len(batch) < lokiBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		if err := s.push(batch); err != nil {
+			s.log.Warnw("loki push failed", "lines", len(batch), "err", err)
+		}
+		batch = batch[:0]
+	}
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (s *LokiSink) push(batch []lokiEntry) error {
+	streams := map[string]*lokiStream{}
+	for _, e := range batch {
+		key := e.component + "/" + e.line.Stream
+		st, ok := streams[key]
+		if !ok {
+			labels := map[string]string{"component": e.component, "stream": e.line.Stream}
+			for k, v := range s.labels {
+				labels[k] = v
+			}
+			st = &lokiStream{Stream: labels}
+			streams[key] = st
+		}
+		st.Values = append(st.Values, [2]string{strconv.FormatInt(e.line.Time, 10), e.line.Text})
+	}
+
+	payload := struct {
+		Streams []*lokiStream `json:"streams"`
+	}{}
+	for _, st := range streams {
+		payload.Streams = append(payload.Streams, st)
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("loki returned %d", resp.StatusCode)
+	}
+	return nil
+}
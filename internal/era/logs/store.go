@@ -0,0 +1,285 @@
+// Package logs captures component stdout/stderr on the ERA into size-rotated
+// files and lets callers tail or follow them.
+//
+// Lines are stored in the CRI log format ("<RFC3339Nano> <stream> F <text>") so
+// the files can also be scraped by promtail or any other CRI-aware shipper.
+package logs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+
+	DefaultMaxBytes = 10 << 20
+	DefaultMaxFiles = 3
+
+	subscriberBuffer = 256
+)
+
+// Sink receives every captured line, e.g. to ship it to Loki.
+type Sink interface {
+	Write(component string, line model.LogLine)
+}
+
+type Options struct {
+	MaxBytes int64
+	MaxFiles int
+	Sinks    []Sink
+}
+
+// Store owns the capture files of all components on this host.
+type Store struct {
+	dir  string
+	opts Options
+
+	mu   sync.Mutex
+	logs map[string]*componentLog
+}
+
+func NewStore(dir string, opts Options) (*Store, error) {
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = DefaultMaxBytes
+	}
+	if opts.MaxFiles <= 0 {
+		opts.MaxFiles = DefaultMaxFiles
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir, opts: opts, logs: map[string]*componentLog{}}, nil
+}
+
+type componentLog struct {
+	name  string
+	file  *rotatingFile
+	sinks []Sink
+
+	mu   sync.Mutex
+	subs map[chan model.LogLine]struct{}
+}
+
+func (s *Store) get(component string) (*componentLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cl, ok := s.logs[component]; ok {
+		return cl, nil
+	}
+
+	f, err := openRotatingFile(s.path(component), s.opts.MaxBytes, s.opts.MaxFiles)
+	if err != nil {
+		return nil, err
+	}
+	cl := &componentLog{
+		name:  component,
+		file:  f,
+		sinks: s.opts.Sinks,
+		subs:  map[chan model.LogLine]struct{}{},
+	}
+	s.logs[component] = cl
+	return cl, nil
+}
+
+func (s *Store) path(component string) string {
+	return filepath.Join(s.dir, component+".log")
+}
+
+// Open returns the writers a runtime plugin attaches to the component's stdio.
+// Calling it again for the same component appends to the same file.
+func (s *Store) Open(component string) (io.Writer, io.Writer, error) {
+	cl, err := s.get(component)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &lineWriter{cl: cl, stream: StreamStdout},
+		&lineWriter{cl: cl, stream: StreamStderr}, nil
+}
+
+// Remove closes and deletes a component's capture files.
+func (s *Store) Remove(component string) error {
+	s.mu.Lock()
+	cl, ok := s.logs[component]
+	delete(s.logs, component)
+	s.mu.Unlock()
+
+	path := s.path(component)
+	files := []string{path}
+	if ok {
+		cl.closeSubscribers()
+		cl.file.Close()
+		files = cl.file.files()
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tail returns up to n of the most recent lines, oldest first. n <= 0 returns
+// everything still on disk.
+func (s *Store) Tail(component string, n int) ([]model.LogLine, error) {
+	s.mu.Lock()
+	cl, ok := s.logs[component]
+	s.mu.Unlock()
+
+	files := []string{s.path(component)}
+	if ok {
+		files = cl.file.files()
+	} else if _, err := os.Stat(files[0]); err != nil {
+		return nil, fmt.Errorf("no logs for component %s", component)
+	}
+
+	var lines []model.LogLine
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			continue
+		}
+		sc := bufio.NewScanner(f)
+		sc.Buffer(make([]byte, 64*1024), 1024*1024)
+		for sc.Scan() {
+			line, ok := parseLine(sc.Text())
+			if !ok {
+				continue
+			}
+			lines = append(lines, line)
+			if n > 0 && len(lines) > n {
+				lines = lines[1:]
+			}
+		}
+		f.Close()
+	}
+	return lines, nil
+}
+
+// Subscribe delivers lines written after the call. Lines are dropped, not
+// queued, when the subscriber falls behind. The channel is closed by cancel
+// or when the component's logs are removed. It fails for a component that
+// has never been captured.
+func (s *Store) Subscribe(component string) (<-chan model.LogLine, func(), error) {
+	s.mu.Lock()
+	_, ok := s.logs[component]
+	s.mu.Unlock()
+	if !ok {
+		if _, err := os.Stat(s.path(component)); err != nil {
+			return nil, nil, fmt.Errorf("no logs for component %s", component)
+		}
+	}
+
+	cl, err := s.get(component)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan model.LogLine, subscriberBuffer)
+	cl.mu.Lock()
+	cl.subs[ch] = struct{}{}
+	cl.mu.Unlock()
+
+	cancel := func() {
+		cl.mu.Lock()
+		defer cl.mu.Unlock()
+		if _, ok := cl.subs[ch]; ok {
+			delete(cl.subs, ch)
+			close(ch)
+		}
+	}
+	return ch, cancel, nil
+}
+
+func (cl *componentLog) emit(line model.LogLine) error {
+	if _, err := cl.file.Write([]byte(formatLine(line))); err != nil {
+		return err
+	}
+
+	cl.mu.Lock()
+	for ch := range cl.subs {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+	cl.mu.Unlock()
+
+	for _, sink := range cl.sinks {
+		sink.Write(cl.name, line)
+	}
+	return nil
+}
+
+func (cl *componentLog) closeSubscribers() {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	for ch := range cl.subs {
+		delete(cl.subs, ch)
+		close(ch)
+	}
+}
+
+// lineWriter splits a process stream into lines. A trailing partial line is
+// held until its newline arrives.
+type lineWriter struct {
+	cl     *componentLog
+	stream string
+
+	mu      sync.Mutex
+	partial []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data := append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		text := strings.TrimSuffix(string(data[:i]), "\r")
+		data = data[i+1:]
+
+		line := model.LogLine{Time: time.Now().UnixNano(), Stream: w.stream, Text: text}
+		if err := w.cl.emit(line); err != nil {
+			return 0, err
+		}
+	}
+	w.partial = append([]byte(nil), data...)
+	return len(p), nil
+}
+
+func formatLine(l model.LogLine) string {
+	return fmt.Sprintf("%s %s F %s\n",
+		time.Unix(0, l.Time).UTC().Format(time.RFC3339Nano), l.Stream, l.Text)
+}
+
+func parseLine(s string) (model.LogLine, bool) {
+	parts := strings.SplitN(s, " ", 4)
+	if len(parts) < 3 {
+		return model.LogLine{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return model.LogLine{}, false
+	}
+	text := ""
+	if len(parts) == 4 {
+		text = parts[3]
+	}
+	return model.LogLine{Time: ts.UnixNano(), Stream: parts[1], Text: text}, true
+}
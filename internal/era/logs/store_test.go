@@ -0,0 +1,83 @@
+package logs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStoreTailAcrossRotation(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir, Options{MaxBytes: 512, MaxFiles: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, stderr, err := s.Open("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(stdout, "line %d\n", i)
+	}
+	fmt.Fprint(stderr, "partial")
+	fmt.Fprint(stderr, " error\n")
+
+	if _, err := os.Stat(filepath.Join(dir, "web.log.1")); err != nil {
+		t.Fatalf("expected rotated file: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "web.log.3")); err == nil {
+		t.Fatalf("kept more than MaxFiles rotations")
+	}
+
+	lines, err := s.Tail("web", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+	if lines[1].Text != "line 19" || lines[2].Text != "partial error" || lines[2].Stream != StreamStderr {
+		t.Fatalf("unexpected tail: %+v", lines)
+	}
+}
+
+func TestStoreSubscribe(t *testing.T) {
+	s, err := NewStore(t.TempDir(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Subscribe("web"); err == nil {
+		t.Fatal("subscribed to a component never captured")
+	}
+	if _, err := os.Stat(filepath.Join(s.dir, "web.log")); err == nil {
+		t.Fatal("subscribing created a log file")
+	}
+
+	stdout, _, _ := s.Open("web")
+	ch, cancel, err := s.Subscribe("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	fmt.Fprintln(stdout, "hello")
+
+	select {
+	case line := <-ch:
+		if line.Text != "hello" || line.Stream != StreamStdout {
+			t.Fatalf("unexpected line: %+v", line)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no line delivered")
+	}
+
+	if err := s.Remove("web"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("subscription not closed on Remove")
+	}
+}
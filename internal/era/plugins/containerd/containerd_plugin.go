@@ -3,8 +3,11 @@ package containerd
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"sync"
 	"syscall"
 	"time"
 
@@ -12,7 +15,8 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/namespaces"
-	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
 
 	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
 	"github.com/balaji-balu/margo-hello-world/internal/era/plugins"
@@ -27,8 +31,33 @@ func init() {
 type ContainerdPlugin struct {
 	client     *containerd.Client
 	socketPath string
-	containers map[string]containerd.Container
     log        *zap.SugaredLogger
+    logs       edgeruntime.LogWriters
+
+	mu         sync.Mutex // guards containers; probes read it concurrently
+	containers map[string]containerd.Container
+}
+
+func (c *ContainerdPlugin) container(name string) (containerd.Container, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ctr, ok := c.containers[name]
+	return ctr, ok
+}
+
+func (c *ContainerdPlugin) setContainer(name string, ctr containerd.Container) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.containers == nil {
+		c.containers = map[string]containerd.Container{}
+	}
+	c.containers[name] = ctr
+}
+
+func (c *ContainerdPlugin) dropContainer(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.containers, name)
 }
 
 func (c *ContainerdPlugin) Name() string {
@@ -36,7 +65,24 @@ func (c *ContainerdPlugin) Name() string {
 }
 
 func (c *ContainerdPlugin) Capabilities() []string {
-	return []string{"oci", "containerd"}
+	return []string{"oci", "containerd", "logs", "gc", "list", "probes"}
+}
+
+// SetLogWriters wires component stdout/stderr to w on subsequent starts.
+func (c *ContainerdPlugin) SetLogWriters(w edgeruntime.LogWriters) {
+	c.logs = w
+}
+
+// taskIO attaches the task's stdio to the log writers when capture is on.
+func (c *ContainerdPlugin) taskIO(name string) (cio.Creator, error) {
+	if c.logs == nil {
+		return cio.NullIO, nil
+	}
+	stdout, stderr, err := c.logs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return cio.NewCreator(cio.WithStreams(nil, stdout, stderr)), nil
 }
 
 // detectContainerdSocket tries common socket locations (standalone containerd, k3s, etc.)
@@ -58,6 +104,9 @@ func detectContainerdSocket() string {
 
 // ensureClient initializes the containerd client once and stores detected socket
 func (c *ContainerdPlugin) ensureClient() error {
+	if c.log == nil {
+		c.log = logx.New("era.containerd")
+	}
 	if c.client != nil {
 		return nil
 	}
@@ -74,7 +123,6 @@ func (c *ContainerdPlugin) ensureClient() error {
 
 	c.client = cli
 	c.socketPath = socket
-	c.containers = map[string]containerd.Container{}
 	return nil
 }
 
@@ -98,7 +146,15 @@ func (c *ContainerdPlugin) Install(spec edgeruntime.ComponentSpec) error {
 	}
 
 	// Pull and unpack the image into containerd content store
-	_, err := c.client.Pull(ctx, spec.Artifact, containerd.WithPullUnpack)
+	opts := []containerd.RemoteOpt{containerd.WithPullUnpack}
+	if spec.Mirror != "" {
+		resolver, err := mirrorResolver(spec.Mirror, spec.MirrorToken)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, containerd.WithResolver(resolver))
+	}
+	_, err := c.client.Pull(ctx, spec.Artifact, opts...)
 	if err != nil {
 		return fmt.Errorf("containerd pull failed for %s: %w", spec.Artifact, err)
 	}
@@ -106,6 +162,36 @@ func (c *ContainerdPlugin) Install(spec edgeruntime.ComponentSpec) error {
 	return nil
 }
 
+// mirrorResolver pulls every image through mirror before trying its own
+// registry, as a containerd hosts.toml mirror entry would. token goes to
+// the mirror alone.
+func mirrorResolver(mirror, token string) (remotes.Resolver, error) {
+	u, err := url.Parse(mirror)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid mirror %q", mirror)
+	}
+	defaults := docker.ConfigureDefaultRegistries()
+	return docker.NewResolver(docker.ResolverOptions{
+		Hosts: func(host string) ([]docker.RegistryHost, error) {
+			hosts, err := defaults(host)
+			if err != nil {
+				return nil, err
+			}
+			m := docker.RegistryHost{
+				Client:       http.DefaultClient,
+				Host:         u.Host,
+				Scheme:       u.Scheme,
+				Path:         "/v2",
+				Capabilities: docker.HostCapabilityPull | docker.HostCapabilityResolve,
+			}
+			if token != "" {
+				m.Header = http.Header{"Authorization": {"Bearer " + token}}
+			}
+			return append([]docker.RegistryHost{m}, hosts...), nil
+		},
+	}), nil
+}
+
 /* ====================
         START
    Create container + task and start it
@@ -130,24 +216,31 @@ func (c *ContainerdPlugin) Start(spec edgeruntime.ComponentSpec) error {
 	// Snapshot key (unique per container name)
 	snapKey := fmt.Sprintf("%s-snap", spec.Name)
 
-	// Create container with new snapshot and spec configured from image
+	devices, err := resolvePeripherals(spec.Peripherals)
+	if err != nil {
+		return fmt.Errorf("peripherals for %s: %w", spec.Name, err)
+	}
+
+	// Create container with new snapshot and spec configured from image,
+	// then layered with the component's env, mounts, limits and devices
 	container, err := c.client.NewContainer(
 		ctx,
 		spec.Name,
-		containerd.WithImage(image),
-		containerd.WithNewSnapshot(snapKey, image),
-		containerd.WithNewSpec(
-			// populate spec using image config (entrypoint, env, etc.)
-			oci.WithImageConfig(image),
-			// you may append more config options here (mounts, env, user) using oci.With... funcs
-		),
+		newContainerOpts(image, snapKey, spec, devices)...,
 	)
 	if err != nil {
 		return fmt.Errorf("container create failed: %w", err)
 	}
 
-	// Create task (process) for the container using Null IO (no TTY). You can change to cio.NewCreator() to wire logs.
-	task, err := container.NewTask(ctx, cio.NullIO)
+	ioCreator, err := c.taskIO(spec.Name)
+	if err != nil {
+		_ = container.Delete(ctx, containerd.WithSnapshotCleanup)
+		return fmt.Errorf("log capture for %s: %w", spec.Name, err)
+	}
+
+	// Create task (process) for the container; stdout/stderr go to the log
+	// store when capture is enabled, otherwise to Null IO (no TTY).
+	task, err := container.NewTask(ctx, ioCreator)
 	if err != nil {
 		// try to cleanup container on failure
 		_ = container.Delete(ctx, containerd.WithSnapshotCleanup)
@@ -163,7 +256,7 @@ func (c *ContainerdPlugin) Start(spec edgeruntime.ComponentSpec) error {
 	}
 
 	// Save container reference for future ops
-	c.containers[spec.Name] = container
+	c.setContainer(spec.Name, container)
 
 	c.log.Infow("Start: exit")
 	return nil
@@ -181,7 +274,7 @@ func (c *ContainerdPlugin) Stop(name string) error {
 
 	ctx := namespaces.WithNamespace(context.Background(), "era")
 
-	container, ok := c.containers[name]
+	container, ok := c.container(name)
 	if !ok {
 		// attempt to load container if not present in map (best-effort)
 		var err error
@@ -195,7 +288,7 @@ func (c *ContainerdPlugin) Stop(name string) error {
 	task, err := container.Task(ctx, nil)
 	if err != nil {
 		// task not present/running
-		delete(c.containers, name)
+		c.dropContainer(name)
 		return nil
 	}
 
@@ -219,7 +312,7 @@ func (c *ContainerdPlugin) Stop(name string) error {
 
 	// Note: we keep the container and snapshot; Delete(name) will perform full cleanup if desired
 	c.log.Infow("Stop: exit")
-	delete(c.containers, name)
+	c.dropContainer(name)
 	return nil
 }
 
@@ -249,7 +342,7 @@ func (c *ContainerdPlugin) Stop(name string) error {
 		return fmt.Errorf("failed to delete container %s: %w", name, err)
 	}
 
-	delete(c.containers, name)
+	c.dropContainer(name)
 	c.log.Infow("Delete: exit")
 
 	return nil
@@ -296,7 +389,7 @@ func (c *ContainerdPlugin) Delete(name string) error {
         return fmt.Errorf("failed to delete container %s: %w", name, err)
     }
 
-    delete(c.containers, name)
+    c.dropContainer(name)
 
     c.log.Infow("Delete: exit", "name", name)
     return nil
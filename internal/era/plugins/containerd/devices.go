@@ -0,0 +1,66 @@
+package containerd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+// peripheralPatterns maps margo peripheral types to the device nodes that
+// usually back them on a Linux host.
+var peripheralPatterns = map[string][]string{
+	"camera": {"/dev/video*", "/dev/media*"},
+	"video":  {"/dev/video*", "/dev/media*"},
+	"serial": {"/dev/ttyUSB*", "/dev/ttyACM*", "/dev/ttyS[0-9]*"},
+	"usb":    {"/dev/bus/usb/*/*"},
+	"gpu":    {"/dev/dri/*", "/dev/nvidia*"},
+	"i2c":    {"/dev/i2c-*"},
+	"spi":    {"/dev/spidev*"},
+	"gpio":   {"/dev/gpiochip*"},
+	"audio":  {"/dev/snd/*"},
+	"tpu":    {"/dev/apex_*"},
+}
+
+// resolvePeripherals finds the host device nodes for the declared peripherals.
+// A declared peripheral with no matching node is an error: starting the
+// component without it would only fail later in a less obvious way.
+func resolvePeripherals(ps []edgeruntime.Peripheral) ([]specs.LinuxDevice, error) {
+	var devices []specs.LinuxDevice
+	seen := map[string]bool{}
+
+	for _, p := range ps {
+		patterns, ok := peripheralPatterns[strings.ToLower(p.Type)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported peripheral type %q", p.Type)
+		}
+
+		found := 0
+		for _, pattern := range patterns {
+			paths, _ := filepath.Glob(pattern)
+			for _, path := range paths {
+				if seen[path] {
+					found++
+					continue
+				}
+				d, err := oci.DeviceFromPath(path)
+				if err != nil {
+					// not a device node (e.g. a directory under /dev/dri)
+					continue
+				}
+				seen[path] = true
+				devices = append(devices, *d)
+				found++
+			}
+		}
+		if found == 0 {
+			return nil, fmt.Errorf("no device node found for peripheral %q", p.Type)
+		}
+	}
+
+	return devices, nil
+}
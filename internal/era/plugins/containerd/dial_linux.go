@@ -0,0 +1,72 @@
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/sys/unix"
+)
+
+// DialComponent connects to address from inside the component's network
+// namespace, so probes can reach ports that are not published on the host.
+func (c *ContainerdPlugin) DialComponent(ctx context.Context, name, network, address string) (net.Conn, error) {
+	nsCtx := namespaces.WithNamespace(ctx, "era")
+	_, task, err := c.runningTask(nsCtx, name)
+	if err != nil {
+		return nil, err
+	}
+	return dialInNetns(ctx, fmt.Sprintf("/proc/%d/ns/net", task.Pid()), network, address)
+}
+
+// dialInNetns creates the socket on a locked thread switched into the
+// namespace at path; the socket stays bound to that namespace afterwards.
+func dialInNetns(ctx context.Context, path, network, address string) (net.Conn, error) {
+	target, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open netns: %w", err)
+	}
+	defer target.Close()
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	ch := make(chan result, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		orig, err := os.Open(fmt.Sprintf("/proc/self/task/%d/ns/net", unix.Gettid()))
+		if err != nil {
+			runtime.UnlockOSThread()
+			ch <- result{err: fmt.Errorf("open own netns: %w", err)}
+			return
+		}
+		defer orig.Close()
+
+		if err := unix.Setns(int(target.Fd()), unix.CLONE_NEWNET); err != nil {
+			runtime.UnlockOSThread()
+			ch <- result{err: fmt.Errorf("setns: %w", err)}
+			return
+		}
+
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, network, address)
+
+		// if the thread cannot be switched back it stays locked and the Go
+		// runtime discards it when this goroutine exits
+		if unix.Setns(int(orig.Fd()), unix.CLONE_NEWNET) == nil {
+			runtime.UnlockOSThread()
+		}
+		ch <- result{conn, err}
+	}()
+
+	r := <-ch
+	return r.conn, r.err
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package containerd
+
+import (
+	"context"
+	"net"
+)
+
+// DialComponent falls back to the host network where namespaces are not
+// available.
+func (c *ContainerdPlugin) DialComponent(ctx context.Context, name, network, address string) (net.Conn, error) {
+	var d net.Dialer
+	return d.DialContext(ctx, network, address)
+}
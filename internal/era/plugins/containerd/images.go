@@ -0,0 +1,96 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+// Images is the content store view used by the ERA garbage collector.
+func (c *ContainerdPlugin) Images() ([]edgeruntime.Image, error) {
+	if err := c.ensureClient(); err != nil {
+		return nil, err
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "era")
+
+	imgs, err := c.client.ListImages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list images: %w", err)
+	}
+
+	out := make([]edgeruntime.Image, 0, len(imgs))
+	for _, img := range imgs {
+		size, err := img.Size(ctx)
+		if err != nil {
+			size = 0
+		}
+		out = append(out, edgeruntime.Image{Ref: img.Name(), Size: size})
+	}
+	return out, nil
+}
+
+// RemoveImage refuses to delete an image a container in the era namespace was
+// created from; the returned size is the image's compressed content size.
+func (c *ContainerdPlugin) RemoveImage(ref string) (int64, error) {
+	if err := c.ensureClient(); err != nil {
+		return 0, err
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "era")
+
+	containers, err := c.client.Containers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list containers: %w", err)
+	}
+	for _, ctr := range containers {
+		info, err := ctr.Info(ctx)
+		if err == nil && info.Image == ref {
+			return 0, fmt.Errorf("image %s in use by container %s", ref, ctr.ID())
+		}
+	}
+
+	img, err := c.client.GetImage(ctx, ref)
+	if err != nil {
+		return 0, fmt.Errorf("image not found %s: %w", ref, err)
+	}
+	size, _ := img.Size(ctx)
+
+	if err := c.client.ImageService().Delete(ctx, ref, images.SynchronousDelete()); err != nil {
+		return 0, fmt.Errorf("delete image %s: %w", ref, err)
+	}
+	return size, nil
+}
+
+// List returns every container in the era namespace, for startup
+// reconciliation.
+func (c *ContainerdPlugin) List() ([]edgeruntime.RuntimeComponent, error) {
+	if err := c.ensureClient(); err != nil {
+		return nil, err
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "era")
+
+	containers, err := c.client.Containers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	out := make([]edgeruntime.RuntimeComponent, 0, len(containers))
+	for _, ctr := range containers {
+		rc := edgeruntime.RuntimeComponent{Name: ctr.ID(), State: "Stopped"}
+		if info, err := ctr.Info(ctx); err == nil {
+			rc.Artifact = info.Image
+		}
+		if task, err := ctr.Task(ctx, nil); err == nil {
+			if st, err := task.Status(ctx); err == nil && st.Status == containerd.Running {
+				rc.State = "Running"
+			}
+		}
+		c.setContainer(ctr.ID(), ctr)
+		out = append(out, rc)
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/namespaces"
+)
+
+// runningTask returns the task for component name.
+func (c *ContainerdPlugin) runningTask(ctx context.Context, name string) (containerd.Container, containerd.Task, error) {
+	if err := c.ensureClient(); err != nil {
+		return nil, nil, err
+	}
+	container, ok := c.container(name)
+	if !ok {
+		var err error
+		container, err = c.client.LoadContainer(ctx, name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("container %s: %w", name, err)
+		}
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("task %s: %w", name, err)
+	}
+	return container, task, nil
+}
+
+// Exec runs cmd inside the component with the container's own process
+// settings (user, env, cwd) and returns its exit code.
+func (c *ContainerdPlugin) Exec(ctx context.Context, name string, cmd []string) (int, error) {
+	ctx = namespaces.WithNamespace(ctx, "era")
+
+	container, task, err := c.runningTask(ctx, name)
+	if err != nil {
+		return -1, err
+	}
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("spec %s: %w", name, err)
+	}
+	pspec := *spec.Process
+	pspec.Args = cmd
+	pspec.Terminal = false
+
+	execID := fmt.Sprintf("probe-%d", time.Now().UnixNano())
+	proc, err := task.Exec(ctx, execID, &pspec, cio.NullIO)
+	if err != nil {
+		return -1, fmt.Errorf("exec in %s: %w", name, err)
+	}
+	// ctx may be expired by the time we clean up
+	defer proc.Delete(namespaces.WithNamespace(context.Background(), "era"), containerd.WithProcessKill)
+
+	statusC, err := proc.Wait(ctx)
+	if err != nil {
+		return -1, err
+	}
+	if err := proc.Start(ctx); err != nil {
+		return -1, fmt.Errorf("exec start in %s: %w", name, err)
+	}
+
+	select {
+	case st := <-statusC:
+		code, _, err := st.Result()
+		return int(code), err
+	case <-ctx.Done():
+		_ = proc.Kill(namespaces.WithNamespace(context.Background(), "era"), syscall.SIGKILL)
+		return -1, ctx.Err()
+	}
+}
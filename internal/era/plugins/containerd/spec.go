@@ -0,0 +1,144 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+// cpuPeriod is the CFS period (in microseconds) used to express fractional
+// core limits as a quota.
+const cpuPeriod uint64 = 100000
+
+// withComponentSpec adapts applyComponentSpec to containerd's spec options so it
+// runs after the image config has populated the base spec.
+func withComponentSpec(c edgeruntime.ComponentSpec, devices []specs.LinuxDevice) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *oci.Spec) error {
+		return applyComponentSpec(s, c, devices)
+	}
+}
+
+// applyComponentSpec layers the component's env, mounts, resource limits and
+// device nodes on top of an OCI spec. It only touches s, so it can be tested
+// without a containerd daemon.
+func applyComponentSpec(s *specs.Spec, c edgeruntime.ComponentSpec, devices []specs.LinuxDevice) error {
+	if s.Process == nil {
+		s.Process = &specs.Process{}
+	}
+	s.Process.Env = mergeEnv(s.Process.Env, c.Env)
+
+	for _, m := range c.Mounts {
+		if m.Source == "" || m.Target == "" {
+			return fmt.Errorf("mount for %s needs both source and target", c.Name)
+		}
+		opts := []string{"rbind", "rw"}
+		if m.ReadOnly {
+			opts = []string{"rbind", "ro"}
+		}
+		s.Mounts = append(s.Mounts, specs.Mount{
+			Destination: m.Target,
+			Type:        "bind",
+			Source:      m.Source,
+			Options:     opts,
+		})
+	}
+
+	if c.Resources.CPUCores < 0 || c.Resources.MemoryBytes < 0 {
+		return fmt.Errorf("negative resource limits for %s", c.Name)
+	}
+	if c.Resources.CPUCores == 0 && c.Resources.MemoryBytes == 0 && len(devices) == 0 {
+		return nil
+	}
+
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	res := s.Linux.Resources
+
+	if c.Resources.CPUCores > 0 {
+		period := cpuPeriod
+		quota := int64(c.Resources.CPUCores * float64(cpuPeriod))
+		shares := uint64(c.Resources.CPUCores * 1024)
+		res.CPU = &specs.LinuxCPU{
+			Period: &period,
+			Quota:  &quota,
+			Shares: &shares,
+		}
+	}
+
+	if c.Resources.MemoryBytes > 0 {
+		limit := c.Resources.MemoryBytes
+		res.Memory = &specs.LinuxMemory{Limit: &limit}
+	}
+
+	for _, d := range devices {
+		major, minor := d.Major, d.Minor
+		s.Linux.Devices = append(s.Linux.Devices, d)
+		res.Devices = append(res.Devices, specs.LinuxDeviceCgroup{
+			Allow:  true,
+			Type:   d.Type,
+			Major:  &major,
+			Minor:  &minor,
+			Access: "rwm",
+		})
+	}
+
+	return nil
+}
+
+// mergeEnv overrides image-provided variables with the component's env.
+// Added keys are appended in sorted order so the spec is deterministic.
+func mergeEnv(base []string, env map[string]string) []string {
+	if len(env) == 0 {
+		return base
+	}
+
+	out := make([]string, 0, len(base)+len(env))
+	seen := map[string]bool{}
+	for _, kv := range base {
+		k, _, _ := strings.Cut(kv, "=")
+		if v, ok := env[k]; ok {
+			out = append(out, k+"="+v)
+			seen[k] = true
+			continue
+		}
+		out = append(out, kv)
+	}
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		if !seen[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		out = append(out, k+"="+env[k])
+	}
+	return out
+}
+
+// newContainerOpts is the full option set Start uses to create a container.
+func newContainerOpts(image containerd.Image, snapKey string,
+	c edgeruntime.ComponentSpec, devices []specs.LinuxDevice) []containerd.NewContainerOpts {
+	return []containerd.NewContainerOpts{
+		containerd.WithImage(image),
+		containerd.WithNewSnapshot(snapKey, image),
+		containerd.WithNewSpec(
+			// populate spec using image config (entrypoint, env, etc.)
+			oci.WithImageConfig(image),
+			withComponentSpec(c, devices),
+		),
+	}
+}
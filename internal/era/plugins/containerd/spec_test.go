@@ -0,0 +1,81 @@
+package containerd
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+func TestApplyComponentSpec(t *testing.T) {
+	s := &specs.Spec{
+		Process: &specs.Process{Env: []string{"PATH=/usr/bin", "MODE=image"}},
+	}
+	c := edgeruntime.ComponentSpec{
+		Name: "camera-ai",
+		Env:  map[string]string{"MODE": "edge", "SITE": "plant-1"},
+		Mounts: []edgeruntime.Mount{
+			{Source: "/var/lib/models", Target: "/models", ReadOnly: true},
+		},
+		Resources: edgeruntime.Resources{CPUCores: 1.5, MemoryBytes: 256 << 20},
+	}
+	devices := []specs.LinuxDevice{
+		{Path: "/dev/video0", Type: "c", Major: 81, Minor: 0},
+	}
+
+	if err := applyComponentSpec(s, c, devices); err != nil {
+		t.Fatalf("applyComponentSpec: %v", err)
+	}
+
+	wantEnv := []string{"PATH=/usr/bin", "MODE=edge", "SITE=plant-1"}
+	if len(s.Process.Env) != len(wantEnv) {
+		t.Fatalf("env: got %v want %v", s.Process.Env, wantEnv)
+	}
+	for i := range wantEnv {
+		if s.Process.Env[i] != wantEnv[i] {
+			t.Fatalf("env: got %v want %v", s.Process.Env, wantEnv)
+		}
+	}
+
+	if len(s.Mounts) != 1 || s.Mounts[0].Destination != "/models" || s.Mounts[0].Options[1] != "ro" {
+		t.Fatalf("mounts: got %+v", s.Mounts)
+	}
+
+	cpu := s.Linux.Resources.CPU
+	if *cpu.Quota != 150000 || *cpu.Period != 100000 || *cpu.Shares != 1536 {
+		t.Fatalf("cpu: quota=%d period=%d shares=%d", *cpu.Quota, *cpu.Period, *cpu.Shares)
+	}
+	if *s.Linux.Resources.Memory.Limit != 256<<20 {
+		t.Fatalf("memory limit: got %d", *s.Linux.Resources.Memory.Limit)
+	}
+
+	if len(s.Linux.Devices) != 1 || s.Linux.Devices[0].Path != "/dev/video0" {
+		t.Fatalf("devices: got %+v", s.Linux.Devices)
+	}
+	rule := s.Linux.Resources.Devices[0]
+	if !rule.Allow || *rule.Major != 81 || rule.Access != "rwm" {
+		t.Fatalf("device cgroup rule: got %+v", rule)
+	}
+}
+
+func TestApplyComponentSpecNoLimits(t *testing.T) {
+	s := &specs.Spec{Process: &specs.Process{}}
+	if err := applyComponentSpec(s, edgeruntime.ComponentSpec{Name: "plain"}, nil); err != nil {
+		t.Fatalf("applyComponentSpec: %v", err)
+	}
+	if s.Linux != nil {
+		t.Fatalf("expected no linux section, got %+v", s.Linux)
+	}
+}
+
+func TestApplyComponentSpecRejectsBadMount(t *testing.T) {
+	s := &specs.Spec{}
+	c := edgeruntime.ComponentSpec{
+		Name:   "bad",
+		Mounts: []edgeruntime.Mount{{Target: "/data"}},
+	}
+	if err := applyComponentSpec(s, c, nil); err == nil {
+		t.Fatal("expected error for mount without source")
+	}
+}
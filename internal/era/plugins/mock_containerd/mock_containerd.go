@@ -1,6 +1,7 @@
 package mockcontainerd
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -22,6 +23,7 @@ const (
 	StateNone    mockState = "None"
 	StatePulled  mockState = "Pulled"
 	StateStarted mockState = "Started"
+	StateCrashed mockState = "Crashed"
 )
 
 type container struct {
@@ -33,6 +35,9 @@ type MockContainerd struct {
 	mu     sync.Mutex
 	items  map[string]*container
 	logger *zap.SugaredLogger
+	logs   edgeruntime.LogWriters
+	images map[string]int64 // pulled artifact -> fake size
+	scn    scenarioState
 }
 
 func (m *MockContainerd) Name() string {
@@ -40,13 +45,100 @@ func (m *MockContainerd) Name() string {
 }
 
 func (m *MockContainerd) Capabilities() []string {
-	return []string{"oci", "mock"}
+	return []string{"oci", "mock", "logs", "gc", "list", "probes"}
+}
+
+// SetLogWriters makes Start emit a few synthetic lines per component so the
+// log pipeline can be exercised without a real runtime.
+func (m *MockContainerd) SetLogWriters(w edgeruntime.LogWriters) {
+	m.logs = w
+}
+
+// SetScenario replaces the scripted behaviour; nil restores plain success.
+// Rule hit counts start from zero.
+func (m *MockContainerd) SetScenario(s *Scenario) {
+	m.scn.mu.Lock()
+	defer m.scn.mu.Unlock()
+	m.scn.scenario = s
+	m.scn.hits = map[int]int{}
+}
+
+// Calls returns the calls made so far, oldest first.
+func (m *MockContainerd) Calls() []Call {
+	m.scn.mu.Lock()
+	defer m.scn.mu.Unlock()
+	return append([]Call(nil), m.scn.calls...)
+}
+
+func (m *MockContainerd) ResetCalls() {
+	m.scn.mu.Lock()
+	defer m.scn.mu.Unlock()
+	m.scn.calls = nil
+}
+
+// inject applies the scenario to a call: it sleeps for any configured delay
+// and returns the injected error, if any. For StepStart it also returns how
+// long until the component should crash.
+func (m *MockContainerd) inject(step Step, name, artifact string) (time.Duration, error) {
+	r, active := m.scn.rule(step, name, artifact)
+	if d := r.Delay[step]; d > 0 {
+		time.Sleep(d)
+	}
+	if !active {
+		return 0, nil
+	}
+	if r.Fail == step {
+		msg := r.Error
+		if msg == "" {
+			msg = fmt.Sprintf("injected %s failure", step)
+		}
+		m.logger.Infow("Mock injected failure", "step", step, "name", name, "err", msg)
+		return 0, fmt.Errorf("mock: %s", msg)
+	}
+	return r.CrashAfter, nil
+}
+
+func (m *MockContainerd) record(step Step, name, artifact string, err error) {
+	c := Call{Time: time.Now(), Step: step, Name: name, Artifact: artifact}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	m.scn.record(c)
+}
+
+// artifactOf returns the artifact name was installed from, if known.
+func (m *MockContainerd) artifactOf(name string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if item, ok := m.items[name]; ok {
+		return item.spec.Artifact
+	}
+	return ""
+}
+
+// crash marks item as exited unless it was restarted or removed meanwhile.
+func (m *MockContainerd) crash(name string, item *container) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.items[name] != item || item.state != StateStarted {
+		return
+	}
+	item.state = StateCrashed
+	m.logger.Infow("Mock crash", "name", name)
+	if m.logs != nil {
+		if _, stderr, err := m.logs.Open(name); err == nil {
+			fmt.Fprintf(stderr, "mock: %s crashed (scenario)\n", name)
+		}
+	}
 }
 
 func (m *MockContainerd) ensure() {
 	if m.items == nil {
 		m.items = map[string]*container{}
 	}
+	if m.images == nil {
+		m.images = map[string]int64{}
+	}
 	if m.logger == nil {
 		m.logger = logx.New("era.mockcontainerd")
 	}
@@ -55,8 +147,12 @@ func (m *MockContainerd) ensure() {
 /* ================
    INSTALL (fake pull)
 ================ */
-func (m *MockContainerd) Install(spec edgeruntime.ComponentSpec) error {
+func (m *MockContainerd) Install(spec edgeruntime.ComponentSpec) (err error) {
 	m.ensure()
+	defer func() { m.record(StepInstall, spec.Name, spec.Artifact, err) }()
+	if _, err := m.inject(StepInstall, spec.Name, spec.Artifact); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -70,14 +166,22 @@ func (m *MockContainerd) Install(spec edgeruntime.ComponentSpec) error {
 		spec:  spec,
 		state: StatePulled,
 	}
+	if _, ok := m.images[spec.Artifact]; !ok {
+		m.images[spec.Artifact] = mockImageSize
+	}
 	return nil
 }
 
 /* ================
    START (fake start)
 ================ */
-func (m *MockContainerd) Start(spec edgeruntime.ComponentSpec) error {
+func (m *MockContainerd) Start(spec edgeruntime.ComponentSpec) (err error) {
 	m.ensure()
+	defer func() { m.record(StepStart, spec.Name, spec.Artifact, err) }()
+	crashAfter, err := m.inject(StepStart, spec.Name, spec.Artifact)
+	if err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -89,14 +193,32 @@ func (m *MockContainerd) Start(spec edgeruntime.ComponentSpec) error {
 	}
 
 	item.state = StateStarted
+	if crashAfter > 0 {
+		time.AfterFunc(crashAfter, func() { m.crash(spec.Name, item) })
+	}
+
+	if m.logs != nil {
+		stdout, stderr, err := m.logs.Open(spec.Name)
+		if err != nil {
+			return fmt.Errorf("mock: log capture for %s: %w", spec.Name, err)
+		}
+		fmt.Fprintf(stdout, "mock: starting %s (%s)\n", spec.Name, spec.Artifact)
+		fmt.Fprintf(stderr, "mock: no real process for %s\n", spec.Name)
+		fmt.Fprintf(stdout, "mock: %s started\n", spec.Name)
+	}
 	return nil
 }
 
 /* ================
    STOP (fake stop)
 ================ */
-func (m *MockContainerd) Stop(name string) error {
+func (m *MockContainerd) Stop(name string) (err error) {
 	m.ensure()
+	artifact := m.artifactOf(name)
+	defer func() { m.record(StepStop, name, artifact, err) }()
+	if _, err := m.inject(StepStop, name, artifact); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -111,8 +233,13 @@ func (m *MockContainerd) Stop(name string) error {
 /* ================
    DELETE (remove from memory)
 ================ */
-func (m *MockContainerd) Delete(name string) error {
+func (m *MockContainerd) Delete(name string) (err error) {
 	m.ensure()
+	artifact := m.artifactOf(name)
+	defer func() { m.record(StepDelete, name, artifact, err) }()
+	if _, err := m.inject(StepDelete, name, artifact); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -122,6 +249,91 @@ func (m *MockContainerd) Delete(name string) error {
 	return nil
 }
 
+/* ================
+   IMAGES (fake content store)
+================ */
+const mockImageSize = 64 << 20
+
+func (m *MockContainerd) Images() ([]edgeruntime.Image, error) {
+	m.ensure()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]edgeruntime.Image, 0, len(m.images))
+	for ref, size := range m.images {
+		out = append(out, edgeruntime.Image{Ref: ref, Size: size})
+	}
+	return out, nil
+}
+
+func (m *MockContainerd) RemoveImage(ref string) (size int64, err error) {
+	m.ensure()
+	defer func() { m.record(StepRemoveImage, "", ref, err) }()
+	if _, err := m.inject(StepRemoveImage, "", ref); err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, item := range m.items {
+		if item.spec.Artifact == ref {
+			return 0, fmt.Errorf("mock: image %s in use by %s", ref, item.spec.Name)
+		}
+	}
+	size, ok := m.images[ref]
+	if !ok {
+		return 0, fmt.Errorf("mock: image not found: %s", ref)
+	}
+	m.logger.Infow("Mock RemoveImage", "ref", ref)
+	delete(m.images, ref)
+	return size, nil
+}
+
+/* ================
+   LIST (what the fake runtime holds)
+================ */
+func (m *MockContainerd) List() ([]edgeruntime.RuntimeComponent, error) {
+	m.ensure()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]edgeruntime.RuntimeComponent, 0, len(m.items))
+	for name, item := range m.items {
+		state := "Stopped"
+		if item.state == StateStarted {
+			state = "Running"
+		}
+		out = append(out, edgeruntime.RuntimeComponent{Name: name, Artifact: item.spec.Artifact, State: state})
+	}
+	return out, nil
+}
+
+/* ================
+   PROBE (healthy while started)
+================ */
+func (m *MockContainerd) Probe(ctx context.Context, name string, p edgeruntime.Probe) (err error) {
+	m.ensure()
+	artifact := m.artifactOf(name)
+	defer func() { m.record(StepProbe, name, artifact, err) }()
+	if _, err := m.inject(StepProbe, name, artifact); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[name]
+	if !ok {
+		return fmt.Errorf("mock: component not installed: %s", name)
+	}
+	if item.state == StateCrashed {
+		return fmt.Errorf("mock: component crashed: %s", name)
+	}
+	if item.state != StateStarted {
+		return fmt.Errorf("mock: component not started: %s", name)
+	}
+	return nil
+}
+
 /* ================
    STATUS (simple mapping)
 ================ */
@@ -146,6 +358,8 @@ func (m *MockContainerd) Status(name string) (edgeruntime.ComponentStatus, error
 		state = "Stopped"
 	case StateStarted:
 		state = "Running"
+	case StateCrashed:
+		state = "Exited"
 	}
 
 	return edgeruntime.ComponentStatus{
@@ -0,0 +1,156 @@
+package mockcontainerd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Step is a plugin call a scenario rule can act on.
+type Step string
+
+const (
+	StepInstall     Step = "install"
+	StepStart       Step = "start"
+	StepStop        Step = "stop"
+	StepDelete      Step = "delete"
+	StepProbe       Step = "probe"
+	StepRemoveImage Step = "remove_image"
+)
+
+// Scenario scripts the mock's behaviour so failure handling in the ERA and LO
+// can be exercised without containerd. For example:
+//
+//	call_log: /tmp/mock-calls.jsonl
+//	rules:
+//	  - image: "*/db:*"
+//	    fail: install
+//	    error: "pull access denied"
+//	    times: 2          # fail the first two installs, then succeed
+//	  - image: "*/api:*"
+//	    delay: {start: 3s}
+//	    crash_after: 30s  # running component dies 30s after each start
+//
+// The first rule whose patterns match a call decides it.
+type Scenario struct {
+	Rules []Rule `yaml:"rules" json:"rules"`
+	// CallLog, when set, gets every call appended as a JSON line.
+	CallLog string `yaml:"call_log" json:"call_log,omitempty"`
+}
+
+type Rule struct {
+	// Image and Component are glob patterns on the artifact and the
+	// component name, where * also matches "/"; empty matches anything.
+	Image     string `yaml:"image" json:"image,omitempty"`
+	Component string `yaml:"component" json:"component,omitempty"`
+
+	// Fail makes that step return Error.
+	Fail  Step   `yaml:"fail" json:"fail,omitempty"`
+	Error string `yaml:"error" json:"error,omitempty"`
+	// Times limits Fail and CrashAfter to the first Times matches; 0 means
+	// every time.
+	Times int `yaml:"times" json:"times,omitempty"`
+
+	// Delay adds latency to the given steps.
+	Delay map[Step]time.Duration `yaml:"delay" json:"delay,omitempty"`
+	// CrashAfter stops a started component after this long, as if its
+	// process had exited.
+	CrashAfter time.Duration `yaml:"crash_after" json:"crash_after,omitempty"`
+}
+
+func (r Rule) matches(name, artifact string) bool {
+	return globMatch(r.Component, name) && globMatch(r.Image, artifact)
+}
+
+func globMatch(pattern, s string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	ok, err := regexp.MatchString("^"+expr+"$", s)
+	return err == nil && ok
+}
+
+// LoadScenario reads a scenario from a YAML file.
+func LoadScenario(file string) (*Scenario, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var s Scenario
+	if err := yaml.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("scenario %s: %w", file, err)
+	}
+	for i, r := range s.Rules {
+		if r.Fail == "" && len(r.Delay) == 0 && r.CrashAfter == 0 {
+			return nil, fmt.Errorf("scenario %s: rule %d does nothing", file, i)
+		}
+	}
+	return &s, nil
+}
+
+// Call is one entry of the mock's call log.
+type Call struct {
+	Time     time.Time `json:"time"`
+	Step     Step      `json:"step"`
+	Name     string    `json:"name"`
+	Artifact string    `json:"artifact,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// scenarioState is the active scenario with its per-rule hit counts and the
+// call log.
+type scenarioState struct {
+	mu       sync.Mutex
+	scenario *Scenario
+	hits     map[int]int
+	calls    []Call
+}
+
+// rule returns the first rule matching the call, and whether its Fail or
+// CrashAfter still applies under Times. It counts the hit.
+func (s *scenarioState) rule(step Step, name, artifact string) (Rule, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.scenario == nil {
+		return Rule{}, false
+	}
+	for i, r := range s.scenario.Rules {
+		if !r.matches(name, artifact) {
+			continue
+		}
+		acts := (r.Fail == step) || (step == StepStart && r.CrashAfter > 0)
+		if !acts {
+			return r, false
+		}
+		if r.Times > 0 && s.hits[i] >= r.Times {
+			return r, false
+		}
+		s.hits[i]++
+		return r, true
+	}
+	return Rule{}, false
+}
+
+func (s *scenarioState) record(c Call) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, c)
+	if s.scenario == nil || s.scenario.CallLog == "" {
+		return
+	}
+	f, err := os.OpenFile(s.scenario.CallLog, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_ = json.NewEncoder(f).Encode(c)
+}
@@ -0,0 +1,172 @@
+package mockcontainerd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+func newMock() *MockContainerd {
+	return &MockContainerd{logger: zap.NewNop().Sugar()}
+}
+
+func spec(name, artifact string) edgeruntime.ComponentSpec {
+	return edgeruntime.ComponentSpec{Name: name, Artifact: artifact}
+}
+
+func TestFailTimes(t *testing.T) {
+	m := newMock()
+	m.SetScenario(&Scenario{Rules: []Rule{
+		{Image: "*/db:*", Fail: StepInstall, Error: "pull access denied", Times: 2},
+	}})
+
+	db := spec("db", "ghcr.io/acme/db:1")
+	for i := 0; i < 2; i++ {
+		err := m.Install(db)
+		if err == nil || !strings.Contains(err.Error(), "pull access denied") {
+			t.Fatalf("install %d: want injected failure, got %v", i, err)
+		}
+	}
+	if err := m.Install(db); err != nil {
+		t.Fatalf("third install: %v", err)
+	}
+	if err := m.Install(spec("api", "ghcr.io/acme/api:1")); err != nil {
+		t.Fatalf("unmatched image: %v", err)
+	}
+
+	calls := m.Calls()
+	if len(calls) != 4 {
+		t.Fatalf("want 4 calls, got %d", len(calls))
+	}
+	if calls[0].Error == "" || calls[2].Error != "" || calls[3].Name != "api" {
+		t.Fatalf("unexpected call log: %+v", calls)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	m := newMock()
+	m.SetScenario(&Scenario{Rules: []Rule{
+		{Component: "slow-*", Delay: map[Step]time.Duration{StepStart: 50 * time.Millisecond}},
+	}})
+
+	s := spec("slow-api", "api:1")
+	if err := m.Install(s); err != nil {
+		t.Fatal(err)
+	}
+	begin := time.Now()
+	if err := m.Start(s); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(begin); d < 50*time.Millisecond {
+		t.Fatalf("start took %v, want >= 50ms", d)
+	}
+}
+
+func TestCrashAfter(t *testing.T) {
+	m := newMock()
+	m.SetScenario(&Scenario{Rules: []Rule{
+		{Image: "*/flaky:*", CrashAfter: 20 * time.Millisecond, Times: 1},
+	}})
+
+	s := spec("flaky", "ghcr.io/acme/flaky:1")
+	if err := m.Install(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Start(s); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(60 * time.Millisecond)
+
+	st, err := m.Status("flaky")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.State != "Exited" {
+		t.Fatalf("state = %q, want Exited", st.State)
+	}
+	if err := m.Probe(context.Background(), "flaky", edgeruntime.Probe{}); err == nil {
+		t.Fatal("probe of crashed component succeeded")
+	}
+
+	// Times: 1 — the restart keeps running.
+	if err := m.Start(s); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if st, _ := m.Status("flaky"); st.State != "Running" {
+		t.Fatalf("state after restart = %q, want Running", st.State)
+	}
+}
+
+func TestLoadScenarioAndCallLog(t *testing.T) {
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "calls.jsonl")
+	file := filepath.Join(dir, "scenario.yaml")
+	yaml := "call_log: " + logFile + `
+rules:
+  - component: web
+    fail: stop
+  - image: "*/api:*"
+    delay: {install: 1ms}
+    crash_after: 30s
+`
+	if err := os.WriteFile(file, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sc, err := LoadScenario(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.Rules[1].Delay[StepInstall] != time.Millisecond || sc.Rules[1].CrashAfter != 30*time.Second {
+		t.Fatalf("durations not decoded: %+v", sc.Rules[1])
+	}
+
+	m := newMock()
+	m.SetScenario(sc)
+	web := spec("web", "nginx:1")
+	_ = m.Install(web)
+	_ = m.Start(web)
+	if err := m.Stop("web"); err == nil {
+		t.Fatal("want injected stop failure")
+	}
+
+	f, err := os.Open(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var steps []Step
+	sc2 := bufio.NewScanner(f)
+	for sc2.Scan() {
+		var c Call
+		if err := json.Unmarshal(sc2.Bytes(), &c); err != nil {
+			t.Fatal(err)
+		}
+		steps = append(steps, c.Step)
+		if c.Step == StepStop && (c.Error == "" || c.Artifact != "nginx:1") {
+			t.Fatalf("stop call = %+v", c)
+		}
+	}
+	if len(steps) != 3 || steps[0] != StepInstall || steps[2] != StepStop {
+		t.Fatalf("call log steps = %v", steps)
+	}
+}
+
+func TestLoadScenarioRejectsNoopRule(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "scenario.yaml")
+	if err := os.WriteFile(file, []byte("rules:\n  - image: \"*\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadScenario(file); err == nil {
+		t.Fatal("want error for rule without effect")
+	}
+}
@@ -0,0 +1,105 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+// Check runs one probe attempt against component name. HTTP and TCP checks
+// connect to localhost, through the plugin's ComponentDialer when it has one.
+func Check(ctx context.Context, plugin edgeruntime.RuntimePlugin, name string, p edgeruntime.Probe) error {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	if prober, ok := plugin.(edgeruntime.Prober); ok {
+		return prober.Probe(ctx, name, p)
+	}
+
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if d, ok := plugin.(edgeruntime.ComponentDialer); ok {
+			return d.DialComponent(ctx, name, network, addr)
+		}
+		var d net.Dialer
+		return d.DialContext(ctx, network, addr)
+	}
+
+	switch {
+	case p.HTTPGet != nil:
+		return checkHTTP(ctx, dial, *p.HTTPGet)
+
+	case p.TCPPort > 0:
+		conn, err := dial(ctx, "tcp", localAddr(p.TCPPort))
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+
+	case len(p.Exec) > 0:
+		execer, ok := plugin.(edgeruntime.Execer)
+		if !ok {
+			return fmt.Errorf("plugin %s cannot run exec probes", plugin.Name())
+		}
+		code, err := execer.Exec(ctx, name, p.Exec)
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return fmt.Errorf("%s exited with %d", strings.Join(p.Exec, " "), code)
+		}
+		return nil
+	}
+	return errors.New("probe has no action")
+}
+
+type dialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+func checkHTTP(ctx context.Context, dial dialFunc, h edgeruntime.HTTPGetProbe) error {
+	scheme := strings.ToLower(h.Scheme)
+	if scheme == "" {
+		scheme = "http"
+	}
+	path := h.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: dial,
+			// probes check liveness, not certificates
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s://%s%s", scheme, localAddr(h.Port), path), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "margo-era-probe")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("HTTP probe returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func localAddr(port int) string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+}
@@ -0,0 +1,176 @@
+// Package probe runs liveness and readiness checks for started components.
+//
+// A component without a readiness probe is ready as soon as it is started.
+// Readiness flips after SuccessThreshold consecutive passes and back after
+// FailureThreshold consecutive failures. A liveness probe that fails
+// FailureThreshold times in a row is reported through OnLivenessFailure,
+// which the runtime manager wires to its restart supervisor.
+package probe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+var ErrNotWatched = errors.New("component not watched")
+
+type Manager struct {
+	plugin edgeruntime.RuntimePlugin
+	log    *zap.SugaredLogger
+
+	mu    sync.Mutex
+	comps map[string]*watch
+
+	// OnLivenessFailure is called (from a probe goroutine) once per failing
+	// streak; the component's workers keep running.
+	OnLivenessFailure func(name string, err error)
+}
+
+type watch struct {
+	cancel context.CancelFunc
+	ready  bool
+	// readyCh is closed the first time the component becomes ready.
+	readyCh chan struct{}
+	lastErr error
+}
+
+func NewManager(plugin edgeruntime.RuntimePlugin, log *zap.SugaredLogger) *Manager {
+	return &Manager{plugin: plugin, log: log, comps: map[string]*watch{}}
+}
+
+// Start begins probing spec's component, replacing any previous watch.
+func (m *Manager) Start(spec edgeruntime.ComponentSpec) {
+	m.Stop(spec.Name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watch{cancel: cancel, readyCh: make(chan struct{})}
+
+	m.mu.Lock()
+	m.comps[spec.Name] = w
+	m.mu.Unlock()
+
+	if spec.Readiness == nil {
+		m.setReady(spec.Name, w, true, nil)
+	} else {
+		go m.run(ctx, spec.Name, spec.Readiness.WithDefaults(), func(ok bool, err error) {
+			m.setReady(spec.Name, w, ok, err)
+		})
+	}
+
+	if spec.Liveness != nil {
+		go m.run(ctx, spec.Name, spec.Liveness.WithDefaults(), func(ok bool, err error) {
+			if !ok && m.OnLivenessFailure != nil {
+				m.OnLivenessFailure(spec.Name, err)
+			}
+		})
+	}
+}
+
+// Stop ends probing for name.
+func (m *Manager) Stop(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if w, ok := m.comps[name]; ok {
+		w.cancel()
+		delete(m.comps, name)
+	}
+}
+
+func (m *Manager) Ready(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w, ok := m.comps[name]
+	return ok && w.ready
+}
+
+// WaitReady blocks until name has been ready at least once since Start, or
+// returns the last probe error when ctx ends first.
+func (m *Manager) WaitReady(ctx context.Context, name string) error {
+	m.mu.Lock()
+	w, ok := m.comps[name]
+	m.mu.Unlock()
+	if !ok {
+		return ErrNotWatched
+	}
+
+	select {
+	case <-w.readyCh:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		err := w.lastErr
+		m.mu.Unlock()
+		if err == nil {
+			err = ctx.Err()
+		}
+		return err
+	}
+}
+
+func (m *Manager) setReady(name string, w *watch, ok bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w.lastErr = err
+	if w.ready == ok {
+		return
+	}
+	w.ready = ok
+	if ok {
+		select {
+		case <-w.readyCh:
+		default:
+			close(w.readyCh)
+		}
+	}
+	m.log.Infow("readiness changed", "component", name, "ready", ok, "err", err)
+}
+
+// run probes on p's schedule and calls transition whenever the thresholded
+// result flips, starting from "not passing".
+func (m *Manager) run(ctx context.Context, name string, p edgeruntime.Probe, transition func(bool, error)) {
+	if p.InitialDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.InitialDelay):
+		}
+	}
+
+	passing := false
+	successes, failures := 0, 0
+	ticker := time.NewTicker(p.Period)
+	defer ticker.Stop()
+
+	for {
+		err := Check(ctx, m.plugin, name, p)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			successes, failures = successes+1, 0
+			if !passing && successes >= p.SuccessThreshold {
+				passing = true
+				transition(true, nil)
+			}
+		} else {
+			successes, failures = 0, failures+1
+			m.log.Debugw("probe failed", "component", name, "failures", failures, "err", err)
+			if failures == p.FailureThreshold {
+				passing = false
+				transition(false, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
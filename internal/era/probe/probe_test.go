@@ -0,0 +1,133 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+type hostPlugin struct{}
+
+func (hostPlugin) Name() string                            { return "host" }
+func (hostPlugin) Capabilities() []string                  { return nil }
+func (hostPlugin) Install(edgeruntime.ComponentSpec) error { return nil }
+func (hostPlugin) Start(edgeruntime.ComponentSpec) error   { return nil }
+func (hostPlugin) Stop(string) error                       { return nil }
+func (hostPlugin) Delete(string) error                     { return nil }
+func (hostPlugin) Status(string) (edgeruntime.ComponentStatus, error) {
+	return edgeruntime.ComponentStatus{}, nil
+}
+
+// scriptedPlugin answers probes from a per-component health flag.
+type scriptedPlugin struct {
+	hostPlugin
+	mu      sync.Mutex
+	healthy map[string]bool
+}
+
+func (p *scriptedPlugin) set(name string, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.healthy[name] = ok
+}
+
+func (p *scriptedPlugin) Probe(ctx context.Context, name string, _ edgeruntime.Probe) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.healthy[name] {
+		return errors.New("unhealthy")
+	}
+	return nil
+}
+
+func serverPort(t *testing.T, addr string) int {
+	t.Helper()
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, _ := strconv.Atoi(port)
+	return n
+}
+
+func TestCheckHTTPAndTCP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	port := serverPort(t, srv.Listener.Addr().String())
+
+	ok := edgeruntime.Probe{HTTPGet: &edgeruntime.HTTPGetProbe{Path: "/healthz", Port: port}}.WithDefaults()
+	if err := Check(context.Background(), hostPlugin{}, "c", ok); err != nil {
+		t.Fatalf("healthy HTTP probe: %v", err)
+	}
+	bad := edgeruntime.Probe{HTTPGet: &edgeruntime.HTTPGetProbe{Path: "/down", Port: port}}.WithDefaults()
+	if err := Check(context.Background(), hostPlugin{}, "c", bad); err == nil {
+		t.Fatal("503 should fail the probe")
+	}
+
+	tcp := edgeruntime.Probe{TCPPort: port}.WithDefaults()
+	if err := Check(context.Background(), hostPlugin{}, "c", tcp); err != nil {
+		t.Fatalf("TCP probe: %v", err)
+	}
+
+	exec := edgeruntime.Probe{Exec: []string{"true"}}.WithDefaults()
+	if err := Check(context.Background(), hostPlugin{}, "c", exec); err == nil {
+		t.Fatal("exec probe without an Execer should fail")
+	}
+}
+
+func TestManagerReadinessAndLiveness(t *testing.T) {
+	plugin := &scriptedPlugin{healthy: map[string]bool{}}
+	m := NewManager(plugin, zap.NewNop().Sugar())
+
+	var failures int32
+	m.OnLivenessFailure = func(string, error) { atomic.AddInt32(&failures, 1) }
+
+	fast := &edgeruntime.Probe{Period: 10 * time.Millisecond, FailureThreshold: 2}
+	m.Start(edgeruntime.ComponentSpec{Name: "web", Readiness: fast, Liveness: fast})
+	defer m.Stop("web")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	if err := m.WaitReady(ctx, "web"); err == nil {
+		t.Fatal("component should not be ready while failing")
+	}
+	cancel()
+	if atomic.LoadInt32(&failures) != 1 {
+		t.Fatalf("liveness failures reported = %d, want 1 per failing streak", failures)
+	}
+
+	plugin.set("web", true)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := m.WaitReady(ctx, "web"); err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if !m.Ready("web") {
+		t.Fatal("Ready should report true")
+	}
+
+	m.Start(edgeruntime.ComponentSpec{Name: "noprobe"})
+	if !m.Ready("noprobe") {
+		t.Fatal("component without a readiness probe is ready once started")
+	}
+	m.Stop("noprobe")
+	if err := m.WaitReady(ctx, "noprobe"); !errors.Is(err, ErrNotWatched) {
+		t.Fatalf("WaitReady after Stop = %v", err)
+	}
+}
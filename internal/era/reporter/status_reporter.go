@@ -13,8 +13,12 @@ type StatusReporter struct {
 }
 
 func NewStatusReporter(runtime string, log *zap.SugaredLogger ) *StatusReporter {
+    return NewStatusReporterWithPlugin(plugins.Get(runtime), log)
+}
+
+func NewStatusReporterWithPlugin(p edgeruntime.RuntimePlugin, log *zap.SugaredLogger) *StatusReporter {
     return &StatusReporter{
-        plugin: plugins.Get(runtime),
+        plugin: p,
         log: log,
     }
 }
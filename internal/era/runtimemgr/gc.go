@@ -0,0 +1,32 @@
+package runtimemgr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/gc"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// EnableGC tracks installed revisions in tracker and prunes expired artifacts
+// in the background. Reports are published on gc.<site>.<host>.
+func (rm *RuntimeManager) EnableGC(ctx context.Context, tracker *gc.Tracker, opts gc.Options, siteID, hostID string) (*gc.Collector, error) {
+	store, ok := rm.lifecycle.Plugin().(edgeruntime.ImageStore)
+	if !ok {
+		return nil, fmt.Errorf("runtime plugin %s does not support garbage collection", rm.lifecycle.Plugin().Name())
+	}
+
+	c := gc.NewCollector(tracker, store, opts, rm.log)
+	subj := fmt.Sprintf("gc.%s.%s", siteID, hostID)
+	c.OnReport = func(r model.GCReport) {
+		r.SiteID, r.HostID = siteID, hostID
+		if err := rm.nb.Publish(subj, r); err != nil {
+			rm.log.Warnw("gc report publish failed", "err", err)
+		}
+	}
+
+	rm.lifecycle.OnInstalled = c.Track
+	go c.Run(ctx)
+	return c, nil
+}
@@ -0,0 +1,140 @@
+package runtimemgr
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/logs"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	logChunkLines     = 200
+	logFlushInterval  = 250 * time.Millisecond
+	maxFollowDuration = time.Hour
+)
+
+// EnableLogs routes component stdout/stderr into store. It is a no-op for
+// plugins that cannot capture output.
+func (rm *RuntimeManager) EnableLogs(store *logs.Store) {
+	rm.logs = store
+	lc, ok := rm.lifecycle.Plugin().(edgeruntime.LogCapturer)
+	if !ok {
+		rm.log.Warnw("runtime plugin does not support log capture", "plugin", rm.lifecycle.Plugin().Name())
+		return
+	}
+	lc.SetLogWriters(store)
+}
+
+// ServeLogs answers LogRequests published by the LO on site.<site>.logs.<host>.
+func (rm *RuntimeManager) ServeLogs(siteID, hostID string) error {
+	if rm.logs == nil {
+		return fmt.Errorf("log capture not enabled")
+	}
+
+	var mu sync.Mutex
+	streams := map[string]chan struct{}{}
+
+	subj := fmt.Sprintf("site.%s.logs.%s", siteID, hostID)
+	err := rm.nb.SubscribeLogRequests(subj, func(req model.LogRequest) {
+		if req.Reply == "" || req.StreamID == "" {
+			rm.log.Warnw("log request without reply subject", "req", req)
+			return
+		}
+		done := make(chan struct{})
+		mu.Lock()
+		streams[req.StreamID] = done
+		mu.Unlock()
+
+		go func() {
+			rm.streamLogs(req, done)
+			mu.Lock()
+			delete(streams, req.StreamID)
+			mu.Unlock()
+		}()
+	})
+	if err != nil {
+		return err
+	}
+
+	return rm.nb.SubscribeLogCancels(subj+".cancel", func(c model.LogCancel) {
+		mu.Lock()
+		defer mu.Unlock()
+		if done, ok := streams[c.StreamID]; ok {
+			close(done)
+			delete(streams, c.StreamID)
+		}
+	})
+}
+
+func (rm *RuntimeManager) streamLogs(req model.LogRequest, done <-chan struct{}) {
+	send := func(chunk model.LogChunk) {
+		chunk.StreamID = req.StreamID
+		if err := rm.nb.Publish(req.Reply, chunk); err != nil {
+			rm.log.Warnw("log chunk publish failed", "stream", req.StreamID, "err", err)
+		}
+	}
+
+	// Subscribe before reading the tail so no line falls between the two.
+	var (
+		lines  <-chan model.LogLine
+		cancel func()
+	)
+	if req.Follow {
+		var err error
+		lines, cancel, err = rm.logs.Subscribe(req.Component)
+		if err != nil {
+			send(model.LogChunk{EOF: true, Error: err.Error()})
+			return
+		}
+		defer cancel()
+	}
+
+	tail, err := rm.logs.Tail(req.Component, req.Tail)
+	if err != nil && !req.Follow {
+		send(model.LogChunk{EOF: true, Error: err.Error()})
+		return
+	}
+	for len(tail) > logChunkLines {
+		send(model.LogChunk{Lines: tail[:logChunkLines]})
+		tail = tail[logChunkLines:]
+	}
+	if !req.Follow {
+		send(model.LogChunk{Lines: tail, EOF: true})
+		return
+	}
+	send(model.LogChunk{Lines: tail})
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+	deadline := time.After(maxFollowDuration)
+
+	var batch []model.LogLine
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				send(model.LogChunk{Lines: batch, EOF: true})
+				return
+			}
+			batch = append(batch, line)
+			if len(batch) >= logChunkLines {
+				send(model.LogChunk{Lines: batch})
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				send(model.LogChunk{Lines: batch})
+				batch = nil
+			}
+		case <-done:
+			send(model.LogChunk{Lines: batch, EOF: true})
+			return
+		case <-deadline:
+			send(model.LogChunk{Lines: batch, EOF: true})
+			return
+		}
+	}
+}
@@ -7,7 +7,10 @@ import (
     "github.com/balaji-balu/margo-hello-world/pkg/model"
     "github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
     "github.com/balaji-balu/margo-hello-world/internal/natsbroker"
+    "github.com/balaji-balu/margo-hello-world/internal/era/boltstore"
     "github.com/balaji-balu/margo-hello-world/internal/era/lifecycle"
+    "github.com/balaji-balu/margo-hello-world/internal/era/logs"
+    "github.com/balaji-balu/margo-hello-world/internal/era/probe"
     "github.com/balaji-balu/margo-hello-world/internal/era/reporter"
 )
 
@@ -16,6 +19,12 @@ type RuntimeManager struct {
     reporter    *reporter.StatusReporter
     log         *zap.SugaredLogger
     nb          *natsbroker.Broker
+    logs        *logs.Store
+    state       *boltstore.StateStore
+    probes      *probe.Manager
+    probeOpts   ProbeOptions
+    supervisor  *supervisor
+    clock       clock
 }
 
 func NewRuntimeManager(runtime string, nb *natsbroker.Broker, log *zap.SugaredLogger) *RuntimeManager {
@@ -27,6 +36,31 @@ func NewRuntimeManager(runtime string, nb *natsbroker.Broker, log *zap.SugaredLo
     }
 }
 
+// NewRuntimeManagerWithPlugin manages the given plugin instance rather than
+// the one registered under its name.
+func NewRuntimeManagerWithPlugin(p edgeruntime.RuntimePlugin, nb *natsbroker.Broker, log *zap.SugaredLogger) *RuntimeManager {
+    return &RuntimeManager{
+        lifecycle: lifecycle.NewLifecycleControllerWithPlugin(p, log),
+        reporter:  reporter.NewStatusReporterWithPlugin(p, log),
+        log: log,
+        nb: nb,
+    }
+}
+
+// Runtime names the runtime plugin in use.
+func (rm *RuntimeManager) Runtime() string {
+    return rm.lifecycle.Plugin().Name()
+}
+
+// Plugin returns the runtime plugin, e.g. to script the mock in tests.
+func (rm *RuntimeManager) Plugin() edgeruntime.RuntimePlugin {
+    return rm.lifecycle.Plugin()
+}
+
+func (rm *RuntimeManager) Capabilities() []string {
+    return rm.lifecycle.Plugin().Capabilities()
+}
+
 func (rm *RuntimeManager) Deploy(c edgeruntime.ComponentSpec) error {
     rm.log.Infow("RuntimeManager: Deploy")
     return rm.lifecycle.Apply(c)
@@ -44,6 +78,19 @@ func (rm *RuntimeManager) Delete(name string) error {
     return rm.lifecycle.Delete(name)
 }
 
+// SetParamDir sets where config files for /files/ parameter targets are kept.
+func (rm *RuntimeManager) SetParamDir(dir string) {
+    rm.lifecycle.ParamDir = dir
+}
+
+// SetMirror makes the ERA pull images and packages through mirror, the
+// site's LO (http(s)://host[:port]), instead of from the internet, sending
+// it token.
+func (rm *RuntimeManager) SetMirror(mirror, token string) {
+    rm.lifecycle.Mirror = mirror
+    rm.lifecycle.MirrorToken = token
+}
+
 func (rm *RuntimeManager) LoActionDispatcher(siteID, hostID string){
     go func() {
         subj := fmt.Sprintf("site.%s.deploy.%s", siteID, hostID)
@@ -54,7 +101,32 @@ func (rm *RuntimeManager) LoActionDispatcher(siteID, hostID string){
             rm.log.Infow("Received", "Deployment type", req.App.DepType)
             
             //TBD: runtime must be "containerd". rest "not implemented"
-            rm.lifecycle.HandleAction(req)
+            if req.Action == model.ActionRemoveApp {
+                req.App = rm.recordedApp(req.App)
+                if rm.supervisor != nil {
+                    rm.supervisor.untrack(req.App)
+                }
+            }
+            err := rm.lifecycle.HandleAction(req)
+            if err != nil {
+                rm.log.Errorw("action failed", "deployment", req.DeploymentID, "err", err)
+            }
+            rm.record(req, err)
+            if err != nil {
+                rm.publishStatus(siteID, hostID, req, err)
+                return
+            }
+            // only add_app and update_comp are implemented; other actions are
+            // no-ops for now
+            if req.Action == model.ActionAddApp || req.Action == model.ActionUpdateComp {
+                if rm.supervisor != nil {
+                    rm.supervisor.track(req.App, req.DeploymentID)
+                }
+                // "installed" goes out only once the components are ready
+                go func() {
+                    rm.publishStatus(siteID, hostID, req, rm.waitReady(req))
+                }()
+            }
         })
     }()
 }
@@ -0,0 +1,109 @@
+package runtimemgr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/probe"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// CodeReadinessTimeout is reported when a started component does not pass its
+// readiness probe in time.
+const CodeReadinessTimeout = "READINESS_TIMEOUT"
+
+type ProbeOptions struct {
+	// ReadyTimeout bounds how long an install waits for readiness before it
+	// is reported failed.
+	ReadyTimeout time.Duration
+	// RestartBackoff and MaxBackoff pace the supervisor's restarts of
+	// components failing their liveness probe.
+	RestartBackoff time.Duration
+	MaxBackoff     time.Duration
+	// MaxRestarts is how many restarts in a row may fail before a component
+	// is reported failed and no longer restarted.
+	MaxRestarts int
+}
+
+const (
+	defaultReadyTimeout   = 2 * time.Minute
+	defaultRestartBackoff = 10 * time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+	defaultMaxRestarts    = 5
+)
+
+// readinessError marks a component that started but never became ready.
+type readinessError struct {
+	Component string
+	Err       error
+}
+
+func (e *readinessError) Error() string {
+	return fmt.Sprintf("component %s not ready: %v", e.Component, e.Err)
+}
+
+func (e *readinessError) Unwrap() error { return e.Err }
+
+// EnableProbes runs each component's liveness and readiness probes while it is
+// running. Installs are then reported only once their components are ready,
+// and liveness failures are handed to the restart supervisor.
+func (rm *RuntimeManager) EnableProbes(siteID, hostID string, opts ProbeOptions) {
+	if opts.ReadyTimeout <= 0 {
+		opts.ReadyTimeout = defaultReadyTimeout
+	}
+	if opts.RestartBackoff <= 0 {
+		opts.RestartBackoff = defaultRestartBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+	if opts.MaxRestarts <= 0 {
+		opts.MaxRestarts = defaultMaxRestarts
+	}
+
+	rm.probes = probe.NewManager(rm.lifecycle.Plugin(), rm.log)
+	rm.probeOpts = opts
+	rm.supervisor = newSupervisor(rm, siteID, hostID, opts)
+	rm.probes.OnLivenessFailure = rm.supervisor.livenessFailed
+
+	rm.lifecycle.OnStarted = func(c edgeruntime.ComponentSpec) {
+		rm.probes.Start(c)
+	}
+	rm.lifecycle.OnStopping = func(name string) {
+		rm.probes.Stop(name)
+	}
+	rm.lifecycle.WaitReady = func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), opts.ReadyTimeout)
+		defer cancel()
+		if err := rm.probes.WaitReady(ctx, name); err != nil {
+			return &readinessError{Component: name, Err: err}
+		}
+		return nil
+	}
+}
+
+// waitReady waits for every component op started. It returns nil straight
+// away when probes are off.
+func (rm *RuntimeManager) waitReady(op model.DiffOp) error {
+	if rm.probes == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rm.probeOpts.ReadyTimeout)
+	defer cancel()
+
+	names := []string{op.CompName}
+	if op.Action == model.ActionAddApp {
+		names = names[:0]
+		for name := range op.App.Components {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		if err := rm.probes.WaitReady(ctx, name); err != nil {
+			return &readinessError{Component: name, Err: err}
+		}
+	}
+	return nil
+}
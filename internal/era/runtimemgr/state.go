@@ -0,0 +1,172 @@
+package runtimemgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/boltstore"
+	"github.com/balaji-balu/margo-hello-world/internal/era/lifecycle"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// Orphan policies for runtime components no app record owns.
+const (
+	OrphansAdopt  = "adopt"
+	OrphansDelete = "delete"
+)
+
+// EnableState records applied ops and apps in store so the ERA can recover
+// after a restart.
+func (rm *RuntimeManager) EnableState(store *boltstore.StateStore) {
+	rm.state = store
+}
+
+// record notes an op and, when it succeeded, the app it left behind.
+func (rm *RuntimeManager) record(op model.DiffOp, opErr error) {
+	if rm.state == nil {
+		return
+	}
+	if err := rm.state.AddOperation(op, opErr); err != nil {
+		rm.log.Warnw("state: record op", "err", err)
+	}
+	if opErr != nil {
+		return
+	}
+
+	switch op.Action {
+	case model.ActionAddApp, model.ActionUpdateComp:
+		rec := boltstore.AppRecord{App: op.App, DeploymentID: op.DeploymentID}
+		if err := rm.state.SetApp(rec); err != nil {
+			rm.log.Warnw("state: record app", "app", op.App.ID, "err", err)
+		}
+	case model.ActionRemoveApp:
+		if err := rm.state.DeleteApp(op.App.ID); err != nil {
+			rm.log.Warnw("state: forget app", "app", op.App.ID, "err", err)
+		}
+	}
+}
+
+// recordedApp returns the full spec recorded for app, which unlike the LO's
+// view of what runs on this host carries component dependencies.
+func (rm *RuntimeManager) recordedApp(app model.App) model.App {
+	if rm.state == nil {
+		return app
+	}
+	rec, ok, err := rm.state.GetApp(app.ID)
+	if err != nil || !ok {
+		return app
+	}
+	return rec.App
+}
+
+// Recover compares the recorded apps with what the runtime reports:
+// recorded components that are missing or not running are redeployed, and
+// runtime components nobody owns are adopted or deleted per orphans. It ends
+// by publishing a full snapshot on actual.<site>.<host>.
+func (rm *RuntimeManager) Recover(siteID, hostID, orphans string) error {
+	if rm.state == nil {
+		return fmt.Errorf("state not enabled")
+	}
+	apps, err := rm.state.Apps()
+	if err != nil {
+		return err
+	}
+
+	plugin := rm.lifecycle.Plugin()
+	running := map[string]edgeruntime.RuntimeComponent{}
+	if lister, ok := plugin.(edgeruntime.ComponentLister); ok {
+		list, err := lister.List()
+		if err != nil {
+			return fmt.Errorf("list runtime components: %w", err)
+		}
+		for _, rc := range list {
+			running[rc.Name] = rc
+		}
+	} else {
+		rm.log.Warnw("runtime plugin cannot list components; redeploying all recorded apps", "plugin", plugin.Name())
+	}
+
+	owned := map[string]bool{}
+	for _, rec := range apps {
+		app := rec.App
+		if rm.supervisor != nil {
+			rm.supervisor.track(app, rec.DeploymentID)
+		}
+		order, err := lifecycle.DependencyOrder(&app)
+		if err != nil {
+			rm.log.Warnw("recover: dependency order", "app", app.ID, "err", err)
+			for name := range app.Components {
+				order = append(order, name)
+			}
+		}
+		for _, name := range order {
+			owned[name] = true
+			if rc, ok := running[name]; ok && rc.State == "Running" {
+				continue
+			}
+			rm.log.Infow("recover: redeploying component", "app", app.ID, "component", name)
+			if err := rm.lifecycle.Redeploy(&app, name); err != nil {
+				rm.log.Errorw("recover: redeploy failed", "component", name, "err", err)
+			}
+		}
+	}
+
+	if err := rm.handleOrphans(running, owned, orphans); err != nil {
+		rm.log.Warnw("recover: orphans", "err", err)
+	}
+
+	return rm.publishSnapshot(siteID, hostID, apps)
+}
+
+func (rm *RuntimeManager) handleOrphans(running map[string]edgeruntime.RuntimeComponent, owned map[string]bool, policy string) error {
+	adopted, err := rm.state.Adopted()
+	if err != nil {
+		return err
+	}
+	for _, a := range adopted {
+		if _, ok := running[a.Name]; !ok || owned[a.Name] {
+			rm.state.DeleteAdopted(a.Name)
+		}
+	}
+
+	for name, rc := range running {
+		if owned[name] {
+			continue
+		}
+		switch policy {
+		case OrphansDelete:
+			rm.log.Infow("recover: deleting orphan", "component", name)
+			if err := rm.lifecycle.Delete(name); err != nil {
+				rm.log.Warnw("recover: delete orphan", "component", name, "err", err)
+			}
+			rm.state.DeleteAdopted(name)
+		default:
+			rm.log.Infow("recover: adopting orphan", "component", name, "artifact", rc.Artifact)
+			rm.state.SetAdopted(boltstore.Adopted{Name: name, Artifact: rc.Artifact, Since: time.Now().Unix()})
+		}
+	}
+	return nil
+}
+
+func (rm *RuntimeManager) publishSnapshot(siteID, hostID string, apps map[string]boltstore.AppRecord) error {
+	snap := model.ActualSnapshot{SiteID: siteID, HostID: hostID, Timestamp: time.Now().Unix()}
+	for _, rec := range apps {
+		sa := model.SnapshotApp{DeploymentID: rec.DeploymentID, App: rec.App, States: map[string]string{}}
+		for name := range rec.App.Components {
+			sa.States[name] = rm.GetStatus(name).State
+		}
+		snap.Apps = append(snap.Apps, sa)
+	}
+	adopted, _ := rm.state.Adopted()
+	for _, a := range adopted {
+		snap.Adopted = append(snap.Adopted, a.Name)
+	}
+
+	subj := fmt.Sprintf("actual.%s.%s", siteID, hostID)
+	if err := rm.nb.Publish(subj, snap); err != nil {
+		return err
+	}
+	rm.log.Infow("actual-state snapshot published", "apps", len(snap.Apps), "adopted", len(snap.Adopted))
+	return nil
+}
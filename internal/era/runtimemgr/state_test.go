@@ -0,0 +1,147 @@
+package runtimemgr
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/boltstore"
+	mockcontainerd "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// newRecovering returns a manager over the mock runtime with a recorded app
+// of web and db, of which only db still runs, next to an unrecorded "stray".
+// Snapshots it publishes go to the returned channel.
+func newRecovering(t *testing.T) (*RuntimeManager, *mockcontainerd.MockContainerd, <-chan model.ActualSnapshot) {
+	t.Helper()
+	nb, nc := startNATS(t)
+	snaps := make(chan model.ActualSnapshot, 1)
+	if _, err := nc.Subscribe("actual.site.host", func(m *nats.Msg) {
+		var snap model.ActualSnapshot
+		json.Unmarshal(m.Data, &snap)
+		snaps <- snap
+	}); err != nil {
+		t.Fatal(err)
+	}
+	nc.Flush()
+
+	store, err := boltstore.NewStateStore(filepath.Join(t.TempDir(), "era.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	app := model.App{ID: "app", Components: map[string]model.Component{
+		"web": {Name: "web", Repository: "repo/web", DependsOn: []string{"db"}},
+		"db":  {Name: "db", Repository: "repo/db"},
+	}}
+	if err := store.SetApp(boltstore.AppRecord{App: app, DeploymentID: "dep-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &mockcontainerd.MockContainerd{}
+	for _, c := range []edgeruntime.ComponentSpec{{Name: "db", Artifact: "repo/db"}, {Name: "stray", Artifact: "repo/stray"}} {
+		if err := p.Install(c); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Start(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.ResetCalls()
+
+	rm := NewRuntimeManagerWithPlugin(p, nb, zap.NewNop().Sugar())
+	rm.EnableState(store)
+	return rm, p, snaps
+}
+
+// installed lists the components the mock was asked to install.
+func installed(p *mockcontainerd.MockContainerd) []string {
+	var names []string
+	for _, c := range p.Calls() {
+		if c.Step == mockcontainerd.StepInstall {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// running lists the components the mock runs, sorted.
+func running(t *testing.T, p *mockcontainerd.MockContainerd) []string {
+	t.Helper()
+	list, err := p.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, rc := range list {
+		if rc.State == "Running" {
+			names = append(names, rc.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func nextSnapshot(t *testing.T, snaps <-chan model.ActualSnapshot) model.ActualSnapshot {
+	t.Helper()
+	select {
+	case snap := <-snaps:
+		return snap
+	case <-time.After(5 * time.Second):
+		t.Fatal("no snapshot published")
+		return model.ActualSnapshot{}
+	}
+}
+
+func TestRecoverAdoptsOrphans(t *testing.T) {
+	rm, p, snaps := newRecovering(t)
+	if err := rm.Recover("site", "host", OrphansAdopt); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the missing component is deployed again
+	if got := installed(p); !slices.Equal(got, []string{"web"}) {
+		t.Errorf("installed %v, want [web]", got)
+	}
+	if got := running(t, p); !slices.Equal(got, []string{"db", "stray", "web"}) {
+		t.Errorf("running %v", got)
+	}
+	adopted, err := rm.state.Adopted()
+	if err != nil || len(adopted) != 1 || adopted[0].Name != "stray" || adopted[0].Artifact != "repo/stray" {
+		t.Errorf("adopted %+v, %v", adopted, err)
+	}
+
+	snap := nextSnapshot(t, snaps)
+	if snap.SiteID != "site" || snap.HostID != "host" || len(snap.Apps) != 1 || !slices.Equal(snap.Adopted, []string{"stray"}) {
+		t.Fatalf("snapshot %+v", snap)
+	}
+	if sa := snap.Apps[0]; sa.DeploymentID != "dep-1" || sa.App.ID != "app" || len(sa.States) != 2 {
+		t.Errorf("snapshot app %+v", sa)
+	}
+}
+
+func TestRecoverDeletesOrphans(t *testing.T) {
+	rm, p, snaps := newRecovering(t)
+	// adopted earlier, but the policy has changed since
+	rm.state.SetAdopted(boltstore.Adopted{Name: "stray", Artifact: "repo/stray"})
+	if err := rm.Recover("site", "host", OrphansDelete); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := running(t, p); !slices.Equal(got, []string{"db", "web"}) {
+		t.Errorf("running %v, want [db web]", got)
+	}
+	if adopted, _ := rm.state.Adopted(); len(adopted) != 0 {
+		t.Errorf("still adopted: %+v", adopted)
+	}
+	if snap := nextSnapshot(t, snaps); len(snap.Apps) != 1 || len(snap.Adopted) != 0 {
+		t.Errorf("snapshot %+v", snap)
+	}
+}
@@ -0,0 +1,128 @@
+package runtimemgr
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/lifecycle"
+	"github.com/balaji-balu/margo-hello-world/internal/era/verify"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// CodeInstallFailed is reported for failures without a more specific code.
+const CodeInstallFailed = "INSTALL_FAILED"
+
+// CodeDependencyFailed is reported for components skipped because a
+// component they depend on failed.
+const CodeDependencyFailed = "DEPENDENCY_FAILED"
+
+// clock stamps component reports. Its readings strictly increase, so the CO
+// keeps the state observed last even when the goroutines publishing reports
+// race or the wall clock steps back.
+type clock struct {
+	last atomic.Int64
+}
+
+func (c *clock) now() int64 {
+	for {
+		last, now := c.last.Load(), time.Now().UnixNano()
+		if now <= last {
+			now = last + 1
+		}
+		if c.last.CompareAndSwap(last, now) {
+			return now
+		}
+	}
+}
+
+// deploymentStatus builds the report sent to the LO on status.<site>.<host>
+// once an operation has been handled; at stamps its components.
+func deploymentStatus(op model.DiffOp, hostID string, at int64, err error) model.DeploymentStatus {
+	ds := model.DeploymentStatus{
+		APIVersion:   "deployment.margo/v1",
+		Kind:         "DeploymentStatus",
+		DeploymentID: op.DeploymentID,
+		SiteID:       op.SiteID,
+		// the LO finds the op it sent by deployment id and this timestamp
+		TimeStamp: op.TimeStamp,
+	}
+
+	state := string(model.StateInstalled)
+	var statusErr model.StatusError
+	if err != nil {
+		state = string(model.StateFailed)
+		statusErr = model.StatusError{Code: errorCode(err), Message: err.Error()}
+	}
+	ds.Status = model.DeploymentState{State: state, Error: statusErr}
+
+	var appErr *lifecycle.AppError
+	errors.As(err, &appErr)
+	failed := failedComponent(err)
+
+	for name := range op.App.Components {
+		c := model.DeploymentComponent{
+			Name:         name,
+			State:        state,
+			HostID:       hostID,
+			DeploymentID: op.DeploymentID,
+			ReportedAt:   at,
+		}
+		switch {
+		case appErr != nil:
+			// per-component outcome; the others were installed
+			if cerr, ok := appErr.Failed[name]; ok {
+				c.Error = model.StatusError{Code: errorCode(cerr), Message: cerr.Error()}
+			} else {
+				c.State = string(model.StateInstalled)
+			}
+		case failed == "" || failed == name:
+			c.Error = statusErr
+		}
+		ds.Components = append(ds.Components, c)
+	}
+	return ds
+}
+
+func errorCode(err error) string {
+	var verr *verify.Error
+	var rerr *readinessError
+	var derr *lifecycle.DependencyError
+	var appErr *lifecycle.AppError
+	switch {
+	case errors.As(err, &appErr):
+		if cause := appErr.Cause(); cause != nil {
+			return errorCode(cause)
+		}
+		return CodeDependencyFailed
+	case errors.As(err, &derr):
+		return CodeDependencyFailed
+	case errors.As(err, &verr):
+		return verr.Code
+	case errors.As(err, &rerr):
+		return CodeReadinessTimeout
+	}
+	return CodeInstallFailed
+}
+
+// failedComponent names the component a single-component error is about, or
+// "" when it applies to all of them.
+func failedComponent(err error) string {
+	var verr *verify.Error
+	var rerr *readinessError
+	switch {
+	case errors.As(err, &verr):
+		return verr.Component
+	case errors.As(err, &rerr):
+		return rerr.Component
+	}
+	return ""
+}
+
+func (rm *RuntimeManager) publishStatus(siteID, hostID string, op model.DiffOp, err error) {
+	subj := fmt.Sprintf("status.%s.%s", siteID, hostID)
+	if perr := rm.nb.Publish(subj, deploymentStatus(op, hostID, rm.clock.now(), err)); perr != nil {
+		rm.log.Warnw("status publish failed", "subject", subj, "err", perr)
+	}
+}
@@ -0,0 +1,223 @@
+package runtimemgr
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	// CodeLivenessFailed is attached to the "unhealthy" report for a
+	// component whose liveness probe failed.
+	CodeLivenessFailed = "LIVENESS_FAILED"
+	// CodeRestartsExhausted is attached to the "failed" report for a
+	// component the supervisor gave up restarting.
+	CodeRestartsExhausted = "RESTARTS_EXHAUSTED"
+)
+
+// supervisor restarts components that fail their liveness probe, backing off
+// exponentially while they keep failing, and reports each step upstream as
+// unhealthy, restarting and finally recovered. After MaxRestarts restarts in
+// a row that do not bring it back, a component is reported failed and left
+// alone until it is deployed again.
+type supervisor struct {
+	rm     *RuntimeManager
+	siteID string
+	hostID string
+	opts   ProbeOptions
+
+	mu   sync.Mutex
+	apps map[string]ownedBy // component -> app it was deployed with
+	busy map[string]bool
+	// failures counts recent restarts per component; it resets once the
+	// component stays healthy for longer than MaxBackoff.
+	failures map[string]int
+	lastFail map[string]time.Time
+	gaveUp   map[string]bool
+}
+
+type ownedBy struct {
+	app          model.App
+	deploymentID string
+}
+
+func newSupervisor(rm *RuntimeManager, siteID, hostID string, opts ProbeOptions) *supervisor {
+	return &supervisor{
+		rm:       rm,
+		siteID:   siteID,
+		hostID:   hostID,
+		opts:     opts,
+		apps:     map[string]ownedBy{},
+		busy:     map[string]bool{},
+		failures: map[string]int{},
+		lastFail: map[string]time.Time{},
+		gaveUp:   map[string]bool{},
+	}
+}
+
+// track remembers which app and deployment own app's components. A new
+// deployment of a component gets a fresh restart budget.
+func (s *supervisor) track(app model.App, deploymentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range app.Components {
+		s.apps[name] = ownedBy{app: app, deploymentID: deploymentID}
+		delete(s.failures, name)
+		delete(s.gaveUp, name)
+	}
+}
+
+// untrack stops supervising app's components, e.g. because the app is being
+// removed. A restart under way gives up before its next attempt.
+func (s *supervisor) untrack(app model.App) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range app.Components {
+		if owner, ok := s.apps[name]; ok && owner.app.ID == app.ID {
+			delete(s.apps, name)
+			delete(s.failures, name)
+			delete(s.lastFail, name)
+			delete(s.gaveUp, name)
+		}
+	}
+}
+
+// owns tells whether name is still supervised on behalf of owner.
+func (s *supervisor) owns(name string, owner ownedBy) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.apps[name]
+	return ok && cur.app.ID == owner.app.ID && cur.deploymentID == owner.deploymentID
+}
+
+func (s *supervisor) livenessFailed(name string, err error) {
+	s.mu.Lock()
+	owner, ok := s.apps[name]
+	if !ok || s.busy[name] || s.gaveUp[name] {
+		s.mu.Unlock()
+		if !ok {
+			s.rm.log.Warnw("liveness failed for untracked component", "component", name, "err", err)
+		}
+		return
+	}
+	s.busy[name] = true
+	if time.Since(s.lastFail[name]) > s.opts.MaxBackoff {
+		s.failures[name] = 0
+	}
+	attempt := s.failures[name]
+	s.mu.Unlock()
+
+	s.rm.log.Warnw("liveness probe failed", "component", name, "err", err)
+	s.report(owner, name, model.StateUnhealthy, model.StatusError{Code: CodeLivenessFailed, Message: err.Error()})
+
+	go s.restart(owner, name, attempt)
+}
+
+// restart redeploys name until it starts and becomes ready again, it has
+// used up its restarts, or it is no longer owner's.
+func (s *supervisor) restart(owner ownedBy, name string, attempt int) {
+	defer func() {
+		s.mu.Lock()
+		s.busy[name] = false
+		if _, ok := s.apps[name]; ok {
+			s.failures[name] = attempt
+			s.lastFail[name] = time.Now()
+		}
+		s.mu.Unlock()
+	}()
+
+	for {
+		// the first restart is immediate, later ones back off
+		if d := s.backoff(attempt); d > 0 {
+			s.rm.log.Infow("supervisor: waiting before restart", "component", name, "delay", d)
+			time.Sleep(d)
+		}
+		if !s.owns(name, owner) {
+			s.rm.log.Infow("supervisor: component no longer supervised", "component", name)
+			return
+		}
+		attempt++
+
+		s.report(owner, name, model.StateRestarting, model.StatusError{})
+		app := owner.app
+		err := s.rm.lifecycle.Redeploy(&app, name)
+		if err == nil {
+			err = s.waitReady(name)
+		}
+		if err == nil {
+			s.rm.log.Infow("supervisor: component recovered", "component", name, "restarts", attempt)
+			s.report(owner, name, model.StateRecovered, model.StatusError{})
+			return
+		}
+		s.rm.log.Errorw("supervisor: restart failed", "component", name, "attempt", attempt, "err", err)
+		if attempt >= s.opts.MaxRestarts {
+			s.giveUp(owner, name, attempt, err)
+			return
+		}
+		s.report(owner, name, model.StateUnhealthy, model.StatusError{Code: CodeInstallFailed, Message: err.Error()})
+	}
+}
+
+// giveUp stops probing and restarting name and reports it failed.
+func (s *supervisor) giveUp(owner ownedBy, name string, attempts int, err error) {
+	s.mu.Lock()
+	s.gaveUp[name] = true
+	s.mu.Unlock()
+	s.rm.probes.Stop(name)
+
+	s.rm.log.Errorw("supervisor: giving up on component", "component", name, "restarts", attempts)
+	s.report(owner, name, model.StateFailed, model.StatusError{
+		Code:    CodeRestartsExhausted,
+		Message: fmt.Sprintf("not healthy after %d restarts: %v", attempts, err),
+	})
+}
+
+func (s *supervisor) waitReady(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.opts.ReadyTimeout)
+	defer cancel()
+	return s.rm.probes.WaitReady(ctx, name)
+}
+
+// backoff is 0 for the first attempt, then RestartBackoff doubling up to
+// MaxBackoff.
+func (s *supervisor) backoff(attempt int) time.Duration {
+	if attempt == 0 {
+		return 0
+	}
+	d := s.opts.RestartBackoff
+	for i := 1; i < attempt && d < s.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.opts.MaxBackoff {
+		d = s.opts.MaxBackoff
+	}
+	return d
+}
+
+// report publishes a single-component status. Its overall state is the
+// component's, never "installed", so the LO does not treat it as an op ack.
+func (s *supervisor) report(owner ownedBy, name string, state model.DeploymentStage, serr model.StatusError) {
+	ds := model.DeploymentStatus{
+		APIVersion:   "deployment.margo/v1",
+		Kind:         "DeploymentStatus",
+		DeploymentID: owner.deploymentID,
+		SiteID:       s.siteID,
+		TimeStamp:    time.Now().UnixNano(),
+		Status:       model.DeploymentState{State: string(state), Error: serr},
+		Components: []model.DeploymentComponent{{
+			Name:         name,
+			State:        string(state),
+			Error:        serr,
+			HostID:       s.hostID,
+			DeploymentID: owner.deploymentID,
+			ReportedAt:   s.rm.clock.now(),
+		}},
+	}
+	subj := fmt.Sprintf("status.%s.%s", s.siteID, s.hostID)
+	if err := s.rm.nb.Publish(subj, ds); err != nil {
+		s.rm.log.Warnw("status publish failed", "subject", subj, "err", err)
+	}
+}
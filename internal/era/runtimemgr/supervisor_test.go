@@ -0,0 +1,191 @@
+package runtimemgr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// sickPlugin installs and starts anything, but its components never pass a
+// probe.
+type sickPlugin struct {
+	installs atomic.Int32
+}
+
+func (*sickPlugin) Name() string           { return "sick" }
+func (*sickPlugin) Capabilities() []string { return []string{"probes"} }
+func (p *sickPlugin) Install(edgeruntime.ComponentSpec) error {
+	p.installs.Add(1)
+	return nil
+}
+func (*sickPlugin) Start(edgeruntime.ComponentSpec) error { return nil }
+func (*sickPlugin) Stop(string) error                     { return nil }
+func (*sickPlugin) Delete(string) error                   { return nil }
+func (*sickPlugin) Status(string) (edgeruntime.ComponentStatus, error) {
+	return edgeruntime.ComponentStatus{}, nil
+}
+func (*sickPlugin) Probe(context.Context, string, edgeruntime.Probe) error {
+	return errors.New("connection refused")
+}
+
+// statusLog collects the component states an ERA publishes.
+type statusLog struct {
+	mu     sync.Mutex
+	states []model.DeploymentComponent
+}
+
+func (l *statusLog) last() (model.DeploymentComponent, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.states) == 0 {
+		return model.DeploymentComponent{}, 0
+	}
+	return l.states[len(l.states)-1], len(l.states)
+}
+
+// startNATS runs a NATS server for the test and returns a broker and a
+// plain connection to it.
+func startNATS(t *testing.T) (*natsbroker.Broker, *nats.Conn) {
+	t.Helper()
+	s, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: server.RANDOM_PORT, NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(s.Shutdown)
+
+	nb, err := natsbroker.New(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+	return nb, nc
+}
+
+func newSupervised(t *testing.T, opts ProbeOptions) (*RuntimeManager, *sickPlugin, *statusLog) {
+	t.Helper()
+	nb, nc := startNATS(t)
+	log := &statusLog{}
+	if _, err := nc.Subscribe("status.site.host", func(m *nats.Msg) {
+		var ds model.DeploymentStatus
+		json.Unmarshal(m.Data, &ds)
+		log.mu.Lock()
+		log.states = append(log.states, ds.Components...)
+		log.mu.Unlock()
+	}); err != nil {
+		t.Fatal(err)
+	}
+	nc.Flush()
+
+	p := &sickPlugin{}
+	rm := NewRuntimeManagerWithPlugin(p, nb, zap.NewNop().Sugar())
+	rm.EnableProbes("site", "host", opts)
+	return rm, p, log
+}
+
+func sickApp() model.App {
+	probe := &model.Probe{Exec: []string{"true"}}
+	return model.App{ID: "app", Components: map[string]model.Component{
+		"web": {Name: "web", Repository: "repo/web", Liveness: probe, Readiness: probe},
+	}}
+}
+
+func TestSupervisorGivesUp(t *testing.T) {
+	rm, p, log := newSupervised(t, ProbeOptions{
+		ReadyTimeout:   20 * time.Millisecond,
+		RestartBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+		MaxRestarts:    3,
+	})
+	rm.supervisor.track(sickApp(), "dep-1")
+	rm.supervisor.livenessFailed("web", errors.New("connection refused"))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if st, _ := log.last(); st.State == string(model.StateFailed) {
+			if st.Error.Code != CodeRestartsExhausted || st.DeploymentID != "dep-1" {
+				t.Fatalf("failed report = %+v", st)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			st, _ := log.last()
+			t.Fatalf("not given up on; last report %+v", st)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := p.installs.Load(); n != 3 {
+		t.Errorf("restarted %d times, want 3", n)
+	}
+	// the CO orders a component's reports by their stamps
+	log.mu.Lock()
+	for i := 1; i < len(log.states); i++ {
+		if log.states[i].ReportedAt <= log.states[i-1].ReportedAt {
+			t.Errorf("report %d stamped %d after %d", i, log.states[i].ReportedAt, log.states[i-1].ReportedAt)
+		}
+	}
+	log.mu.Unlock()
+
+	// left alone until deployed again
+	rm.supervisor.livenessFailed("web", errors.New("connection refused"))
+	time.Sleep(50 * time.Millisecond)
+	if n := p.installs.Load(); n != 3 {
+		t.Errorf("restarted after giving up: %d installs", n)
+	}
+}
+
+func TestSupervisorUntrack(t *testing.T) {
+	rm, p, log := newSupervised(t, ProbeOptions{
+		ReadyTimeout:   10 * time.Millisecond,
+		RestartBackoff: 200 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		MaxRestarts:    10,
+	})
+	app := sickApp()
+	rm.supervisor.track(app, "dep-1")
+	rm.supervisor.livenessFailed("web", errors.New("connection refused"))
+
+	// the first restart is immediate; the app is removed while the
+	// supervisor backs off before the second
+	deadline := time.Now().Add(5 * time.Second)
+	for p.installs.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("not restarted")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	rm.supervisor.untrack(app)
+	time.Sleep(400 * time.Millisecond)
+	if n := p.installs.Load(); n != 1 {
+		t.Errorf("%d installs after the app was removed", n)
+	}
+	if st, _ := log.last(); st.State == string(model.StateRestarting) {
+		t.Errorf("last report %+v", st)
+	}
+
+	_, before := log.last()
+	rm.supervisor.livenessFailed("web", errors.New("connection refused"))
+	time.Sleep(20 * time.Millisecond)
+	if _, after := log.last(); after != before || p.installs.Load() != 1 {
+		t.Error("removed component still supervised")
+	}
+}
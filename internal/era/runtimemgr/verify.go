@@ -0,0 +1,35 @@
+package runtimemgr
+
+import (
+	"context"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/verify"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+const verifyTimeout = time.Minute
+
+// EnableVerification rejects any component whose artifacts do not carry a
+// valid signature for its KeyLocation or the site trust store, and has the
+// image installed by the digest verified.
+func (rm *RuntimeManager) EnableVerification(v *verify.Verifier) {
+	rm.lifecycle.BeforeInstall = func(c *edgeruntime.ComponentSpec) error {
+		ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+		defer cancel()
+		digest, err := v.Verify(ctx, *c)
+		if err != nil {
+			rm.log.Errorw("artifact verification failed", "component", c.Name, "err", err)
+			return err
+		}
+		if digest != "" {
+			pinned, err := verify.Pin(c.Artifact, digest)
+			if err != nil {
+				return err
+			}
+			c.Artifact = pinned
+		}
+		rm.log.Infow("artifact verified", "component", c.Name, "artifact", c.Artifact)
+		return nil
+	}
+}
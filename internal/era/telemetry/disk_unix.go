@@ -0,0 +1,25 @@
+//go:build !windows
+
+package telemetry
+
+import (
+	"syscall"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func diskUsage(path string) (model.DiskUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return model.DiskUsage{}, err
+	}
+	d := model.DiskUsage{
+		Path:       path,
+		TotalBytes: uint64(st.Blocks) * uint64(st.Bsize),
+		FreeBytes:  uint64(st.Bavail) * uint64(st.Bsize),
+	}
+	if d.TotalBytes > 0 {
+		d.UsedPercent = float64(d.TotalBytes-d.FreeBytes) / float64(d.TotalBytes) * 100
+	}
+	return d, nil
+}
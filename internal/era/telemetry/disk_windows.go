@@ -0,0 +1,14 @@
+//go:build windows
+
+package telemetry
+
+import (
+	"errors"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// diskUsage is not implemented on Windows; Disk stays empty.
+func diskUsage(path string) (model.DiskUsage, error) {
+	return model.DiskUsage{}, errors.New("disk usage not supported on windows")
+}
@@ -0,0 +1,187 @@
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// cpuTimes is the aggregate "cpu" line of /proc/stat, in jiffies.
+type cpuTimes struct {
+	total uint64
+	idle  uint64 // idle + iowait
+}
+
+func (c cpuTimes) usedPercentSince(prev cpuTimes) float64 {
+	total := c.total - prev.total
+	idle := c.idle - prev.idle
+	if c.total <= prev.total || total == 0 {
+		return 0
+	}
+	return float64(total-idle) / float64(total) * 100
+}
+
+func readCPUTimes(path string) (cpuTimes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return cpuTimes{}, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		var t cpuTimes
+		// user nice system idle iowait irq softirq steal; guest time is
+		// already counted in user
+		for i, f := range fields[1:] {
+			if i >= 8 {
+				break
+			}
+			v, err := strconv.ParseUint(f, 10, 64)
+			if err != nil {
+				return cpuTimes{}, fmt.Errorf("%s: %w", path, err)
+			}
+			t.total += v
+			if i == 3 || i == 4 {
+				t.idle += v
+			}
+		}
+		return t, nil
+	}
+	return cpuTimes{}, fmt.Errorf("%s: no cpu line", path)
+}
+
+// readCPUCount counts the per-CPU lines of /proc/stat: the host's online
+// CPUs, whatever the process's affinity.
+func readCPUCount(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	n := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		name, _, _ := strings.Cut(sc.Text(), " ")
+		if id, ok := strings.CutPrefix(name, "cpu"); ok && id != "" {
+			if _, err := strconv.Atoi(id); err == nil {
+				n++
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%s: no per-cpu lines", path)
+	}
+	return n, nil
+}
+
+type meminfo struct {
+	totalKB     uint64
+	availableKB uint64
+}
+
+func readMeminfo(path string) (meminfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return meminfo{}, err
+	}
+	defer f.Close()
+
+	var m meminfo
+	var free, buffers, cached uint64
+	hasAvailable := false
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			m.totalKB = v
+		case "MemAvailable:":
+			m.availableKB = v
+			hasAvailable = true
+		case "MemFree:":
+			free = v
+		case "Buffers:":
+			buffers = v
+		case "Cached:":
+			cached = v
+		}
+	}
+	if m.totalKB == 0 {
+		return meminfo{}, fmt.Errorf("%s: no MemTotal", path)
+	}
+	// kernels before 3.14 have no MemAvailable
+	if !hasAvailable {
+		m.availableKB = free + buffers + cached
+	}
+	if m.availableKB > m.totalKB {
+		m.availableKB = m.totalKB
+	}
+	return m, nil
+}
+
+func readLoadavg(path string) (model.LoadAvg, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return model.LoadAvg{}, err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) < 3 {
+		return model.LoadAvg{}, fmt.Errorf("%s: malformed", path)
+	}
+	var vals [3]float64
+	for i := range vals {
+		if vals[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return model.LoadAvg{}, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return model.LoadAvg{Load1: vals[0], Load5: vals[1], Load15: vals[2]}, nil
+}
+
+func readUptime(path string) (int64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%s: empty", path)
+	}
+	up, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", path, err)
+	}
+	return int64(up), nil
+}
+
+func readNetCounters(dir string) (rx, tx uint64) {
+	read := func(name string) uint64 {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return 0
+		}
+		v, _ := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+		return v
+	}
+	return read("rx_bytes"), read("tx_bytes")
+}
@@ -0,0 +1,96 @@
+// Package telemetry reads host metrics for ERA heartbeats from /proc and /sys.
+//
+// Every reader is best effort: a source that is missing (e.g. on a non-Linux
+// host) leaves its fields zero instead of failing the whole sample.
+package telemetry
+
+import (
+	"net"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+type Sampler struct {
+	// ProcRoot and SysRoot default to /proc and /sys; tests point them at
+	// fixtures.
+	ProcRoot string
+	SysRoot  string
+	// DiskPath is the filesystem reported in Disk, normally the ERA base dir.
+	DiskPath string
+
+	mu   sync.Mutex
+	prev cpuTimes
+}
+
+func NewSampler(diskPath string) *Sampler {
+	return &Sampler{ProcRoot: "/proc", SysRoot: "/sys", DiskPath: diskPath}
+}
+
+// Sample fills msg's metric fields. CPU usage is measured since the previous
+// call (since boot on the first one).
+func (s *Sampler) Sample(msg *model.HealthMsg) {
+	// runtime.NumCPU is the process's affinity, not the host's
+	msg.CPUCount = runtime.NumCPU()
+	if n, err := readCPUCount(filepath.Join(s.ProcRoot, "stat")); err == nil {
+		msg.CPUCount = n
+	}
+
+	if cur, err := readCPUTimes(filepath.Join(s.ProcRoot, "stat")); err == nil {
+		s.mu.Lock()
+		msg.CPUPercent = cur.usedPercentSince(s.prev)
+		s.prev = cur
+		s.mu.Unlock()
+	}
+
+	if mem, err := readMeminfo(filepath.Join(s.ProcRoot, "meminfo")); err == nil {
+		msg.MemTotalMB = float64(mem.totalKB) / 1024
+		msg.MemMB = float64(mem.totalKB-mem.availableKB) / 1024
+	}
+
+	if load, err := readLoadavg(filepath.Join(s.ProcRoot, "loadavg")); err == nil {
+		msg.Load = load
+	}
+
+	if up, err := readUptime(filepath.Join(s.ProcRoot, "uptime")); err == nil {
+		msg.UptimeSeconds = up
+	}
+
+	if s.DiskPath != "" {
+		if d, err := diskUsage(s.DiskPath); err == nil {
+			msg.Disk = d
+		}
+	}
+
+	msg.Interfaces = s.interfaces()
+}
+
+// interfaces lists non-loopback interfaces with their addresses and the byte
+// counters from /sys/class/net.
+func (s *Sampler) interfaces() []model.NetInterface {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	var out []model.NetInterface
+	for _, ifc := range ifaces {
+		if ifc.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		ni := model.NetInterface{
+			Name: ifc.Name,
+			Up:   ifc.Flags&net.FlagUp != 0,
+			MAC:  ifc.HardwareAddr.String(),
+		}
+		if addrs, err := ifc.Addrs(); err == nil {
+			for _, a := range addrs {
+				ni.Addrs = append(ni.Addrs, a.String())
+			}
+		}
+		ni.RxBytes, ni.TxBytes = readNetCounters(filepath.Join(s.SysRoot, "class", "net", ifc.Name, "statistics"))
+		out = append(out, ni)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package telemetry
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+func writeProc(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, body := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSampleFromProc(t *testing.T) {
+	proc := t.TempDir()
+	writeProc(t, proc, map[string]string{
+		"stat":    "cpu  100 0 100 700 100 0 0 0 0 0\ncpu0 50 0 50 350 50 0 0 0 0 0\ncpu1 50 0 50 350 50 0 0 0 0 0\nintr 1\n",
+		"meminfo": "MemTotal:        2048000 kB\nMemFree:          512000 kB\nMemAvailable:    1024000 kB\n",
+		"loadavg": "0.50 0.25 0.10 1/123 4567\n",
+		"uptime":  "3600.42 7000.00\n",
+	})
+
+	s := &Sampler{ProcRoot: proc, SysRoot: t.TempDir(), DiskPath: t.TempDir()}
+	var msg model.HealthMsg
+	s.Sample(&msg)
+
+	// since boot: 200 busy of 1000 jiffies
+	if math.Abs(msg.CPUPercent-20) > 0.01 {
+		t.Fatalf("CPUPercent = %v, want 20", msg.CPUPercent)
+	}
+	if msg.CPUCount != 2 {
+		t.Fatalf("CPUCount = %d, want 2", msg.CPUCount)
+	}
+	if msg.MemTotalMB != 2000 || msg.MemMB != 1000 {
+		t.Fatalf("memory = %v/%v MB, want 1000/2000", msg.MemMB, msg.MemTotalMB)
+	}
+	if msg.Load != (model.LoadAvg{Load1: 0.5, Load5: 0.25, Load15: 0.1}) {
+		t.Fatalf("Load = %+v", msg.Load)
+	}
+	if msg.UptimeSeconds != 3600 {
+		t.Fatalf("UptimeSeconds = %d", msg.UptimeSeconds)
+	}
+	if msg.Disk.TotalBytes == 0 || msg.Disk.Path != s.DiskPath {
+		t.Fatalf("Disk = %+v", msg.Disk)
+	}
+
+	// second sample measures only the delta: 300 more jiffies, 100 idle
+	writeProc(t, proc, map[string]string{
+		"stat": "cpu  250 0 150 800 100 0 0 0 0 0\n",
+	})
+	s.Sample(&msg)
+	if math.Abs(msg.CPUPercent-66.67) > 0.01 {
+		t.Fatalf("CPUPercent delta = %v, want 66.67", msg.CPUPercent)
+	}
+}
+
+func TestMeminfoWithoutAvailable(t *testing.T) {
+	dir := t.TempDir()
+	writeProc(t, dir, map[string]string{
+		"meminfo": "MemTotal: 1000 kB\nMemFree: 100 kB\nBuffers: 50 kB\nCached: 250 kB\n",
+	})
+	m, err := readMeminfo(filepath.Join(dir, "meminfo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.availableKB != 400 {
+		t.Fatalf("available = %d, want free+buffers+cached", m.availableKB)
+	}
+}
+
+func TestMissingSourcesLeaveZeroes(t *testing.T) {
+	s := &Sampler{ProcRoot: t.TempDir(), SysRoot: t.TempDir()}
+	var msg model.HealthMsg
+	s.Sample(&msg)
+	if msg.CPUPercent != 0 || msg.MemTotalMB != 0 || msg.CPUCount == 0 {
+		t.Fatalf("unexpected sample from empty proc: %+v", msg)
+	}
+}
@@ -0,0 +1,25 @@
+package verify
+
+import "fmt"
+
+// Status codes reported in model.StatusError.Code when verification fails.
+const (
+	CodeKeyUnavailable   = "KEY_UNAVAILABLE"
+	CodeSignatureMissing = "SIGNATURE_MISSING"
+	CodeSignatureInvalid = "SIGNATURE_INVALID"
+)
+
+// Error is returned for any artifact that could not be verified. Component
+// and Code are carried through to the deployment status sent to the LO.
+type Error struct {
+	Component string
+	Artifact  string
+	Code      string
+	Err       error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("verify %s (%s): %s: %v", e.Component, e.Artifact, e.Code, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
@@ -0,0 +1,180 @@
+package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PublicKey is a PEM-encoded PKIX key: ECDSA (what cosign generates), Ed25519
+// or RSA.
+type PublicKey struct {
+	Source string
+	key    crypto.PublicKey
+}
+
+func ParsePublicKey(source string, data []byte) (PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return PublicKey{}, fmt.Errorf("%s: no PEM block", source)
+	}
+	k, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%s: %w", source, err)
+	}
+	switch k.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+	default:
+		return PublicKey{}, fmt.Errorf("%s: unsupported key type %T", source, k)
+	}
+	return PublicKey{Source: source, key: k}, nil
+}
+
+// Verify checks sig over msg. ECDSA and RSA signatures are over SHA-256(msg).
+func (p PublicKey) Verify(msg, sig []byte) error {
+	switch k := p.key.(type) {
+	case *ecdsa.PublicKey:
+		sum := sha256.Sum256(msg)
+		if ecdsa.VerifyASN1(k, sum[:], sig) {
+			return nil
+		}
+	case ed25519.PublicKey:
+		if ed25519.Verify(k, msg, sig) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		sum := sha256.Sum256(msg)
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], sig) == nil {
+			return nil
+		}
+	}
+	return errors.New("signature does not match key " + p.Source)
+}
+
+// keyring resolves KeyLocation values to keys. Remote keys are cached on disk
+// so verification keeps working while the node is offline.
+type keyring struct {
+	trustDir string
+	cacheDir string
+}
+
+// resolve returns the key at location, fetched with client if remote, or
+// every key in the trust store when location is empty.
+func (k *keyring) resolve(client *http.Client, location string) ([]PublicKey, error) {
+	if location == "" {
+		return k.trusted()
+	}
+
+	if path, ok := localPath(location); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKey(location, data)
+		if err != nil {
+			return nil, err
+		}
+		return []PublicKey{key}, nil
+	}
+
+	data, fetchErr := fetch(client, location)
+	if fetchErr != nil {
+		cached, err := os.ReadFile(k.cachePath(location))
+		if err != nil {
+			return nil, fmt.Errorf("fetch %s: %w (no cached copy)", location, fetchErr)
+		}
+		data = cached
+	}
+	key, err := ParsePublicKey(location, data)
+	if err != nil {
+		return nil, err
+	}
+	if fetchErr == nil {
+		_ = k.store(location, data)
+	}
+	return []PublicKey{key}, nil
+}
+
+func (k *keyring) trusted() ([]PublicKey, error) {
+	if k.trustDir == "" {
+		return nil, errors.New("no key location and no trust store configured")
+	}
+	entries, err := os.ReadDir(k.trustDir)
+	if err != nil {
+		return nil, err
+	}
+	var keys []PublicKey
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".pem") && !strings.HasSuffix(e.Name(), ".pub") {
+			continue
+		}
+		path := filepath.Join(k.trustDir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKey(path, data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("trust store %s has no keys", k.trustDir)
+	}
+	return keys, nil
+}
+
+func fetch(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+func (k *keyring) cachePath(location string) string {
+	sum := sha256.Sum256([]byte(location))
+	return filepath.Join(k.cacheDir, hex.EncodeToString(sum[:])+".pem")
+}
+
+func (k *keyring) store(location string, data []byte) error {
+	if k.cacheDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(k.cacheDir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(k.cachePath(location), data, 0644)
+}
+
+func localPath(location string) (string, bool) {
+	if strings.HasPrefix(location, "file://") {
+		return strings.TrimPrefix(location, "file://"), true
+	}
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return "", false
+	}
+	return location, true
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: 15 * time.Second}
+}
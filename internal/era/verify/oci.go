@@ -0,0 +1,187 @@
+package verify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+)
+
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// signatureBundle is what we cache per image reference for offline checks.
+// Digest is the image the signatures were checked against; offline, that is
+// the image installed.
+type signatureBundle struct {
+	Digest     string          `json:"digest"`
+	Signatures []signedPayload `json:"signatures"`
+}
+
+type signedPayload struct {
+	Payload   []byte `json:"payload"`
+	Signature []byte `json:"signature"`
+}
+
+// verifyImage checks the cosign signature of ref, fetched from its registry
+// or through mirror when one is set, and returns the digest it vouches for.
+func (v *Verifier) verifyImage(ctx context.Context, client *http.Client, ref, mirror string, keys []PublicKey) (string, error) {
+	bundle, fetchErr := fetchSignatures(ctx, client, ref, mirror)
+	if fetchErr != nil {
+		if errors.Is(fetchErr, errMissing) {
+			return "", fetchErr
+		}
+		cached, err := v.loadBundle(ref)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v (no cached signature)", errMissing, fetchErr)
+		}
+		// a ref pinned to another digest is another image
+		if r, err := registry.ParseReference(ref); err == nil {
+			if d, err := r.Digest(); err == nil && d.String() != cached.Digest {
+				return "", fmt.Errorf("%w: %v (cached signature is for %s)", errMissing, fetchErr, cached.Digest)
+			}
+		}
+		bundle = cached
+	}
+
+	var errs []string
+	for _, s := range bundle.Signatures {
+		err := verifyCosignPayload(s.Payload, s.Signature, bundle.Digest, keys)
+		if err == nil {
+			if fetchErr == nil {
+				_ = v.saveBundle(ref, bundle)
+			}
+			return bundle.Digest, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return "", errors.New(strings.Join(errs, "; "))
+}
+
+// Pin returns ref by digest, so that what is pulled is the image verified
+// even if its tag has moved since.
+func Pin(ref, digest string) (string, error) {
+	r, err := registry.ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	r.Reference = digest
+	if _, err := r.Digest(); err != nil {
+		return "", err
+	}
+	return r.String(), nil
+}
+
+func fetchSignatures(ctx context.Context, client *http.Client, ref, mirror string) (*signatureBundle, error) {
+	r, err := registry.ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+	if r.Registry == "docker.io" {
+		r.Registry = "registry-1.docker.io"
+	}
+	repo, err := remote.NewRepository(r.Registry + "/" + r.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if mirror != "" {
+		rt, err := artifacts.Transport(mirror, client.Transport)
+		if err != nil {
+			return nil, err
+		}
+		repo.Client = &auth.Client{Client: &http.Client{Transport: rt}}
+	}
+
+	desc, err := repo.Resolve(ctx, r.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", ref, err)
+	}
+	digest := desc.Digest.String()
+
+	sigTag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	_, rc, err := repo.FetchReference(ctx, sigTag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", errMissing, sigTag, err)
+	}
+	defer rc.Close()
+
+	var manifest ocispec.Manifest
+	if err := json.NewDecoder(io.LimitReader(rc, 4<<20)).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("signature manifest: %w", err)
+	}
+
+	bundle := &signatureBundle{Digest: digest}
+	for _, layer := range manifest.Layers {
+		sig64, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(sig64)
+		if err != nil {
+			continue
+		}
+		lrc, err := repo.Fetch(ctx, layer)
+		if err != nil {
+			return nil, fmt.Errorf("signature payload: %w", err)
+		}
+		payload, err := io.ReadAll(io.LimitReader(lrc, 1<<20))
+		lrc.Close()
+		if err != nil {
+			return nil, err
+		}
+		bundle.Signatures = append(bundle.Signatures, signedPayload{Payload: payload, Signature: sig})
+	}
+	if len(bundle.Signatures) == 0 {
+		return nil, fmt.Errorf("%w: %s has no cosign signatures", errMissing, sigTag)
+	}
+	return bundle, nil
+}
+
+func (v *Verifier) bundlePath(ref string) string {
+	sum := sha256.Sum256([]byte(ref))
+	return filepath.Join(v.cache, "signatures", hex.EncodeToString(sum[:])+".json")
+}
+
+func (v *Verifier) loadBundle(ref string) (*signatureBundle, error) {
+	if v.cache == "" {
+		return nil, errors.New("no cache")
+	}
+	data, err := os.ReadFile(v.bundlePath(ref))
+	if err != nil {
+		return nil, err
+	}
+	var b signatureBundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (v *Verifier) saveBundle(ref string, b *signatureBundle) error {
+	if v.cache == "" {
+		return nil
+	}
+	path := v.bundlePath(ref)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
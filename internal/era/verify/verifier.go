@@ -0,0 +1,196 @@
+// Package verify checks artifact signatures on the ERA before a component is
+// installed.
+//
+// Packages fetched from a PackageLocation carry a detached signature next to
+// them (<url>.sig, raw or base64). OCI images are checked the way cosign
+// signs them: a "sha256-<digest>.sig" tag in the same repository whose layers
+// are simple-signing payloads naming the image digest, with the signature in
+// the dev.cosignproject.cosign/signature annotation.
+//
+// The key comes from the component's KeyLocation (file path, file:// or
+// http(s) URL) or, when none is given, from the site trust store directory.
+// Remote keys and image signatures are cached so verification still works
+// while the node is offline. Verify returns the digest of the image it
+// checked, and the image must then be pulled by that digest (see Pin): its
+// tag may have moved since.
+package verify
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+// maxPackageSize bounds a package or signature read to verify it.
+const maxPackageSize = 512 << 20
+
+type Options struct {
+	// TrustDir holds *.pem / *.pub public keys trusted for the whole site.
+	TrustDir string
+	// CacheDir keeps fetched keys and image signatures for offline use.
+	CacheDir string
+}
+
+type Verifier struct {
+	keys   *keyring
+	client *http.Client
+	cache  string
+}
+
+func New(opts Options) *Verifier {
+	client := newHTTPClient()
+	return &Verifier{
+		keys:   &keyring{trustDir: opts.TrustDir, cacheDir: opts.CacheDir},
+		client: client,
+		cache:  opts.CacheDir,
+	}
+}
+
+// Verify checks every artifact of spec and returns the digest of the image
+// whose signature it checked, "" if spec has none. The returned error is
+// always *Error.
+func (v *Verifier) Verify(ctx context.Context, spec edgeruntime.ComponentSpec) (string, error) {
+	fail := func(artifact, code string, err error) (string, error) {
+		return "", &Error{Component: spec.Name, Artifact: artifact, Code: code, Err: err}
+	}
+
+	client, err := v.clientFor(spec)
+	if err != nil {
+		return fail(spec.Mirror, CodeKeyUnavailable, err)
+	}
+	keys, err := v.keys.resolve(client, spec.KeyLocation)
+	if err != nil {
+		return fail(spec.KeyLocation, CodeKeyUnavailable, err)
+	}
+
+	if spec.PackageURL != "" {
+		if err := v.verifyPackage(client, spec.PackageURL, keys); err != nil {
+			return fail(spec.PackageURL, codeFor(err), err)
+		}
+	}
+	var digest string
+	if spec.Artifact != "" {
+		if digest, err = v.verifyImage(ctx, client, spec.Artifact, spec.Mirror, keys); err != nil {
+			return fail(spec.Artifact, codeFor(err), err)
+		}
+	}
+	return digest, nil
+}
+
+// clientFor is the client spec's artifacts are fetched with, which sends
+// spec's mirror its token.
+func (v *Verifier) clientFor(spec edgeruntime.ComponentSpec) (*http.Client, error) {
+	if spec.Mirror == "" || spec.MirrorToken == "" {
+		return v.client, nil
+	}
+	rt, err := artifacts.Authorize(spec.Mirror, spec.MirrorToken, v.client.Transport)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Timeout: v.client.Timeout, Transport: rt}, nil
+}
+
+// errMissing marks a signature that could not be found at all, as opposed to
+// one that was found and did not verify.
+var errMissing = errors.New("signature not found")
+
+func codeFor(err error) string {
+	if errors.Is(err, errMissing) {
+		return CodeSignatureMissing
+	}
+	return CodeSignatureInvalid
+}
+
+func (v *Verifier) verifyPackage(client *http.Client, url string, keys []PublicKey) error {
+	data, err := read(client, url)
+	if err != nil {
+		return fmt.Errorf("read package: %w", err)
+	}
+	sig, err := read(client, url+".sig")
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMissing, err)
+	}
+	return verifyBlob(data, decodeSignature(sig), keys)
+}
+
+func read(client *http.Client, location string) ([]byte, error) {
+	var r io.Reader
+	if path, ok := localPath(location); ok {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := client.Get(location)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GET %s: status %d", location, resp.StatusCode)
+		}
+		r = resp.Body
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxPackageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxPackageSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", location, maxPackageSize)
+	}
+	return data, nil
+}
+
+func verifyBlob(data, sig []byte, keys []PublicKey) error {
+	var errs []string
+	for _, k := range keys {
+		err := k.Verify(data, sig)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// decodeSignature accepts raw signature bytes or their base64 encoding.
+func decodeSignature(sig []byte) []byte {
+	trimmed := strings.TrimSpace(string(sig))
+	if dec, err := base64.StdEncoding.DecodeString(trimmed); err == nil {
+		return dec
+	}
+	return sig
+}
+
+// simpleSigning is the cosign signature payload; only the digest matters here.
+type simpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifyCosignPayload checks that payload names digest and is signed by one
+// of keys.
+func verifyCosignPayload(payload, sig []byte, digest string, keys []PublicKey) error {
+	var ss simpleSigning
+	if err := json.Unmarshal(payload, &ss); err != nil {
+		return fmt.Errorf("signature payload: %w", err)
+	}
+	if got := ss.Critical.Image.DockerManifestDigest; got != digest {
+		return fmt.Errorf("signature is for %s, image is %s", got, digest)
+	}
+	return verifyBlob(payload, sig, keys)
+}
@@ -0,0 +1,158 @@
+package verify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/era/edgeruntime"
+)
+
+func newKey(t *testing.T, dir, name string) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return priv, path
+}
+
+func sign(t *testing.T, priv *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+	sum := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, priv, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func TestVerifyPackage(t *testing.T) {
+	dir := t.TempDir()
+	trust := filepath.Join(dir, "trust")
+	os.Mkdir(trust, 0755)
+	priv, keyPath := newKey(t, dir, "app.pem")
+	_, _ = newKey(t, trust, "site.pem")
+
+	pkg := filepath.Join(dir, "app.wasm")
+	data := []byte("package contents")
+	os.WriteFile(pkg, data, 0644)
+	os.WriteFile(pkg+".sig", []byte(base64.StdEncoding.EncodeToString(sign(t, priv, data))), 0644)
+
+	v := New(Options{TrustDir: trust, CacheDir: filepath.Join(dir, "cache")})
+	spec := edgeruntime.ComponentSpec{Name: "app", PackageURL: pkg, KeyLocation: keyPath}
+	if _, err := v.Verify(context.Background(), spec); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+
+	// without a KeyLocation only the site key is trusted, which did not sign it
+	spec.KeyLocation = ""
+	assertCode(t, verifyErr(v, spec), CodeSignatureInvalid)
+
+	spec.KeyLocation = keyPath
+	os.WriteFile(pkg, []byte("tampered"), 0644)
+	assertCode(t, verifyErr(v, spec), CodeSignatureInvalid)
+
+	os.Remove(pkg + ".sig")
+	assertCode(t, verifyErr(v, spec), CodeSignatureMissing)
+
+	spec.KeyLocation = filepath.Join(dir, "missing.pem")
+	assertCode(t, verifyErr(v, spec), CodeKeyUnavailable)
+}
+
+func TestVerifyCosignPayload(t *testing.T) {
+	priv, keyPath := newKey(t, t.TempDir(), "cosign.pub")
+	data, _ := os.ReadFile(keyPath)
+	key, err := ParsePublicKey(keyPath, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := "sha256:0123456789abcdef"
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"example/app"},"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"},"optional":null}`)
+	sig := sign(t, priv, payload)
+
+	if err := verifyCosignPayload(payload, sig, digest, []PublicKey{key}); err != nil {
+		t.Fatalf("valid cosign signature rejected: %v", err)
+	}
+	if err := verifyCosignPayload(payload, sig, "sha256:other", []PublicKey{key}); err == nil {
+		t.Fatal("signature for another digest accepted")
+	}
+}
+
+func verifyErr(v *Verifier, spec edgeruntime.ComponentSpec) error {
+	_, err := v.Verify(context.Background(), spec)
+	return err
+}
+
+func assertCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var verr *Error
+	if !errors.As(err, &verr) || verr.Code != code {
+		t.Fatalf("got %v, want code %s", err, code)
+	}
+}
+
+func TestPin(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	for ref, want := range map[string]string{
+		"registry.local/apps/web:1.2":                           "registry.local/apps/web@" + digest,
+		"docker.io/library/nginx":                               "docker.io/library/nginx@" + digest,
+		"localhost:5000/web@sha256:" + strings.Repeat("cd", 32): "localhost:5000/web@" + digest,
+	} {
+		if got, err := Pin(ref, digest); err != nil || got != want {
+			t.Errorf("Pin(%s) = %s, %v", ref, got, err)
+		}
+	}
+	if _, err := Pin("registry.local/web:1", "latest"); err == nil {
+		t.Error("pinned to a tag")
+	}
+}
+
+func TestCachedBundleBoundToDigest(t *testing.T) {
+	priv, keyPath := newKey(t, t.TempDir(), "cosign.pub")
+	data, _ := os.ReadFile(keyPath)
+	key, err := ParsePublicKey(keyPath, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	payload := []byte(`{"critical":{"image":{"docker-manifest-digest":"` + digest + `"}}}`)
+
+	v := New(Options{CacheDir: t.TempDir()})
+	// an unreachable registry, so only the cache can answer
+	ref := "127.0.0.1:1/apps/web:1"
+	if err := v.saveBundle(ref, &signatureBundle{Digest: digest, Signatures: []signedPayload{{payload, sign(t, priv, payload)}}}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := v.verifyImage(context.Background(), v.client, ref, "", []PublicKey{key})
+	if err != nil || got != digest {
+		t.Fatalf("offline verify = %s, %v", got, err)
+	}
+
+	other := "sha256:" + strings.Repeat("cd", 32)
+	if err := v.saveBundle("127.0.0.1:1/apps/web@"+other, &signatureBundle{Digest: digest, Signatures: []signedPayload{{payload, sign(t, priv, payload)}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.verifyImage(context.Background(), v.client, "127.0.0.1:1/apps/web@"+other, "", []PublicKey{key}); err == nil {
+		t.Fatal("cached signature for another digest accepted")
+	}
+}
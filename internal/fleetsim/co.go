@@ -0,0 +1,163 @@
+package fleetsim
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	_ "github.com/balaji-balu/margo-hello-world/ent/runtime"
+	"github.com/balaji-balu/margo-hello-world/internal/api"
+	"github.com/balaji-balu/margo-hello-world/internal/api/handlers"
+	"github.com/balaji-balu/margo-hello-world/internal/co"
+	"github.com/balaji-balu/margo-hello-world/internal/metrics"
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	comodel "github.com/balaji-balu/margo-hello-world/pkg/co/model"
+)
+
+// coNode is the CO API served from this process.
+type coNode struct {
+	client *ent.Client
+	srv    *http.Server
+	ln     net.Listener
+}
+
+// openDB connects to dsn when it is a postgres URL and otherwise opens (or
+// creates) a SQLite file at dsn. The schema is created either way.
+func openDB(ctx context.Context, dsn string) (*ent.Client, error) {
+	var drv *sql.Driver
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		d, err := sql.Open(dialect.Postgres, dsn)
+		if err != nil {
+			return nil, err
+		}
+		drv = d
+	} else {
+		// immediate transactions let busy_timeout cover lock upgrades when
+		// many LOs report status at once
+		db, err := stdsql.Open("sqlite", fmt.Sprintf(
+			"file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)&_txlock=immediate", dsn))
+		if err != nil {
+			return nil, err
+		}
+		drv = sql.OpenDB(dialect.SQLite, db)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	if err := client.Schema.Create(ctx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+	return client, nil
+}
+
+func startCO(ctx context.Context, dir, dsn, repoURL string) (*coNode, error) {
+	if dsn == "" {
+		dsn = filepath.Join(dir, "co.db")
+	}
+	client, err := openDB(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	workDir := filepath.Join(dir, "co", deploymentsRepo)
+	gitm := deploymentsManager(repoURL, workDir)
+	if err := gitm.InitRepo(deploymentsRepo); err != nil {
+		client.Close()
+		return nil, err
+	}
+	if err := setIdentity(workDir); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	metrics.Init("co")
+	router := api.NewRouter(client, co.NewCO(gitm, "app-registry", deploymentsRepo), comodel.CoConfig{})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	n := &coNode{client: client, srv: &http.Server{Handler: router}, ln: ln}
+	go n.srv.Serve(ln)
+	return n, nil
+}
+
+func (n *coNode) Addr() string { return n.ln.Addr().String() }
+
+// URL is the API base, e.g. http://127.0.0.1:1234/api/v1.
+func (n *coNode) URL() string { return "http://" + n.Addr() + "/api/v1" }
+
+func (n *coNode) Close() {
+	n.srv.Close()
+	n.client.Close()
+}
+
+// addApp stores the application description directly, as CreateApp would
+// after fetching it from the app registry.
+func (n *coNode) addApp(ctx context.Context, ad *application.ApplicationDescription, category string) error {
+	return handlers.Persist(ctx, n.client, ad.Metadata.Name, category, ad, nil)
+}
+
+// deploy asks the CO to deploy the app to sites and returns one deployment
+// ID per site, in order.
+func (n *coNode) deploy(ad *application.ApplicationDescription, category string, sites []string) ([]string, error) {
+	req := handlers.App{
+		AppName:    ad.Metadata.Name,
+		Version:    ad.Metadata.Version,
+		Category:   category,
+		DeployType: ad.DeploymentProfiles[0].Type,
+	}
+	for _, s := range sites {
+		req.Sites = append(req.Sites, handlers.HostMapping{SiteID: s})
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.Post(n.URL()+"/deployments", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("CO deploy: %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	var out struct {
+		DeploymentIDs []string `json:"deployment_ids"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	if len(out.DeploymentIDs) != len(sites) {
+		return nil, fmt.Errorf("CO deploy: %d deployments for %d sites", len(out.DeploymentIDs), len(sites))
+	}
+	return out.DeploymentIDs, nil
+}
+
+// deploymentState is the state the CO has recorded for a deployment.
+func (n *coNode) deploymentState(ctx context.Context, id string) string {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return ""
+	}
+	ds, err := n.client.DeploymentStatus.Get(ctx, uid)
+	if err != nil {
+		return ""
+	}
+	return ds.State
+}
@@ -0,0 +1,204 @@
+package fleetsim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/internal/era/boltstore"
+	"github.com/balaji-balu/margo-hello-world/internal/era/heartbeat"
+	"github.com/balaji-balu/margo-hello-world/internal/era/inventory"
+	mockcontainerd "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
+	"github.com/balaji-balu/margo-hello-world/internal/era/runtimemgr"
+	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// eraNode is a simulated host: the ERA runtime manager driving its own mock
+// containerd, wired up as cmd/era does. Its NATS traffic goes through a link.
+type eraNode struct {
+	hostID string
+	siteID string
+	dir    string
+	loURL  string
+	lnk    *link
+	opts   Options
+	log    *zap.SugaredLogger
+
+	mu     sync.Mutex
+	mock   *mockcontainerd.MockContainerd // nil while the host is down
+	nb     *natsbroker.Broker
+	state  *boltstore.StateStore
+	cancel context.CancelFunc
+}
+
+func newERANode(hostID, siteID, dir, loURL, natsAddr string, opts Options, log *zap.SugaredLogger) (*eraNode, error) {
+	lnk, err := newLink(natsAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &eraNode{
+		hostID: hostID,
+		siteID: siteID,
+		dir:    dir,
+		loURL:  loURL,
+		lnk:    lnk,
+		opts:   opts,
+		log:    log.With("host", hostID),
+	}, nil
+}
+
+// Boot starts the host with an empty runtime, as after a power cycle; the
+// ERA restores recorded apps from its state store.
+func (e *eraNode) Boot() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.mock != nil {
+		return nil
+	}
+
+	nb, err := natsbroker.New("nats://"+e.lnk.Addr(), natsOptions("era-"+e.hostID)...)
+	if err != nil {
+		return fmt.Errorf("host %s: nats: %w", e.hostID, err)
+	}
+	siteID, err := register(e.loURL, e.hostID)
+	if err != nil {
+		nb.Close()
+		return fmt.Errorf("host %s: register: %w", e.hostID, err)
+	}
+	if siteID != e.siteID {
+		nb.Close()
+		return fmt.Errorf("host %s: registered with site %q, want %q", e.hostID, siteID, e.siteID)
+	}
+	if err := os.MkdirAll(e.dir, 0o755); err != nil {
+		nb.Close()
+		return err
+	}
+	state, err := boltstore.NewStateStore(filepath.Join(e.dir, "era.db"))
+	if err != nil {
+		nb.Close()
+		return err
+	}
+
+	mock := &mockcontainerd.MockContainerd{}
+	if e.opts.Scenario != nil {
+		mock.SetScenario(e.opts.Scenario)
+	}
+	rm := runtimemgr.NewRuntimeManagerWithPlugin(mock, nb, e.log)
+	rm.SetParamDir(filepath.Join(e.dir, "params"))
+	rm.EnableState(state)
+	rm.EnableProbes(e.siteID, e.hostID, runtimemgr.ProbeOptions{
+		ReadyTimeout:   30 * time.Second,
+		RestartBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go heartbeat.Run(ctx, nb, e.log, e.siteID, e.hostID, heartbeat.Options{
+		Interval:     e.opts.Heartbeat,
+		Jitter:       e.opts.Heartbeat / 5,
+		MaxBackoff:   4 * e.opts.Heartbeat,
+		Runtime:      rm.Runtime(),
+		Version:      "fleetsim",
+		Capabilities: rm.Capabilities(),
+		DiskPath:     e.dir,
+	})
+
+	if err := rm.Recover(e.siteID, e.hostID, "adopt"); err != nil {
+		e.log.Warnw("startup reconciliation failed", "err", err)
+	}
+	rm.LoActionDispatcher(e.siteID, e.hostID)
+
+	e.mock, e.nb, e.state, e.cancel = mock, nb, state, cancel
+	return nil
+}
+
+// Fail takes the host down: the ERA stops and everything it ran is lost.
+func (e *eraNode) Fail() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.mock == nil {
+		return
+	}
+	e.cancel()
+	e.nb.Close()
+	e.state.Close()
+	e.mock, e.nb, e.state, e.cancel = nil, nil, nil, nil
+}
+
+func (e *eraNode) Up() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.mock != nil
+}
+
+// running returns the names of the components the host's runtime reports
+// as running.
+func (e *eraNode) running() map[string]bool {
+	e.mu.Lock()
+	mock := e.mock
+	e.mu.Unlock()
+	out := map[string]bool{}
+	if mock == nil {
+		return out
+	}
+	list, err := mock.List()
+	if err != nil {
+		return out
+	}
+	for _, c := range list {
+		if c.State == "Running" {
+			out[c.Name] = true
+		}
+	}
+	return out
+}
+
+func (e *eraNode) Close() {
+	e.Fail()
+	e.lnk.Close()
+}
+
+// register announces the host to its LO, as the ERA does at startup, with
+// a small synthetic inventory, and returns the LO's site ID.
+func register(loURL, hostID string) (string, error) {
+	inv := model.HardwareInventory{
+		HostID:      hostID,
+		CPU:         model.CPUInventory{Model: "fleetsim", Arch: runtime.GOARCH, Cores: 4},
+		MemTotalMB:  4096,
+		CollectedAt: time.Now().Unix(),
+	}
+	inv.Hash = inventory.Hash(inv)
+	b, err := json.Marshal(struct {
+		HostID    string                  `json:"host_id"`
+		Inventory model.HardwareInventory `json:"inventory"`
+	}{hostID, inv})
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.Post(loURL+"/register", "application/json", bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("LO returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	var siteID string
+	if err := json.NewDecoder(resp.Body).Decode(&siteID); err != nil {
+		return "", err
+	}
+	return siteID, nil
+}
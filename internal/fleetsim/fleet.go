@@ -0,0 +1,206 @@
+// Package fleetsim runs a CO, one LO per site and simulated ERA hosts in a
+// single process, so orchestration can be exercised at scale without
+// hardware. Hosts use the mock containerd runtime; NATS is embedded and the
+// deployments repo is a local bare git repo.
+package fleetsim
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/nats-io/nats-server/v2/server"
+
+	mockcontainerd "github.com/balaji-balu/margo-hello-world/internal/era/plugins/mock_containerd"
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
+)
+
+//go:embed sample-app.yaml
+var sampleApp []byte
+
+// appCategory is the catalog category simulated apps are stored under.
+const appCategory = "fleetsim"
+
+type Options struct {
+	// Dir holds all state: databases, git clones, ERA state stores.
+	Dir          string
+	Sites        int
+	HostsPerSite int
+	// DB is a postgres:// URL or a SQLite file; empty means <Dir>/co.db.
+	DB string
+	// App is deployed by deploy steps; nil means the built-in sample.
+	App *application.ApplicationDescription
+	// Scenario scripts every host's mock runtime.
+	Scenario *mockcontainerd.Scenario
+	// Heartbeat is the ERA heartbeat interval.
+	Heartbeat time.Duration
+	Log       *zap.SugaredLogger
+}
+
+// Fleet is a running simulated fleet.
+type Fleet struct {
+	opts   Options
+	log    *zap.SugaredLogger
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	nats  *server.Server
+	co    *coNode
+	sites []*site
+
+	mu          sync.Mutex
+	deployments map[string]string // site ID -> deployment ID of the app
+}
+
+type site struct {
+	id    string
+	lo    *loNode
+	hosts []*eraNode
+}
+
+// SampleApp is the three-component app deployed when none is given.
+func SampleApp() (*application.ApplicationDescription, error) {
+	var ad application.ApplicationDescription
+	if err := yaml.Unmarshal(sampleApp, &ad); err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+// Start brings the fleet up and returns once every LO watches the
+// deployments repo and every host has registered.
+func Start(ctx context.Context, opts Options) (*Fleet, error) {
+	if opts.Sites <= 0 || opts.HostsPerSite <= 0 {
+		return nil, fmt.Errorf("fleetsim: need at least one site and one host per site")
+	}
+	if opts.Heartbeat <= 0 {
+		opts.Heartbeat = 2 * time.Second
+	}
+	if opts.Log == nil {
+		opts.Log = zap.NewNop().Sugar()
+	}
+	if opts.App == nil {
+		app, err := SampleApp()
+		if err != nil {
+			return nil, err
+		}
+		opts.App = app
+	}
+	if len(opts.App.DeploymentProfiles) == 0 {
+		return nil, fmt.Errorf("fleetsim: app %s has no deployment profile", opts.App.Metadata.Name)
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	fctx, cancel := context.WithCancel(ctx)
+	f := &Fleet{
+		opts:        opts,
+		log:         opts.Log,
+		ctx:         fctx,
+		cancel:      cancel,
+		deployments: map[string]string{},
+	}
+	if err := f.start(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *Fleet) start() error {
+	var err error
+	if f.nats, err = startNATS(); err != nil {
+		return err
+	}
+	natsAddr := f.nats.Addr().String()
+
+	repoURL, err := initDeploymentsRepo(f.opts.Dir)
+	if err != nil {
+		return err
+	}
+	if f.co, err = startCO(f.ctx, f.opts.Dir, f.opts.DB, repoURL); err != nil {
+		return fmt.Errorf("co: %w", err)
+	}
+	if err := f.co.addApp(f.ctx, f.opts.App, appCategory); err != nil {
+		return fmt.Errorf("co: add app: %w", err)
+	}
+
+	for i := 0; i < f.opts.Sites; i++ {
+		s := &site{id: fmt.Sprintf("site-%d", i)}
+		dir := filepath.Join(f.opts.Dir, s.id)
+		s.lo, err = startLO(f.ctx, filepath.Join(dir, "lo"), s.id, natsAddr, f.co.Addr(), repoURL,
+			f.log.Named("lo").With("site", s.id))
+		if err != nil {
+			return err
+		}
+		f.sites = append(f.sites, s)
+
+		for j := 0; j < f.opts.HostsPerSite; j++ {
+			hostID := fmt.Sprintf("%s-host-%d", s.id, j)
+			h, err := newERANode(hostID, s.id, filepath.Join(dir, hostID), s.lo.URL(), natsAddr,
+				f.opts, f.log.Named("era"))
+			if err != nil {
+				return err
+			}
+			s.hosts = append(s.hosts, h)
+			if err := h.Boot(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the LOs only pick up deployments committed after their watcher has
+	// cloned the repo
+	return f.waitFor(2*time.Minute, func() bool {
+		for _, s := range f.sites {
+			if !s.lo.watching() {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// waitFor polls cond until it holds or timeout passes.
+func (f *Fleet) waitFor(timeout time.Duration, cond func() bool) error {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("fleetsim: timed out after %s", timeout)
+		}
+		select {
+		case <-f.ctx.Done():
+			return f.ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return nil
+}
+
+// Hosts is the total number of simulated hosts.
+func (f *Fleet) Hosts() int { return f.opts.Sites * f.opts.HostsPerSite }
+
+// Close stops everything; the state under Dir is left in place.
+func (f *Fleet) Close() {
+	f.cancel()
+	for _, s := range f.sites {
+		for _, h := range s.hosts {
+			h.Close()
+		}
+		s.lo.Close()
+	}
+	if f.co != nil {
+		f.co.Close()
+	}
+	if f.nats != nil {
+		f.nats.Shutdown()
+	}
+}
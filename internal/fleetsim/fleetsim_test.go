@@ -0,0 +1,147 @@
+package fleetsim
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// echo serves a line echo on a random port.
+func echo(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				r := bufio.NewReader(c)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					c.Write([]byte(line))
+				}
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func roundTrip(c net.Conn) error {
+	c.SetDeadline(time.Now().Add(2 * time.Second))
+	if _, err := c.Write([]byte("ping\n")); err != nil {
+		return err
+	}
+	_, err := bufio.NewReader(c).ReadString('\n')
+	return err
+}
+
+func TestLinkCutAndHeal(t *testing.T) {
+	l, err := newLink(echo(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	c, err := net.Dial("tcp", l.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err := roundTrip(c); err != nil {
+		t.Fatalf("before cut: %v", err)
+	}
+
+	l.Cut()
+	if err := roundTrip(c); err == nil {
+		t.Fatal("open connection survived the cut")
+	}
+	if c2, err := net.Dial("tcp", l.Addr()); err == nil {
+		if err := roundTrip(c2); err == nil {
+			t.Fatal("new connection got through a cut link")
+		}
+		c2.Close()
+	}
+
+	l.Heal()
+	c3, err := net.Dial("tcp", l.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c3.Close()
+	if err := roundTrip(c3); err != nil {
+		t.Fatalf("after heal: %v", err)
+	}
+}
+
+func TestLoadPlan(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	os.WriteFile(file, []byte(`
+sites: 2
+hosts_per_site: 4
+steps:
+  - action: deploy
+  - action: fail_hosts
+    site: 1
+    count: 2
+    for: 10s
+  - action: partition_site
+    site: 0
+    for: 5s
+`), 0o644)
+
+	p, err := LoadPlan(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Timeout != DefaultTimeout {
+		t.Errorf("timeout = %s, want the default", p.Timeout)
+	}
+	if len(p.Steps) != 3 || p.Steps[1].For != 10*time.Second || *p.Steps[1].Site != 1 {
+		t.Errorf("steps = %+v", p.Steps)
+	}
+}
+
+func TestPlanValidate(t *testing.T) {
+	one, five := 1, 5
+	cases := map[string]Step{
+		"unknown action":        {Action: "reboot"},
+		"site out of range":     {Action: ActionDeploy, Site: &five},
+		"too many hosts":        {Action: ActionFailHosts, Count: 7},
+		"too many site hosts":   {Action: ActionPartitionHosts, Site: &one, Count: 4},
+		"no hosts":              {Action: ActionFailHosts},
+		"partition needs site":  {Action: ActionPartitionSite, For: time.Second},
+		"wait needs a duration": {Action: ActionWait},
+	}
+	for name, st := range cases {
+		p := &Plan{Sites: 2, HostsPerSite: 3, Steps: []Step{st}}
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+	if err := DefaultPlan().Validate(); err != nil {
+		t.Errorf("default plan: %v", err)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	p50, p95, max := percentiles([]float64{5, 1, 4, 2, 3})
+	if p50 != 3 || p95 != 5 || max != 5 {
+		t.Errorf("got %v %v %v", p50, p95, max)
+	}
+	if p50, _, _ := percentiles(nil); p50 != 0 {
+		t.Errorf("empty: %v", p50)
+	}
+}
@@ -0,0 +1,102 @@
+package fleetsim
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
+)
+
+const (
+	deploymentsRepo = "deployments"
+	branch          = "main"
+)
+
+// initDeploymentsRepo creates the bare repo the CO pushes desired state to
+// and the LOs pull from. It gets one commit so that it can be cloned.
+func initDeploymentsRepo(dir string) (string, error) {
+	bare := filepath.Join(dir, "deployments.git")
+	if _, err := git.PlainInit(bare, true); err != nil {
+		return "", fmt.Errorf("init %s: %w", bare, err)
+	}
+	head := plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName(branch))
+
+	seed := filepath.Join(dir, "deployments-seed")
+	repo, err := git.PlainInit(seed, false)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(seed)
+	if err := repo.Storer.SetReference(head); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(seed, "README.md"), []byte("fleetsim deployments\n"), 0o644); err != nil {
+		return "", err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+	if _, err := wt.Add("README.md"); err != nil {
+		return "", err
+	}
+	if _, err := wt.Commit("init", &git.CommitOptions{Author: signature()}); err != nil {
+		return "", err
+	}
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bare}}); err != nil {
+		return "", err
+	}
+	refspec := config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%s", branch, branch))
+	if err := repo.Push(&git.PushOptions{RefSpecs: []config.RefSpec{refspec}}); err != nil {
+		return "", fmt.Errorf("seed push: %w", err)
+	}
+
+	origin, err := git.PlainOpen(bare)
+	if err != nil {
+		return "", err
+	}
+	if err := origin.Storer.SetReference(head); err != nil {
+		return "", err
+	}
+	return "file://" + bare, nil
+}
+
+func signature() *object.Signature {
+	return &object.Signature{Name: "fleetsim", Email: "fleetsim@localhost", When: time.Now()}
+}
+
+// deploymentsManager registers the deployments repo cloned into workDir.
+func deploymentsManager(url, workDir string) *gitmanager.Manager {
+	m := gitmanager.NewManager()
+	m.Register(gitmanager.RepoConfig{
+		Name:        deploymentsRepo,
+		Mode:        gitmanager.GitRemote,
+		RemoteURL:   url,
+		Branch:      branch,
+		WorkingPath: workDir,
+	})
+	return m
+}
+
+// setIdentity sets the commit author of a working clone; the CO commits
+// without one and the simulator cannot rely on a global git config.
+func setIdentity(workDir string) error {
+	repo, err := git.PlainOpen(workDir)
+	if err != nil {
+		return err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+	sig := signature()
+	cfg.User.Name, cfg.User.Email = sig.Name, sig.Email
+	return repo.SetConfig(cfg)
+}
@@ -0,0 +1,114 @@
+package fleetsim
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/internal/lo"
+	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
+)
+
+// loNode is one site's LO. Its NATS and CO traffic goes through links so
+// the whole site can be partitioned.
+type loNode struct {
+	siteID  string
+	dir     string
+	natsLnk *link
+	coLnk   *link
+	srv     *http.Server
+	ln      net.Listener
+	nb      *natsbroker.Broker
+}
+
+func startLO(ctx context.Context, dir, siteID, natsAddr, coAddr, repoURL string, log *zap.SugaredLogger) (*loNode, error) {
+	natsLnk, err := newLink(natsAddr)
+	if err != nil {
+		return nil, err
+	}
+	coLnk, err := newLink(coAddr)
+	if err != nil {
+		natsLnk.Close()
+		return nil, err
+	}
+	n := &loNode{siteID: siteID, dir: dir, natsLnk: natsLnk, coLnk: coLnk}
+
+	natsURL := "nats://" + natsLnk.Addr()
+	n.nb, err = natsbroker.New(natsURL, natsOptions("lo-"+siteID)...)
+	if err != nil {
+		n.Close()
+		return nil, fmt.Errorf("lo %s: nats: %w", siteID, err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		n.Close()
+		return nil, err
+	}
+
+	gitm := deploymentsManager(repoURL, filepath.Join(dir, deploymentsRepo))
+	orch := lo.NewLO(ctx, siteID, filepath.Join(dir, "bolt.db"), natsURL,
+		deploymentsRepo, n.nb, gitm, "", log)
+	if orch == nil {
+		n.Close()
+		return nil, fmt.Errorf("lo %s: unable to create", siteID)
+	}
+
+	r := gin.New()
+	r.Use(gin.Recovery())
+	r.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+	r.GET("/hosts", orch.HandlerGetHosts)
+	r.GET("/actual", orch.HandlerGetActual)
+	r.GET("/hosts/:host/logs/:component", orch.HandlerGetLogs)
+	r.POST("/register", orch.RegisterERA)
+
+	n.ln, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		n.Close()
+		return nil, err
+	}
+	n.srv = &http.Server{Handler: r}
+	go n.srv.Serve(n.ln)
+
+	orch.Start("http://" + coLnk.Addr() + "/api/v1")
+	return n, nil
+}
+
+// URL is where ERAs register.
+func (n *loNode) URL() string { return "http://" + n.ln.Addr().String() }
+
+// watching reports whether the LO has cloned the deployments repo, i.e. its
+// git watcher is running and will see new deployments.
+func (n *loNode) watching() bool {
+	_, err := os.Stat(filepath.Join(n.dir, deploymentsRepo, ".git"))
+	return err == nil
+}
+
+// Partition cuts the site off: the LO loses NATS (so every host of the
+// site) and the CO.
+func (n *loNode) Partition() {
+	n.natsLnk.Cut()
+	n.coLnk.Cut()
+}
+
+func (n *loNode) Heal() {
+	n.natsLnk.Heal()
+	n.coLnk.Heal()
+}
+
+func (n *loNode) Close() {
+	if n.srv != nil {
+		n.srv.Close()
+	}
+	if n.nb != nil {
+		n.nb.Close()
+	}
+	n.natsLnk.Close()
+	n.coLnk.Close()
+}
@@ -0,0 +1,149 @@
+package fleetsim
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// reconnectWait is how often simulated nodes retry a cut link.
+const reconnectWait = 250 * time.Millisecond
+
+// natsOptions keep simulated nodes reconnecting for as long as a partition
+// lasts; the client default gives up after about two minutes.
+func natsOptions(name string) []nats.Option {
+	return []nats.Option{
+		nats.Name(name),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(reconnectWait),
+	}
+}
+
+// startNATS runs an in-process NATS server on a random loopback port.
+func startNATS() (*server.Server, error) {
+	s, err := server.NewServer(&server.Options{
+		Host:   "127.0.0.1",
+		Port:   server.RANDOM_PORT,
+		NoLog:  true,
+		NoSigs: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		s.Shutdown()
+		return nil, fmt.Errorf("nats server not ready")
+	}
+	return s, nil
+}
+
+// link is a TCP proxy standing for one node's network path. Cutting it
+// drops the open connections and refuses new ones until it is healed, which
+// clients see the same way as a real partition.
+type link struct {
+	upstream string
+	ln       net.Listener
+
+	mu    sync.Mutex
+	cut   bool
+	conns map[net.Conn]struct{}
+}
+
+func newLink(upstream string) (*link, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	l := &link{upstream: upstream, ln: ln, conns: map[net.Conn]struct{}{}}
+	go l.serve()
+	return l, nil
+}
+
+// Addr is the host:port clients should dial instead of upstream.
+func (l *link) Addr() string { return l.ln.Addr().String() }
+
+// Cut drops every open connection and refuses new ones until Heal.
+func (l *link) Cut() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cut = true
+	for c := range l.conns {
+		c.Close()
+		delete(l.conns, c)
+	}
+}
+
+func (l *link) Heal() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cut = false
+}
+
+func (l *link) IsCut() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cut
+}
+
+func (l *link) Close() error {
+	l.Cut()
+	return l.ln.Close()
+}
+
+func (l *link) serve() {
+	for {
+		c, err := l.ln.Accept()
+		if err != nil {
+			return
+		}
+		if !l.track(c) {
+			c.Close()
+			continue
+		}
+		go l.pipe(c)
+	}
+}
+
+// track registers c unless the link is cut.
+func (l *link) track(c net.Conn) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cut {
+		return false
+	}
+	l.conns[c] = struct{}{}
+	return true
+}
+
+func (l *link) untrack(c net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.conns, c)
+}
+
+func (l *link) pipe(c net.Conn) {
+	defer l.untrack(c)
+	defer c.Close()
+
+	u, err := net.Dial("tcp", l.upstream)
+	if err != nil {
+		return
+	}
+	if !l.track(u) {
+		u.Close()
+		return
+	}
+	defer l.untrack(u)
+	defer u.Close()
+
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(u, c); done <- struct{}{} }()
+	go func() { io.Copy(c, u); done <- struct{}{} }()
+	<-done
+}
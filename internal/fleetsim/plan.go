@@ -0,0 +1,134 @@
+package fleetsim
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Action is what a plan step does to the fleet.
+type Action string
+
+const (
+	// ActionDeploy deploys the app to every site (or Site) and waits for
+	// the hosts to run it.
+	ActionDeploy Action = "deploy"
+	// ActionFailHosts powers Count hosts off for For; they come back with
+	// an empty runtime and must recover their apps.
+	ActionFailHosts Action = "fail_hosts"
+	// ActionPartitionHosts cuts Count hosts off NATS for For.
+	ActionPartitionHosts Action = "partition_hosts"
+	// ActionPartitionSite cuts a site's LO off NATS and the CO for For.
+	ActionPartitionSite Action = "partition_site"
+	// ActionWait just lets For pass.
+	ActionWait Action = "wait"
+)
+
+// Plan is a scripted simulation run. For example:
+//
+//	sites: 3
+//	hosts_per_site: 20
+//	timeout: 3m
+//	steps:
+//	  - action: deploy
+//	  - action: fail_hosts
+//	    count: 5
+//	    for: 30s
+//	  - action: partition_site
+//	    site: 1
+//	    for: 45s
+//
+// After every step but wait the fleet is checked until each live host runs
+// the deployed app again; the time that takes is the step's convergence
+// time.
+type Plan struct {
+	Sites        int           `yaml:"sites"`
+	HostsPerSite int           `yaml:"hosts_per_site"`
+	Timeout      time.Duration `yaml:"timeout"`
+	// Seed picks the hosts fault steps hit.
+	Seed  int64  `yaml:"seed"`
+	Steps []Step `yaml:"steps"`
+}
+
+type Step struct {
+	Action Action `yaml:"action"`
+	// Site restricts deploy, fail_hosts and partition_hosts to one site
+	// (by index) and names the site partition_site cuts off.
+	Site  *int          `yaml:"site"`
+	Count int           `yaml:"count"`
+	For   time.Duration `yaml:"for"`
+}
+
+const DefaultTimeout = 2 * time.Minute
+
+// DefaultPlan deploys once, then fails a host and partitions the first site.
+func DefaultPlan() *Plan {
+	first := 0
+	return &Plan{
+		Sites:        2,
+		HostsPerSite: 3,
+		Timeout:      DefaultTimeout,
+		Seed:         1,
+		Steps: []Step{
+			{Action: ActionDeploy},
+			{Action: ActionFailHosts, Count: 1, For: 20 * time.Second},
+			{Action: ActionPartitionHosts, Count: 1, For: 20 * time.Second},
+			{Action: ActionPartitionSite, Site: &first, For: 20 * time.Second},
+		},
+	}
+}
+
+// LoadPlan reads a plan from a YAML file.
+func LoadPlan(file string) (*Plan, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var p Plan
+	if err := yaml.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("plan %s: %w", file, err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("plan %s: %w", file, err)
+	}
+	return &p, nil
+}
+
+// Validate fills in defaults and rejects steps the fleet cannot carry out.
+func (p *Plan) Validate() error {
+	if p.Sites <= 0 || p.HostsPerSite <= 0 {
+		return fmt.Errorf("need at least one site and one host per site")
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultTimeout
+	}
+	for i, s := range p.Steps {
+		if s.Site != nil && (*s.Site < 0 || *s.Site >= p.Sites) {
+			return fmt.Errorf("step %d: site %d out of range", i+1, *s.Site)
+		}
+		switch s.Action {
+		case ActionDeploy:
+		case ActionFailHosts, ActionPartitionHosts:
+			max := p.Sites * p.HostsPerSite
+			if s.Site != nil {
+				max = p.HostsPerSite
+			}
+			if s.Count <= 0 || s.Count > max {
+				return fmt.Errorf("step %d: count must be 1..%d", i+1, max)
+			}
+		case ActionPartitionSite:
+			if s.Site == nil {
+				return fmt.Errorf("step %d: partition_site needs a site", i+1)
+			}
+		case ActionWait:
+			if s.For <= 0 {
+				return fmt.Errorf("step %d: wait needs for", i+1)
+			}
+		default:
+			return fmt.Errorf("step %d: unknown action %q", i+1, s.Action)
+		}
+	}
+	return nil
+}
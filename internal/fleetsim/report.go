@@ -0,0 +1,67 @@
+package fleetsim
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is what a simulation run produced.
+type Report struct {
+	Sites        int      `json:"sites"`
+	HostsPerSite int      `json:"hosts_per_site"`
+	StartupSecs  float64  `json:"startup_seconds"`
+	Results      []Result `json:"results"`
+}
+
+// OK is whether every step converged.
+func (r *Report) OK() bool {
+	for _, res := range r.Results {
+		if res.Error != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteText prints the report as a table.
+func (r *Report) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "fleet: %d sites x %d hosts, ready in %s\n\n",
+		r.Sites, r.HostsPerSite, seconds(r.StartupSecs))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STEP\tACTION\tTARGETS\tCONVERGED\tP50\tP95\tMAX\tCO INSTALLED\tERROR")
+	for _, res := range r.Results {
+		co := "-"
+		if res.COInstalled {
+			co = seconds(res.COSeconds)
+		}
+		conv := "no"
+		if res.Converged {
+			conv = "yes"
+		}
+		if res.Action == ActionWait {
+			conv, co = "-", "-"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			res.Step, res.Action, targets(res.Targets), conv,
+			seconds(res.P50), seconds(res.P95), seconds(res.Max), co, res.Error)
+	}
+	tw.Flush()
+}
+
+func seconds(s float64) string {
+	return time.Duration(s * float64(time.Second)).Round(100 * time.Millisecond).String()
+}
+
+func targets(t []string) string {
+	switch {
+	case len(t) == 0:
+		return "-"
+	case len(t) <= 3:
+		return strings.Join(t, ",")
+	}
+	return fmt.Sprintf("%s,... (%d)", strings.Join(t[:2], ","), len(t))
+}
@@ -0,0 +1,231 @@
+package fleetsim
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// Result is the outcome of one plan step.
+type Result struct {
+	Step    int      `json:"step"`
+	Action  Action   `json:"action"`
+	Targets []string `json:"targets,omitempty"`
+	// Converged is whether every host of the deployed sites ran the app
+	// within the plan timeout, counted from the end of the fault (or from
+	// the deploy request).
+	Converged bool `json:"converged"`
+	// Per-host convergence times in seconds; Max is the fleet's.
+	P50 float64 `json:"p50_seconds"`
+	P95 float64 `json:"p95_seconds"`
+	Max float64 `json:"max_seconds"`
+	// COInstalled is whether the CO recorded every deployment as
+	// installed, and after how long.
+	COInstalled bool    `json:"co_installed"`
+	COSeconds   float64 `json:"co_seconds"`
+	Error       string  `json:"error,omitempty"`
+}
+
+// Run carries out plan against the fleet, step by step.
+func (f *Fleet) Run(plan *Plan) []Result {
+	rng := rand.New(rand.NewSource(plan.Seed))
+	var results []Result
+	for i, st := range plan.Steps {
+		res := Result{Step: i + 1, Action: st.Action}
+		f.log.Infow("fleetsim step", "step", res.Step, "action", st.Action)
+		start, err := f.apply(st, rng, &res)
+		if err != nil {
+			res.Error = err.Error()
+		} else if st.Action != ActionWait {
+			f.awaitConvergence(start, plan.Timeout, &res)
+		}
+		results = append(results, res)
+		if f.ctx.Err() != nil {
+			break
+		}
+	}
+	return results
+}
+
+// apply performs the step and returns when convergence timing starts.
+func (f *Fleet) apply(st Step, rng *rand.Rand, res *Result) (time.Time, error) {
+	switch st.Action {
+	case ActionDeploy:
+		var ids []string
+		for _, s := range f.pickSites(st.Site) {
+			ids = append(ids, s.id)
+		}
+		res.Targets = ids
+		start := time.Now()
+		deps, err := f.co.deploy(f.opts.App, appCategory, ids)
+		if err != nil {
+			return start, err
+		}
+		f.mu.Lock()
+		for i, id := range ids {
+			f.deployments[id] = deps[i]
+		}
+		f.mu.Unlock()
+		return start, nil
+
+	case ActionFailHosts:
+		hosts := f.pickHosts(st.Site, st.Count, rng)
+		for _, h := range hosts {
+			res.Targets = append(res.Targets, h.hostID)
+			h.Fail()
+		}
+		f.sleep(st.For)
+		start := time.Now()
+		for _, h := range hosts {
+			if err := h.Boot(); err != nil {
+				return start, err
+			}
+		}
+		return start, nil
+
+	case ActionPartitionHosts:
+		hosts := f.pickHosts(st.Site, st.Count, rng)
+		for _, h := range hosts {
+			res.Targets = append(res.Targets, h.hostID)
+			h.lnk.Cut()
+		}
+		f.sleep(st.For)
+		for _, h := range hosts {
+			h.lnk.Heal()
+		}
+		return time.Now(), nil
+
+	case ActionPartitionSite:
+		s := f.sites[*st.Site]
+		res.Targets = []string{s.id}
+		s.lo.Partition()
+		f.sleep(st.For)
+		s.lo.Heal()
+		return time.Now(), nil
+
+	case ActionWait:
+		f.sleep(st.For)
+		return time.Now(), nil
+	}
+	return time.Now(), fmt.Errorf("unknown action %q", st.Action)
+}
+
+func (f *Fleet) sleep(d time.Duration) {
+	select {
+	case <-f.ctx.Done():
+	case <-time.After(d):
+	}
+}
+
+func (f *Fleet) pickSites(idx *int) []*site {
+	if idx != nil {
+		return []*site{f.sites[*idx]}
+	}
+	return f.sites
+}
+
+// pickHosts returns n hosts of the chosen sites, at random.
+func (f *Fleet) pickHosts(idx *int, n int, rng *rand.Rand) []*eraNode {
+	var all []*eraNode
+	for _, s := range f.pickSites(idx) {
+		all = append(all, s.hosts...)
+	}
+	var out []*eraNode
+	for _, i := range rng.Perm(len(all))[:n] {
+		out = append(out, all[i])
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].hostID < out[j].hostID })
+	return out
+}
+
+// awaitConvergence polls the hosts of every site with a deployment until
+// each runs all of the app's components, and the CO until it records every
+// deployment as installed.
+func (f *Fleet) awaitConvergence(start time.Time, timeout time.Duration, res *Result) {
+	f.mu.Lock()
+	deps := make(map[string]string, len(f.deployments))
+	for k, v := range f.deployments {
+		deps[k] = v
+	}
+	f.mu.Unlock()
+
+	var hosts []*eraNode
+	for _, s := range f.sites {
+		if _, ok := deps[s.id]; ok {
+			hosts = append(hosts, s.hosts...)
+		}
+	}
+	var comps []string
+	for _, c := range f.opts.App.DeploymentProfiles[0].Components {
+		comps = append(comps, c.Name)
+	}
+
+	done := map[*eraNode]time.Duration{}
+	var coAt time.Duration
+	deadline := start.Add(timeout)
+	for {
+		now := time.Now()
+		for _, h := range hosts {
+			if _, ok := done[h]; !ok && runsAll(h, comps) {
+				done[h] = now.Sub(start)
+			}
+		}
+		if !res.COInstalled && f.coInstalled(deps) {
+			res.COInstalled, coAt = true, now.Sub(start)
+		}
+		if (len(done) == len(hosts) && res.COInstalled) || now.After(deadline) || f.ctx.Err() != nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	res.Converged = len(done) == len(hosts)
+	res.COSeconds = coAt.Seconds()
+	var times []float64
+	for _, d := range done {
+		times = append(times, d.Seconds())
+	}
+	res.P50, res.P95, res.Max = percentiles(times)
+	if !res.Converged {
+		res.Error = fmt.Sprintf("%d of %d hosts not converged after %s", len(hosts)-len(done), len(hosts), timeout)
+	}
+}
+
+func runsAll(h *eraNode, comps []string) bool {
+	running := h.running()
+	for _, c := range comps {
+		if !running[c] {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Fleet) coInstalled(deps map[string]string) bool {
+	for _, id := range deps {
+		if f.co.deploymentState(f.ctx, id) != string(model.StateInstalled) {
+			return false
+		}
+	}
+	return true
+}
+
+// percentiles returns the nearest-rank p50, p95 and max of xs.
+func percentiles(xs []float64) (p50, p95, max float64) {
+	if len(xs) == 0 {
+		return 0, 0, 0
+	}
+	sort.Float64s(xs)
+	rank := func(p float64) float64 {
+		i := int(math.Ceil(p*float64(len(xs)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return xs[i]
+	}
+	return rank(0.50), rank(0.95), xs[len(xs)-1]
+}
@@ -9,11 +9,11 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
-	//"time"
+	"time"
 
 	git "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
-	//"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 )
@@ -242,39 +242,36 @@ func (m *Manager) Pull(name string) error {
 }
 
 // CommitAndPush commits a file under WorkingPath but performs git ops at ClonePath
-func (m *Manager) CommitAndPush(name, relPath, msg string) error {
+// and returns the hash of the commit.
+func (m *Manager) CommitAndPush(name, relPath, msg string) (string, error) {
+	return m.CommitAndPushAs(name, relPath, msg, nil)
+}
+
+// CommitAndPushAs is CommitAndPush with the commit authored by author; nil
+// is the user configured for the repo.
+func (m *Manager) CommitAndPushAs(name, relPath, msg string, author *object.Signature) (string, error) {
 
 
 	cfg, err := m.GetConfig(name)
     if err != nil {
-        return err
+        return "", err
     }
 
 fmt.Println("DEBUG: WorkingPath =", cfg.WorkingPath)
 fmt.Println("DEBUG: Folder exists? = ", isDir(cfg.WorkingPath))
 fmt.Println("DEBUG: .git exists? =", isDir(filepath.Join(cfg.WorkingPath, ".git")))
 
-    return m.withLock(name, func() error {
-        // Always open the REAL repo root
-        repo, err := git.PlainOpen(cfg.WorkingPath)
+    var sha string
+    err = m.withLock(name, func() error {
+        repo, err := commitFiles(cfg.WorkingPath, msg, author, relPath)
         if err != nil {
-            return fmt.Errorf("open repo: %w", err)
+            return err
         }
-
-        wt, err := repo.Worktree()
+        head, err := repo.Head()
         if err != nil {
-            return fmt.Errorf("worktree: %w", err)
-        }
-
-        // Add file relative to the repo root
-        if _, err := wt.Add(relPath); err != nil {
-            return fmt.Errorf("add %s: %w", relPath, err)
-        }
-
-        // Commit
-        if _, err := wt.Commit(msg, &git.CommitOptions{}); err != nil {
-            return fmt.Errorf("commit: %w", err)
+            return fmt.Errorf("head: %w", err)
         }
+        sha = head.Hash().String()
 
         // Push
         if err := repo.Push(&git.PushOptions{Auth: cfg.Auth()}); err != nil {
@@ -286,6 +283,54 @@ fmt.Println("DEBUG: .git exists? =", isDir(filepath.Join(cfg.WorkingPath, ".git"
 
         return nil
     })
+    return sha, err
+}
+
+// Commit commits files under WorkingPath without pushing them, for changes
+// that did not come from the remote, such as an imported bundle. Committing
+// files that did not change is not an error.
+func (m *Manager) Commit(name, msg string, relPaths ...string) error {
+    cfg, err := m.GetConfig(name)
+    if err != nil {
+        return err
+    }
+    return m.withLock(name, func() error {
+        _, err := commitFiles(cfg.WorkingPath, msg, nil, relPaths...)
+        if errors.Is(err, git.ErrEmptyCommit) {
+            return nil
+        }
+        return err
+    })
+}
+
+func commitFiles(workingPath, msg string, author *object.Signature, relPaths ...string) (*git.Repository, error) {
+    // Always open the REAL repo root
+    repo, err := git.PlainOpen(workingPath)
+    if err != nil {
+        return nil, fmt.Errorf("open repo: %w", err)
+    }
+
+    wt, err := repo.Worktree()
+    if err != nil {
+        return nil, fmt.Errorf("worktree: %w", err)
+    }
+
+    // Add files relative to the repo root
+    for _, relPath := range relPaths {
+        if _, err := wt.Add(relPath); err != nil {
+            return nil, fmt.Errorf("add %s: %w", relPath, err)
+        }
+    }
+
+    if author != nil && author.When.IsZero() {
+        a := *author
+        a.When = time.Now()
+        author = &a
+    }
+    if _, err := wt.Commit(msg, &git.CommitOptions{Author: author}); err != nil {
+        return nil, fmt.Errorf("commit: %w", err)
+    }
+    return repo, nil
 }
 
 func isDir(path string) bool {
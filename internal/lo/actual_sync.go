@@ -0,0 +1,51 @@
+package lo
+
+import (
+	"fmt"
+
+	"github.com/balaji-balu/margo-hello-world/internal/lo/reconciler"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// SubscribeActualSnapshots listens for the full actual state an ERA sends
+// after it restarts and makes it the LO's actual state for that host, so the
+// next reconcile diffs against what is really running.
+func (l *LocalOrchestrator) SubscribeActualSnapshots() error {
+	subj := fmt.Sprintf("actual.%s.*", l.Config.Site)
+	return l.nc.SubscribeActualSnapshots(subj, func(snap model.ActualSnapshot) {
+		apps := snapshotToActual(snap)
+		if err := l.store.ReplaceActualForHost(snap.HostID, apps); err != nil {
+			l.log.Errorw("actual snapshot: store failed", "host", snap.HostID, "err", err)
+			return
+		}
+		l.log.Infow("actual snapshot applied", "host", snap.HostID,
+			"apps", len(apps), "adopted", snap.Adopted)
+	})
+}
+
+func snapshotToActual(snap model.ActualSnapshot) map[string]model.ActualApp {
+	apps := map[string]model.ActualApp{}
+	for _, sa := range snap.Apps {
+		comps := map[string]model.ActualComponent{}
+		for name, c := range sa.App.Components {
+			status := "failed"
+			if sa.States[name] == "Running" {
+				status = "success"
+			}
+			comps[name] = model.ActualComponent{
+				Name:        name,
+				Status:      status,
+				Version:     c.Version,
+				LastUpdated: snap.Timestamp,
+				Hash:        reconciler.ComputeComponentHash(c, sa.App.Resources),
+			}
+		}
+		apps[sa.App.ID] = model.ActualApp{
+			ID:         sa.App.ID,
+			Version:    sa.App.Version,
+			Components: comps,
+			Hash:       reconciler.ComputeAppHash(sa.App),
+		}
+	}
+	return apps
+}
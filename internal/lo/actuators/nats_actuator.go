@@ -9,6 +9,7 @@ import (
     "io"
     "net/http"
     "bytes"
+    "sync"
 
     //"github.com/nats-io/nats.go"
 
@@ -25,8 +26,14 @@ type NatsActuator struct {
     timeout time.Duration
     siteId  string
     store *boltstore.StateStore
+
+    mu    sync.Mutex
+    coURL string // CO API base status reports are forwarded to
+    coToken string // bearer token for the CO API, if it has auth on
 }
 
+const defaultCOURL = "http://localhost:8080/api/v1"
+
 // NewNatsActuator creates a new NatsActuator
 func NewNatsActuator(store *boltstore.StateStore, 
     nc *natsbroker.Broker, siteId string, timeout time.Duration) *NatsActuator {
@@ -38,6 +45,7 @@ func NewNatsActuator(store *boltstore.StateStore,
         //subject: subject,
         siteId: siteId,
         timeout: timeout,
+        coURL: defaultCOURL,
     }
     //return &
 
@@ -46,6 +54,33 @@ func NewNatsActuator(store *boltstore.StateStore,
     return &a
 }
 
+// SetCOURL sets the CO API base (e.g. http://co:8080/api/v1) that status
+// reports are forwarded to.
+func (a *NatsActuator) SetCOURL(url string) {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+    a.coURL = url
+}
+
+func (a *NatsActuator) currentCOURL() string {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+    return a.coURL
+}
+
+// SetCOToken sets the bearer token status reports are sent to the CO with.
+func (a *NatsActuator) SetCOToken(token string) {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+    a.coToken = token
+}
+
+func (a *NatsActuator) currentCOToken() string {
+    a.mu.Lock()
+    defer a.mu.Unlock()
+    return a.coToken
+}
+
 // Execute sends the operation to EN via NATS
 func (a *NatsActuator) Execute(op model.DiffOp) error {
     _, cancel := context.WithTimeout(context.Background(), a.timeout)
@@ -57,7 +92,7 @@ func (a *NatsActuator) Execute(op model.DiffOp) error {
 
     subject := fmt.Sprintf("site.%s.deploy.%s", a.siteId, op.HostID)
     if err := a.nc.Publish(subject, op); err != nil {
-        fmt.Errorf("NatsActuator: publish error", err)
+        return fmt.Errorf("NatsActuator: publish error: %w", err)
     }
 
 /*
@@ -158,7 +193,7 @@ func (a *NatsActuator) ReceiveStatus() {
 			// l.sendStatusToCO(s)			
 
 			//forward to CO
-			forwardToCO("http://localhost:8080/api/v1", s)
+			forwardToCO(a.currentCOURL(), a.currentCOToken(), s)
 
 		})
 		if err != nil {
@@ -168,7 +203,7 @@ func (a *NatsActuator) ReceiveStatus() {
 	}()
 }
 
-func forwardToCO(baseurl string, report model.DeploymentStatus) {
+func forwardToCO(baseurl, token string, report model.DeploymentStatus) {
 	url := fmt.Sprintf("%s/deployments/%s/status", baseurl, report.DeploymentID)
 	payload, err := json.Marshal(report)
 	if err != nil {
@@ -176,7 +211,16 @@ func forwardToCO(baseurl string, report model.DeploymentStatus) {
 		return
 	}
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(payload))
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payload))
+	if err != nil {
+		log.Println("[LO] failed to build report request:", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		log.Println("[LO] failed to send report to CO:", err)
 		return
@@ -239,7 +283,7 @@ func (a *NatsActuator) ApplySuccessOp(
                 Status:      "success",
                 Version:     dc.Version,
                 LastUpdated: time.Now().Unix(),
-                //Hash:        ComputeHash(dc.Content),
+                Hash:        reconciler.ComputeComponentHash(dc, dApp.Resources),
             }
         }
 
@@ -262,7 +306,7 @@ func (a *NatsActuator) ApplySuccessOp(
             Status:      "success",
             Version:     dComp.Version,
             LastUpdated: time.Now().Unix(),
-            //Hash:        ComputeHash(dComp.Content),
+            Hash:        reconciler.ComputeComponentHash(dComp, desired.Resources),
         }
         actual.AppsByHost[op.HostID][op.App.ID] = aApp
 
@@ -0,0 +1,118 @@
+package lo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+const (
+	// defaultPrefetchTimeout bounds how long a deployment waits for its
+	// artifacts before its ops are dispatched anyway.
+	defaultPrefetchTimeout = 10 * time.Minute
+	// evictInterval is how often a bounded artifact store is trimmed, on
+	// top of after every prefetch.
+	evictInterval = 10 * time.Minute
+)
+
+// EnableArtifacts keeps images and packages in store, which the LO serves
+// to its ERAs, and prefetches those of newly desired apps into it before
+// dispatching ops, waiting at most prefetch (0 means 10 minutes). When
+// maxSize is above 0, the least recently used artifacts are evicted to keep
+// the store within maxSize bytes.
+func (l *LocalOrchestrator) EnableArtifacts(store *artifacts.Store, prefetch time.Duration, maxSize int64) {
+	if prefetch <= 0 {
+		prefetch = defaultPrefetchTimeout
+	}
+	l.artifacts = store
+	l.prefetchTimeout = prefetch
+	l.artifactsMax = maxSize
+	if maxSize > 0 {
+		go func() {
+			ticker := time.NewTicker(evictInterval)
+			defer ticker.Stop()
+			for {
+				l.evictArtifacts()
+				select {
+				case <-l.RootCtx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	}
+}
+
+// evictArtifacts trims the artifact store to its size, if it has one. The
+// artifacts of desired apps are kept: an ERA needs them to restart or
+// redeploy a component.
+func (l *LocalOrchestrator) evictArtifacts() {
+	if l.artifacts == nil || l.artifactsMax <= 0 {
+		return
+	}
+	apps, err := l.store.DesiredApps()
+	if err != nil {
+		// without the desired apps, nothing is safe to drop
+		l.log.Warnw("artifact eviction skipped", "err", err)
+		return
+	}
+	var pinned []string
+	for _, app := range apps {
+		pinned = append(pinned, appArtifacts(app)...)
+	}
+	evicted, err := l.artifacts.Evict(l.RootCtx, l.artifactsMax, pinned)
+	if err != nil {
+		l.log.Warnw("artifact eviction failed", "err", err)
+	}
+	if len(evicted) > 0 {
+		l.log.Infow("evicted artifacts", "count", len(evicted), "artifacts", evicted)
+	}
+}
+
+// prefetch pulls the images and packages of app into the artifact store so
+// the ERAs find them there. Failures are logged, not returned: an ERA may
+// still get what is missing itself.
+func (l *LocalOrchestrator) prefetch(depID string, app model.App) {
+	if l.artifacts == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(l.RootCtx, l.prefetchTimeout)
+	defer cancel()
+
+	began := time.Now()
+	refs := appArtifacts(app)
+	for _, ref := range refs {
+		err := l.artifacts.Fetch(ctx, ref)
+		switch {
+		case err == nil:
+		case errors.Is(err, artifacts.ErrNoPackage) && strings.HasSuffix(ref, ".sig"):
+			// unsigned package
+		default:
+			l.log.Warnw("prefetch failed", "deployment", depID, "artifact", ref, "err", err)
+		}
+	}
+	l.log.Infow("prefetched artifacts", "deployment", depID, "count", len(refs), "took", time.Since(began))
+	l.evictArtifacts()
+}
+
+// appArtifacts lists the images and packages the components of app need,
+// with package signatures.
+func appArtifacts(app model.App) []string {
+	var refs []string
+	for _, c := range app.Components {
+		if c.Repository != "" {
+			refs = append(refs, artifacts.Normalize(c.Repository))
+		}
+		if artifacts.IsPackage(c.PackageURL) {
+			refs = append(refs, c.PackageURL, c.PackageURL+".sig")
+		}
+		if artifacts.IsPackage(c.KeyURL) {
+			refs = append(refs, c.KeyURL)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,28 @@
+package lo
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireToken lets through requests bearing one of tokens. Empty tokens
+// are ignored; with none set every request is refused, so a route is never
+// left open by a missing setting.
+func RequireToken(tokens ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		got, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if ok && got != "" {
+			for _, t := range tokens {
+				if t != "" && subtle.ConstantTimeCompare([]byte(got), []byte(t)) == 1 {
+					c.Next()
+					return
+				}
+			}
+		}
+		c.Header("WWW-Authenticate", `Bearer realm="lo"`)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing or invalid token"})
+	}
+}
@@ -228,6 +228,24 @@ func (s *StateStore) AddOrUpdateHost(host model.Host) (error) {
 	return nil
 }
 
+// UpdateHost applies fn to the stored host (a zero Host with ID set when it
+// is new), so heartbeats and inventory updates do not overwrite each other.
+func (s *StateStore) UpdateHost(id string, fn func(*model.Host)) error {
+	return s.write(func(tx *bolt.Tx) error {
+		b, err := s.GetOrCreateBucket(tx, []string{"hosts"})
+		if err != nil {
+			return err
+		}
+		host := model.Host{ID: id}
+		if raw := b.Get([]byte(id)); raw != nil {
+			if err := json.Unmarshal(raw, &host); err != nil {
+				return err
+			}
+		}
+		fn(&host)
+		return s.SaveJSON(b, id, host)
+	})
+}
 
 func (s *StateStore) SetDesired(depId string, app model.App) (error) {
 	log.Println("SetDesired depid:", depId, app)
@@ -251,6 +269,30 @@ func (s *StateStore) GetDesired(depId string) (model.App, error) {
 	return desired, nil
 }
 
+// DesiredApps returns the desired app of every deployment, by deployment id.
+func (s *StateStore) DesiredApps() (map[string]model.App, error) {
+	apps := map[string]model.App{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := s.GetBucket(tx, []string{"desired"})
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			dep := b.Bucket(k)
+			if v != nil || dep == nil {
+				return nil
+			}
+			var app model.App
+			if err := s.LoadJSON(dep, "app", &app); err != nil {
+				return fmt.Errorf("desired %s: %w", k, err)
+			}
+			apps[string(k)] = app
+			return nil
+		})
+	})
+	return apps, err
+}
+
 func (s *StateStore) GetActual() (model.ActualState, error) {
 	actual := model.ActualState{
 		AppsByHost: map[string]map[string]model.ActualApp{},
@@ -276,6 +318,32 @@ func (s *StateStore) SetActual(hostid string, app model.ActualApp) (error) {
 	return nil
 }
 
+// ReplaceActualForHost drops everything recorded for host and stores apps in
+// its place, e.g. from an ERA's startup snapshot.
+func (s *StateStore) ReplaceActualForHost(hostid string, apps map[string]model.ActualApp) error {
+	return s.write(func(tx *bolt.Tx) error {
+		actual, err := s.GetOrCreateBucket(tx, []string{"actual"})
+		if err != nil {
+			return err
+		}
+		if actual.Bucket([]byte(hostid)) != nil {
+			if err := actual.DeleteBucket([]byte(hostid)); err != nil {
+				return err
+			}
+		}
+		b, err := actual.CreateBucket([]byte(hostid))
+		if err != nil {
+			return err
+		}
+		for id, app := range apps {
+			if err := s.SaveJSON(b, id, app); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (s *StateStore) SetOperation(depId string, op model.DiffOp){
 	path := []string{"operations"}
 	key := fmt.Sprintf("%s-%d", depId, op.TimeStamp)
@@ -0,0 +1,124 @@
+package lo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/internal/bundle"
+	"github.com/balaji-balu/margo-hello-world/internal/lo/watcher"
+)
+
+// MaxBundleSize bounds the bundle a single import may upload.
+const MaxBundleSize = 16 << 30
+
+// HandlerImportBundle loads a bundle written by edgectl bundle export (the
+// request body) into the artifact store, commits its deployment to the
+// local deployments repo and deploys it, as if it had been pulled from git.
+func (l *LocalOrchestrator) HandlerImportBundle(c *gin.Context) {
+	if l.artifacts == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "artifact store not enabled"})
+		return
+	}
+
+	f, err := os.CreateTemp("", "bundle-*.tar")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer os.Remove(f.Name())
+	_, err = io.Copy(f, http.MaxBytesReader(c.Writer, c.Request.Body, MaxBundleSize))
+	f.Close()
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("bundle larger than %d bytes", MaxBundleSize)})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	contents, err := bundle.Read(ctx, f.Name())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := checkDeploymentID(contents.DeploymentID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if contents.Site != "" && contents.Site != l.Config.Site {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("bundle is for site %s, this is %s", contents.Site, l.Config.Site)})
+		return
+	}
+	if contents, err = bundle.Import(ctx, f.Name(), l.artifacts.Target()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	rel, err := l.commitBundle(contents)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	l.log.Infow("bundle imported", "deployment", contents.DeploymentID,
+		"images", len(contents.Images), "packages", len(contents.Packages))
+
+	l.TriggerEvent(l.RootCtx, EventGitPolled, GitPolledPayload{
+		Deployments: []watcher.DeploymentChange{{
+			DeploymentID: contents.DeploymentID,
+			FilePath:     rel,
+			Content:      string(contents.Deployment),
+		}},
+	})
+	c.JSON(http.StatusOK, contents)
+}
+
+// commitBundle writes the bundle's deployment, and app description if it
+// has one, where the CO would have put them, and commits them locally.
+func (l *LocalOrchestrator) commitBundle(contents *bundle.Contents) (string, error) {
+	// the id names the deployment's directory in the repo
+	if err := checkDeploymentID(contents.DeploymentID); err != nil {
+		return "", err
+	}
+	cfg, err := l.Mgr.GetConfig(l.Config.Repo)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(l.Config.RepoDir(), contents.DeploymentID)
+	if err := os.MkdirAll(filepath.Join(cfg.WorkingPath, dir), 0o755); err != nil {
+		return "", err
+	}
+
+	rel := filepath.Join(dir, "desiredstate.yaml")
+	files := map[string][]byte{rel: contents.Deployment}
+	if len(contents.App) > 0 {
+		files[filepath.Join(dir, "margo.yaml")] = contents.App
+	}
+	var paths []string
+	for p, data := range files {
+		if err := os.WriteFile(filepath.Join(cfg.WorkingPath, p), data, 0o644); err != nil {
+			return "", err
+		}
+		paths = append(paths, p)
+	}
+	msg := fmt.Sprintf("LO: import bundle for deployment %s", contents.DeploymentID)
+	return rel, l.Mgr.Commit(l.Config.Repo, msg, paths...)
+}
+
+// checkDeploymentID accepts only the canonical UUIDs the CO gives
+// deployments.
+func checkDeploymentID(id string) error {
+	if u, err := uuid.Parse(id); err != nil || u.String() != id {
+		return fmt.Errorf("deployment id %q is not a UUID", id)
+	}
+	return nil
+}
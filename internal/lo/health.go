@@ -7,6 +7,7 @@ import (
 	"fmt"
 	//"io"
 	"log"
+	"time"
 	
 
 	"github.com/balaji-balu/margo-hello-world/internal/lo/heartbeat"
@@ -26,18 +27,17 @@ func (l *LocalOrchestrator) MonitorHealthandStatusFromEN(
 		err := l.nc.Subscribe2(subHealth, func(h model.HealthMsg) {
 			//log.Printf("[LO] health from %s runtime=%s", h.NodeID, h.Runtime)
 
-			host := model.Host{
-				ID: h.NodeID,
-				Alive: true,
-				// ID: h.NodeID,
-				// Labels: map[string]string{
-				// 	"region": "us-east",
-				// 	"role":   "worker",
-				// },
-				// Status: "alive",
-			}
-			l.store.AddOrUpdateHost(host)
-			monitor.Update(h.NodeID)	
+			// ID: h.NodeID,
+			// Labels: map[string]string{
+			// 	"region": "us-east",
+			// 	"role":   "worker",
+			// },
+			// Status: "alive",
+			l.store.UpdateHost(h.NodeID, func(host *model.Host) {
+				host.Alive = true
+				host.Health = &h
+			})
+			monitor.UpdateEvery(h.NodeID, time.Duration(h.IntervalSeconds)*time.Second)
 
 			// err := lo.CreateEdgeNode(lo.RootCtx, h)
 			// if err != nil {
@@ -19,6 +19,8 @@ type NodeState struct {
     LastSeen time.Time
     Misses   int
     Status   Status
+    // Every is the interval the host announced; 0 means ExpectedEvery.
+    Every    time.Duration
 }
 
 type Monitor struct {
@@ -50,6 +52,12 @@ func pathForHeartbeat(status string) []string {
 }
 
 func (m *Monitor) Update(enID string) {
+    m.UpdateEvery(enID, 0)
+}
+
+// UpdateEvery records a heartbeat from a host that announced it sends one
+// every interval, so slower hosts are not declared dead early.
+func (m *Monitor) UpdateEvery(enID string, every time.Duration) {
     m.mu.Lock()
     defer m.mu.Unlock()
 
@@ -62,6 +70,7 @@ func (m *Monitor) Update(enID string) {
             LastSeen: now,
             Status:   Alive,
             Misses:   0,
+            Every:    every,
         }
         //_ = m.store.SetHostAlive(enID, now) // stores alive + misses=0
         _ = m.store.SaveState(pathForHeartbeat("alive"), enID, now)
@@ -74,6 +83,7 @@ func (m *Monitor) Update(enID string) {
         s.Status = Alive
         s.LastSeen = now
         s.Misses = 0
+        s.Every = every
 
         //_ = m.store.SetHostAlive(enID, now) // updates status=alive + misses=0
         _ = m.store.SaveState(pathForHeartbeat("alive"), enID, now)
@@ -88,6 +98,7 @@ func (m *Monitor) Update(enID string) {
     // Normal heartbeat
     s.LastSeen = now
     s.Misses = 0
+    s.Every = every
 
     //_ = m.store.SetHostAlive(enID, now) // always resets misses=0
     _ = m.store.SaveState(pathForHeartbeat("alive"), enID, now)
@@ -116,7 +127,11 @@ func (m *Monitor) check() {
             continue
         }
 
-        if now.Sub(s.LastSeen) > m.ExpectedEvery {
+        expected := m.ExpectedEvery
+        if s.Every > expected {
+            expected = s.Every
+        }
+        if now.Sub(s.LastSeen) > expected {
             s.Misses++
 
             //_ = m.store.IncrementMisses(enID, s.Misses)
@@ -0,0 +1,81 @@
+package lo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+var coClient = &http.Client{Timeout: 10 * time.Second}
+
+// SubscribeInventory takes the hardware inventory ERAs send when it changes
+// after registration.
+func (l *LocalOrchestrator) SubscribeInventory() error {
+	subj := fmt.Sprintf("inventory.%s.*", l.Config.Site)
+	return l.nc.SubscribeInventory(subj, func(inv model.HardwareInventory) {
+		l.saveInventory(inv)
+	})
+}
+
+// saveInventory keeps inv on the host record and forwards it to the CO.
+func (l *LocalOrchestrator) saveInventory(inv model.HardwareInventory) {
+	inv.SiteID = l.Config.Site
+	if err := l.store.UpdateHost(inv.HostID, func(h *model.Host) {
+		h.Inventory = &inv
+	}); err != nil {
+		l.log.Errorw("inventory: store failed", "host", inv.HostID, "err", err)
+		return
+	}
+	l.log.Infow("inventory updated", "host", inv.HostID, "hash", inv.Hash)
+
+	go func() {
+		if err := l.forwardInventory(inv); err != nil {
+			l.log.Warnw("inventory: forward to CO failed", "host", inv.HostID, "err", err)
+		}
+	}()
+}
+
+func (l *LocalOrchestrator) forwardInventory(inv model.HardwareInventory) error {
+	if l.coURL == "" {
+		return fmt.Errorf("CO url not set")
+	}
+	body, err := json.Marshal(inv)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/hosts/%s/inventory", l.coURL, inv.HostID)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := l.doCO(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("CO returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SetCOToken sets the bearer token the LO calls the CO API with, for a CO
+// with auth on. The token's user needs the deployer role on the site.
+func (l *LocalOrchestrator) SetCOToken(token string) {
+	l.coToken = token
+	l.actuator.SetCOToken(token)
+}
+
+// doCO sends req to the CO with the LO's token, if it has one.
+func (l *LocalOrchestrator) doCO(req *http.Request) (*http.Response, error) {
+	if l.coToken != "" {
+		req.Header.Set("Authorization", "Bearer "+l.coToken)
+	}
+	return coClient.Do(req)
+}
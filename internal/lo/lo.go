@@ -3,6 +3,8 @@ package lo
 import (
 	"context"
 	"os"
+	"path"
+	"sync"
 	"time"
 	//"log"
 	"net/http"
@@ -10,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	
 	"github.com/balaji-balu/margo-hello-world/pkg/model"
+	"github.com/balaji-balu/margo-hello-world/internal/artifacts"
 	"github.com/balaji-balu/margo-hello-world/internal/gitmanager"
 	"github.com/balaji-balu/margo-hello-world/internal/natsbroker"
 	"github.com/balaji-balu/margo-hello-world/internal/lo/heartbeat"
@@ -48,6 +51,15 @@ type LoConfig struct {
 	Path    string
 	NatsUrl string
 	Site    string
+	// Org is the CO organization owning the site, under whose directory
+	// its deployments are kept in the deployments repo; empty for none.
+	Org string
+}
+
+// RepoDir is where the site's deployments are in the deployments repo:
+// <org>/<site>, or <site> for a site of no organization.
+func (c LoConfig) RepoDir() string {
+	return path.Join(c.Org, c.Site)
 }
 
 type LocalOrchestrator struct {
@@ -64,6 +76,7 @@ type LocalOrchestrator struct {
 	nc      	*natsbroker.Broker
 
 	reconcile  	*reconciler.Reconciler
+	actuator    *actuators.NatsActuator
 	//Store 		*Db.DbStore
 	store 	*boltstore.StateStore
 	//inMemStore	*reconciler.InMemoryStore
@@ -75,6 +88,13 @@ type LocalOrchestrator struct {
 	log      *zap.SugaredLogger
 	currentMode string
 	cancelFunc  context.CancelFunc // for stopping running process
+	coURL       string
+	coToken     string
+	artifacts   *artifacts.Store
+	prefetchTimeout time.Duration
+	artifactsMax    int64
+	dispatchMu      sync.Mutex // one deployment reconciled at a time
+	site        SiteConfig
 }
 
 func NewLO(
@@ -102,6 +122,9 @@ func NewLO(
 		return nil
 	}
 	monitor := heartbeat.NewMonitor(10*time.Second, 3, store) // EN heartbeat every ~10 sec, max 3 misses
+	monitor.OnDead = func(id string) {
+		store.UpdateHost(id, func(h *model.Host) { h.Alive = false })
+	}
 	monitor.Start()
 
 	metrics.Init("lo")
@@ -129,6 +152,7 @@ func NewLO(
 		nc:      nc,
 		Mgr: 	gitmgr,
 		reconcile: reconcile,
+		actuator: na,
 		//Store: store,
 		monitor: monitor,
 		store: store,
@@ -136,12 +160,27 @@ func NewLO(
 }
 
 func (l *LocalOrchestrator) Start(coURL string) {
-	
+	l.coURL = coURL
+	if coURL != "" {
+		l.actuator.SetCOURL(coURL)
+	}
+
 	go l.StartEventDispatcher(l.RootCtx)
 
 	go l.StartNetworkMonitor(l.RootCtx)
 
+	if err := l.SubscribeActualSnapshots(); err != nil {
+		l.log.Errorw("unable to subscribe to ERA snapshots", "err", err)
+	}
+	if err := l.SubscribeInventory(); err != nil {
+		l.log.Errorw("unable to subscribe to ERA inventory", "err", err)
+	}
+
 	l.MonitorHealthandStatusFromEN(l.monitor, coURL)
+
+	if coURL != "" {
+		go l.syncSiteLoop(l.RootCtx)
+	}
 }
 
 func (l *LocalOrchestrator) HandlerGetActual(c *gin.Context) {
@@ -164,7 +203,8 @@ func (l *LocalOrchestrator) HandlerGetHosts(c *gin.Context) {
 
 func (l *LocalOrchestrator) RegisterERA(c *gin.Context) {
     var req struct {
-        HostID string `json:"host_id"`
+        HostID    string                   `json:"host_id"`
+        Inventory *model.HardwareInventory `json:"inventory,omitempty"`
     }
 
     if err := c.BindJSON(&req); err != nil {
@@ -178,10 +218,14 @@ func (l *LocalOrchestrator) RegisterERA(c *gin.Context) {
     }
 
 	// store host id 
-	if err := l.store.AddOrUpdateHost(model.Host{ID: req.HostID}); err != nil {
+	if err := l.store.UpdateHost(req.HostID, func(*model.Host) {}); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	} 
+	if req.Inventory != nil {
+		req.Inventory.HostID = req.HostID
+		l.saveInventory(*req.Inventory)
+	}
 
 	// return site id
 	c.JSON(http.StatusOK, l.Config.Site)
@@ -0,0 +1,109 @@
+package lo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// firstChunkTimeout bounds how long we wait for an ERA to answer at all; once
+// it has, follow streams run until the client or the ERA closes them.
+const firstChunkTimeout = 10 * time.Second
+
+// HandlerGetLogs relays a component's captured output from the ERA on :host.
+// Query: tail=<n> (default 100, 0 for everything), follow=true to keep the
+// response open and stream new lines as plain text.
+func (l *LocalOrchestrator) HandlerGetLogs(c *gin.Context) {
+	host := c.Param("host")
+	component := c.Param("component")
+
+	tail := 100
+	if v := c.Query("tail"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tail"})
+			return
+		}
+		tail = n
+	}
+	follow, _ := strconv.ParseBool(c.Query("follow"))
+
+	req := model.LogRequest{
+		StreamID:  uuid.New().String(),
+		Component: component,
+		Tail:      tail,
+		Follow:    follow,
+		Reply:     l.nc.NewInbox(),
+	}
+
+	// A slow client holds up the subscription rather than losing chunks, the
+	// EOF one included; NATS queues what the ERA sends meanwhile.
+	chunks := make(chan model.LogChunk, 64)
+	gone := make(chan struct{})
+	defer close(gone)
+	sub, err := l.nc.SubscribeLogChunks(req.Reply, func(ch model.LogChunk) {
+		select {
+		case chunks <- ch:
+		case <-gone:
+		}
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer sub.Unsubscribe()
+
+	subj := fmt.Sprintf("site.%s.logs.%s", l.Config.Site, host)
+	if err := l.nc.Publish(subj, req); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+	if follow {
+		defer l.nc.Publish(subj+".cancel", model.LogCancel{StreamID: req.StreamID})
+	}
+
+	var first model.LogChunk
+	select {
+	case first = <-chunks:
+	case <-time.After(firstChunkTimeout):
+		c.JSON(http.StatusGatewayTimeout, gin.H{"error": "no response from host " + host})
+		return
+	case <-c.Request.Context().Done():
+		return
+	}
+	if first.Error != "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": first.Error})
+		return
+	}
+
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	write := func(ch model.LogChunk) bool {
+		for _, line := range ch.Lines {
+			fmt.Fprintln(c.Writer, line.Text)
+		}
+		c.Writer.Flush()
+		return !ch.EOF
+	}
+
+	if !write(first) {
+		return
+	}
+	for {
+		select {
+		case ch := <-chunks:
+			if !write(ch) {
+				return
+			}
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
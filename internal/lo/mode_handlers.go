@@ -62,7 +62,7 @@ func (l *LocalOrchestrator) StartPushMode(ctx context.Context, cfg LoConfig) err
 func (l *LocalOrchestrator) StartPullMode(ctx context.Context, cfg LoConfig) {
 	logger.Info("📡 Starting Pull Mode (periodic git sync)")
 
-	w := watcher.NewWatcher(l.Mgr, cfg.Repo, cfg.Site, 3*time.Second)
+	w := watcher.NewWatcher(l.Mgr, cfg.Repo, cfg.RepoDir(), 3*time.Second)
 	//w.OnChange = lo.onDeployments
 
 	//watcher := gitobserver.New(cfg.Repo, "main", 30*time.Second)
@@ -9,6 +9,7 @@ import (
 	"gopkg.in/yaml.v3"		
 
 	//"github.com/balaji-balu/margo-hello-world/internal/lo/reconciler"
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
 	"github.com/balaji-balu/margo-hello-world/pkg/model"
 	"github.com/balaji-balu/margo-hello-world/internal/lo/logger"
 )
@@ -154,6 +155,7 @@ func (l *LocalOrchestrator) handleGitPolled(data GitPolledPayload) {
 			ID: dep.Metadata.Annotations.ApplicationID,
 			Version: dep.Metadata.Annotations.Version,
 			Components: make(map[string]model.Component),
+			Resources: toModelResources(dep.Spec.DeploymentProfile.RequiredResources),
 		}
 		for _, c := range dep.Spec.DeploymentProfile.Components {
 			log.Println("comp", c)
@@ -163,18 +165,84 @@ func (l *LocalOrchestrator) handleGitPolled(data GitPolledPayload) {
 				Repository: c.Properties.Repository,
 				PackageURL: c.Properties.PackageURL,
 				KeyURL: c.Properties.KeyURL, 
+				Env: c.Properties.Env,
+				Liveness: toModelProbe(c.Properties.LivenessProbe),
+				Readiness: toModelProbe(c.Properties.ReadinessProbe),
+				DependsOn: c.Properties.DependsOn,
+			}
+			for _, m := range c.Properties.Mounts {
+				comp.Mounts = append(comp.Mounts, model.Mount{
+					Source:   m.Source,
+					Target:   m.Target,
+					ReadOnly: m.ReadOnly,
+				})
 			}
 			app.Components[c.Name] = comp
 		}
+		resolveParameters(dep.Spec.Parameters, app.Components)
 		depId := dep.Metadata.Annotations.ID
 		l.store.SetDesired(depId, app)
+		// prefetching may take minutes; the event loop does not wait
+		go l.dispatch(depId, app)
+		//l.DeployToEdges(d.DeploymentID, dep)
+	}
+}
 
+// dispatch reconciles depId once the artifacts of app are prefetched.
+func (l *LocalOrchestrator) dispatch(depId string, app model.App) {
+	l.prefetch(depId, app)
 
-		// Call reconciler here
-		if err := l.reconcile.ReconcileMulti(depId); err != nil {
-			logger.Info("Reconcilemulti failed:", zap.Error(err))
-    		log.Fatal(err)
+	l.dispatchMu.Lock()
+	defer l.dispatchMu.Unlock()
+	// Call reconciler here
+	if err := l.reconcile.ReconcileMulti(depId); err != nil {
+		logger.Info("Reconcilemulti failed:", zap.Error(err))
+		log.Fatal(err)
+	}
+}
+
+// toModelResources flattens the profile's requiredResources into the form the
+// ERA consumes.
+func toModelResources(r *application.Resources) *model.Resources {
+	if r == nil {
+		return nil
+	}
+	res := &model.Resources{
+		CPUCores: r.CPU.Cores,
+		Memory:   r.Memory,
+	}
+	for _, p := range r.Peripherals {
+		res.Peripherals = append(res.Peripherals, model.Peripheral{
+			Type:         p.Type,
+			Manufacturer: p.Manufacturer,
+			Model:        p.Model,
+		})
+	}
+	return res
+}
+
+func toModelProbe(p *application.Probe) *model.Probe {
+	if p == nil {
+		return nil
+	}
+	mp := &model.Probe{
+		InitialDelay:     p.InitialDelaySeconds,
+		Period:           p.PeriodSeconds,
+		Timeout:          p.TimeoutSeconds,
+		FailureThreshold: p.FailureThreshold,
+		SuccessThreshold: p.SuccessThreshold,
+	}
+	switch {
+	case p.HTTPGet != nil:
+		mp.HTTPGet = &model.HTTPGetProbe{
+			Path:   p.HTTPGet.Path,
+			Port:   p.HTTPGet.Port,
+			Scheme: p.HTTPGet.Scheme,
 		}
-		//l.DeployToEdges(d.DeploymentID, dep)
+	case p.TCPSocket != nil:
+		mp.TCPPort = p.TCPSocket.Port
+	case p.Exec != nil:
+		mp.Exec = p.Exec.Command
 	}
+	return mp
 }
\ No newline at end of file
@@ -0,0 +1,54 @@
+package lo
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/balaji-balu/margo-hello-world/internal/lo/logger"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// resolveParameters attaches every parameter target to the components it
+// names, or to all components when a target names none. Pointers the ERA
+// cannot apply (e.g. the CO's /sites/<id>) are skipped.
+//
+// Values end up in model.Component, so a changed value changes the
+// component's spec hash and the reconciler issues an update_comp.
+func resolveParameters(params []deployment.Parameter, comps map[string]model.Component) {
+	names := make([]string, 0, len(comps))
+	for name := range comps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, p := range params {
+		for _, t := range p.Targets {
+			if _, _, ok := model.ParseParameterPointer(t.Pointer); !ok {
+				logger.Debug("skipping parameter target",
+					zap.String("parameter", p.Name), zap.String("pointer", t.Pointer))
+				continue
+			}
+
+			targets := t.Components
+			if len(targets) == 0 {
+				targets = names
+			}
+			for _, name := range targets {
+				comp, ok := comps[name]
+				if !ok {
+					logger.Warn("parameter targets unknown component",
+						zap.String("parameter", p.Name), zap.String("component", name))
+					continue
+				}
+				comp.Parameters = append(comp.Parameters, model.ParameterValue{
+					Name:    p.Name,
+					Pointer: t.Pointer,
+					Value:   p.Value,
+				})
+				comps[name] = comp
+			}
+		}
+	}
+}
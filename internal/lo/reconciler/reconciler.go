@@ -35,6 +35,24 @@ func ComputeAppHash(app model.App) string {
     return hex.EncodeToString(h[:])
 }
 
+// ComputeComponentHash is the spec hash of one component: everything the ERA
+// is given for it, including resolved parameter values and the resources of
+// its app, res.
+func ComputeComponentHash(c model.Component, res *model.Resources) string {
+    c.Hash = ""
+    var b []byte
+    if res == nil {
+        b, _ = json.Marshal(c)
+    } else {
+        b, _ = json.Marshal(struct {
+            model.Component
+            Resources *model.Resources `json:"resources"`
+        }{c, res})
+    }
+    h := sha256.Sum256(b)
+    return hex.EncodeToString(h[:])
+}
+
 func pretty(v interface{}) string {
 	b, _ := json.MarshalIndent(v, "", "  ")
 	return string(b)
@@ -159,6 +177,18 @@ func computeDiff(desired model.App,
 					continue
 				}
 
+				// spec changed (env, mounts, parameter values, ...) at the same version
+				if actualComp.Hash != "" && actualComp.Hash != ComputeComponentHash(desiredComp, desiredApp.Resources) {
+					ops = append(ops, model.DiffOp{
+						Action:   model.ActionUpdateComp,
+						SiteID:   "",
+						HostID:   hostID,
+						App:      desiredApp,
+						CompName: compName,
+					})
+					continue
+				}
+
 				if actualComp.Hash != "" && desiredComp.Content != "" {
 					desiredCompHash := ComputeHash(desiredComp.Content)
 					if actualComp.Hash != desiredCompHash {
@@ -0,0 +1,160 @@
+package lo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// defaultSyncInterval is how often the LO syncs its site with the CO when
+// SiteConfig does not say.
+const defaultSyncInterval = time.Minute
+
+// SiteConfig is how the LO describes its site to the CO.
+type SiteConfig struct {
+	// Organization is the CO organization owning the site.
+	Organization string
+	Name         string
+	Location     string
+	Region       string
+	// APIEndpoint is where the CO can reach this LO.
+	APIEndpoint string
+	// SyncInterval is how often the site is synced; 0 means 1m.
+	SyncInterval time.Duration
+}
+
+// DescribeSite sets what the LO tells the CO about its site. Call it before
+// Start.
+func (l *LocalOrchestrator) DescribeSite(cfg SiteConfig) {
+	l.site = cfg
+	l.Config.Org = cfg.Organization
+}
+
+// syncSiteLoop registers the site with the CO and then keeps the CO's view
+// of it, its hosts, their liveness and inventory, current until ctx ends.
+func (l *LocalOrchestrator) syncSiteLoop(ctx context.Context) {
+	every := l.site.SyncInterval
+	if every <= 0 {
+		every = defaultSyncInterval
+	}
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	registered := false
+	for {
+		if err := l.syncSite(ctx); err != nil {
+			l.log.Warnw("site sync with CO failed", "site", l.Config.Site, "err", err)
+		} else if !registered {
+			registered = true
+			l.log.Infow("site registered with CO", "site", l.Config.Site, "every", every)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *LocalOrchestrator) syncSite(ctx context.Context) error {
+	report, err := l.siteReport()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("%s/sites/%s/sync", l.coURL, url.PathEscape(l.Config.Site))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := l.doCO(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("CO returned %s", resp.Status)
+	}
+	return nil
+}
+
+// siteReport describes the site from the bolt store.
+func (l *LocalOrchestrator) siteReport() (model.SiteSync, error) {
+	hosts, err := l.store.LoadAllHosts()
+	if err != nil {
+		return model.SiteSync{}, err
+	}
+	name, _ := os.Hostname()
+	report := model.SiteSync{
+		SiteID:   l.Config.Site,
+		Name:     l.site.Name,
+		Location: l.site.Location,
+		Orchestrator: model.OrchestratorSync{
+			ID:          orchestratorID(l.Config.Site).String(),
+			Name:        name,
+			Region:      l.site.Region,
+			APIEndpoint: l.site.APIEndpoint,
+		},
+		Hosts: make([]model.HostSync, 0, len(hosts)),
+	}
+	for id, h := range hosts {
+		hs := model.HostSync{
+			HostID:    id,
+			Status:    model.HostOffline,
+			Inventory: h.Inventory,
+		}
+		if h.Alive {
+			hs.Status = model.HostOnline
+		}
+		if hm := h.Health; hm != nil {
+			hs.LastSeen = time.Unix(hm.Timestamp, 0)
+			hs.Runtime = hm.Runtime
+			hs.CPUFree = 100 - hm.CPUPercent
+			hs.IPAddress = hostAddress(hm.Interfaces)
+		}
+		report.Hosts = append(report.Hosts, hs)
+	}
+	sort.Slice(report.Hosts, func(i, j int) bool { return report.Hosts[i].HostID < report.Hosts[j].HostID })
+	return report, nil
+}
+
+// orchestratorID is the id the CO knows this site's LO by. There is one LO
+// per site, so it is derived from the site id.
+func orchestratorID(siteID string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("lo:"+siteID))
+}
+
+// hostAddress picks the first global address of an interface that is up.
+func hostAddress(ifaces []model.NetInterface) string {
+	for _, i := range ifaces {
+		if !i.Up {
+			continue
+		}
+		for _, a := range i.Addrs {
+			ip, _, err := net.ParseCIDR(a)
+			if err != nil {
+				ip = net.ParseIP(a)
+			}
+			if ip != nil && ip.IsGlobalUnicast() {
+				return ip.String()
+			}
+		}
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package metrics
 import (
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -13,8 +14,13 @@ var (
 	DeploymentsTotal  *prometheus.CounterVec
 	DeploymentsFailed *prometheus.CounterVec
 	RequestDuration   *prometheus.HistogramVec
+
+	handleOnce sync.Once
 )
 
+// Init creates the collectors for subsystem. It may be called again, e.g. by
+// several orchestrators sharing a process; the registered collectors are
+// then reused.
 func Init(subsystem string) {
 	DeploymentsActive = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -57,11 +63,28 @@ func Init(subsystem string) {
 		[]string{"endpoint"},
 	)
 
-	prometheus.MustRegister(DeploymentsActive, DeploymentsTotal, DeploymentsFailed, RequestDuration)
+	DeploymentsActive = register(DeploymentsActive).(*prometheus.GaugeVec)
+	DeploymentsTotal = register(DeploymentsTotal).(*prometheus.CounterVec)
+	DeploymentsFailed = register(DeploymentsFailed).(*prometheus.CounterVec)
+	RequestDuration = register(RequestDuration).(*prometheus.HistogramVec)
+}
+
+func register(c prometheus.Collector) prometheus.Collector {
+	if err := prometheus.Register(c); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector
+		}
+		panic(err)
+	}
+	return c
 }
 
+// StartServer serves /metrics on port; an empty port disables it.
 func StartServer(port string) {
-	http.Handle("/metrics", promhttp.Handler())
+	if port == "" {
+		return
+	}
+	handleOnce.Do(func() { http.Handle("/metrics", promhttp.Handler()) })
 	go func() {
 		if err := http.ListenAndServe(":"+port, nil); err != nil {
 			panic("metrics server failed: " + err.Error())
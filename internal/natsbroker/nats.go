@@ -2,6 +2,8 @@ package natsbroker
 
 import (
 	"encoding/json"
+	"time"
+
 	"github.com/balaji-balu/margo-hello-world/internal/gitobserver"
 	"github.com/balaji-balu/margo-hello-world/pkg/model"
 	//"github.com/balaji-balu/margo-hello-world/pkg/model/reconciler"
@@ -15,8 +17,8 @@ type Broker struct {
 	conn *nats.Conn
 }
 
-func New(url string) (*Broker, error) {
-	nc, err := nats.Connect(url)
+func New(url string, opts ...nats.Option) (*Broker, error) {
+	nc, err := nats.Connect(url, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +66,57 @@ func (b *Broker) Subscribe4(topic string, handler func(model.DeploymentStatus))
 	return err
 }
 
+func (b *Broker) SubscribeLogRequests(topic string, handler func(model.LogRequest)) error {
+	_, err := b.conn.Subscribe(topic, func(m *nats.Msg) {
+		var ev model.LogRequest
+		_ = json.Unmarshal(m.Data, &ev)
+		handler(ev)
+	})
+	return err
+}
+
+func (b *Broker) SubscribeLogCancels(topic string, handler func(model.LogCancel)) error {
+	_, err := b.conn.Subscribe(topic, func(m *nats.Msg) {
+		var ev model.LogCancel
+		_ = json.Unmarshal(m.Data, &ev)
+		handler(ev)
+	})
+	return err
+}
+
+// SubscribeLogChunks returns the subscription so the caller can drop it once
+// the stream ends.
+func (b *Broker) SubscribeLogChunks(topic string, handler func(model.LogChunk)) (*nats.Subscription, error) {
+	return b.conn.Subscribe(topic, func(m *nats.Msg) {
+		var ev model.LogChunk
+		_ = json.Unmarshal(m.Data, &ev)
+		handler(ev)
+	})
+}
+
+func (b *Broker) SubscribeActualSnapshots(topic string, handler func(model.ActualSnapshot)) error {
+	_, err := b.conn.Subscribe(topic, func(m *nats.Msg) {
+		var ev model.ActualSnapshot
+		_ = json.Unmarshal(m.Data, &ev)
+		handler(ev)
+	})
+	return err
+}
+
+func (b *Broker) SubscribeInventory(topic string, handler func(model.HardwareInventory)) error {
+	_, err := b.conn.Subscribe(topic, func(m *nats.Msg) {
+		var ev model.HardwareInventory
+		_ = json.Unmarshal(m.Data, &ev)
+		handler(ev)
+	})
+	return err
+}
+
+// NewInbox returns a unique subject for one-off replies.
+func (b *Broker) NewInbox() string {
+	return nats.NewInbox()
+}
+
 // SubscribeGeneric allows subscribing with any message type
 // Generic subscribe helper (Go 1.18+ compatible)
 // func (b *Broker) SubscribeGeneric[T any](topic string, handler func(T)) error {
@@ -82,6 +135,12 @@ func (b *Broker) Flush() {
 	b.conn.Flush()
 }
 
+// FlushTimeout waits for the server to acknowledge everything published so
+// far; it fails while the connection is down.
+func (b *Broker) FlushTimeout(d time.Duration) error {
+	return b.conn.FlushTimeout(d)
+}
+
 func (b *Broker) Close() {
 	if b.conn != nil {
 		b.conn.Close()
@@ -0,0 +1,202 @@
+package ocifetch
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+// DescriptionFile is the file of an app package holding its
+// ApplicationDescription.
+const DescriptionFile = "margo.yaml"
+
+// Limits on what a package may hold, so that a bad reference cannot fill
+// the CO's memory.
+const (
+	MaxFileSize    = 16 << 20
+	MaxPackageSize = 64 << 20
+)
+
+// annotationUnpack marks a layer oras pushed from a directory, as a tarball
+// to be unpacked.
+const annotationUnpack = "io.deis.oras.content.unpack"
+
+// Package is an app package pulled from an OCI registry.
+type Package struct {
+	// Reference is the package pinned to the digest pulled, as
+	// registry/repository@sha256:...
+	Reference string
+	// Files are the package's files by path; directories pushed as
+	// tarballs are unpacked.
+	Files map[string][]byte
+	// MediaTypes are the media types the layers gave their files.
+	MediaTypes map[string]string
+}
+
+// File returns the package file at name, a path relative to the package as
+// margo.yaml refers to its resources.
+func (p *Package) File(name string) ([]byte, bool) {
+	b, ok := p.Files[cleanPath(name)]
+	return b, ok
+}
+
+// MediaType returns the media type the package gave the file at name, or
+// "" if it was unpacked from a directory.
+func (p *Package) MediaType(name string) string {
+	return p.MediaTypes[cleanPath(name)]
+}
+
+// Puller pulls app packages from OCI registries.
+type Puller struct {
+	// Registry is the host[:port] Username and Password log in to; other
+	// registries are pulled from anonymously, so that a reference cannot
+	// have the credentials sent elsewhere.
+	Registry string
+	// Username and Password log in to Registry; empty is anonymous.
+	Username string
+	Password string
+	// PlainHTTP talks to registries over http, for local ones.
+	PlainHTTP bool
+}
+
+// Pull pulls the package at ref, registry/repository:tag or
+// registry/repository@digest.
+func (p *Puller) Pull(ctx context.Context, ref string) (*Package, error) {
+	repo, err := remote.NewRepository(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference %q: %w", ref, err)
+	}
+	if repo.Reference.Reference == "" {
+		return nil, fmt.Errorf("reference %q has no tag or digest", ref)
+	}
+	repo.PlainHTTP = p.PlainHTTP
+	client := &auth.Client{Client: retry.DefaultClient, Cache: auth.NewCache()}
+	if p.Registry != "" && (p.Username != "" || p.Password != "") {
+		client.Credential = auth.StaticCredential(p.Registry, auth.Credential{
+			Username: p.Username,
+			Password: p.Password,
+		})
+	}
+	repo.Client = client
+
+	desc, err := repo.Resolve(ctx, repo.Reference.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", ref, err)
+	}
+	pkg, err := ReadPackage(ctx, repo, desc)
+	if err != nil {
+		return nil, err
+	}
+	pkg.Reference = fmt.Sprintf("%s/%s@%s", repo.Reference.Registry, repo.Reference.Repository, desc.Digest)
+	return pkg, nil
+}
+
+// ReadPackage reads the files of the package whose manifest is desc from
+// fetcher. A file is a layer named by its title annotation.
+func ReadPackage(ctx context.Context, fetcher content.Fetcher, desc ocispec.Descriptor) (*Package, error) {
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("%s is a %s, not an image manifest", desc.Digest, desc.MediaType)
+	}
+	if desc.Size > MaxFileSize {
+		return nil, fmt.Errorf("manifest of %d bytes is too large", desc.Size)
+	}
+	data, err := content.FetchAll(ctx, fetcher, desc)
+	if err != nil {
+		return nil, fmt.Errorf("manifest: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("manifest: %w", err)
+	}
+
+	pkg := &Package{Files: map[string][]byte{}, MediaTypes: map[string]string{}}
+	// one budget for every file, whether a layer or unpacked from one
+	var total int64
+	for _, layer := range manifest.Layers {
+		name := cleanPath(layer.Annotations[ocispec.AnnotationTitle])
+		if name == "" {
+			continue
+		}
+		if layer.Size > MaxFileSize {
+			return nil, fmt.Errorf("%s: %d bytes is more than %d", name, layer.Size, MaxFileSize)
+		}
+		unpack := layer.Annotations[annotationUnpack] == "true"
+		if !unpack {
+			if total += layer.Size; total > MaxPackageSize {
+				return nil, fmt.Errorf("package is more than %d bytes", MaxPackageSize)
+			}
+		}
+		data, err := content.FetchAll(ctx, fetcher, layer)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if unpack {
+			if err := untar(pkg, data, &total); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			continue
+		}
+		pkg.Files[name] = data
+		pkg.MediaTypes[name] = layer.MediaType
+	}
+	if _, ok := pkg.File(DescriptionFile); !ok {
+		return nil, fmt.Errorf("package has no %s", DescriptionFile)
+	}
+	return pkg, nil
+}
+
+// untar adds the files of a gzipped tarball to pkg, adding their size to
+// total, the size of the package so far, up to MaxPackageSize.
+func untar(pkg *Package, data []byte, total *int64) error {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		if h.Size > MaxFileSize {
+			return fmt.Errorf("%s: %d bytes is more than %d", h.Name, h.Size, MaxFileSize)
+		}
+		if *total += h.Size; *total > MaxPackageSize {
+			return fmt.Errorf("package unpacks to more than %d bytes", MaxPackageSize)
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		pkg.Files[cleanPath(h.Name)] = b
+	}
+}
+
+// cleanPath makes name relative to the package root, so that "./icon.png"
+// and "icon.png" are one file and no name leaves the package.
+func cleanPath(name string) string {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "." {
+		return ""
+	}
+	return name
+}
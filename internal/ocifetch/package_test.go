@@ -0,0 +1,141 @@
+package ocifetch
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+const artifactType = "application/vnd.margo.app.v1"
+
+func push(t *testing.T, store *memory.Store, mediaType string, data []byte, annotations map[string]string) ocispec.Descriptor {
+	t.Helper()
+	desc := ocispec.Descriptor{
+		MediaType:   mediaType,
+		Digest:      digest.FromBytes(data),
+		Size:        int64(len(data)),
+		Annotations: annotations,
+	}
+	if err := store.Push(context.Background(), desc, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func file(t *testing.T, store *memory.Store, mediaType, name string, data []byte) ocispec.Descriptor {
+	return push(t, store, mediaType, data, map[string]string{ocispec.AnnotationTitle: name})
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, body := range files {
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(body)), Typeflag: tar.TypeReg})
+		tw.Write([]byte(body))
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func pack(t *testing.T, store *memory.Store, layers ...ocispec.Descriptor) ocispec.Descriptor {
+	t.Helper()
+	desc, err := oras.PackManifest(context.Background(), store, oras.PackManifestVersion1_1, artifactType,
+		oras.PackManifestOptions{Layers: layers})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func TestReadPackage(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	docs := push(t, store, "application/vnd.oci.image.layer.v1.tar+gzip",
+		tarball(t, map[string]string{"docs/LICENSE": "MIT", "docs/RELEASE.md": "first"}),
+		map[string]string{ocispec.AnnotationTitle: "docs", annotationUnpack: "true"})
+	desc := pack(t, store,
+		file(t, store, "application/yaml", "margo.yaml", []byte("metadata:\n  name: hello\n")),
+		file(t, store, "image/png", "./resources/icon.png", []byte("png")),
+		docs,
+		// a layer without a title is no file
+		push(t, store, "application/octet-stream", []byte("blob"), nil),
+	)
+
+	pkg, err := ReadPackage(ctx, store, desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pkg.Files) != 4 {
+		t.Errorf("files: %v", pkg.Files)
+	}
+	if b, ok := pkg.File("resources/icon.png"); !ok || string(b) != "png" || pkg.MediaType("./resources/icon.png") != "image/png" {
+		t.Errorf("icon: %q %v %q", b, ok, pkg.MediaType("resources/icon.png"))
+	}
+	if b, ok := pkg.File("./docs/LICENSE"); !ok || string(b) != "MIT" {
+		t.Errorf("unpacked license: %q %v", b, ok)
+	}
+	if _, ok := pkg.File("../margo.yaml"); !ok {
+		t.Error("a path leaving the package is not cleaned to it")
+	}
+
+	if _, err := ReadPackage(ctx, store, pack(t, store, file(t, store, "text/plain", "README", []byte("hi")))); err == nil {
+		t.Error("read a package without margo.yaml")
+	}
+	if _, err := ReadPackage(ctx, store, docs); err == nil {
+		t.Error("read a layer as a manifest")
+	}
+}
+
+func TestReadPackageBudget(t *testing.T) {
+	// each tarball is within the limit, together they are not
+	big := strings.Repeat("x", MaxFileSize)
+	store := memory.New()
+	var layers []ocispec.Descriptor
+	for _, name := range []string{"a", "b"} {
+		layers = append(layers, push(t, store, "application/vnd.oci.image.layer.v1.tar+gzip",
+			tarball(t, map[string]string{name + "/1": big, name + "/2": big, name + "/3": big}),
+			map[string]string{ocispec.AnnotationTitle: name, annotationUnpack: "true"}))
+	}
+	layers = append(layers, file(t, store, "application/yaml", "margo.yaml", []byte("metadata: {}\n")))
+	if _, err := ReadPackage(context.Background(), store, pack(t, store, layers...)); err == nil {
+		t.Error("read a package unpacking to more than MaxPackageSize")
+	}
+}
+
+func TestPullCredentials(t *testing.T) {
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a := r.Header.Get("Authorization"); a != "" {
+			sent = append(sent, a)
+		}
+		w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	// a reference to another registry gets no credentials
+	p := &Puller{Registry: "registry.example.com", Username: "co", Password: "secret", PlainHTTP: true}
+	p.Pull(context.Background(), host+"/apps/hello:1.0")
+	if len(sent) != 0 {
+		t.Errorf("credentials sent to %s: %v", host, sent)
+	}
+	p.Registry = host
+	p.Pull(context.Background(), host+"/apps/hello:1.0")
+	if len(sent) == 0 {
+		t.Error("no credentials sent to the configured registry")
+	}
+}
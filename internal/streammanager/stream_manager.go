@@ -1,70 +1,409 @@
+// Package streammanager streams the CO's event log to clients as it grows.
+//
+// Publish appends an event to the log, which is persisted; a StreamManager
+// tails the log and fans new events out, in id order, to the subscriptions
+// they match. A subscription holds at most Buffer events its client has not
+// taken: past that, it stops taking events and counts the ones it drops, so
+// a slow client is told what it missed and can resume after the last event
+// it got. The log keeps events for its Retention; Run prunes the rest.
 package streammanager
 
 import (
+	"context"
+	"encoding/json"
 	"log"
+	"math"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/event"
+	"github.com/balaji-balu/margo-hello-world/ent/predicate"
+	"github.com/balaji-balu/margo-hello-world/ent/schema"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
 )
 
+// tailBatch is how many events a StreamManager reads from the log at a time.
+const tailBatch = 500
+
+// pruneInterval is how often a running StreamManager prunes the log.
+const pruneInterval = time.Hour
+
+// DeployEvent is a deployment's state as /deployments/:id/stream sends it.
 type DeployEvent struct {
-	//Status  string `json:"status"`
-	//Message string `json:"message"`
 	DeploymentId string `json:"deployment_id"`
 	Timestamp    string `json:"timestamp"`
 	SiteID       string `json:"site_id"`
 	Message      string `json:"message"`
-	Status       string `json:"status"` // pending, in-progress, completed, failed
+	Status       string `json:"status"` // pending, installing, installed, failed
+}
+
+// Event is an entry of the event log.
+type Event struct {
+	ID           int       `json:"id"`
+	Type         string    `json:"type"`
+	Time         time.Time `json:"time"`
+	DeploymentID string    `json:"deployment_id,omitempty"`
+	SiteID       string    `json:"site_id,omitempty"`
+	// OrgID is the organization the event is about; nil is none.
+	OrgID uuid.UUID       `json:"-"`
+	Data  json.RawMessage `json:"data,omitempty"`
+}
+
+func fromEnt(e *ent.Event) Event {
+	return Event{
+		ID:           e.ID,
+		Type:         e.Type,
+		Time:         e.CreatedAt.UTC(),
+		DeploymentID: e.DeploymentID,
+		SiteID:       e.SiteID,
+		OrgID:        e.OrgID,
+		Data:         e.Data,
+	}
+}
+
+var (
+	// publishMu has events appended one at a time, so that ids are
+	// committed in order and a tail never skips one committed late.
+	publishMu sync.Mutex
+	// wakeup tells a running StreamManager that events were appended, so
+	// they need not wait for its next poll.
+	wakeup = make(chan struct{}, 1)
+)
+
+// Publish appends ev to the event log and returns it with its id and time.
+func Publish(ctx context.Context, client *ent.Client, ev Event) (Event, error) {
+	publishMu.Lock()
+	defer publishMu.Unlock()
+
+	// the event is ev.OrgID's, whichever tenant ctx acts within
+	ctx = context.WithoutCancel(tenant.Unscoped(ctx))
+	create := client.Event.Create().
+		SetType(ev.Type).
+		SetDeploymentID(ev.DeploymentID).
+		SetSiteID(ev.SiteID).
+		SetData(ev.Data)
+	if ev.OrgID != uuid.Nil {
+		create.SetOrgID(ev.OrgID)
+	}
+	e, err := create.Save(ctx)
+	if err != nil {
+		return Event{}, err
+	}
+	select {
+	case wakeup <- struct{}{}:
+	default:
+	}
+	return fromEnt(e), nil
+}
+
+// Filter picks events; empty fields do not filter.
+type Filter struct {
+	// Types are event types, or prefixes of them ending in ".*", e.g.
+	// "host.*".
+	Types        []string
+	DeploymentID string
+	SiteID       string
+	// OrgID, if set, limits events to those of one organization.
+	OrgID *uuid.UUID
+}
+
+// Match tells whether ev passes f.
+func (f Filter) Match(ev Event) bool {
+	if len(f.Types) > 0 && !matchType(f.Types, ev.Type) {
+		return false
+	}
+	if f.DeploymentID != "" && ev.DeploymentID != f.DeploymentID {
+		return false
+	}
+	if f.SiteID != "" && ev.SiteID != f.SiteID {
+		return false
+	}
+	return f.OrgID == nil || ev.OrgID == *f.OrgID
+}
+
+func matchType(types []string, t string) bool {
+	for _, p := range types {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasPrefix(t, prefix) || p == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (f Filter) predicates() []predicate.Event {
+	var ps []predicate.Event
+	if len(f.Types) > 0 {
+		var or []predicate.Event
+		for _, p := range f.Types {
+			if prefix, ok := strings.CutSuffix(p, "*"); ok {
+				or = append(or, event.TypeHasPrefix(prefix))
+			} else {
+				or = append(or, event.Type(p))
+			}
+		}
+		ps = append(ps, event.Or(or...))
+	}
+	if f.DeploymentID != "" {
+		ps = append(ps, event.DeploymentID(f.DeploymentID))
+	}
+	if f.SiteID != "" {
+		ps = append(ps, event.SiteID(f.SiteID))
+	}
+	if f.OrgID != nil {
+		ps = append(ps, event.OrgID(*f.OrgID))
+	}
+	return ps
+}
 
+// Replay returns up to limit logged events that pass f, oldest first, from
+// the one after the event after.
+func Replay(ctx context.Context, client *ent.Client, f Filter, after, limit int) ([]Event, error) {
+	es, err := client.Event.Query().
+		Where(event.IDGT(after)).
+		Where(f.predicates()...).
+		Order(ent.Asc(event.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	evs := make([]Event, len(es))
+	for i, e := range es {
+		evs[i] = fromEnt(e)
+	}
+	return evs, nil
 }
 
+// Retention is how much of a log is kept: entries older than MaxAge, and
+// all but the MaxCount latest, are pruned. A zero field prunes nothing.
+type Retention struct {
+	MaxAge   time.Duration
+	MaxCount int
+}
+
+// DefaultRetention keeps a week of events, at most 100000 of them.
+var DefaultRetention = Retention{MaxAge: 7 * 24 * time.Hour, MaxCount: 100000}
+
+// Or is r with the fields it leaves zero taken from def.
+func (r Retention) Or(def Retention) Retention {
+	if r.MaxAge == 0 {
+		r.MaxAge = def.MaxAge
+	}
+	if r.MaxCount == 0 {
+		r.MaxCount = def.MaxCount
+	}
+	return r
+}
+
+// Prune deletes the events r does not keep, all but the latest one, and
+// returns how many it deleted. The latest is kept so that Oldest tells a
+// client resuming after a pruned event that it missed some.
+func Prune(ctx context.Context, client *ent.Client, r Retention) (int, error) {
+	ctx = schema.Pruning(tenant.Unscoped(ctx))
+	keep := 0
+	if r.MaxAge > 0 {
+		// ids increase with time: the first event young enough is kept,
+		// and all after it
+		e, err := client.Event.Query().
+			Where(event.CreatedAtGTE(time.Now().Add(-r.MaxAge))).
+			Order(ent.Asc(event.FieldID)).
+			First(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			keep = math.MaxInt
+		case err != nil:
+			return 0, err
+		default:
+			keep = e.ID
+		}
+	}
+	if r.MaxCount > 0 {
+		e, err := client.Event.Query().
+			Order(ent.Desc(event.FieldID)).
+			Offset(r.MaxCount - 1).
+			First(ctx)
+		switch {
+		case ent.IsNotFound(err):
+		case err != nil:
+			return 0, err
+		default:
+			keep = max(keep, e.ID)
+		}
+	}
+	if keep == 0 {
+		return 0, nil
+	}
+	latest, err := client.Event.Query().Order(ent.Desc(event.FieldID)).First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return client.Event.Delete().Where(event.IDLT(min(keep, latest.ID))).Exec(ctx)
+}
+
+// Oldest is the id of the oldest event still logged, or 0 if none is.
+// Events before it, if a client saw any, have been pruned.
+func Oldest(ctx context.Context, client *ent.Client) (int, error) {
+	e, err := client.Event.Query().Order(ent.Asc(event.FieldID)).First(tenant.Unscoped(ctx))
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// Subscription is a client's share of the events a StreamManager fans out.
+type Subscription struct {
+	filter  Filter
+	c       chan Event
+	lagged  chan struct{}
+	dropped atomic.Int64
+}
+
+// Events are the subscription's events, in id order.
+func (s *Subscription) Events() <-chan Event { return s.c }
+
+// Lagged is closed when the subscription's buffer overflowed. Events still
+// in Events came before the first one dropped; no more are added.
+func (s *Subscription) Lagged() <-chan struct{} { return s.lagged }
+
+// Dropped is how many events the subscription has dropped.
+func (s *Subscription) Dropped() int { return int(s.dropped.Load()) }
+
+// StreamManager fans the event log out to subscriptions as it grows.
 type StreamManager struct {
-	mu      sync.Mutex
-	streams map[string][]chan DeployEvent
+	client *ent.Client
+	// Buffer is how many events a subscription holds for its client.
+	Buffer int
+	// PollInterval is how often the log is read for events appended by
+	// others than Publish in this process.
+	PollInterval time.Duration
+	// Retention is how much of the log Run keeps.
+	Retention Retention
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
 }
 
-func NewStreamManager() *StreamManager {
+// NewStreamManager returns a StreamManager of client's event log.
+func NewStreamManager(client *ent.Client) *StreamManager {
 	return &StreamManager{
-		streams: make(map[string][]chan DeployEvent),
+		client:       client,
+		Buffer:       256,
+		PollInterval: 2 * time.Second,
+		Retention:    DefaultRetention,
+		subs:         make(map[*Subscription]struct{}),
 	}
 }
 
-func (s *StreamManager) Register(deployID string) chan DeployEvent {
-	log.Println("xxxxxxxx SM Register called", deployID)
-
-	ch := make(chan DeployEvent, 10)
+// Subscribe returns a subscription to the events that pass f from now on.
+func (s *StreamManager) Subscribe(f Filter) *Subscription {
+	sub := &Subscription{
+		filter: f,
+		c:      make(chan Event, max(s.Buffer, 1)),
+		lagged: make(chan struct{}),
+	}
 	s.mu.Lock()
-	s.streams[deployID] = append(s.streams[deployID], ch)
-
-	log.Println("s.streams[deployID]:", s.streams[deployID])
+	s.subs[sub] = struct{}{}
 	s.mu.Unlock()
-	return ch
+	return sub
 }
 
-func (s *StreamManager) Unregister(deployID string, ch chan DeployEvent) {
+// Unsubscribe ends sub.
+func (s *StreamManager) Unsubscribe(sub *Subscription) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-	channels := s.streams[deployID]
-	for i, c := range channels {
-		if c == ch {
-			close(c)
-			s.streams[deployID] = append(channels[:i], channels[i+1:]...)
-			break
+	delete(s.subs, sub)
+	s.mu.Unlock()
+}
+
+// Run tails the log, from the events appended after it starts, and prunes
+// it for s.Retention, until ctx ends.
+func (s *StreamManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+	last := -1
+	for {
+		var err error
+		if last < 0 {
+			last, err = s.latestID(ctx)
+		} else {
+			last, err = s.tail(ctx, last)
+		}
+		if err != nil {
+			log.Printf("event stream: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wakeup:
+		case <-prune.C:
+			if n, err := Prune(ctx, s.client, s.Retention); err != nil {
+				log.Printf("event log: prune: %v", err)
+			} else if n > 0 {
+				log.Printf("event log: pruned %d events", n)
+			}
 		}
 	}
-	if len(s.streams[deployID]) == 0 {
-		delete(s.streams, deployID)
+}
+
+// latestID is the id of the last event logged, 0 if there is none, or -1
+// if it could not be read.
+func (s *StreamManager) latestID(ctx context.Context) (int, error) {
+	e, err := s.client.Event.Query().Order(ent.Desc(event.FieldID)).First(tenant.Unscoped(ctx))
+	switch {
+	case ent.IsNotFound(err):
+		return 0, nil
+	case err != nil:
+		return -1, err
+	}
+	return e.ID, nil
+}
+
+// tail fans out the events after last and returns the id of the last one.
+func (s *StreamManager) tail(ctx context.Context, last int) (int, error) {
+	for {
+		evs, err := Replay(tenant.Unscoped(ctx), s.client, Filter{}, last, tailBatch)
+		if err != nil {
+			return last, err
+		}
+		for _, ev := range evs {
+			s.fanOut(ev)
+			last = ev.ID
+		}
+		if len(evs) < tailBatch {
+			return last, nil
+		}
 	}
 }
 
-func (s *StreamManager) Broadcast(deployID string, ev DeployEvent) {
+func (s *StreamManager) fanOut(ev Event) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-
-	log.Println("xxxxxxxx SM Brodcast called", deployID, s.streams[deployID])
-	for _, ch := range s.streams[deployID] {
+	for sub := range s.subs {
+		if !sub.filter.Match(ev) {
+			continue
+		}
+		if sub.dropped.Load() > 0 {
+			sub.dropped.Add(1)
+			continue
+		}
 		select {
-		case ch <- ev:
+		case sub.c <- ev:
 		default:
-			// drop slow clients
+			sub.dropped.Add(1)
+			close(sub.lagged)
 		}
 	}
 }
@@ -0,0 +1,166 @@
+package streammanager
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	_ "github.com/balaji-balu/margo-hello-world/ent/runtime"
+)
+
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := stdsql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)",
+		filepath.Join(t.TempDir(), "co.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestFilter(t *testing.T) {
+	org := uuid.New()
+	ev := Event{Type: "host.dead", SiteID: "plant-1", OrgID: org}
+	for _, tc := range []struct {
+		f    Filter
+		want bool
+	}{
+		{Filter{}, true},
+		{Filter{Types: []string{"host.dead"}}, true},
+		{Filter{Types: []string{"deployment.state_changed", "host.*"}}, true},
+		{Filter{Types: []string{"host"}}, false},
+		{Filter{Types: []string{"deployment.*"}}, false},
+		{Filter{SiteID: "plant-1", OrgID: &org}, true},
+		{Filter{SiteID: "plant-2"}, false},
+		{Filter{DeploymentID: "d1"}, false},
+		{Filter{OrgID: &uuid.Nil}, false},
+	} {
+		if got := tc.f.Match(ev); got != tc.want {
+			t.Errorf("%+v matched %v", tc.f, got)
+		}
+	}
+}
+
+func TestStreamManager(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	publish := func(typ, site string) Event {
+		t.Helper()
+		ev, err := Publish(ctx, client, Event{Type: typ, SiteID: site, Data: []byte(`{"host_id":"h1"}`)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ev
+	}
+	// logged before the manager starts: replayed, not streamed
+	first := publish("host.dead", "plant-1")
+
+	sm := NewStreamManager(client)
+	sm.Buffer, sm.PollInterval = 2, 10*time.Millisecond
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go sm.Run(runCtx)
+	// let it find where the log ends
+	time.Sleep(50 * time.Millisecond)
+
+	hosts := sm.Subscribe(Filter{Types: []string{"host.*"}, SiteID: "plant-1"})
+	slow := sm.Subscribe(Filter{})
+	defer sm.Unsubscribe(hosts)
+	defer sm.Unsubscribe(slow)
+
+	publish("deployment.state_changed", "plant-1")
+	second := publish("host.recovered", "plant-1")
+	publish("host.dead", "plant-2")
+	publish("host.dead", "plant-1")
+
+	select {
+	case ev := <-hosts.Events():
+		if ev.ID != second.ID || ev.Type != "host.recovered" || string(ev.Data) != `{"host_id":"h1"}` {
+			t.Errorf("first streamed = %+v", ev)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("nothing streamed")
+	}
+
+	// slow took none of the four, and holds two
+	select {
+	case <-slow.Lagged():
+	case <-time.After(2 * time.Second):
+		t.Fatal("slow subscription did not lag")
+	}
+	if n := slow.Dropped(); n != 2 {
+		t.Errorf("dropped %d", n)
+	}
+	if n := len(slow.Events()); n != 2 {
+		t.Errorf("%d buffered", n)
+	}
+
+	evs, err := Replay(ctx, client, Filter{Types: []string{"host.dead"}}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 3 || evs[0].ID != first.ID || evs[1].SiteID != "plant-2" {
+		t.Errorf("replayed %+v", evs)
+	}
+	if evs, _ := Replay(ctx, client, Filter{}, second.ID, 1); len(evs) != 1 || evs[0].ID != second.ID+1 {
+		t.Errorf("replayed after %d: %+v", second.ID, evs)
+	}
+
+	if _, err := client.Event.Delete().Exec(ctx); err == nil {
+		t.Error("deleted from the event log")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	old := time.Now().Add(-2 * time.Hour)
+	for range 3 {
+		client.Event.Create().SetType("host.dead").SetCreatedAt(old).ExecX(ctx)
+	}
+	var ids []int
+	for range 3 {
+		ev, err := Publish(ctx, client, Event{Type: "host.recovered"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, ev.ID)
+	}
+	prune := func(r Retention, want, oldest int) {
+		t.Helper()
+		n, err := Prune(ctx, client, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, err := Oldest(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != want || first != oldest {
+			t.Errorf("%+v pruned %d, oldest %d; want %d, %d", r, n, first, want, oldest)
+		}
+	}
+
+	prune(Retention{}, 0, 1)
+	prune(Retention{MaxAge: time.Hour}, 3, ids[0])
+	prune(Retention{MaxCount: 2}, 1, ids[1])
+	// the latest stays, however old
+	prune(Retention{MaxAge: time.Nanosecond}, 1, ids[2])
+	prune(Retention{MaxCount: 1}, 0, ids[2])
+}
@@ -0,0 +1,39 @@
+// Package tenant carries the organization a request acts within. The ent
+// schema's TenantMixin reads it to scope every query and mutation of the
+// entities organizations own.
+package tenant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Tenant is an organization: ID scopes rows, Name namespaces its paths in
+// the deployments repo.
+type Tenant struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type key struct{}
+
+// NewContext returns ctx acting within t.
+func NewContext(ctx context.Context, t Tenant) context.Context {
+	return context.WithValue(ctx, key{}, t)
+}
+
+// FromContext returns the tenant ctx acts within. Without one, nothing is
+// scoped: that is the CO itself, or a platform user acting across
+// organizations.
+func FromContext(ctx context.Context) (Tenant, bool) {
+	t, ok := ctx.Value(key{}).(Tenant)
+	return t, ok
+}
+
+// Unscoped returns ctx acting within no tenant, for the CO's own work on
+// behalf of a tenant's request, e.g. fanning an event out to platform
+// webhooks.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, key{}, nil)
+}
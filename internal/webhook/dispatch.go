@@ -0,0 +1,295 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
+	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
+)
+
+// dispatchBatch is how many due deliveries a Dispatcher takes at a time.
+const dispatchBatch = 100
+
+// pruneInterval is how often a running Dispatcher prunes deliveries.
+const pruneInterval = time.Hour
+
+// wakeup tells a running Dispatcher that deliveries are due, so they need
+// not wait for its next poll.
+var wakeup = make(chan struct{}, 1)
+
+// Wake has a running Dispatcher look for due deliveries now, e.g. after
+// one is queued again.
+func Wake() {
+	select {
+	case wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Dispatcher sends recorded deliveries and retries failed ones, waiting
+// MinBackoff after the first failure and doubling up to MaxBackoff, until
+// MaxAttempts have failed.
+type Dispatcher struct {
+	Client *ent.Client
+	HTTP   *http.Client
+	// PollInterval is how often due retries are looked for.
+	PollInterval time.Duration
+	MaxAttempts  int
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+	// Workers is how many deliveries are sent at once.
+	Workers int
+	// Retention is how many of the deliveries no longer pending are kept,
+	// as the event log keeps events.
+	Retention streammanager.Retention
+}
+
+// NewDispatcher returns a Dispatcher with the CO's defaults: 10 attempts
+// over about 3 hours, 30s apart at first and at most an hour, sent with
+// NewHTTPClient, and the event log's default retention.
+func NewDispatcher(client *ent.Client) *Dispatcher {
+	return &Dispatcher{
+		Client:       client,
+		HTTP:         NewHTTPClient(),
+		PollInterval: 5 * time.Second,
+		MaxAttempts:  10,
+		MinBackoff:   30 * time.Second,
+		MaxBackoff:   time.Hour,
+		Workers:      4,
+		Retention:    streammanager.DefaultRetention,
+	}
+}
+
+// NewHTTPClient returns a client for sending deliveries that will not
+// connect to an Internal address, whatever a webhook's host resolves to,
+// and does not follow redirects, so that webhooks cannot reach into the
+// CO's own network.
+func NewHTTPClient() *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, Control: dialPublic}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would connect on the CO's behalf, past dialPublic
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// dialPublic refuses to connect to an Internal address. It runs once the
+// host is resolved, so a name cannot resolve to one past the check.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if Internal(ip) {
+		return fmt.Errorf("%s is an internal address", ip)
+	}
+	return nil
+}
+
+// sharedAddress is the carrier-grade NAT range, which is no more public
+// than a private one.
+var sharedAddress = netip.MustParsePrefix("100.64.0.0/10")
+
+// Internal tells whether webhooks may not reach ip: a loopback, private,
+// link-local, shared or unspecified address.
+func Internal(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified() || sharedAddress.Contains(ip)
+}
+
+// Run sends deliveries as they fall due, and prunes them for d.Retention,
+// until ctx ends.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+	for {
+		for {
+			n, err := d.DispatchDue(ctx)
+			if err != nil {
+				log.Printf("webhooks: %v", err)
+			}
+			if err != nil || n < dispatchBatch {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wakeup:
+		case <-prune.C:
+			if n, err := d.Prune(ctx); err != nil {
+				log.Printf("webhooks: prune: %v", err)
+			} else if n > 0 {
+				log.Printf("webhooks: pruned %d deliveries", n)
+			}
+		}
+	}
+}
+
+// Prune deletes the deliveries d.Retention does not keep, but for pending
+// ones, and returns how many it deleted.
+func (d *Dispatcher) Prune(ctx context.Context) (int, error) {
+	keep := 0
+	if d.Retention.MaxAge > 0 {
+		// ids increase with time: the first delivery young enough is
+		// kept, and all after it
+		del, err := d.Client.WebhookDelivery.Query().
+			Where(webhookdelivery.CreatedAtGTE(time.Now().Add(-d.Retention.MaxAge))).
+			Order(ent.Asc(webhookdelivery.FieldID)).
+			First(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			keep = math.MaxInt
+		case err != nil:
+			return 0, err
+		default:
+			keep = del.ID
+		}
+	}
+	if d.Retention.MaxCount > 0 {
+		del, err := d.Client.WebhookDelivery.Query().
+			Order(ent.Desc(webhookdelivery.FieldID)).
+			Offset(d.Retention.MaxCount - 1).
+			First(ctx)
+		switch {
+		case ent.IsNotFound(err):
+		case err != nil:
+			return 0, err
+		default:
+			keep = max(keep, del.ID)
+		}
+	}
+	if keep == 0 {
+		return 0, nil
+	}
+	return d.Client.WebhookDelivery.Delete().
+		Where(
+			webhookdelivery.IDLT(keep),
+			webhookdelivery.StatusNEQ(StatusPending),
+		).
+		Exec(ctx)
+}
+
+// DispatchDue makes one attempt at each pending delivery that is due, to
+// an active webhook, and returns how many it attempted.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	due, err := d.Client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.Status(StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+			webhookdelivery.HasWebhookWith(webhook.Active(true)),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		Limit(dispatchBatch).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	work := make(chan *ent.WebhookDelivery)
+	var wg sync.WaitGroup
+	for range max(d.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for del := range work {
+				if err := d.attempt(ctx, del); err != nil {
+					log.Printf("webhook delivery %d: %v", del.ID, err)
+				}
+			}
+		}()
+	}
+	for _, del := range due {
+		work <- del
+	}
+	close(work)
+	wg.Wait()
+	return len(due), nil
+}
+
+// attempt sends del once and records how it went.
+func (d *Dispatcher) attempt(ctx context.Context, del *ent.WebhookDelivery) error {
+	status, sendErr := d.send(ctx, del.Edges.Webhook, del)
+	attempts := del.Attempts + 1
+	now := time.Now()
+
+	update := del.Update().SetAttempts(attempts)
+	if status > 0 {
+		update.SetResponseStatus(status)
+	}
+	switch {
+	case sendErr == nil:
+		update.SetStatus(StatusSucceeded).SetDeliveredAt(now).ClearError()
+	case attempts >= d.MaxAttempts:
+		update.SetStatus(StatusFailed).SetError(sendErr.Error())
+	default:
+		update.SetNextAttemptAt(now.Add(d.backoff(attempts))).SetError(sendErr.Error())
+	}
+	return update.Exec(ctx)
+}
+
+// backoff is how long to wait after the nth failed attempt.
+func (d *Dispatcher) backoff(n int) time.Duration {
+	wait := d.MinBackoff
+	for i := 1; i < n && wait < d.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.MaxBackoff)
+}
+
+// send POSTs del's payload to h and returns the response status; any but
+// a 2xx is an error. The response body is not kept: the delivery log is no
+// way to read what a URL answers.
+func (d *Dispatcher) send(ctx context.Context, h *ent.Webhook, del *ent.WebhookDelivery) (int, error) {
+	body := []byte(del.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "margo-co-webhook")
+	req.Header.Set(HeaderEvent, del.EventType)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(del.ID))
+	req.Header.Set(HeaderSignature, Sign(h.Secret, body))
+
+	resp, err := d.HTTP.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode/100 != 2 {
+		return resp.StatusCode, fmt.Errorf("%s returned %d", h.URL, resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,160 @@
+// Package webhook delivers CO events to the URLs subscribed to them.
+//
+// Publish records a delivery for every active webhook an event matches; a
+// Dispatcher POSTs them, signed with the webhook's secret, and retries the
+// ones that fail with backoff. Deliveries are kept, so they survive a
+// restart and serve as each webhook's delivery log. Delivery is at least
+// once: receivers tell repeats apart by the event id.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	"github.com/balaji-balu/margo-hello-world/ent/webhook"
+	"github.com/balaji-balu/margo-hello-world/internal/tenant"
+)
+
+// Event types.
+const (
+	// EventDeploymentState is a deployment's rolled-up state changing,
+	// e.g. from installing to installed.
+	EventDeploymentState = "deployment.state_changed"
+	// EventHostDead is an LO reporting a host offline that was not, or
+	// the host's site going stale.
+	EventHostDead = "host.dead"
+	// EventHostRecovered is an LO reporting an offline host online again.
+	EventHostRecovered = "host.recovered"
+	// EventRolloutPaused is a rollout stopping between waves. The CO does
+	// not stage rollouts yet, so nothing publishes it; webhooks may
+	// subscribe to it ahead of that.
+	EventRolloutPaused = "rollout.paused"
+)
+
+// EventTypes are the events webhooks can subscribe to.
+var EventTypes = []string{EventDeploymentState, EventHostDead, EventHostRecovered, EventRolloutPaused}
+
+// Delivery statuses.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Headers of a delivery.
+const (
+	HeaderEvent     = "X-Margo-Event"
+	HeaderDelivery  = "X-Margo-Delivery"
+	HeaderSignature = "X-Margo-Signature"
+)
+
+// Event is something that happened in the CO, as webhooks receive it.
+type Event struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// OrgID is the organization the event is about; only its webhooks
+	// and platform ones get it. Nil is no organization.
+	OrgID uuid.UUID `json:"-"`
+	Data  any       `json:"data"`
+}
+
+// DeploymentState is the data of EventDeploymentState.
+type DeploymentState struct {
+	DeploymentID string `json:"deployment_id"`
+	SiteID       string `json:"site_id,omitempty"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// HostState is the data of EventHostDead and EventHostRecovered.
+type HostState struct {
+	HostID string `json:"host_id"`
+	SiteID string `json:"site_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// Publish records a delivery of ev to every active webhook subscribed to
+// it, for a Dispatcher to send. It fills in the event's id and time when
+// they are empty.
+func Publish(ctx context.Context, client *ent.Client, ev Event) error {
+	if ev.ID == "" {
+		ev.ID = uuid.NewString()
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now().UTC()
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("event %s: %w", ev.Type, err)
+	}
+
+	// the event may come from within one organization's request, but
+	// platform webhooks are not that organization's
+	ctx = context.WithoutCancel(tenant.Unscoped(ctx))
+	q := client.Webhook.Query().Where(webhook.Active(true))
+	if ev.OrgID == uuid.Nil {
+		q = q.Where(webhook.OrgIDIsNil())
+	} else {
+		q = q.Where(webhook.Or(webhook.OrgIDIsNil(), webhook.OrgID(ev.OrgID)))
+	}
+	hooks, err := q.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var creates []*ent.WebhookDeliveryCreate
+	for _, h := range hooks {
+		if !Subscribed(h.EventTypes, ev.Type) {
+			continue
+		}
+		create := client.WebhookDelivery.Create().
+			SetWebhookID(h.ID).
+			SetEventID(ev.ID).
+			SetEventType(ev.Type).
+			SetPayload(string(payload))
+		if h.OrgID != uuid.Nil {
+			create.SetOrgID(h.OrgID)
+		}
+		creates = append(creates, create)
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+	if err := client.WebhookDelivery.CreateBulk(creates...).Exec(ctx); err != nil {
+		return err
+	}
+	Wake()
+	return nil
+}
+
+// Subscribed tells whether a webhook subscribed to types gets events of
+// type t; no types is every type.
+func Subscribed(types []string, t string) bool {
+	return len(types) == 0 || slices.Contains(types, t)
+}
+
+// Sign returns the signature of body with secret, as HeaderSignature
+// carries it: "sha256=" and the hex HMAC-SHA256.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether signature is body's with secret, for receivers.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+
+	"github.com/balaji-balu/margo-hello-world/ent"
+	_ "github.com/balaji-balu/margo-hello-world/ent/runtime"
+	"github.com/balaji-balu/margo-hello-world/ent/webhookdelivery"
+	"github.com/balaji-balu/margo-hello-world/internal/streammanager"
+)
+
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := stdsql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)",
+		filepath.Join(t.TempDir(), "co.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestPublishAndDispatch(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+
+	var calls atomic.Int32
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail twice, then take it
+		if calls.Add(1) <= 2 {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		got = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	org := client.Organization.Create().SetName("acme").SaveX(ctx)
+	hosts := client.Webhook.Create().SetName("hosts").SetURL(srv.URL).SetSecret("s3cret").
+		SetEventTypes([]string{EventHostDead}).SetOrgID(org.ID).SaveX(ctx)
+	// all events of every organization
+	client.Webhook.Create().SetName("platform").SetURL(srv.URL + "/all").SetSecret("p").SaveX(ctx)
+	// another organization's
+	client.Webhook.Create().SetName("other").SetURL(srv.URL).SetSecret("o").SetOrgID(uuid.New()).SaveX(ctx)
+	client.Webhook.Create().SetName("off").SetURL(srv.URL).SetSecret("x").SetActive(false).SaveX(ctx)
+
+	ev := Event{Type: EventHostDead, OrgID: org.ID, Data: HostState{HostID: "h1", SiteID: "s1", From: "online", To: "offline"}}
+	if err := Publish(ctx, client, ev); err != nil {
+		t.Fatal(err)
+	}
+	if err := Publish(ctx, client, Event{Type: EventDeploymentState, OrgID: org.ID}); err != nil {
+		t.Fatal(err)
+	}
+	// hosts and platform get the host event; platform the deployment one
+	if n := client.WebhookDelivery.Query().CountX(ctx); n != 3 {
+		t.Fatalf("%d deliveries", n)
+	}
+	client.WebhookDelivery.Delete().Where(webhookdelivery.WebhookIDNEQ(hosts.ID)).ExecX(ctx)
+
+	d := NewDispatcher(client)
+	// the test server listens on loopback, which NewHTTPClient refuses
+	d.HTTP = srv.Client()
+	d.MinBackoff, d.MaxBackoff, d.MaxAttempts = time.Millisecond, 2*time.Millisecond, 5
+	for i := 0; i < 3; i++ {
+		time.Sleep(5 * time.Millisecond)
+		if _, err := d.DispatchDue(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	del := client.WebhookDelivery.Query().OnlyX(ctx)
+	if del.Status != StatusSucceeded || del.Attempts != 3 || del.ResponseStatus != http.StatusOK || del.DeliveredAt == nil || del.Error != "" {
+		t.Errorf("delivery: %+v", del)
+	}
+	if got == nil {
+		t.Fatal("not delivered")
+	}
+	if got.Header.Get(HeaderEvent) != EventHostDead || got.Header.Get(HeaderDelivery) != fmt.Sprint(del.ID) ||
+		!Verify("s3cret", body, got.Header.Get(HeaderSignature)) {
+		t.Errorf("headers: %v", got.Header)
+	}
+	var sent struct {
+		ID   string    `json:"id"`
+		Type string    `json:"type"`
+		Data HostState `json:"data"`
+	}
+	if err := json.Unmarshal(body, &sent); err != nil || sent.ID == "" || sent.Type != EventHostDead || sent.Data.To != "offline" {
+		t.Errorf("body: %s", body)
+	}
+}
+
+func TestDispatchGivesUp(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	client.Webhook.Create().SetName("down").SetURL(srv.URL).SetSecret("s").SaveX(ctx)
+	if err := Publish(ctx, client, Event{Type: EventHostRecovered}); err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDispatcher(client)
+	d.HTTP = srv.Client()
+	d.MinBackoff, d.MaxBackoff, d.MaxAttempts = time.Hour, time.Hour, 2
+	d.DispatchDue(ctx)
+	del := client.WebhookDelivery.Query().OnlyX(ctx)
+	if del.Status != StatusPending || del.Attempts != 1 || !del.NextAttemptAt.After(time.Now().Add(59*time.Minute)) {
+		t.Fatalf("after one failure: %+v", del)
+	}
+	// not due yet
+	if n, _ := d.DispatchDue(ctx); n != 0 {
+		t.Errorf("retried %d early", n)
+	}
+	del.Update().SetNextAttemptAt(time.Now()).ExecX(ctx)
+	d.DispatchDue(ctx)
+	del = client.WebhookDelivery.Query().OnlyX(ctx)
+	if del.Status != StatusFailed || del.Attempts != 2 || del.ResponseStatus != http.StatusInternalServerError || del.Error == "" {
+		t.Errorf("after giving up: %+v", del)
+	}
+	if strings.Contains(del.Error, "nope") {
+		t.Errorf("response body logged: %s", del.Error)
+	}
+}
+
+func TestDispatcherPrune(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	h := client.Webhook.Create().SetName("hosts").SetURL("https://example.com").SetSecret("s").SaveX(ctx)
+	deliver := func(status string, at time.Time) int {
+		return client.WebhookDelivery.Create().SetWebhookID(h.ID).SetEventID("1").
+			SetEventType(EventHostDead).SetPayload("{}").SetStatus(status).SetCreatedAt(at).
+			SaveX(ctx).ID
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	pending := deliver(StatusPending, old)
+	deliver(StatusSucceeded, old)
+	deliver(StatusFailed, old)
+	var recent []int
+	for range 3 {
+		recent = append(recent, deliver(StatusSucceeded, time.Now()))
+	}
+
+	d := NewDispatcher(client)
+	prune := func(r streammanager.Retention, want int) {
+		t.Helper()
+		d.Retention = r
+		if n, err := d.Prune(ctx); err != nil || n != want {
+			t.Errorf("%+v pruned %d, %v; want %d", r, n, err, want)
+		}
+	}
+	prune(streammanager.Retention{}, 0)
+	prune(streammanager.Retention{MaxAge: time.Hour}, 2)
+	prune(streammanager.Retention{MaxCount: 2}, 1)
+
+	// pending deliveries are still to be sent, however old
+	var left []int
+	for _, del := range client.WebhookDelivery.Query().Order(ent.Asc(webhookdelivery.FieldID)).AllX(ctx) {
+		left = append(left, del.ID)
+	}
+	if want := []int{pending, recent[1], recent[2]}; fmt.Sprint(left) != fmt.Sprint(want) {
+		t.Errorf("left %v, want %v", left, want)
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "http://169.254.169.254/", http.StatusFound)
+		}
+	}))
+	defer srv.Close()
+
+	if _, err := NewHTTPClient().Post(srv.URL, "application/json", nil); err == nil || !strings.Contains(err.Error(), "internal address") {
+		t.Errorf("loopback: %v", err)
+	}
+
+	// past the dial check, a redirect is returned rather than followed
+	hc := NewHTTPClient()
+	hc.Transport = srv.Client().Transport
+	resp, err := hc.Post(srv.URL+"/moved", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("redirect: %d", resp.StatusCode)
+	}
+}
+
+func TestInternal(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"127.0.0.1": true, "::1": true, "10.1.2.3": true, "172.16.0.1": true, "192.168.1.1": true,
+		"169.254.169.254": true, "fe80::1": true, "fd00::1": true, "100.64.0.1": true, "0.0.0.0": true,
+		"::ffff:127.0.0.1": true, "8.8.8.8": false, "2001:4860:4860::8888": false, "100.128.0.1": false,
+	} {
+		if got := Internal(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("Internal(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := &Dispatcher{MinBackoff: 30 * time.Second, MaxBackoff: time.Hour}
+	for n, want := range map[int]time.Duration{1: 30 * time.Second, 2: time.Minute, 4: 4 * time.Minute, 9: time.Hour, 40: time.Hour} {
+		if got := d.backoff(n); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
@@ -24,4 +24,7 @@ type Schema struct {
 	AllowEmpty bool     `yaml:"allowEmpty,omitempty"`
 	MinValue   *float64 `yaml:"minValue,omitempty"`
 	MaxValue   *float64 `yaml:"maxValue,omitempty"`
+	MinLength  *int     `yaml:"minLength,omitempty"`
+	MaxLength  *int     `yaml:"maxLength,omitempty"`
+	RegexMatch string   `yaml:"regexMatch,omitempty"`
 }
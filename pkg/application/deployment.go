@@ -14,12 +14,25 @@ type Component struct {
 }
 
 type ComponentProperties struct {
-	Repository      string `yaml:"repository,omitempty"`
-	Revision        string `yaml:"revision,omitempty"`
-	Wait            bool   `yaml:"wait,omitempty"`
-	Timeout         string `yaml:"timeout,omitempty"`
-	PackageLocation string `yaml:"packageLocation,omitempty"`
-	KeyLocation     string `yaml:"keyLocation,omitempty"`
+	Repository      string            `yaml:"repository,omitempty"`
+	Revision        string            `yaml:"revision,omitempty"`
+	Wait            bool              `yaml:"wait,omitempty"`
+	Timeout         string            `yaml:"timeout,omitempty"`
+	PackageLocation string            `yaml:"packageLocation,omitempty"`
+	KeyLocation     string            `yaml:"keyLocation,omitempty"`
+	Env             map[string]string `yaml:"env,omitempty"`
+	Mounts          []Mount           `yaml:"mounts,omitempty"`
+	LivenessProbe   *Probe            `yaml:"livenessProbe,omitempty"`
+	ReadinessProbe  *Probe            `yaml:"readinessProbe,omitempty"`
+	// DependsOn names components of the same profile that must be running
+	// (and ready) before this one starts.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+}
+
+type Mount struct {
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"readOnly,omitempty"`
 }
 
 type Resources struct {
@@ -0,0 +1,29 @@
+package application
+
+// Probe is a health check the ERA runs against a component. Exactly one of
+// HTTPGet, TCPSocket and Exec should be set. Zero timings take the ERA's
+// defaults.
+type Probe struct {
+	HTTPGet             *HTTPGetAction   `yaml:"httpGet,omitempty"`
+	TCPSocket           *TCPSocketAction `yaml:"tcpSocket,omitempty"`
+	Exec                *ExecAction      `yaml:"exec,omitempty"`
+	InitialDelaySeconds int              `yaml:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int              `yaml:"periodSeconds,omitempty"`
+	TimeoutSeconds      int              `yaml:"timeoutSeconds,omitempty"`
+	FailureThreshold    int              `yaml:"failureThreshold,omitempty"`
+	SuccessThreshold    int              `yaml:"successThreshold,omitempty"`
+}
+
+type HTTPGetAction struct {
+	Path   string `yaml:"path,omitempty"`
+	Port   int    `yaml:"port"`
+	Scheme string `yaml:"scheme,omitempty"` // HTTP (default) or HTTPS
+}
+
+type TCPSocketAction struct {
+	Port int `yaml:"port"`
+}
+
+type ExecAction struct {
+	Command []string `yaml:"command"`
+}
@@ -0,0 +1,319 @@
+// Package validation checks an ApplicationDescription against the Margo
+// spec and against itself, reporting every problem with the YAML path, and
+// when parsed from YAML the line, it was found at.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	"github.com/balaji-balu/margo-hello-world/pkg/model"
+)
+
+// What a description must declare itself as.
+const (
+	APIVersion = "margo.org/v1-alpha1"
+	Kind       = "ApplicationDescription"
+)
+
+// ProfileTypes are the deployment profile types an app may have.
+var ProfileTypes = []string{"helm.v3", "compose"}
+
+// DataTypes are the data types a configuration schema may have.
+var DataTypes = []string{"string", "integer", "double", "boolean"}
+
+// Error is one problem with a description.
+type Error struct {
+	// Path is where the problem is, as a YAML path, e.g.
+	// $.deploymentProfiles[0].type.
+	Path string `json:"path"`
+	// Line is the line of Path, or of the nearest part of it present;
+	// 0 when the description was not parsed from YAML.
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Errors are all the problems with a description.
+type Errors []Error
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Parse parses and validates a description. A description that parses but
+// is not valid is returned along with its Errors.
+func Parse(data []byte) (*application.ApplicationDescription, error) {
+	var ad application.ApplicationDescription
+	if err := yaml.Unmarshal(data, &ad); err != nil {
+		return nil, err
+	}
+	errs := Validate(&ad)
+	if errs == nil {
+		return &ad, nil
+	}
+	if file, err := parser.ParseBytes(data, 0); err == nil {
+		for i := range errs {
+			errs[i].Line = line(file, errs[i].Path)
+		}
+		// parameters are checked in name order; report in file order
+		slices.SortStableFunc(errs, func(a, b Error) int { return a.Line - b.Line })
+	}
+	return &ad, errs
+}
+
+// AsErrors returns the Errors in err, if it holds any.
+func AsErrors(err error) (Errors, bool) {
+	var errs Errors
+	ok := errors.As(err, &errs)
+	return errs, ok
+}
+
+// Validate checks ad, returning nil if it is valid.
+func Validate(ad *application.ApplicationDescription) Errors {
+	v := &validator{}
+	root := path("$")
+
+	if ad.APIVersion != APIVersion {
+		v.addf(root.key("apiVersion"), "must be %q, not %q", APIVersion, ad.APIVersion)
+	}
+	if ad.Kind != Kind {
+		v.addf(root.key("kind"), "must be %q, not %q", Kind, ad.Kind)
+	}
+	v.metadata(root.key("metadata"), &ad.Metadata)
+	components := v.profiles(root.key("deploymentProfiles"), ad.DeploymentProfiles)
+	v.parameters(root.key("parameters"), ad.Parameters, components)
+	if ad.Configuration != nil {
+		v.configuration(root.key("configuration"), ad.Configuration, ad.Parameters)
+	}
+	return v.errs
+}
+
+type validator struct {
+	errs Errors
+}
+
+func (v *validator) addf(p path, format string, args ...any) {
+	v.errs = append(v.errs, Error{Path: string(p), Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(p path, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf(p, "is required")
+	}
+}
+
+func (v *validator) metadata(p path, m *application.Metadata) {
+	v.required(p.key("id"), m.ID)
+	v.required(p.key("name"), m.Name)
+	v.required(p.key("version"), m.Version)
+	orgs := p.key("catalog").key("organization")
+	if len(m.Catalog.Organization) == 0 {
+		v.addf(orgs, "needs at least one organization")
+	}
+	for i, o := range m.Catalog.Organization {
+		v.required(orgs.index(i).key("name"), o.Name)
+	}
+}
+
+// profiles checks the deployment profiles and returns the names of the
+// components they have.
+func (v *validator) profiles(p path, profiles []application.DeploymentProfile) map[string]bool {
+	components := map[string]bool{}
+	if len(profiles) == 0 {
+		v.addf(p, "needs at least one deployment profile")
+	}
+	ids := map[string]bool{}
+	for i, dp := range profiles {
+		pp := p.index(i)
+		if !slices.Contains(ProfileTypes, dp.Type) {
+			v.addf(pp.key("type"), "unknown profile type %q; want one of %s", dp.Type, strings.Join(ProfileTypes, ", "))
+		}
+		v.required(pp.key("id"), dp.ID)
+		if dp.ID != "" && ids[dp.ID] {
+			v.addf(pp.key("id"), "duplicate profile id %q", dp.ID)
+		}
+		ids[dp.ID] = true
+
+		if len(dp.Components) == 0 {
+			v.addf(pp.key("components"), "needs at least one component")
+		}
+		names := map[string]bool{}
+		for j, c := range dp.Components {
+			cp := pp.key("components").index(j)
+			v.required(cp.key("name"), c.Name)
+			if c.Name != "" && names[c.Name] {
+				v.addf(cp.key("name"), "duplicate component %q", c.Name)
+			}
+			names[c.Name] = true
+			components[c.Name] = true
+			if c.Properties.Repository == "" && c.Properties.PackageLocation == "" {
+				v.addf(cp.key("properties").key("repository"), "is required without a packageLocation")
+			}
+		}
+		for j, c := range dp.Components {
+			for k, dep := range c.Properties.DependsOn {
+				depp := pp.key("components").index(j).key("properties").key("dependsOn").index(k)
+				switch {
+				case dep == c.Name:
+					v.addf(depp, "component depends on itself")
+				case !names[dep]:
+					v.addf(depp, "unknown component %q in this profile", dep)
+				}
+			}
+		}
+	}
+	return components
+}
+
+func (v *validator) parameters(p path, params map[string]application.Parameter, components map[string]bool) {
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		pp := p.key(name)
+		targets := params[name].Targets
+		if len(targets) == 0 {
+			v.addf(pp.key("targets"), "needs at least one target")
+		}
+		for i, t := range targets {
+			tp := pp.key("targets").index(i)
+			if msg := pointerError(t.Pointer); msg != "" {
+				v.addf(tp.key("pointer"), "%s", msg)
+			}
+			for j, c := range t.Components {
+				if !components[c] {
+					v.addf(tp.key("components").index(j), "unknown component %q", c)
+				}
+			}
+		}
+	}
+}
+
+// pointerError says what is wrong with a parameter target's pointer, or ""
+// if nothing is. A pointer is a JSON pointer the ERA applies (/env/NAME,
+// /files/~1path) or, for a chart or compose file, a dotted path.
+func pointerError(pointer string) string {
+	switch {
+	case pointer == "":
+		return "is required"
+	case strings.HasPrefix(pointer, "/"):
+		if _, _, ok := model.ParseParameterPointer(pointer); !ok {
+			return fmt.Sprintf("%q does not resolve; want /%s/<name> or /%s/<~1escaped~1path>",
+				pointer, model.ParamTargetEnv, model.ParamTargetFile)
+		}
+	default:
+		for _, seg := range strings.Split(pointer, ".") {
+			if seg == "" {
+				return fmt.Sprintf("%q has an empty segment", pointer)
+			}
+		}
+	}
+	return ""
+}
+
+func (v *validator) configuration(p path, cfg *application.Configuration, params map[string]application.Parameter) {
+	schemas := map[string]bool{}
+	for i, s := range cfg.Schema {
+		sp := p.key("schema").index(i)
+		v.required(sp.key("name"), s.Name)
+		if s.Name != "" && schemas[s.Name] {
+			v.addf(sp.key("name"), "duplicate schema %q", s.Name)
+		}
+		schemas[s.Name] = true
+		if !slices.Contains(DataTypes, s.DataType) {
+			v.addf(sp.key("dataType"), "unknown data type %q; want one of %s", s.DataType, strings.Join(DataTypes, ", "))
+		}
+		if s.MinValue != nil && s.MaxValue != nil && *s.MinValue > *s.MaxValue {
+			v.addf(sp.key("minValue"), "%v is more than maxValue %v", *s.MinValue, *s.MaxValue)
+		}
+		if s.MinLength != nil && *s.MinLength < 0 {
+			v.addf(sp.key("minLength"), "must not be negative")
+		}
+		if s.MinLength != nil && s.MaxLength != nil && *s.MinLength > *s.MaxLength {
+			v.addf(sp.key("minLength"), "%d is more than maxLength %d", *s.MinLength, *s.MaxLength)
+		}
+		if s.RegexMatch != "" {
+			if _, err := regexp.Compile(s.RegexMatch); err != nil {
+				v.addf(sp.key("regexMatch"), "%v", err)
+			}
+		}
+	}
+
+	for i, sec := range cfg.Sections {
+		sp := p.key("sections").index(i)
+		v.required(sp.key("name"), sec.Name)
+		for j, set := range sec.Settings {
+			setp := sp.key("settings").index(j)
+			v.required(setp.key("name"), set.Name)
+			if _, ok := params[set.Parameter]; !ok {
+				v.addf(setp.key("parameter"), "unknown parameter %q", set.Parameter)
+			}
+			if !schemas[set.Schema] {
+				v.addf(setp.key("schema"), "unknown schema %q", set.Schema)
+			}
+		}
+	}
+}
+
+// path is a YAML path, as goccy/go-yaml reads them.
+type path string
+
+func (p path) key(k string) path {
+	if strings.ContainsAny(k, ".*[]' ") {
+		k = "'" + strings.ReplaceAll(k, "'", `\'`) + "'"
+	}
+	return p + "." + path(k)
+}
+
+func (p path) index(i int) path {
+	return p + "[" + path(strconv.Itoa(i)) + "]"
+}
+
+// parent drops the last key or index of p.
+func (p path) parent() path {
+	s := string(p)
+	if strings.HasSuffix(s, "]") {
+		return path(s[:strings.LastIndex(s, "[")])
+	}
+	if strings.HasSuffix(s, "'") {
+		s = s[:strings.LastIndex(s[:len(s)-1], "'")]
+		return path(strings.TrimSuffix(s, "."))
+	}
+	if i := strings.LastIndex(s, "."); i > 0 {
+		return path(s[:i])
+	}
+	return "$"
+}
+
+// line is the line of p in file or, for a missing key, of the nearest
+// part of p that is there.
+func line(file *ast.File, p string) int {
+	for cur := path(p); cur != "$"; cur = cur.parent() {
+		yp, err := yaml.PathString(string(cur))
+		if err != nil {
+			continue
+		}
+		if n, err := yp.FilterFile(file); err == nil && n != nil {
+			return n.GetToken().Position.Line
+		}
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseValid(t *testing.T) {
+	for _, f := range []string{"../../../tests/app1.yaml", "../../../internal/fleetsim/sample-app.yaml"} {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Parse(data); err != nil {
+			t.Errorf("%s: %v", f, err)
+		}
+	}
+}
+
+const invalid = `apiVersion: margo.org/v1
+kind: ApplicationDescription
+metadata:
+  id: broken
+  version: "1.0"
+  catalog:
+    organization:
+      - name: Example
+deploymentProfiles:
+  - type: kubernetes
+    id: broken-a
+    components:
+      - name: api
+        properties:
+          repository: registry.local/api:1.0
+          dependsOn: [db]
+parameters:
+  port:
+    value: "8080"
+    targets:
+      - pointer: /sites/s1
+        components: [web]
+      - pointer: config..port
+configuration:
+  sections:
+    - name: General
+      settings:
+        - parameter: port
+          name: Port
+          schema: portRange
+        - parameter: host
+          name: Host
+          schema: text
+  schema:
+    - name: portRange
+      dataType: integer
+      minValue: 65535
+      maxValue: 1
+    - name: text
+      dataType: str
+`
+
+func TestParseInvalid(t *testing.T) {
+	ad, err := Parse([]byte(invalid))
+	if ad == nil {
+		t.Fatal("no description for a parsed one")
+	}
+	errs, ok := AsErrors(err)
+	if !ok {
+		t.Fatalf("not Errors: %v", err)
+	}
+	want := map[string]int{
+		"$.apiVersion":                 1,
+		"$.metadata.name":              4, // missing: where metadata starts
+		"$.deploymentProfiles[0].type": 10,
+		"$.deploymentProfiles[0].components[0].properties.dependsOn[0]": 16,
+		"$.parameters.port.targets[0].pointer":                          21,
+		"$.parameters.port.targets[0].components[0]":                    22,
+		"$.parameters.port.targets[1].pointer":                          23,
+		"$.configuration.sections[0].settings[1].parameter":             31,
+		"$.configuration.schema[0].minValue":                            37,
+		"$.configuration.schema[1].dataType":                            40,
+	}
+	for _, e := range errs {
+		line, ok := want[e.Path]
+		if !ok {
+			t.Errorf("unexpected error %v", e)
+			continue
+		}
+		if e.Line != line {
+			t.Errorf("%s: line %d, want %d", e.Path, e.Line, line)
+		}
+		delete(want, e.Path)
+	}
+	for p := range want {
+		t.Errorf("no error at %s", p)
+	}
+}
+
+func TestPathKeyQuoting(t *testing.T) {
+	ad, err := Parse([]byte(`apiVersion: margo.org/v1-alpha1
+kind: ApplicationDescription
+metadata: {id: a, name: a, version: "1", catalog: {organization: [{name: o}]}}
+deploymentProfiles:
+  - {type: compose, id: a, components: [{name: c, properties: {repository: r}}]}
+parameters:
+  log.level:
+    targets:
+      - pointer: /env/LOG_LEVEL
+        components: [d]
+`))
+	errs, _ := AsErrors(err)
+	if ad == nil || len(errs) != 1 || errs[0].Path != "$.parameters.'log.level'.targets[0].components[0]" || errs[0].Line != 10 {
+		t.Errorf("errors: %v", err)
+	}
+}
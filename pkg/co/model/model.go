@@ -1,14 +1,49 @@
 package model
+
+import "time"
+
 type CoConfig struct {
 	Server struct {
 		Port string
 		Metricsport string
+		// CORSOrigins are the origins browsers may call the API from;
+		// none means any.
+		CORSOrigins []string `koanf:"cors_origins"`
 	}
 	Appregistry struct {
 		Repo string
 		Branch string
+		// OCI logs in to the registry apps are imported from by
+		// oci_ref; the password comes from OCI_REGISTRY_PASSWORD.
+		OCI struct {
+			// Registry is the host[:port] the credentials are for;
+			// others are pulled from anonymously.
+			Registry string
+			Username string
+			// PlainHTTP talks to registries over http, for local ones.
+			PlainHTTP bool `koanf:"plain_http"`
+		} `koanf:"oci"`
 	}
 	Git struct {
 		Repo string
 	}
+	Sites struct {
+		// StaleAfter is how long a site may go without its LO syncing
+		// before it is marked stale; 0 means 5m.
+		StaleAfter time.Duration `koanf:"stale_after"`
+	}
+	Events struct {
+		// MaxAge and MaxCount bound the event log and the webhook
+		// deliveries no longer pending: older ones, and all but the
+		// MaxCount latest, are pruned; 0 means 168h and 100000.
+		MaxAge   time.Duration `koanf:"max_age"`
+		MaxCount int           `koanf:"max_count"`
+	}
+	Auth struct {
+		// Enabled turns on tokens and roles; without it every request
+		// acts as an admin.
+		Enabled bool
+		// TokenTTL is how long a login token lasts; 0 means 720h.
+		TokenTTL time.Duration `koanf:"token_ttl"`
+	}
 }
@@ -33,8 +33,9 @@ type Spec struct {
 }
 
 type DeploymentProfile struct {
-	Type       string      `yaml:"type"`
-	Components []Component `yaml:"components"`
+	Type              string                 `yaml:"type"`
+	Components        []Component            `yaml:"components"`
+	RequiredResources *application.Resources `yaml:"requiredResources,omitempty"`
 }
 
 type Component struct {
@@ -0,0 +1,69 @@
+package edgeruntime
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// Probe is a health check run by the ERA. Exactly one of HTTPGet, TCPPort and
+// Exec is set.
+type Probe struct {
+	HTTPGet *HTTPGetProbe
+	TCPPort int
+	Exec    []string
+
+	InitialDelay     time.Duration
+	Period           time.Duration
+	Timeout          time.Duration
+	FailureThreshold int
+	SuccessThreshold int
+}
+
+type HTTPGetProbe struct {
+	Path   string
+	Port   int
+	Scheme string
+}
+
+const (
+	DefaultProbePeriod           = 10 * time.Second
+	DefaultProbeTimeout          = time.Second
+	DefaultProbeFailureThreshold = 3
+	DefaultProbeSuccessThreshold = 1
+)
+
+// WithDefaults fills zero timings and thresholds.
+func (p Probe) WithDefaults() Probe {
+	if p.Period <= 0 {
+		p.Period = DefaultProbePeriod
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultProbeTimeout
+	}
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = DefaultProbeFailureThreshold
+	}
+	if p.SuccessThreshold <= 0 {
+		p.SuccessThreshold = DefaultProbeSuccessThreshold
+	}
+	return p
+}
+
+// ComponentDialer is implemented by plugins whose components are not reachable
+// on the host network; HTTP and TCP probes connect through it.
+type ComponentDialer interface {
+	DialComponent(ctx context.Context, name, network, address string) (net.Conn, error)
+}
+
+// Execer is implemented by plugins that can run a command inside a running
+// component, for exec probes. A non-zero exit code means the probe failed.
+type Execer interface {
+	Exec(ctx context.Context, name string, cmd []string) (exitCode int, err error)
+}
+
+// Prober lets a plugin answer probes itself (e.g. a mock runtime). When a
+// plugin implements it, the ERA does not run HTTP, TCP or exec checks.
+type Prober interface {
+	Probe(ctx context.Context, name string, p Probe) error
+}
@@ -0,0 +1,51 @@
+package edgeruntime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var memoryUnits = []struct {
+	suffix string
+	factor int64
+}{
+	{"Ki", 1 << 10},
+	{"Mi", 1 << 20},
+	{"Gi", 1 << 30},
+	{"Ti", 1 << 40},
+	{"KB", 1000},
+	{"MB", 1000 * 1000},
+	{"GB", 1000 * 1000 * 1000},
+	{"TB", 1000 * 1000 * 1000 * 1000},
+	{"K", 1000},
+	{"M", 1000 * 1000},
+	{"G", 1000 * 1000 * 1000},
+	{"T", 1000 * 1000 * 1000 * 1000},
+	{"B", 1},
+}
+
+// ParseMemory converts a profile memory quantity such as "512Mi", "2GB" or
+// "1048576" into bytes. An empty string yields 0 (no limit).
+func ParseMemory(q string) (int64, error) {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return 0, nil
+	}
+
+	factor := int64(1)
+	num := q
+	for _, u := range memoryUnits {
+		if strings.HasSuffix(q, u.suffix) {
+			factor = u.factor
+			num = strings.TrimSpace(strings.TrimSuffix(q, u.suffix))
+			break
+		}
+	}
+
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid memory quantity %q", q)
+	}
+	return int64(v * float64(factor)), nil
+}
@@ -0,0 +1,29 @@
+package edgeruntime
+
+import "testing"
+
+func TestParseMemory(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"1048576", 1048576, false},
+		{"512Mi", 512 << 20, false},
+		{"1Gi", 1 << 30, false},
+		{"2GB", 2000000000, false},
+		{"1.5Gi", 3 << 29, false},
+		{"lots", 0, true},
+		{"-1Mi", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseMemory(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseMemory(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Fatalf("ParseMemory(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
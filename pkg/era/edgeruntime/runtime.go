@@ -1,5 +1,6 @@
 package edgeruntime
 import (
+	"io"
 	//"github.com/balaji-balu/margo-hello-world/pkg/era"
 )
 
@@ -11,6 +12,47 @@ type ComponentSpec struct {
     WasmFile  string
     Artifact  string
     Args      []string
+
+    // PackageURL and KeyLocation come from the component properties and are
+    // used to verify the artifact before it is installed.
+    PackageURL  string
+    KeyLocation string
+
+    // Mirror, when set, is a registry to pull Artifact through (the site's
+    // LO), addressed as a containerd mirror: http(s)://host[:port].
+    Mirror string
+    // MirrorToken is the bearer token the mirror wants; it is sent to the
+    // mirror only.
+    MirrorToken string
+
+    Env         map[string]string
+    Mounts      []Mount
+    Resources   Resources
+    Peripherals []Peripheral
+
+    Liveness  *Probe
+    Readiness *Probe
+}
+
+type Mount struct {
+    Source   string
+    Target   string
+    ReadOnly bool
+}
+
+// Resources are the limits a plugin should enforce for the component.
+// Zero values mean unlimited.
+type Resources struct {
+    CPUCores    float64
+    MemoryBytes int64
+}
+
+// Peripheral is a host device class the component needs access to, as declared
+// in the deployment profile (e.g. "camera", "serial", "gpu").
+type Peripheral struct {
+    Type         string
+    Manufacturer string
+    Model        string
 }
 
 type ComponentStatus struct {
@@ -32,6 +74,46 @@ type RuntimePlugin interface {
     Status(string) (ComponentStatus, error)
 }
 
+// RuntimeComponent is a component as the runtime itself sees it.
+type RuntimeComponent struct {
+    Name     string
+    Artifact string
+    State    string // same values as ComponentStatus.State
+}
+
+// ComponentLister is implemented by plugins that can enumerate what they run,
+// so the ERA can reconcile its records after a restart. Plugins advertise it
+// with the "list" capability.
+type ComponentLister interface {
+    List() ([]RuntimeComponent, error)
+}
+
+// Image is an artifact held in a plugin's local store.
+type Image struct {
+    Ref  string
+    Size int64
+}
+
+// ImageStore is implemented by plugins that keep pulled artifacts on disk and
+// can release them. Plugins advertise it with the "gc" capability.
+type ImageStore interface {
+    Images() ([]Image, error)
+    // RemoveImage deletes ref and returns the bytes it accounted for.
+    RemoveImage(ref string) (int64, error)
+}
+
+// LogWriters hands out the writers a component's stdout and stderr are
+// attached to.
+type LogWriters interface {
+    Open(component string) (stdout, stderr io.Writer, err error)
+}
+
+// LogCapturer is implemented by plugins that can forward component output.
+// Plugins advertise it with the "logs" capability.
+type LogCapturer interface {
+    SetLogWriters(LogWriters)
+}
+
 // type RuntimePlugin interface {
 //     Install(c era.ComponentSpec) error
 //     Start(c era.ComponentSpec) error
@@ -152,9 +152,11 @@ func New(service string) *zap.SugaredLogger {
 }
 
 func ensureInit() {
+	// Init takes mu itself
 	mu.Lock()
-	defer mu.Unlock()
-	if !inited {
+	done := inited
+	mu.Unlock()
+	if !done {
 		// default initialization
 		_ = Init(Options{Env: "dev"})
 	}
@@ -7,6 +7,11 @@ const (
     StateInstalling DeploymentStage = "installing"
     StateInstalled  DeploymentStage = "installed"
     StateFailed     DeploymentStage = "failed"
+
+    // reported by the ERA's supervisor after install
+    StateUnhealthy  DeploymentStage = "unhealthy"
+    StateRestarting DeploymentStage = "restarting"
+    StateRecovered  DeploymentStage = "recovered"
 )
 
 type DeploymentStatus struct {
@@ -31,6 +36,9 @@ type DeploymentComponent struct {
     SpecHash    string        `json:"spec_hash"`
     HostID      string        `json:"host_id"`
     DeploymentID string       `json:"deployment_id"`
+    // ReportedAt is when the host observed State (unix ns, by the host's
+    // clock); the CO orders a component's reports on it
+    ReportedAt   int64        `json:"reported_at,omitempty"`
 }
 
 type StatusError struct {
@@ -0,0 +1,12 @@
+package model
+
+// GCReport is published by an ERA on gc.<site>.<host> after each collection run.
+type GCReport struct {
+	SiteID         string   `json:"site_id"`
+	HostID         string   `json:"host_id"`
+	Reason         string   `json:"reason"` // schedule or disk-pressure
+	Removed        []string `json:"removed,omitempty"`
+	Failed         []string `json:"failed,omitempty"`
+	ReclaimedBytes int64    `json:"reclaimed_bytes"`
+	Timestamp      int64    `json:"timestamp"`
+}
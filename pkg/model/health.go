@@ -4,8 +4,43 @@ type HealthMsg struct {
 	NodeID     string  `json:"node_id"`
 	SiteID     string  `json:"site_id"`
 	CPUPercent float64 `json:"cpu_percent"`
-	MemMB      float64 `json:"mem_mb"`
+	MemMB      float64 `json:"mem_mb"` // memory in use
 	Timestamp  int64   `json:"timestamp"`
 	Runtime    string  `json:"runtime"`
 	Region     string  `json:"region"`
+
+	Version      string   `json:"version,omitempty"`
+	Capabilities []string `json:"capabilities,omitempty"`
+	// IntervalSeconds is the longest gap between heartbeats while the host
+	// is healthy (interval plus jitter).
+	IntervalSeconds int `json:"interval_seconds,omitempty"`
+
+	CPUCount      int            `json:"cpu_count,omitempty"`
+	MemTotalMB    float64        `json:"mem_total_mb,omitempty"`
+	Load          LoadAvg        `json:"load"`
+	Disk          DiskUsage      `json:"disk"`
+	Interfaces    []NetInterface `json:"interfaces,omitempty"`
+	UptimeSeconds int64          `json:"uptime_seconds,omitempty"`
+}
+
+type LoadAvg struct {
+	Load1  float64 `json:"load1"`
+	Load5  float64 `json:"load5"`
+	Load15 float64 `json:"load15"`
+}
+
+type DiskUsage struct {
+	Path        string  `json:"path,omitempty"`
+	TotalBytes  uint64  `json:"total_bytes"`
+	FreeBytes   uint64  `json:"free_bytes"`
+	UsedPercent float64 `json:"used_percent"`
+}
+
+type NetInterface struct {
+	Name    string   `json:"name"`
+	Up      bool     `json:"up"`
+	MAC     string   `json:"mac,omitempty"`
+	Addrs   []string `json:"addrs,omitempty"`
+	RxBytes uint64   `json:"rx_bytes"`
+	TxBytes uint64   `json:"tx_bytes"`
 }
@@ -0,0 +1,63 @@
+package model
+
+// HardwareInventory is the static hardware of a host, collected by the ERA at
+// registration and re-sent when it changes. Device types use the same names as
+// application profile peripherals and interfaces (camera, serial, usb, gpu,
+// ...), so placement can match them directly.
+type HardwareInventory struct {
+	HostID string `json:"host_id,omitempty"`
+	SiteID string `json:"site_id,omitempty"`
+
+	CPU          CPUInventory  `json:"cpu"`
+	MemTotalMB   uint64        `json:"mem_total_mb"`
+	BlockDevices []BlockDevice `json:"block_devices,omitempty"`
+	NICs         []NIC         `json:"nics,omitempty"`
+	Devices      []Device      `json:"devices,omitempty"`
+
+	// Hash identifies the content above; collectors compare it to detect
+	// changes.
+	Hash        string `json:"hash,omitempty"`
+	CollectedAt int64  `json:"collected_at,omitempty"`
+}
+
+type CPUInventory struct {
+	Model string `json:"model,omitempty"`
+	Arch  string `json:"arch"`
+	Cores int    `json:"cores"`
+}
+
+type BlockDevice struct {
+	Name       string `json:"name"`
+	SizeBytes  uint64 `json:"size_bytes"`
+	Model      string `json:"model,omitempty"`
+	Rotational bool   `json:"rotational"`
+	Removable  bool   `json:"removable"`
+}
+
+type NIC struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"` // ethernet, wifi or virtual
+	MAC       string `json:"mac,omitempty"`
+	SpeedMbps int    `json:"speed_mbps,omitempty"`
+	Driver    string `json:"driver,omitempty"`
+}
+
+type Device struct {
+	Type         string `json:"type"`
+	Path         string `json:"path,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Model        string `json:"model,omitempty"`
+	VendorID     string `json:"vendor_id,omitempty"`
+	ProductID    string `json:"product_id,omitempty"`
+}
+
+// HasDevice reports whether the inventory lists a device of type t.
+func (inv HardwareInventory) HasDevice(t string) bool {
+	for _, d := range inv.Devices {
+		if d.Type == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package model
+
+// LogRequest asks an ERA for a component's captured output. It is published by
+// the LO on site.<site>.logs.<host>; the ERA answers with LogChunks on Reply.
+type LogRequest struct {
+	StreamID  string `json:"stream_id"`
+	Component string `json:"component"`
+	Tail      int    `json:"tail"`
+	Follow    bool   `json:"follow"`
+	Reply     string `json:"reply"`
+}
+
+// LogCancel stops a follow stream. Published on site.<site>.logs.<host>.cancel.
+type LogCancel struct {
+	StreamID string `json:"stream_id"`
+}
+
+type LogLine struct {
+	Time   int64  `json:"time"`   // unix nanoseconds
+	Stream string `json:"stream"` // stdout or stderr
+	Text   string `json:"text"`
+}
+
+// LogChunk carries a batch of lines for one stream. EOF marks the last chunk;
+// Error is set when the ERA could not serve the request.
+type LogChunk struct {
+	StreamID string    `json:"stream_id"`
+	Lines    []LogLine `json:"lines,omitempty"`
+	EOF      bool      `json:"eof,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
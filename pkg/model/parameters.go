@@ -0,0 +1,44 @@
+package model
+
+import "strings"
+
+// Parameter target kinds understood by the ERA.
+const (
+	// /env/<NAME> sets an environment variable.
+	ParamTargetEnv = "env"
+	// /files/<path> writes the value to a read-only file mounted at <path>
+	// inside the component. The path is one JSON-pointer token, so "/" in it
+	// is escaped as "~1": /files/~1etc~1app~1mode -> /etc/app/mode.
+	ParamTargetFile = "files"
+)
+
+// ParameterValue is a deployment parameter resolved for one component.
+type ParameterValue struct {
+	Name    string `json:"name"`
+	Pointer string `json:"pointer"`
+	Value   string `json:"value"`
+}
+
+// ParseParameterPointer splits a JSON pointer (RFC 6901) into its target kind
+// and unescaped key. ok is false for pointers the ERA cannot apply.
+func ParseParameterPointer(pointer string) (kind, key string, ok bool) {
+	if !strings.HasPrefix(pointer, "/") {
+		return "", "", false
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	if len(tokens) != 2 || tokens[1] == "" {
+		return "", "", false
+	}
+	kind, key = tokens[0], unescapePointerToken(tokens[1])
+	switch kind {
+	case ParamTargetEnv:
+		return kind, key, true
+	case ParamTargetFile:
+		return kind, key, strings.HasPrefix(key, "/")
+	}
+	return "", "", false
+}
+
+func unescapePointerToken(t string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+}
@@ -3,11 +3,18 @@ package model
 import (
 	"time"
 	"github.com/google/uuid"
+
+	"github.com/balaji-balu/margo-hello-world/pkg/application"
+	"github.com/balaji-balu/margo-hello-world/pkg/deployment"
 )
 
 type Host struct {
 	ID    string `json:"id"`
 	Alive bool   `json:"alive"`
+	// Health is the latest heartbeat, with the host's telemetry.
+	Health *HealthMsg `json:"health,omitempty"`
+	// Inventory is the host's hardware as last reported by its ERA.
+	Inventory *HardwareInventory `json:"inventory,omitempty"`
 }
 
 //
@@ -23,6 +30,11 @@ type ComponentSpec struct {
 		Wait       *bool  `yaml:"wait,omitempty"`
 		Timeout    string `yaml:"timeout,omitempty"`
 		NodeSelector map[string]string `yaml:"nodeSelector,omitempty"`
+		Env        map[string]string   `yaml:"env,omitempty"`
+		Mounts     []application.Mount `yaml:"mounts,omitempty"`
+		LivenessProbe  *application.Probe `yaml:"livenessProbe,omitempty"`
+		ReadinessProbe *application.Probe `yaml:"readinessProbe,omitempty"`
+		DependsOn      []string           `yaml:"dependsOn,omitempty"`
 	} `yaml:"properties"`
 }
 
@@ -41,7 +53,9 @@ type ApplicationDeployment struct {
 		DeploymentProfile struct {
 			Type       string          `yaml:"type"`
 			Components []ComponentSpec `yaml:"components"`
+			RequiredResources *application.Resources `yaml:"requiredResources,omitempty"`
 		} `yaml:"deploymentProfile"`
+		Parameters []deployment.Parameter `yaml:"parameters,omitempty"`
 	} `yaml:"spec"`
 }
 
@@ -53,6 +67,52 @@ type Component struct {
 	Repository string `json:"repository"`
 	PackageURL string `json:"package_url"`
 	KeyURL string `json:"key_url"`
+	Env    map[string]string `json:"env,omitempty"`
+	Mounts []Mount           `json:"mounts,omitempty"`
+	Parameters []ParameterValue `json:"parameters,omitempty"`
+	Liveness   *Probe           `json:"liveness,omitempty"`
+	Readiness  *Probe           `json:"readiness,omitempty"`
+	// DependsOn names components of the same app started before this one.
+	DependsOn  []string         `json:"depends_on,omitempty"`
+}
+
+// Probe is a component health check as shipped to the ERA. Timings are in
+// seconds; exactly one of HTTPGet, TCPPort and Exec is set.
+type Probe struct {
+	HTTPGet          *HTTPGetProbe `json:"http_get,omitempty"`
+	TCPPort          int           `json:"tcp_port,omitempty"`
+	Exec             []string      `json:"exec,omitempty"`
+	InitialDelay     int           `json:"initial_delay,omitempty"`
+	Period           int           `json:"period,omitempty"`
+	Timeout          int           `json:"timeout,omitempty"`
+	FailureThreshold int           `json:"failure_threshold,omitempty"`
+	SuccessThreshold int           `json:"success_threshold,omitempty"`
+}
+
+type HTTPGetProbe struct {
+	Path   string `json:"path,omitempty"`
+	Port   int    `json:"port"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+type Mount struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	ReadOnly bool   `json:"read_only,omitempty"`
+}
+
+// Resources is the profile's requiredResources as shipped to the ERA. Limits
+// apply to every component of the app.
+type Resources struct {
+	CPUCores    float64      `json:"cpu_cores,omitempty"`
+	Memory      string       `json:"memory,omitempty"`
+	Peripherals []Peripheral `json:"peripherals,omitempty"`
+}
+
+type Peripheral struct {
+	Type         string `json:"type"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Model        string `json:"model,omitempty"`
 }
 
 type App struct {
@@ -60,6 +120,7 @@ type App struct {
 	Version    string               `json:"version"`
 	DepType	   string 				`json:"dep_type"`	
 	Components map[string]Component `json:"components"`
+	Resources  *Resources           `json:"resources,omitempty"`
 }
 
 //
@@ -126,4 +187,23 @@ type DeploymentComponentStatus struct {
 	Status        string
 	Message       string
 	LastUpdate    time.Time
+}
+//
+//---------------- ERA actual-state snapshot ----------------
+//
+// ActualSnapshot is the full state an ERA reports on actual.<site>.<host>
+// after startup reconciliation. The LO replaces its actual state for the host
+// with it.
+type ActualSnapshot struct {
+	SiteID    string        `json:"site_id"`
+	HostID    string        `json:"host_id"`
+	Apps      []SnapshotApp `json:"apps"`
+	Adopted   []string      `json:"adopted,omitempty"` // runtime components no app owns
+	Timestamp int64         `json:"timestamp"`
+}
+
+type SnapshotApp struct {
+	DeploymentID string            `json:"deployment_id"`
+	App          App               `json:"app"`
+	States       map[string]string `json:"states"` // component -> Running, Stopped, ...
 }
\ No newline at end of file
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// SiteOutcome is how one revision of a deployment fared at a site, as its
+// LO reported it.
+type SiteOutcome struct {
+	State     string                 `json:"state"`
+	Error     StatusError            `json:"error"`
+	Hosts     map[string]HostOutcome `json:"hosts,omitempty"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// HostOutcome is how one revision fared on a host: the state of each of
+// its components there, and the worst of them.
+type HostOutcome struct {
+	State      string            `json:"state"`
+	Components map[string]string `json:"components"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Host liveness as its LO reports it to the CO.
+const (
+	HostOnline  = "online"
+	HostOffline = "offline"
+	HostUnknown = "unknown"
+)
+
+// Site status, rolled up by the CO from the liveness of the site's hosts.
+const (
+	SiteOnline   = "online"   // every host is online
+	SiteDegraded = "degraded" // some hosts are online
+	SiteOffline  = "offline"  // no host is online
+	SiteUnknown  = "unknown"  // no hosts reported yet
+	SiteStale    = "stale"    // the LO stopped syncing; host status is old
+)
+
+// SiteSync is what an LO tells the CO about its site: who runs it and which
+// hosts it has. The first sync registers the site; the host list replaces
+// the one the CO has.
+type SiteSync struct {
+	SiteID       string           `json:"site_id"`
+	Name         string           `json:"name,omitempty"`
+	Location     string           `json:"location,omitempty"`
+	Orchestrator OrchestratorSync `json:"orchestrator"`
+	Hosts        []HostSync       `json:"hosts"`
+}
+
+// OrchestratorSync identifies the LO running a site.
+type OrchestratorSync struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Region      string `json:"region,omitempty"`
+	APIEndpoint string `json:"api_endpoint,omitempty"`
+}
+
+type HostSync struct {
+	HostID    string             `json:"host_id"`
+	Status    string             `json:"status"`
+	LastSeen  time.Time          `json:"last_seen,omitempty"`
+	Runtime   string             `json:"runtime,omitempty"`
+	IPAddress string             `json:"ip_address,omitempty"`
+	CPUFree   float64            `json:"cpu_free,omitempty"`
+	Inventory *HardwareInventory `json:"inventory,omitempty"`
+}